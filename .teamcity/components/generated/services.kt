@@ -45,6 +45,7 @@ var services = mapOf(
         "elastic" to "Elastic",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
+        "fabric" to "Fabric",
         "firewall" to "Firewall",
         "fluidrelay" to "Fluid Relay",
         "frontdoor" to "FrontDoor",
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/claims"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type ResourceManagerAccount struct {
@@ -22,15 +23,22 @@ type ResourceManagerAccount struct {
 	SubscriptionId string
 	TenantId       string
 
+	// AuxiliaryTenantIds are the tenants which tokens are also obtained for, to allow requests which reference
+	// resources in these tenants (e.g. a Virtual Network Peering to a Virtual Network in another tenant)
+	AuxiliaryTenantIds []string
+
 	AuthenticatedAsAServicePrincipal bool
 	SkipResourceProviderRegistration bool
 
+	// ResourceProvidersToRegister is the set of Resource Providers which are automatically registered
+	ResourceProvidersToRegister map[string]struct{}
+
 	// TODO: delete these when no longer needed by older clients
 	AzureEnvironment azure.Environment
 }
 
-func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, subscriptionId string, skipResourceProviderRegistration bool, azureEnvironment azure.Environment) (*ResourceManagerAccount, error) {
-	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, config, config.Environment.MicrosoftGraph)
+func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, oidcTokenProvider common.OIDCTokenProvider, subscriptionId string, skipResourceProviderRegistration bool, azureEnvironment azure.Environment) (*ResourceManagerAccount, error) {
+	authorizer, err := newAuthorizer(ctx, config, oidcTokenProvider, config.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
 	}
@@ -131,6 +139,8 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 		SubscriptionId: subscriptionId,
 		TenantId:       tenantId,
 
+		AuxiliaryTenantIds: config.AuxiliaryTenantIDs,
+
 		AuthenticatedAsAServicePrincipal: authenticatedAsServicePrincipal,
 		SkipResourceProviderRegistration: skipResourceProviderRegistration,
 
@@ -17,8 +17,13 @@ import (
 )
 
 type ClientBuilder struct {
-	AuthConfig *auth.Credentials
-	Features   features.UserFeatures
+	AuthConfig        *auth.Credentials
+	Features          features.UserFeatures
+	OIDCTokenProvider common.OIDCTokenProvider
+	RetryPolicy       *common.RetryPolicy
+
+	// ResourceProvidersToRegister is the set of Resource Providers which are automatically registered
+	ResourceProvidersToRegister map[string]struct{}
 
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
@@ -26,10 +31,15 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 
 	CustomCorrelationRequestID string
+	DiagnosticsLogFilePath     string
 	MetadataHost               string
 	PartnerID                  string
 	SubscriptionID             string
 	TerraformVersion           string
+
+	// TelemetryEndpoint is the OTLP/HTTP endpoint which a span for each request is exported to, when set
+	TelemetryEndpoint string
+	TelemetryHeaders  map[string]string
 }
 
 const azureStackEnvironmentError = `
@@ -58,23 +68,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if _, ok := builder.AuthConfig.Environment.Synapse.ResourceIdentifier(); ok {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -83,7 +93,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if _, ok := builder.AuthConfig.Environment.Batch.ResourceIdentifier(); ok {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -93,7 +103,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -108,15 +118,39 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 	resourceManagerEndpoint, _ := builder.AuthConfig.Environment.ResourceManager.Endpoint()
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.SkipProviderRegistration, *azureEnvironment)
+	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.OIDCTokenProvider, builder.SubscriptionID, builder.SkipProviderRegistration, *azureEnvironment)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
+	account.ResourceProvidersToRegister = builder.ResourceProvidersToRegister
+
 	client := Client{
 		Account: account,
 	}
 
+	var diagnostics *common.DiagnosticsLogger
+	if builder.DiagnosticsLogFilePath != "" {
+		diagnostics, err = common.NewDiagnosticsLogger(builder.DiagnosticsLogFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("building diagnostics logger: %+v", err)
+		}
+	}
+
+	var telemetry *common.TelemetryExporter
+	if builder.TelemetryEndpoint != "" {
+		telemetry, err = common.NewTelemetryExporter(builder.TelemetryEndpoint, builder.TelemetryHeaders)
+		if err != nil {
+			return nil, fmt.Errorf("building telemetry exporter: %+v", err)
+		}
+
+		// export any pending spans when Terraform stops the Provider
+		go func() {
+			<-ctx.Done()
+			telemetry.Flush()
+		}()
+	}
+
 	o := &common.ClientOptions{
 		Authorizers: &common.Authorizers{
 			BatchManagement: batchManagementAuth,
@@ -147,6 +181,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
+		Diagnostics: diagnostics,
+		RetryPolicy: builder.RetryPolicy,
+		Telemetry:   telemetry,
+
 		// TODO: remove when `Azure/go-autorest` is no longer used
 		AzureEnvironment:        *azureEnvironment,
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
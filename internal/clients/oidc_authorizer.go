@@ -0,0 +1,82 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"golang.org/x/oauth2"
+)
+
+// newAuthorizer builds an Authorizer for the specified API. When an OIDC Token Provider is configured (and neither a
+// Client Certificate, Client Secret nor a static OIDC token have been specified) the ID token is obtained from that
+// provider, otherwise this defers to the authentication methods supported by the SDK.
+func newAuthorizer(ctx context.Context, config auth.Credentials, tokenProvider common.OIDCTokenProvider, api environments.Api) (auth.Authorizer, error) {
+	if tokenProvider != nil && useOIDCTokenProvider(config) {
+		return auth.NewCachedAuthorizer(&oidcTokenProviderAuthorizer{
+			provider: tokenProvider,
+			options: auth.OIDCAuthorizerOptions{
+				Environment:        config.Environment,
+				Api:                api,
+				TenantId:           config.TenantID,
+				AuxiliaryTenantIds: config.AuxiliaryTenantIDs,
+				ClientId:           config.ClientID,
+			},
+		})
+	}
+
+	return auth.NewAuthorizerFromCredentials(ctx, config, api)
+}
+
+func useOIDCTokenProvider(config auth.Credentials) bool {
+	if !config.EnableAuthenticationUsingOIDC || strings.TrimSpace(config.TenantID) == "" || strings.TrimSpace(config.ClientID) == "" {
+		return false
+	}
+
+	// these take precedence, matching the order used by the SDK
+	hasClientCertificate := config.EnableAuthenticatingUsingClientCertificate && (len(config.ClientCertificateData) > 0 || strings.TrimSpace(config.ClientCertificatePath) != "")
+	hasClientSecret := config.EnableAuthenticatingUsingClientSecret && strings.TrimSpace(config.ClientSecret) != ""
+	hasOIDCToken := strings.TrimSpace(config.OIDCAssertionToken) != ""
+
+	return !hasClientCertificate && !hasClientSecret && !hasOIDCToken
+}
+
+var _ auth.Authorizer = &oidcTokenProviderAuthorizer{}
+
+// oidcTokenProviderAuthorizer obtains a fresh ID token from the OIDC Token Provider each time an access token is
+// requested, which is then exchanged for an access token using federated credentials
+type oidcTokenProviderAuthorizer struct {
+	provider common.OIDCTokenProvider
+	options  auth.OIDCAuthorizerOptions
+}
+
+func (a *oidcTokenProviderAuthorizer) tokenSource(ctx context.Context) (auth.Authorizer, error) {
+	idToken, err := a.provider.IDToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an OIDC token from %s: %+v", a.provider.Name(), err)
+	}
+
+	options := a.options
+	options.FederatedAssertion = idToken
+	return auth.NewOIDCAuthorizer(ctx, options)
+}
+
+func (a *oidcTokenProviderAuthorizer) Token(ctx context.Context, req *http.Request) (*oauth2.Token, error) {
+	source, err := a.tokenSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return source.Token(ctx, req)
+}
+
+func (a *oidcTokenProviderAuthorizer) AuxiliaryTokens(ctx context.Context, req *http.Request) ([]*oauth2.Token, error) {
+	source, err := a.tokenSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return source.AuxiliaryTokens(ctx, req)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -47,6 +48,15 @@ type ClientOptions struct {
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
 
+	// RetryPolicy optionally overrides how requests are retried, when nil the default behaviour of each SDK is used
+	RetryPolicy *RetryPolicy
+
+	// Diagnostics optionally traces each request/response to a file
+	Diagnostics *DiagnosticsLogger
+
+	// Telemetry optionally exports a span for each request to an OpenTelemetry collector
+	Telemetry *TelemetryExporter
+
 	// Keep these around for convenience with Autorest based clients, remove when we are no longer using autorest
 	AzureEnvironment        azure.Environment
 	ResourceManagerEndpoint string
@@ -72,12 +82,29 @@ func (o ClientOptions) Configure(c *resourcemanager.Client, authorizer auth.Auth
 		}
 		requestMiddlewares = append(requestMiddlewares, correlationRequestIDMiddleware(id))
 	}
+	if o.RetryPolicy != nil || o.Diagnostics != nil {
+		requestMiddlewares = append(requestMiddlewares, bufferRequestBodyMiddleware())
+	}
+	if o.Telemetry != nil {
+		requestMiddlewares = append(requestMiddlewares, telemetryRequestMiddleware())
+	}
 	requestMiddlewares = append(requestMiddlewares, requestLoggerMiddleware("AzureRM"))
 	c.RequestMiddlewares = &requestMiddlewares
 
-	c.ResponseMiddlewares = &[]client.ResponseMiddleware{
-		responseLoggerMiddleware("AzureRM"),
+	responseMiddlewares := make([]client.ResponseMiddleware, 0)
+	if o.Diagnostics != nil {
+		responseMiddlewares = append(responseMiddlewares, diagnosticsResponseMiddleware(o.Diagnostics))
 	}
+	if o.Telemetry != nil {
+		responseMiddlewares = append(responseMiddlewares, telemetryResponseMiddleware(o.Telemetry))
+	}
+	if o.RetryPolicy != nil {
+		// this runs after the diagnostics and telemetry middlewares, which trace the first attempt - any further attempts
+		// are traced by the Sender used to resend the request
+		responseMiddlewares = append(responseMiddlewares, retryPolicyResponseMiddleware(*o.RetryPolicy, o.retrySender()))
+	}
+	responseMiddlewares = append(responseMiddlewares, responseLoggerMiddleware("AzureRM"))
+	c.ResponseMiddlewares = &responseMiddlewares
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -85,7 +112,18 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = providerSender()
+	if o.Diagnostics != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withDiagnostics(o.Diagnostics))
+	}
+	if o.Telemetry != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withTelemetry(o.Telemetry))
+	}
+	if o.RetryPolicy != nil {
+		// the retries of go-autorest itself are left as-is, since these wrap the Sender and so would multiply the
+		// number of attempts made by the RetryPolicy
+		c.Sender = autorest.DecorateSender(c.Sender, withRetryPolicy(*o.RetryPolicy))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -96,6 +134,33 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 }
 
+// retrySender returns the Sender used to resend requests made by the hashicorp/go-azure-sdk clients, which is decorated
+// in the same way as the Sender of the go-autorest clients so that each attempt is traced
+func (o ClientOptions) retrySender() autorest.Sender {
+	s := providerSender()
+	if o.Diagnostics != nil {
+		s = autorest.DecorateSender(s, withDiagnostics(o.Diagnostics))
+	}
+	if o.Telemetry != nil {
+		s = autorest.DecorateSender(s, withTelemetry(o.Telemetry))
+	}
+	return s
+}
+
+var (
+	providerSenderInstance autorest.Sender
+	providerSenderOnce     sync.Once
+)
+
+// providerSender returns the Sender shared by the go-autorest clients and used to resend requests made by the
+// hashicorp/go-azure-sdk clients, so that they share a single transport
+func providerSender() autorest.Sender {
+	providerSenderOnce.Do(func() {
+		providerSenderInstance = sender.BuildSender("AzureRM")
+	})
+	return providerSenderInstance
+}
+
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
@@ -0,0 +1,394 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveHeaders are the (canonicalised) HTTP headers whose values are never written to the diagnostics log
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":                  {},
+	"Ocp-Apim-Subscription-Key":      {},
+	"Proxy-Authorization":            {},
+	"X-Ms-Authorization-Auxiliary":   {},
+	"X-Ms-Copy-Source-Authorization": {},
+}
+
+// sensitiveFields are (lower-cased) JSON fields whose values are redacted from request/response bodies for every API
+var sensitiveFields = map[string]struct{}{
+	"access_token":              {},
+	"accesstoken":               {},
+	"adminpassword":             {},
+	"client_secret":             {},
+	"clientsecret":              {},
+	"connectionstring":          {},
+	"password":                  {},
+	"primaryconnectionstring":   {},
+	"primarykey":                {},
+	"refresh_token":             {},
+	"sastoken":                  {},
+	"secondaryconnectionstring": {},
+	"secondarykey":              {},
+	"secret":                    {},
+}
+
+// sensitiveFieldPattern matches (lower-cased) JSON fields whose string values are redacted for every API, which covers
+// the keys returned by the `listKeys` and `regenerateKey` API's of most services (e.g. `key1`, `primaryMasterKey`,
+// `sharedAccessKey`, `aliasPrimaryConnectionString`) without needing to list them individually
+var sensitiveFieldPattern = regexp.MustCompile(`(key\d*|connectionstring|password|secret|token)$`)
+
+// nonSensitiveSecretResponseFields are the (lower-cased) JSON fields which are still logged from the responses of
+// API's which return secrets, since these describe the secret rather than containing it
+var nonSensitiveSecretResponseFields = map[string]struct{}{
+	"id":          {},
+	"keyname":     {},
+	"name":        {},
+	"permissions": {},
+	"type":        {},
+}
+
+// sensitiveQueryParameters are the query string parameters of a SAS token which are redacted from logged URLs
+var sensitiveQueryParameters = []string{
+	"se",
+	"sig",
+	"sp",
+}
+
+// dataPlaneSecretHosts are the host suffixes of data plane API's where the `value` field of a body contains a secret,
+// such as a Key Vault Secret or an App Configuration Key
+var dataPlaneSecretHosts = []string{
+	".vault.azure.net",
+	".vault.azure.cn",
+	".vault.usgovcloudapi.net",
+	".managedhsm.azure.net",
+	".azconfig.io",
+	".azconfig.azure.cn",
+	".azconfig.azure.us",
+}
+
+// DiagnosticsLogger writes a structured (JSON) trace of each HTTP request/response made to Azure to a file, with any
+// credentials and secrets redacted.
+type DiagnosticsLogger struct {
+	correlationId string
+
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewDiagnosticsLogger returns a DiagnosticsLogger which appends to the file at the specified path, creating it if needed
+func NewDiagnosticsLogger(path string) (*DiagnosticsLogger, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening diagnostics log file %q: %+v", path, err)
+	}
+
+	return newDiagnosticsLogger(file), nil
+}
+
+func newDiagnosticsLogger(writer io.Writer) *DiagnosticsLogger {
+	return &DiagnosticsLogger{
+		correlationId: correlationRequestID(),
+		writer:        writer,
+	}
+}
+
+type diagnosticsEntry struct {
+	Timestamp     string               `json:"timestamp"`
+	CorrelationId string               `json:"correlation_id"`
+	DurationMs    int64                `json:"duration_ms,omitempty"`
+	Request       diagnosticsRequest   `json:"request"`
+	Response      *diagnosticsResponse `json:"response,omitempty"`
+	Error         string               `json:"error,omitempty"`
+}
+
+type diagnosticsRequest struct {
+	Method  string              `json:"method"`
+	Url     string              `json:"url"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    interface{}         `json:"body,omitempty"`
+}
+
+type diagnosticsResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       interface{}         `json:"body,omitempty"`
+}
+
+// log writes an entry for the request and response, where requestBody has been read from the request beforehand
+func (l *DiagnosticsLogger) log(req *http.Request, requestBody []byte, resp *http.Response, duration time.Duration, requestErr error) {
+	correlationId := req.Header.Get(HeaderCorrelationRequestID)
+	if correlationId == "" {
+		correlationId = l.correlationId
+	}
+
+	redactValue := isDataPlaneSecretHost(req.URL.Hostname())
+	entry := diagnosticsEntry{
+		Timestamp:     time.Now().UTC().Format(time.RFC3339Nano),
+		CorrelationId: correlationId,
+		DurationMs:    duration.Milliseconds(),
+		Request: diagnosticsRequest{
+			Method:  req.Method,
+			Url:     redactUrl(req.URL),
+			Headers: redactHeaders(req.Header),
+			Body:    redactBody(requestBody, redactValue),
+		},
+	}
+
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+
+	if resp != nil {
+		var responseBody []byte
+		if resp.Body != nil {
+			var err error
+			responseBody, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+			if err != nil {
+				entry.Error = fmt.Sprintf("reading response body: %+v", err)
+			}
+		}
+
+		redactedResponseBody := redactBody(responseBody, redactValue)
+		if isSecretsRequest(req) {
+			redactedResponseBody = redactSecretsResponseBody(responseBody)
+		}
+
+		entry.Response = &diagnosticsResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+			Body:       redactedResponseBody,
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.writer.Write(append(line, '\n'))
+}
+
+// withDiagnostics returns a SendDecorator which traces requests sent by go-autorest to the DiagnosticsLogger.
+func withDiagnostics(logger *DiagnosticsLogger) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			var body []byte
+			if r.Body != nil {
+				var err error
+				body, err = io.ReadAll(r.Body)
+				if err != nil {
+					return nil, fmt.Errorf("reading request body: %+v", err)
+				}
+				r.Body.Close()
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			start := time.Now()
+			resp, err := s.Do(r)
+			logger.log(r, body, resp, time.Since(start), err)
+			return resp, err
+		})
+	}
+}
+
+// diagnosticsResponseMiddleware traces requests sent by hashicorp/go-azure-sdk to the DiagnosticsLogger, this relies on
+// bufferRequestBodyMiddleware to be able to read the request body.
+func diagnosticsResponseMiddleware(logger *DiagnosticsLogger) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		var body []byte
+		if request.GetBody != nil {
+			if reader, err := request.GetBody(); err == nil {
+				body, _ = io.ReadAll(reader)
+				reader.Close()
+			}
+		}
+
+		logger.log(request, body, response, 0, nil)
+		return response, nil
+	}
+}
+
+func isDataPlaneSecretHost(host string) bool {
+	host = strings.ToLower(host)
+	for _, suffix := range dataPlaneSecretHosts {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretsRequest returns whether the request is to an API which returns secrets, such as `listKeys`, `listSecrets`
+// or `regenerateKey` - the responses of which are redacted entirely rather than by field name
+func isSecretsRequest(req *http.Request) bool {
+	if req.Method != http.MethodPost || req.URL == nil {
+		return false
+	}
+
+	segments := strings.Split(strings.TrimSuffix(req.URL.Path, "/"), "/")
+	operation := strings.ToLower(segments[len(segments)-1])
+	if !strings.HasPrefix(operation, "list") && !strings.HasPrefix(operation, "regenerate") {
+		return false
+	}
+
+	return strings.Contains(operation, "key") || strings.Contains(operation, "secret") || strings.Contains(operation, "credential") || strings.Contains(operation, "connectionstring")
+}
+
+// redactUrl returns the URL with the values of any SAS token query string parameters redacted
+func redactUrl(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	query := input.Query()
+	redacted := false
+	for key := range query {
+		for _, param := range sensitiveQueryParameters {
+			if strings.EqualFold(key, param) {
+				query[key] = []string{redactedValue}
+				redacted = true
+			}
+		}
+	}
+	if !redacted {
+		return input.String()
+	}
+
+	output := *input
+	output.RawQuery = query.Encode()
+	return output.String()
+}
+
+func redactHeaders(input http.Header) map[string][]string {
+	output := make(map[string][]string, len(input))
+	for k, v := range input {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]; ok {
+			output[k] = []string{redactedValue}
+			continue
+		}
+		output[k] = v
+	}
+	return output
+}
+
+// redactBody returns the body with any sensitive fields redacted - bodies which aren't JSON are returned as a string
+func redactBody(input []byte, redactValue bool) interface{} {
+	if len(input) == 0 {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(input, &body); err != nil {
+		if redactValue {
+			return redactedValue
+		}
+		return string(input)
+	}
+
+	return redactJson(body, redactValue)
+}
+
+// redactSecretsResponseBody returns the body with every string value redacted, other than the fields which describe
+// the secret (e.g. `keyName` and `permissions`)
+func redactSecretsResponseBody(input []byte) interface{} {
+	if len(input) == 0 {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(input, &body); err != nil {
+		return redactedValue
+	}
+
+	return redactJsonStrings(body)
+}
+
+func redactJsonStrings(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if _, ok := nonSensitiveSecretResponseFields[strings.ToLower(key)]; ok && !isJsonCollection(val) {
+				continue
+			}
+			v[key] = redactJsonStrings(val)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJsonStrings(val)
+		}
+		return v
+
+	case string:
+		return redactedValue
+	}
+
+	return input
+}
+
+func redactJson(input interface{}, redactValue bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSensitiveField(key, val, redactValue) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJson(val, redactValue)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJson(val, redactValue)
+		}
+		return v
+	}
+
+	return input
+}
+
+// isJsonCollection returns whether the value is a JSON object/array, for example the `value` field of a List response
+func isJsonCollection(input interface{}) bool {
+	switch input.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+func isSensitiveField(key string, value interface{}, redactValue bool) bool {
+	lowerKey := strings.ToLower(key)
+	if _, ok := sensitiveFields[lowerKey]; ok {
+		return true
+	}
+	if isJsonCollection(value) {
+		return false
+	}
+	if redactValue && lowerKey == "value" {
+		return true
+	}
+
+	// `key` on its own is the name of an App Configuration Key rather than a secret
+	_, isString := value.(string)
+	return isString && lowerKey != "key" && sensitiveFieldPattern.MatchString(lowerKey)
+}
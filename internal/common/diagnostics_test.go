@@ -0,0 +1,223 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestDiagnosticsRedactsHeaders(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newDiagnosticsLogger(buf)
+
+	sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"properties":{"primaryKey":"hunter2","name":"example"}}`)),
+		}, nil
+	}), withDiagnostics(logger))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set(HeaderCorrelationRequestID, "abc123")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// the response body must still be readable by the caller
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "hunter2") {
+		t.Fatalf("expected the response body to be unchanged but got %q", string(body))
+	}
+
+	var entry diagnosticsEntry
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("unmarshalling entry: %+v", err)
+	}
+	if entry.CorrelationId != "abc123" {
+		t.Fatalf("expected the correlation ID `abc123` but got %q", entry.CorrelationId)
+	}
+	if v := entry.Request.Headers["Authorization"]; len(v) != 1 || v[0] != redactedValue {
+		t.Fatalf("expected the Authorization header to be redacted but got %+v", v)
+	}
+	if strings.Contains(buf.String(), "hunter2") || strings.Contains(buf.String(), "Bearer token") {
+		t.Fatalf("expected secrets to be redacted but got %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "example") {
+		t.Fatalf("expected non-sensitive fields to be logged but got %q", buf.String())
+	}
+}
+
+func TestDiagnosticsRedactsDataPlaneValues(t *testing.T) {
+	testData := []struct {
+		host     string
+		redacted bool
+	}{
+		{
+			host:     "example.vault.azure.net",
+			redacted: true,
+		},
+		{
+			host:     "example.azconfig.io",
+			redacted: true,
+		},
+		{
+			host:     "management.azure.com",
+			redacted: false,
+		},
+	}
+
+	for _, v := range testData {
+		buf := &bytes.Buffer{}
+		logger := newDiagnosticsLogger(buf)
+
+		req := &http.Request{
+			Method: http.MethodPut,
+			URL:    &url.URL{Scheme: "https", Host: v.host, Path: "/secrets/example"},
+			Header: http.Header{},
+		}
+		logger.log(req, []byte(`{"value":"s3cr3t","tags":{"env":"test"}}`), nil, 0, nil)
+
+		if actual := !strings.Contains(buf.String(), "s3cr3t"); actual != v.redacted {
+			t.Fatalf("expected redacted to be %t for %q but got %q", v.redacted, v.host, buf.String())
+		}
+		if !strings.Contains(buf.String(), `"env":"test"`) {
+			t.Fatalf("expected non-sensitive fields to be logged for %q but got %q", v.host, buf.String())
+		}
+	}
+}
+
+func TestDiagnosticsRedactsSecretsResponses(t *testing.T) {
+	testData := []struct {
+		name     string
+		path     string
+		body     string
+		secrets  []string
+		retained []string
+	}{
+		{
+			name:     "storage account listKeys",
+			path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			body:     `{"keys":[{"keyName":"key1","value":"storagekey1","permissions":"FULL"},{"keyName":"key2","value":"storagekey2","permissions":"FULL"}]}`,
+			secrets:  []string{"storagekey1", "storagekey2"},
+			retained: []string{`"keyName":"key1"`, `"permissions":"FULL"`},
+		},
+		{
+			name:    "cognitive account listKeys",
+			path:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.CognitiveServices/accounts/account1/listKeys",
+			body:    `{"key1":"cognitivekey1","key2":"cognitivekey2"}`,
+			secrets: []string{"cognitivekey1", "cognitivekey2"},
+		},
+		{
+			name:    "cosmosdb account listKeys",
+			path:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.DocumentDB/databaseAccounts/account1/listKeys",
+			body:    `{"primaryMasterKey":"cosmoskey1","secondaryMasterKey":"cosmoskey2","primaryReadonlyMasterKey":"cosmoskey3"}`,
+			secrets: []string{"cosmoskey1", "cosmoskey2", "cosmoskey3"},
+		},
+		{
+			name:     "function app listSecrets",
+			path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Web/sites/site1/functions/function1/listSecrets",
+			body:     `{"key":"functionkey","trigger_url":"https://site1.azurewebsites.net/api/function1?code=functionkey"}`,
+			secrets:  []string{"functionkey"},
+			retained: []string{`"key"`},
+		},
+	}
+
+	for _, v := range testData {
+		buf := &bytes.Buffer{}
+		logger := newDiagnosticsLogger(buf)
+
+		req := &http.Request{
+			Method: http.MethodPost,
+			URL:    &url.URL{Scheme: "https", Host: "management.azure.com", Path: v.path},
+			Header: http.Header{},
+		}
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(v.body)),
+		}
+		logger.log(req, nil, resp, 0, nil)
+
+		for _, secret := range v.secrets {
+			if strings.Contains(buf.String(), secret) {
+				t.Fatalf("%s: expected %q to be redacted but got %q", v.name, secret, buf.String())
+			}
+		}
+		for _, retained := range v.retained {
+			if !strings.Contains(buf.String(), retained) {
+				t.Fatalf("%s: expected %q to be logged but got %q", v.name, retained, buf.String())
+			}
+		}
+	}
+}
+
+func TestDiagnosticsRedactsKeyFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newDiagnosticsLogger(buf)
+
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Scheme: "https", Host: "management.azure.com", Path: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Relay/namespaces/ns1/authorizationRules/rule1"},
+		Header: http.Header{},
+	}
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"properties":{"sharedAccessKey":"sharedkey","aliasPrimaryConnectionString":"Endpoint=sb://example;SharedAccessKey=aliaskey","rights":["Listen"]}}`)),
+	}
+	logger.log(req, nil, resp, 0, nil)
+
+	for _, secret := range []string{"sharedkey", "aliaskey"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Fatalf("expected %q to be redacted but got %q", secret, buf.String())
+		}
+	}
+	if !strings.Contains(buf.String(), `"rights":["Listen"]`) {
+		t.Fatalf("expected non-sensitive fields to be logged but got %q", buf.String())
+	}
+}
+
+func TestDiagnosticsRedactsSasTokenFromUrl(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newDiagnosticsLogger(buf)
+
+	input, _ := url.Parse("https://account1.blob.core.windows.net/container1/blob1?sv=2021-08-06&sp=rw&se=2030-01-01T00%3A00%3A00Z&sig=c2lnbmF0dXJl&comp=block")
+	req := &http.Request{
+		Method: http.MethodPut,
+		URL:    input,
+		Header: http.Header{},
+	}
+	logger.log(req, nil, nil, 0, nil)
+
+	var entry diagnosticsEntry
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("unmarshalling entry: %+v", err)
+	}
+
+	actual, err := url.Parse(entry.Request.Url)
+	if err != nil {
+		t.Fatalf("parsing the logged URL %q: %+v", entry.Request.Url, err)
+	}
+	query := actual.Query()
+	for _, param := range []string{"sig", "se", "sp"} {
+		if v := query.Get(param); v != redactedValue {
+			t.Fatalf("expected the `%s` query parameter to be redacted but got %q", param, v)
+		}
+	}
+	if v := query.Get("comp"); v != "block" {
+		t.Fatalf("expected the `comp` query parameter to be logged but got %q", v)
+	}
+	if strings.Contains(buf.String(), "c2lnbmF0dXJl") {
+		t.Fatalf("expected the signature to be redacted but got %q", buf.String())
+	}
+}
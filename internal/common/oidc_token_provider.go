@@ -0,0 +1,162 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// OIDCTokenProvider returns an OIDC ID token which can be exchanged for an access token using federated credentials.
+// Implementations are called each time a new access token is required, so that short-lived ID tokens can be refreshed.
+type OIDCTokenProvider interface {
+	// IDToken returns a current OIDC ID token
+	IDToken(ctx context.Context) (string, error)
+
+	// Name returns a description of where the ID token is obtained from, used in error messages
+	Name() string
+}
+
+var (
+	_ OIDCTokenProvider = OIDCTokenFileProvider{}
+	_ OIDCTokenProvider = OIDCTokenCommandProvider{}
+	_ OIDCTokenProvider = AzureDevOpsOIDCTokenProvider{}
+)
+
+// OIDCTokenFileProvider reads the ID token from a file, which is re-read each time a token is requested so that the
+// file can be refreshed by an external process (e.g. a projected Kubernetes service account token)
+type OIDCTokenFileProvider struct {
+	Path string
+}
+
+func (p OIDCTokenFileProvider) IDToken(_ context.Context) (string, error) {
+	b, err := os.ReadFile(p.Path)
+	if err != nil {
+		return "", fmt.Errorf("reading OIDC token from %q: %+v", p.Path, err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("the OIDC token file %q was empty", p.Path)
+	}
+
+	return token, nil
+}
+
+func (p OIDCTokenFileProvider) Name() string {
+	return fmt.Sprintf("file %q", p.Path)
+}
+
+// OIDCTokenCommandProvider runs a command using the platform shell and uses its standard output as the ID token
+type OIDCTokenCommandProvider struct {
+	Command string
+}
+
+func (p OIDCTokenCommandProvider) IDToken(ctx context.Context) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", p.Command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running OIDC token command: %+v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("the OIDC token command returned no output")
+	}
+
+	return token, nil
+}
+
+func (p OIDCTokenCommandProvider) Name() string {
+	return "command"
+}
+
+// AzureDevOpsOIDCTokenProvider requests an ID token for an Azure DevOps Service Connection which uses Workload Identity
+// Federation, from the OIDC endpoint exposed to Azure Pipelines (`SYSTEM_OIDCREQUESTURI`)
+type AzureDevOpsOIDCTokenProvider struct {
+	// RequestURL is the Azure DevOps OIDC endpoint, usually the value of the `SYSTEM_OIDCREQUESTURI` environment variable
+	RequestURL string
+
+	// RequestToken is the bearer token for the request, usually the value of the `SYSTEM_ACCESSTOKEN` environment variable
+	RequestToken string
+
+	// ServiceConnectionID is the ID of the Azure Resource Manager Service Connection to request an ID token for
+	ServiceConnectionID string
+
+	// HTTPClient is used to send the request, defaulting to http.DefaultClient when nil
+	HTTPClient *http.Client
+}
+
+func (p AzureDevOpsOIDCTokenProvider) IDToken(ctx context.Context) (string, error) {
+	u, err := url.Parse(p.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing the Azure DevOps OIDC request URL %q: %+v", p.RequestURL, err)
+	}
+
+	query := u.Query()
+	if query.Get("api-version") == "" {
+		query.Set("api-version", "7.1")
+	}
+	query.Set("serviceConnectionId", p.ServiceConnectionID)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("building the Azure DevOps OIDC token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.RequestToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting an OIDC token from Azure DevOps: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("reading the Azure DevOps OIDC token response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting an OIDC token from Azure DevOps: received HTTP status %d with response: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		OIDCToken *string `json:"oidcToken"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("parsing the Azure DevOps OIDC token response: %+v", err)
+	}
+
+	if result.OIDCToken == nil || *result.OIDCToken == "" {
+		return "", fmt.Errorf("the Azure DevOps OIDC token response did not contain an `oidcToken`")
+	}
+
+	return *result.OIDCToken, nil
+}
+
+func (p AzureDevOpsOIDCTokenProvider) Name() string {
+	return fmt.Sprintf("Azure DevOps Service Connection %q", p.ServiceConnectionID)
+}
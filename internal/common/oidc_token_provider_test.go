@@ -0,0 +1,176 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestOIDCTokenFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	provider := OIDCTokenFileProvider{
+		Path: path,
+	}
+
+	if _, err := provider.IDToken(context.TODO()); err == nil {
+		t.Fatalf("expected an error when the file doesn't exist")
+	}
+
+	if err := os.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+	token, err := provider.IDToken(context.TODO())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if token != "first" {
+		t.Fatalf("expected the token to be %q but got %q", "first", token)
+	}
+
+	// the file should be re-read for each token
+	if err := os.WriteFile(path, []byte("second"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+	token, err = provider.IDToken(context.TODO())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if token != "second" {
+		t.Fatalf("expected the token to be %q but got %q", "second", token)
+	}
+
+	if err := os.WriteFile(path, []byte("  \n"), 0600); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+	if _, err := provider.IDToken(context.TODO()); err == nil {
+		t.Fatalf("expected an error when the file is empty")
+	}
+}
+
+func TestOIDCTokenCommandProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on Windows")
+	}
+
+	testData := []struct {
+		Command  string
+		Expected string
+		Error    bool
+	}{
+		{
+			Command:  "echo some-token",
+			Expected: "some-token",
+		},
+		{
+			Command: "true",
+			Error:   true,
+		},
+		{
+			Command: "echo some-token && exit 1",
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Command)
+
+		token, err := OIDCTokenCommandProvider{Command: v.Command}.IDToken(context.TODO())
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if token != v.Expected {
+			t.Fatalf("expected the token to be %q but got %q", v.Expected, token)
+		}
+	}
+}
+
+func TestAzureDevOpsOIDCTokenProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Query().Get("serviceConnectionId") {
+		case "valid":
+			if r.URL.Query().Get("api-version") != "7.1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"oidcToken":"some-token"}`))
+		case "empty":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testData := []struct {
+		ServiceConnectionID string
+		RequestToken        string
+		Expected            string
+		Error               bool
+	}{
+		{
+			ServiceConnectionID: "valid",
+			RequestToken:        "request-token",
+			Expected:            "some-token",
+		},
+		{
+			ServiceConnectionID: "valid",
+			RequestToken:        "wrong-token",
+			Error:               true,
+		},
+		{
+			ServiceConnectionID: "empty",
+			RequestToken:        "request-token",
+			Error:               true,
+		},
+		{
+			ServiceConnectionID: "missing",
+			RequestToken:        "request-token",
+			Error:               true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.ServiceConnectionID)
+
+		provider := AzureDevOpsOIDCTokenProvider{
+			RequestURL:          server.URL,
+			RequestToken:        v.RequestToken,
+			ServiceConnectionID: v.ServiceConnectionID,
+			HTTPClient:          server.Client(),
+		}
+		token, err := provider.IDToken(context.TODO())
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if token != v.Expected {
+			t.Fatalf("expected the token to be %q but got %q", v.Expected, token)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// maxRetryDelay caps the exponential backoff between attempts, regardless of the base delay which is configured
+const maxRetryDelay = 5 * time.Minute
+
+// RetryPolicy configures how requests to the Azure APIs are retried when a retryable status code is returned,
+// this is applied to both the go-autorest and the hashicorp/go-azure-sdk based clients.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is sent, including the initial attempt.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, which is doubled for each subsequent retry.
+	BaseDelay time.Duration
+
+	// RetryableStatusCodes are the HTTP Status Codes which should cause a request to be retried.
+	RetryableStatusCodes []int
+
+	// RespectRetryAfter determines whether the `Retry-After` header returned by the API takes precedence
+	// over the exponential backoff.
+	RespectRetryAfter bool
+}
+
+func (p RetryPolicy) shouldRetry(resp *http.Response, attempt int) bool {
+	if resp == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	for _, statusCode := range p.RetryableStatusCodes {
+		if resp.StatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// delay returns how long to wait before the next attempt, where attempt is the number of attempts made so far
+func (p RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if p.RespectRetryAfter && resp != nil {
+		if v := resp.Header.Get("Retry-After"); v != "" {
+			if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+			if t, err := http.ParseTime(v); err == nil {
+				if d := time.Until(t); d > 0 {
+					return d
+				}
+				return 0
+			}
+		}
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if delay > float64(maxRetryDelay) {
+		return maxRetryDelay
+	}
+	return time.Duration(delay)
+}
+
+// wait blocks for the backoff delay, returning an error if the request is cancelled in the meantime
+func (p RetryPolicy) wait(req *http.Request, resp *http.Response, attempt int) error {
+	delay := p.delay(resp, attempt)
+	log.Printf("[DEBUG] Retrying %s %s after receiving a %d (attempt %d of %d) in %s", req.Method, req.URL, resp.StatusCode, attempt+1, p.MaxAttempts, delay)
+
+	// drain and close the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+// withRetryPolicy returns a SendDecorator which retries requests sent by go-autorest according to the RetryPolicy.
+func withRetryPolicy(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if err != nil || !policy.shouldRetry(resp, attempt) {
+					return resp, err
+				}
+
+				if err := policy.wait(r, resp, attempt); err != nil {
+					return nil, err
+				}
+			}
+		})
+	}
+}
+
+// bufferRequestBodyMiddleware buffers the request body so that it can be read again by response middlewares, for
+// example to resend the request in retryPolicyResponseMiddleware.
+func bufferRequestBodyMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if request.Body == nil || request.GetBody != nil {
+			return request, nil
+		}
+
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		request.Body.Close()
+
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		return request, nil
+	}
+}
+
+// sdkRetriesResponse returns whether hashicorp/go-azure-sdk has already retried this response using its own built-in
+// retry policy, which (in the vendored version) always retries throttled requests and server errors and can't be
+// configured. The same conditions as the SDK's retry policy (go-retryablehttp's DefaultRetryPolicy) are used.
+func sdkRetriesResponse(resp *http.Response) bool {
+	return resp.StatusCode == 0 || resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented)
+}
+
+// retryPolicyResponseMiddleware resends requests made by hashicorp/go-azure-sdk according to the RetryPolicy using the
+// specified Sender, which should be the Sender used by the Provider decorated in the same way as the go-autorest clients
+// (see retrySender) so that each attempt is traced.
+//
+// Responses which the SDK retries itself are returned as-is, since resending these would multiply the SDK's attempts
+// by the RetryPolicy's. Other responses have only been sent once, so the RetryPolicy's MaxAttempts is the total number
+// of attempts made for these.
+func retryPolicyResponseMiddleware(policy RetryPolicy, sender autorest.Sender) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if response == nil || sdkRetriesResponse(response) {
+			return response, nil
+		}
+
+		for attempt := 1; policy.shouldRetry(response, attempt); attempt++ {
+			if request.Body != nil && request.GetBody == nil {
+				// the body can't be replayed, so return the response we've got
+				return response, nil
+			}
+
+			if err := policy.wait(request, response, attempt); err != nil {
+				return nil, err
+			}
+
+			// the headers are cloned, so the replayed request keeps the Authorization and Correlation ID headers
+			retry := request.Clone(request.Context())
+			if request.GetBody != nil {
+				body, err := request.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("rewinding request body: %+v", err)
+				}
+				retry.Body = body
+			}
+
+			var err error
+			response, err = sender.Do(retry)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return response, nil
+	}
+}
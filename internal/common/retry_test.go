@@ -0,0 +1,227 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:       5,
+		BaseDelay:         time.Second,
+		RespectRetryAfter: true,
+	}
+
+	if actual := policy.delay(nil, 1); actual != time.Second {
+		t.Fatalf("expected a delay of 1s for the first retry but got %s", actual)
+	}
+	if actual := policy.delay(nil, 3); actual != 4*time.Second {
+		t.Fatalf("expected a delay of 4s for the third retry but got %s", actual)
+	}
+	if actual := policy.delay(nil, 20); actual != maxRetryDelay {
+		t.Fatalf("expected the delay to be capped at %s but got %s", maxRetryDelay, actual)
+	}
+
+	resp := &http.Response{
+		Header: http.Header{
+			"Retry-After": []string{"7"},
+		},
+	}
+	if actual := policy.delay(resp, 1); actual != 7*time.Second {
+		t.Fatalf("expected the Retry-After header to be used but got %s", actual)
+	}
+
+	policy.RespectRetryAfter = false
+	if actual := policy.delay(resp, 1); actual != time.Second {
+		t.Fatalf("expected the Retry-After header to be ignored but got %s", actual)
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("expected the request body to be replayed on attempt %d but got %q", attempts, string(body))
+		}
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests},
+	}
+	sender := autorest.DecorateSender(server.Client(), withRetryPolicy(policy))
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts but got %d", attempts)
+	}
+}
+
+func TestRetryPolicyMiddleware(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("expected the request body to be replayed on attempt %d but got %q", attempts, string(body))
+		}
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxAttempts:          4,
+		BaseDelay:            time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusConflict},
+	}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, io.NopCloser(strings.NewReader("payload")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err = bufferRequestBodyMiddleware()(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = retryPolicyResponseMiddleware(policy, server.Client())(req, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected a 409 but got %d", resp.StatusCode)
+	}
+	if attempts != 4 {
+		t.Fatalf("expected 4 attempts but got %d", attempts)
+	}
+}
+
+func TestRetryPolicyMiddlewareLeavesSdkRetries(t *testing.T) {
+	for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable} {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(statusCode)
+		}))
+
+		policy := RetryPolicy{
+			MaxAttempts:          4,
+			BaseDelay:            time.Millisecond,
+			RetryableStatusCodes: []int{statusCode},
+		}
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// these have already been retried by go-azure-sdk, so resending them would multiply the number of attempts
+		resp, err = retryPolicyResponseMiddleware(policy, server.Client())(req, resp)
+		server.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if resp.StatusCode != statusCode {
+			t.Fatalf("expected a %d but got %d", statusCode, resp.StatusCode)
+		}
+		if attempts != 1 {
+			t.Fatalf("expected a %d to be sent once but got %d attempts", statusCode, attempts)
+		}
+	}
+}
+
+func TestRetrySenderTracesEachAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	options := ClientOptions{
+		Diagnostics: newDiagnosticsLogger(&log),
+	}
+	policy := RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusConflict},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(HeaderCorrelationRequestID, "00000000-0000-0000-0000-000000000000")
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = retryPolicyResponseMiddleware(policy, options.retrySender())(req, resp); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// the first attempt is logged by the diagnostics response middleware, the two replays by the retry Sender
+	entries := strings.Split(strings.TrimSpace(log.String()), "\n")
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 replayed attempts to be logged but got %d", len(entries))
+	}
+	for _, entry := range entries {
+		if !strings.Contains(entry, "00000000-0000-0000-0000-000000000000") {
+			t.Fatalf("expected the replayed attempt to keep the Correlation ID but got %s", entry)
+		}
+	}
+}
+
+func TestConfigureClientRetryPolicyLeavesAutorestRetries(t *testing.T) {
+	options := ClientOptions{
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:          5,
+			BaseDelay:            time.Second,
+			RetryableStatusCodes: []int{http.StatusTooManyRequests},
+		},
+	}
+
+	c := autorest.NewClientWithUserAgent("")
+	options.ConfigureClient(&c, autorest.NullAuthorizer{})
+
+	// the RetryPolicy is applied by the Sender, so the retries of go-autorest itself must not be multiplied by it
+	if c.RetryAttempts != autorest.DefaultRetryAttempts {
+		t.Fatalf("expected RetryAttempts to be the default of %d but got %d", autorest.DefaultRetryAttempts, c.RetryAttempts)
+	}
+	if c.RetryDuration != autorest.DefaultRetryDuration {
+		t.Fatalf("expected RetryDuration to be the default of %s but got %s", autorest.DefaultRetryDuration, c.RetryDuration)
+	}
+}
@@ -0,0 +1,394 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-provider-azurerm/version"
+)
+
+const (
+	// telemetryBatchSize is the number of spans which triggers an export, regardless of the interval
+	telemetryBatchSize = 100
+
+	// telemetryExportInterval is the maximum time a span is held before being exported
+	telemetryExportInterval = 2 * time.Second
+
+	// otlpSpanKindClient and the status codes are defined by the OTLP trace protobuf
+	otlpSpanKindClient = 3
+	otlpStatusCodeOk   = 1
+	otlpStatusError    = 2
+)
+
+// telemetryHeaders are the (canonicalised) response headers which are recorded on each span, since these are useful to
+// determine whether time was spent being throttled
+var telemetryHeaders = []string{
+	"Retry-After",
+	"X-Ms-Ratelimit-Remaining-Resource",
+	"X-Ms-Ratelimit-Remaining-Subscription-Deletes",
+	"X-Ms-Ratelimit-Remaining-Subscription-Reads",
+	"X-Ms-Ratelimit-Remaining-Subscription-Writes",
+	"X-Ms-Ratelimit-Remaining-Tenant-Reads",
+	"X-Ms-Ratelimit-Remaining-Tenant-Writes",
+	"X-Ms-Request-Id",
+}
+
+type telemetryStartTimeKey struct{}
+
+var (
+	// telemetryExporters tracks each TelemetryExporter which has been created, so that any pending spans can be
+	// exported when the Provider is shutting down (see FlushTelemetry)
+	telemetryExporters     []*TelemetryExporter
+	telemetryExportersLock sync.Mutex
+)
+
+// TelemetryExporter records a span for each HTTP request made to Azure and exports these in batches to an OpenTelemetry
+// collector using the OTLP/HTTP (JSON) protocol. All spans created by a single instance of the Provider share a Trace ID.
+type TelemetryExporter struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+	traceId  string
+
+	mu      sync.Mutex
+	pending []otlpSpan
+	timer   *time.Timer
+
+	// exporting tracks the batches which are being exported in the background
+	exporting sync.WaitGroup
+}
+
+// NewTelemetryExporter returns a TelemetryExporter which sends spans to the `/v1/traces` path of the specified endpoint,
+// including the specified headers (for example for authentication) in each export request
+func NewTelemetryExporter(endpoint string, headers map[string]string) (*TelemetryExporter, error) {
+	traceId, err := randomHex(16)
+	if err != nil {
+		return nil, fmt.Errorf("generating trace id: %+v", err)
+	}
+
+	exporter := &TelemetryExporter{
+		endpoint: strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		headers:  headers,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		traceId:  traceId,
+	}
+
+	telemetryExportersLock.Lock()
+	telemetryExporters = append(telemetryExporters, exporter)
+	telemetryExportersLock.Unlock()
+
+	return exporter, nil
+}
+
+// FlushTelemetry exports any pending spans from each TelemetryExporter, waiting for these to be sent. This is called
+// when the Provider is stopped, since spans are otherwise exported in batches and would be lost when the process exits.
+func FlushTelemetry() {
+	telemetryExportersLock.Lock()
+	exporters := telemetryExporters
+	telemetryExportersLock.Unlock()
+
+	for _, exporter := range exporters {
+		exporter.Flush()
+	}
+}
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string             `json:"key"`
+	Value otlpAttributeValue `json:"value"`
+}
+
+type otlpAttributeValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpAttributeValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int64) otlpAttribute {
+	// int64 values are encoded as strings in the JSON encoding of OTLP
+	v := strconv.FormatInt(value, 10)
+	return otlpAttribute{Key: key, Value: otlpAttributeValue{IntValue: &v}}
+}
+
+// record adds a span for the request and response to the pending batch
+func (e *TelemetryExporter) record(req *http.Request, resp *http.Response, start, end time.Time, requestErr error) {
+	spanId, err := randomHex(8)
+	if err != nil {
+		return
+	}
+
+	service, operation := telemetryOperation(req)
+	attributes := []otlpAttribute{
+		stringAttribute("azure.service", service),
+		stringAttribute("azure.operation", operation),
+		stringAttribute("http.method", req.Method),
+		stringAttribute("server.address", req.URL.Hostname()),
+		intAttribute("duration_ms", end.Sub(start).Milliseconds()),
+	}
+	if v := req.URL.Query().Get("api-version"); v != "" {
+		attributes = append(attributes, stringAttribute("azure.api_version", v))
+	}
+	if v := req.Header.Get(HeaderCorrelationRequestID); v != "" {
+		attributes = append(attributes, stringAttribute("azure.correlation_request_id", v))
+	}
+
+	status := otlpStatus{Code: otlpStatusCodeOk}
+	if requestErr != nil {
+		status = otlpStatus{Code: otlpStatusError, Message: requestErr.Error()}
+	}
+
+	if resp != nil {
+		attributes = append(attributes, intAttribute("http.status_code", int64(resp.StatusCode)))
+		for _, header := range telemetryHeaders {
+			if v := resp.Header.Get(header); v != "" {
+				attributes = append(attributes, stringAttribute(fmt.Sprintf("http.response.header.%s", strings.ToLower(header)), v))
+			}
+		}
+
+		if resp.StatusCode >= 400 && requestErr == nil {
+			status = otlpStatus{Code: otlpStatusError, Message: resp.Status}
+		}
+	}
+
+	span := otlpSpan{
+		TraceId:           e.traceId,
+		SpanId:            spanId,
+		Name:              operation,
+		Kind:              otlpSpanKindClient,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attributes,
+		Status:            status,
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.pending = append(e.pending, span)
+	if len(e.pending) >= telemetryBatchSize {
+		spans := e.takePending()
+		e.exporting.Add(1)
+		go func() {
+			defer e.exporting.Done()
+			e.export(spans)
+		}()
+		return
+	}
+	if e.timer == nil {
+		e.timer = time.AfterFunc(telemetryExportInterval, e.Flush)
+	}
+}
+
+// takePending returns and clears the pending batch, the caller must hold the lock
+func (e *TelemetryExporter) takePending() []otlpSpan {
+	spans := e.pending
+	e.pending = nil
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	}
+	return spans
+}
+
+// Flush exports any pending spans, and waits for any batches which are being exported in the background
+func (e *TelemetryExporter) Flush() {
+	e.mu.Lock()
+	spans := e.takePending()
+	e.mu.Unlock()
+
+	e.export(spans)
+	e.exporting.Wait()
+}
+
+func (e *TelemetryExporter) export(spans []otlpSpan) {
+	if len(spans) == 0 {
+		return
+	}
+
+	payload := otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{
+						stringAttribute("service.name", "terraform-provider-azurerm"),
+						stringAttribute("service.version", version.ProviderVersion),
+					},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{
+							Name:    "github.com/hashicorp/terraform-provider-azurerm/internal/common",
+							Version: version.ProviderVersion,
+						},
+						Spans: spans,
+					},
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("[DEBUG] marshalling telemetry: %+v", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("[DEBUG] building telemetry export request: %+v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	// failing to export telemetry shouldn't fail the apply, so any errors are only logged
+	resp, err := e.client.Do(req)
+	if err != nil {
+		log.Printf("[DEBUG] exporting %d telemetry spans to %q: %+v", len(spans), e.endpoint, err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Printf("[DEBUG] exporting %d telemetry spans to %q: unexpected status %d", len(spans), e.endpoint, resp.StatusCode)
+	}
+}
+
+// telemetryOperation returns the service and operation name for the request - for Resource Manager requests the service is
+// the Resource Provider and the operation is the method and the Resource Type (and action, if any), for example
+// `PUT Microsoft.Compute/virtualMachines` or `POST Microsoft.Compute/virtualMachines/start`. For data plane requests the
+// service is the host without the account name, for example `vault.azure.net`.
+func telemetryOperation(req *http.Request) (string, string) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	providersIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+1 < len(segments) {
+			providersIndex = i
+		}
+	}
+
+	if providersIndex == -1 {
+		service := req.URL.Hostname()
+		if strings.HasPrefix(strings.ToLower(service), "management.") {
+			// requests which aren't scoped to a Resource Provider, such as Resource Groups and Subscriptions
+			service = "Microsoft.Resources"
+			types := make([]string, 0)
+			for i := 0; i < len(segments); i += 2 {
+				if segments[i] != "" {
+					types = append(types, segments[i])
+				}
+			}
+			return service, fmt.Sprintf("%s %s/%s", req.Method, service, strings.Join(types, "/"))
+		}
+
+		if parts := strings.SplitN(service, ".", 2); len(parts) == 2 {
+			service = parts[1]
+		}
+		return service, fmt.Sprintf("%s %s", req.Method, service)
+	}
+
+	service := segments[providersIndex+1]
+	types := []string{service}
+	for i := providersIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return service, fmt.Sprintf("%s %s", req.Method, strings.Join(types, "/"))
+}
+
+// withTelemetry returns a SendDecorator which records a span for each request sent by go-autorest.
+func withTelemetry(exporter *TelemetryExporter) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := s.Do(r)
+			exporter.record(r, resp, start, time.Now(), err)
+			return resp, err
+		})
+	}
+}
+
+// telemetryRequestMiddleware stores the time the request was sent by hashicorp/go-azure-sdk, so that the duration can
+// be calculated by telemetryResponseMiddleware.
+func telemetryRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		return request.WithContext(context.WithValue(request.Context(), telemetryStartTimeKey{}, time.Now())), nil
+	}
+}
+
+// telemetryResponseMiddleware records a span for each request sent by hashicorp/go-azure-sdk.
+func telemetryResponseMiddleware(exporter *TelemetryExporter) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		end := time.Now()
+		start, ok := request.Context().Value(telemetryStartTimeKey{}).(time.Time)
+		if !ok {
+			start = end
+		}
+
+		exporter.record(request, response, start, end, nil)
+		return response, nil
+	}
+}
+
+func randomHex(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
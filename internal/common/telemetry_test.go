@@ -0,0 +1,190 @@
+package common
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestTelemetryOperation(t *testing.T) {
+	testData := []struct {
+		method    string
+		url       string
+		service   string
+		operation string
+	}{
+		{
+			method:    http.MethodPut,
+			url:       "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1?api-version=2022-08-01",
+			service:   "Microsoft.Compute",
+			operation: "PUT Microsoft.Compute/virtualMachines",
+		},
+		{
+			method:    http.MethodPost,
+			url:       "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/start",
+			service:   "Microsoft.Compute",
+			operation: "POST Microsoft.Compute/virtualMachines/start",
+		},
+		{
+			// nested resources use the innermost Resource Provider
+			method:    http.MethodGet,
+			url:       "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/acct1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			service:   "Microsoft.Insights",
+			operation: "GET Microsoft.Insights/diagnosticSettings",
+		},
+		{
+			method:    http.MethodGet,
+			url:       "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			service:   "Microsoft.Resources",
+			operation: "GET Microsoft.Resources/subscriptions/resourceGroups",
+		},
+		{
+			method:    http.MethodGet,
+			url:       "https://vault1.vault.azure.net/secrets/secret1",
+			service:   "vault.azure.net",
+			operation: "GET vault.azure.net",
+		},
+	}
+
+	for _, v := range testData {
+		req, _ := http.NewRequest(v.method, v.url, nil)
+		service, operation := telemetryOperation(req)
+		if service != v.service {
+			t.Fatalf("expected the service %q for %q but got %q", v.service, v.url, service)
+		}
+		if operation != v.operation {
+			t.Fatalf("expected the operation %q for %q but got %q", v.operation, v.url, operation)
+		}
+	}
+}
+
+func TestTelemetryExportsSpans(t *testing.T) {
+	received := make(chan otlpExportRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("expected the path `/v1/traces` but got %q", r.URL.Path)
+		}
+		if v := r.Header.Get("Authorization"); v != "Bearer collector" {
+			t.Errorf("expected the configured headers to be sent but got %q", v)
+		}
+
+		var payload otlpExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding payload: %+v", err)
+		}
+		received <- payload
+	}))
+	defer collector.Close()
+
+	exporter, err := NewTelemetryExporter(collector.URL+"/", map[string]string{"Authorization": "Bearer collector"})
+	if err != nil {
+		t.Fatalf("building exporter: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Status:     "429 Too Many Requests",
+			Header: http.Header{
+				"Retry-After": []string{"10"},
+				"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"0"},
+			},
+			Body: io.NopCloser(strings.NewReader("")),
+		}, nil
+	}), withTelemetry(exporter))
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/vnet1?api-version=2022-07-01", nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	exporter.Flush()
+
+	payload := <-received
+	if len(payload.ResourceSpans) != 1 || len(payload.ResourceSpans[0].ScopeSpans) != 1 || len(payload.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("expected a single span but got %+v", payload)
+	}
+
+	span := payload.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if span.Name != "PUT Microsoft.Network/virtualNetworks" {
+		t.Fatalf("expected the span name `PUT Microsoft.Network/virtualNetworks` but got %q", span.Name)
+	}
+	if span.Status.Code != otlpStatusError {
+		t.Fatalf("expected a throttled request to have an error status but got %d", span.Status.Code)
+	}
+	if len(span.TraceId) != 32 || len(span.SpanId) != 16 {
+		t.Fatalf("expected a 16 byte trace id and 8 byte span id but got %q / %q", span.TraceId, span.SpanId)
+	}
+
+	attributes := make(map[string]string)
+	for _, attr := range span.Attributes {
+		switch {
+		case attr.Value.StringValue != nil:
+			attributes[attr.Key] = *attr.Value.StringValue
+		case attr.Value.IntValue != nil:
+			attributes[attr.Key] = *attr.Value.IntValue
+		}
+	}
+
+	expected := map[string]string{
+		"azure.service":                    "Microsoft.Network",
+		"azure.api_version":                "2022-07-01",
+		"http.status_code":                 "429",
+		"http.response.header.retry-after": "10",
+		"http.response.header.x-ms-ratelimit-remaining-subscription-writes": "0",
+	}
+	for k, v := range expected {
+		if attributes[k] != v {
+			t.Fatalf("expected the attribute %q to be %q but got %q", k, v, attributes[k])
+		}
+	}
+}
+
+func TestFlushTelemetryExportsPendingSpans(t *testing.T) {
+	var exported int64
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload otlpExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding payload: %+v", err)
+		}
+		for _, resourceSpans := range payload.ResourceSpans {
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				atomic.AddInt64(&exported, int64(len(scopeSpans.Spans)))
+			}
+		}
+	}))
+	defer collector.Close()
+
+	exporter, err := NewTelemetryExporter(collector.URL, nil)
+	if err != nil {
+		t.Fatalf("building exporter: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}), withTelemetry(exporter))
+
+	// one full batch which is exported in the background, plus a partial batch which is pending
+	total := telemetryBatchSize + 5
+	for i := 0; i < total; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1?api-version=2022-09-01", nil)
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	FlushTelemetry()
+
+	if v := atomic.LoadInt64(&exported); v != int64(total) {
+		t.Fatalf("expected %d spans to be exported once flushed but got %d", total, v)
+	}
+}
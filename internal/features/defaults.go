@@ -34,9 +34,17 @@ func Default() UserFeatures {
 		ManagedDisk: ManagedDiskFeatures{
 			ExpandWithoutDowntime: true,
 		},
+		ProtectedResource: ProtectedResourceFeatures{
+			TagName:       "",
+			TagValue:      "true",
+			AllowDeletion: false,
+		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: true,
 		},
+		Storage: StorageFeatures{
+			DataPlaneAccessEnabled: true,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
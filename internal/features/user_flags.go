@@ -12,6 +12,8 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
 	ManagedDisk            ManagedDiskFeatures
+	ProtectedResource      ProtectedResourceFeatures
+	Storage                StorageFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -63,6 +65,12 @@ type ApplicationInsightFeatures struct {
 	DisableGeneratedRule bool
 }
 
+type ProtectedResourceFeatures struct {
+	TagName       string
+	TagValue      string
+	AllowDeletion bool
+}
+
 type ManagedDiskFeatures struct {
 	ExpandWithoutDowntime bool
 }
@@ -71,3 +79,7 @@ type AppConfigurationFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 	RecoverSoftDeleted       bool
 }
+
+type StorageFeatures struct {
+	DataPlaneAccessEnabled bool
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
@@ -250,6 +251,32 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"protected_resource": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tag_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"tag_value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "true",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"allow_deletion": {
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						DefaultFunc: schema.EnvDefaultFunc("ARM_ALLOW_PROTECTED_RESOURCE_DELETION", false),
+					},
+				},
+			},
+		},
+
 		"resource_group": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -279,6 +306,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_plane_access_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -446,6 +488,22 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["protected_resource"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			protectedResourceRaw := items[0].(map[string]interface{})
+			if v, ok := protectedResourceRaw["tag_name"]; ok {
+				featuresMap.ProtectedResource.TagName = v.(string)
+			}
+			if v, ok := protectedResourceRaw["tag_value"]; ok {
+				featuresMap.ProtectedResource.TagValue = v.(string)
+			}
+			if v, ok := protectedResourceRaw["allow_deletion"]; ok {
+				featuresMap.ProtectedResource.AllowDeletion = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["resource_group"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -466,5 +524,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_access_enabled"]; ok {
+				featuresMap.Storage.DataPlaneAccessEnabled = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
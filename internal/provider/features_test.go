@@ -62,6 +62,14 @@ func TestExpandFeatures(t *testing.T) {
 					RollInstancesWhenRequired: true,
 					ScaleToZeroOnDelete:       true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: true,
+				},
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "",
+					TagValue:      "true",
+					AllowDeletion: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -121,6 +129,18 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": true,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_access_enabled": true,
+						},
+					},
+					"protected_resource": []interface{}{
+						map[string]interface{}{
+							"tag_name":       "protected",
+							"tag_value":      "yes",
+							"allow_deletion": true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": true,
@@ -179,6 +199,14 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: true,
+				},
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "protected",
+					TagValue:      "yes",
+					AllowDeletion: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -251,6 +279,18 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": false,
 						},
 					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_access_enabled": false,
+						},
+					},
+					"protected_resource": []interface{}{
+						map[string]interface{}{
+							"tag_name":       "protected",
+							"tag_value":      "true",
+							"allow_deletion": false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
 							"prevent_deletion_if_contains_resources": false,
@@ -309,6 +349,14 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: false,
 				},
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: false,
+				},
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "protected",
+					TagValue:      "true",
+					AllowDeletion: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
@@ -1203,3 +1251,143 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesProtectedResource(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protected_resource": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "",
+					TagValue:      "true",
+					AllowDeletion: false,
+				},
+			},
+		},
+		{
+			Name: "Protected Resources Deletion Refused",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protected_resource": []interface{}{
+						map[string]interface{}{
+							"tag_name":       "protected",
+							"tag_value":      "true",
+							"allow_deletion": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "protected",
+					TagValue:      "true",
+					AllowDeletion: false,
+				},
+			},
+		},
+		{
+			Name: "Protected Resources Deletion Allowed",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protected_resource": []interface{}{
+						map[string]interface{}{
+							"tag_name":       "environment",
+							"tag_value":      "production",
+							"allow_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResource: features.ProtectedResourceFeatures{
+					TagName:       "environment",
+					TagValue:      "production",
+					AllowDeletion: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ProtectedResource, testCase.Expected.ProtectedResource) {
+			t.Fatalf("Expected %+v but got %+v", result.ProtectedResource, testCase.Expected.ProtectedResource)
+		}
+	}
+}
+
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Access Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_access_enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: true,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Access Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_access_enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAccessEnabled: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
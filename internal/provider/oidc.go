@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// expandOIDCTokenProvider returns the OIDC Token Provider configured in the Provider block, which is used to obtain a
+// fresh ID token each time an access token is required. This returns nil when none is configured.
+func expandOIDCTokenProvider(d *schema.ResourceData) (common.OIDCTokenProvider, error) {
+	if serviceConnectionId := d.Get("ado_pipeline_service_connection_id").(string); serviceConnectionId != "" {
+		// Azure Pipelines exposes these to tasks, but they're intentionally not added to the defaults for
+		// `oidc_request_url` and `oidc_request_token` since those are also used for GitHub Actions
+		requestUrl := d.Get("oidc_request_url").(string)
+		if requestUrl == "" {
+			requestUrl = os.Getenv("SYSTEM_OIDCREQUESTURI")
+		}
+		requestToken := d.Get("oidc_request_token").(string)
+		if requestToken == "" {
+			requestToken = os.Getenv("SYSTEM_ACCESSTOKEN")
+		}
+
+		if requestUrl == "" || requestToken == "" {
+			return nil, fmt.Errorf("`oidc_request_url` and `oidc_request_token` (or the `SYSTEM_OIDCREQUESTURI` and `SYSTEM_ACCESSTOKEN` environment variables) must be set when `ado_pipeline_service_connection_id` is specified")
+		}
+
+		return common.AzureDevOpsOIDCTokenProvider{
+			RequestURL:          requestUrl,
+			RequestToken:        requestToken,
+			ServiceConnectionID: serviceConnectionId,
+		}, nil
+	}
+
+	if command := d.Get("oidc_token_command").(string); command != "" {
+		return common.OIDCTokenCommandProvider{
+			Command: command,
+		}, nil
+	}
+
+	if path := d.Get("oidc_token_file_path").(string); path != "" {
+		return common.OIDCTokenFileProvider{
+			Path: path,
+		}, nil
+	}
+
+	return nil, nil
+}
@@ -156,6 +156,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			sdk.ProtectDeletion(v)
 			resources[k] = v
 		}
 	}
@@ -264,6 +265,20 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"oidc_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_COMMAND", ""),
+				Description: "A command which outputs an OIDC ID token, which is run each time a new token is required. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"ado_pipeline_service_connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID", "ARM_OIDC_AZURE_SERVICE_CONNECTION_ID"}, ""),
+				Description: "The Azure DevOps Pipeline Service Connection ID which uses Workload Identity Federation. For use when authenticating as a Service Principal using OpenID Connect from Azure Pipelines.",
+			},
+
 			"use_oidc": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -319,6 +334,52 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"diagnostics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_file_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The path to a file which structured (JSON) traces of each request/response to Azure are appended to, with credentials and secrets redacted.",
+						},
+					},
+				},
+			},
+
+			"telemetry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"otlp_endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The OTLP/HTTP endpoint of the OpenTelemetry collector which a span for each request to Azure is exported to.",
+						},
+
+						"headers": {
+							Type:      schema.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: "A mapping of HTTP headers which are sent to the OpenTelemetry collector, for example for authentication.",
+						},
+					},
+				},
+			},
+
+			"default_timeouts": schemaDefaultTimeouts(),
+
+			"retry": schemaRetry(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -327,6 +388,24 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.ProviderRegistrationsExtended),
+				ValidateFunc: validation.StringInSlice(resourceproviders.ProviderRegistrationModes(), false),
+				Description:  "The set of Resource Providers which should be automatically registered for the subscription. Possible values are `core`, `extended`, `all` and `none`.",
+			},
+
+			"resource_providers_to_register": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Resource Providers to explicitly register for the subscription, in addition to those specified by the `resource_provider_registrations` property.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -418,18 +497,63 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 }
 
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
-	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+	resourceProvidersToRegister := make(map[string]struct{})
+	if !d.Get("skip_provider_registration").(bool) {
+		additionalResourceProviders := make([]string, 0)
+		for _, v := range d.Get("resource_providers_to_register").(*schema.Set).List() {
+			additionalResourceProviders = append(additionalResourceProviders, v.(string))
+		}
+
+		var err error
+		resourceProvidersToRegister, err = resourceproviders.ForRegistration(d.Get("resource_provider_registrations").(string), additionalResourceProviders)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	skipProviderRegistration := len(resourceProvidersToRegister) == 0
+
+	diagnosticsLogFilePath := ""
+	if v := d.Get("diagnostics").([]interface{}); len(v) > 0 && v[0] != nil {
+		diagnosticsLogFilePath = v[0].(map[string]interface{})["log_file_path"].(string)
+	}
+
+	telemetryEndpoint := ""
+	telemetryHeaders := make(map[string]string)
+	if v := d.Get("telemetry").([]interface{}); len(v) > 0 && v[0] != nil {
+		telemetry := v[0].(map[string]interface{})
+		telemetryEndpoint = telemetry["otlp_endpoint"].(string)
+		for k, v := range telemetry["headers"].(map[string]interface{}) {
+			telemetryHeaders[k] = v.(string)
+		}
+	}
+
+	oidcTokenProvider, err := expandOIDCTokenProvider(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	defaultTimeouts, err := expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	applyDefaultTimeouts(p, defaultTimeouts)
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		DiagnosticsLogFilePath:      diagnosticsLogFilePath,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
+		OIDCTokenProvider:           oidcTokenProvider,
 		PartnerID:                   d.Get("partner_id").(string),
+		ResourceProvidersToRegister: resourceProvidersToRegister,
+		RetryPolicy:                 expandRetryPolicy(d.Get("retry").([]interface{})),
 		SkipProviderRegistration:    skipProviderRegistration,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
+		TelemetryEndpoint:           telemetryEndpoint,
+		TelemetryHeaders:            telemetryHeaders,
 		TerraformVersion:            p.TerraformVersion,
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
@@ -461,9 +585,8 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		}
 
 		availableResourceProviders := providerList.Values()
-		requiredResourceProviders := resourceproviders.Required()
 
-		if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+		if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, resourceProvidersToRegister); err != nil {
 			return nil, diag.Errorf(resourceProviderRegistrationErrorFmt, err)
 		}
 	}
@@ -489,8 +612,10 @@ const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers
 Terraform automatically attempts to register the Resource Providers it supports to
 ensure it's able to provision resources.
 
-If you don't have permission to register Resource Providers you may wish to use the
-"skip_provider_registration" flag in the Provider block to disable this functionality.
+If you don't have permission to register some Resource Providers you may wish to use the
+"resource_provider_registrations" field in the Provider block to register a smaller set
+(such as "core"), optionally listing any others you need in "resource_providers_to_register"
+- or the "skip_provider_registration" flag to disable this functionality entirely.
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
 to provision a resource from a Resource Provider which is unregistered, then the errors
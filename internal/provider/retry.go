@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// defaultRetryableStatusCodes are used when the `retry` block is specified without any `retryable_status_codes`
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+func schemaRetry() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(1, 20),
+					Description:  "The total number of times a request is sent to the Azure API, including the initial attempt.",
+				},
+
+				"base_delay_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntBetween(1, 300),
+					Description:  "The delay before the first retry, which is doubled for each subsequent retry.",
+				},
+
+				"retryable_status_codes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeInt,
+						ValidateFunc: validation.IntBetween(400, 599),
+					},
+					Description: "The HTTP Status Codes which should cause a request to be retried. Defaults to `429`, `500`, `502`, `503` and `504`.",
+				},
+
+				"respect_retry_after": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the `Retry-After` header returned by the Azure API take precedence over the exponential backoff?",
+				},
+			},
+		},
+	}
+}
+
+func expandRetryPolicy(input []interface{}) *common.RetryPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	statusCodes := make([]int, 0)
+	if v, ok := raw["retryable_status_codes"].(*pluginsdk.Set); ok {
+		for _, code := range v.List() {
+			statusCodes = append(statusCodes, code.(int))
+		}
+	}
+	if len(statusCodes) == 0 {
+		statusCodes = defaultRetryableStatusCodes
+	}
+
+	return &common.RetryPolicy{
+		MaxAttempts:          raw["max_attempts"].(int),
+		BaseDelay:            time.Duration(raw["base_delay_in_seconds"].(int)) * time.Second,
+		RetryableStatusCodes: statusCodes,
+		RespectRetryAfter:    raw["respect_retry_after"].(bool),
+	}
+}
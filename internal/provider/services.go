@@ -46,6 +46,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fluidrelay"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor"
@@ -141,6 +142,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		disks.Registration{},
 		domainservices.Registration{},
 		eventhub.Registration{},
+		fabric.Registration{},
 		fluidrelay.Registration{},
 		hybridcompute.Registration{},
 		iothub.Registration{},
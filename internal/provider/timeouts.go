@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	timeout := func(operation string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeoutDuration,
+			Description:  fmt.Sprintf("The default timeout used when %s a resource, unless a timeout is specified in the `timeouts` block of the resource, e.g. `2h`.", operation),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"create": timeout("creating"),
+				"read":   timeout("reading"),
+				"update": timeout("updating"),
+				"delete": timeout("deleting"),
+
+				"service": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(supportedServiceNames(), false),
+								Description:  "The name of the Service whose Resources and Data Sources these timeouts apply to, e.g. `Container Services`.",
+							},
+
+							"create": timeout("creating"),
+							"read":   timeout("reading"),
+							"update": timeout("updating"),
+							"delete": timeout("deleting"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func supportedServiceNames() []string {
+	names := make(map[string]struct{})
+	for _, service := range SupportedTypedServices() {
+		names[service.Name()] = struct{}{}
+	}
+	for _, service := range SupportedUntypedServices() {
+		names[service.Name()] = struct{}{}
+	}
+
+	output := make([]string, 0, len(names))
+	for name := range names {
+		output = append(output, name)
+	}
+	sort.Strings(output)
+	return output
+}
+
+func validateTimeoutDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, errs := validation.StringIsNotEmpty(v, k); len(errs) > 0 {
+		return nil, errs
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration (e.g. `30m` or `2h`): %+v", k, err)}
+	}
+
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero", k)}
+	}
+
+	return nil, nil
+}
+
+type defaultTimeouts struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+
+	// Services contains the timeouts for a specific Service, keyed by the name of the Service
+	Services map[string]defaultTimeouts
+}
+
+func expandDefaultTimeouts(input []interface{}) (*defaultTimeouts, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	output, err := expandTimeoutDurations(raw, "default_timeouts.0")
+	if err != nil {
+		return nil, err
+	}
+
+	services, _ := raw["service"].([]interface{})
+	for i, v := range services {
+		if v == nil {
+			continue
+		}
+		service := v.(map[string]interface{})
+		name := service["name"].(string)
+
+		if _, exists := output.Services[name]; exists {
+			return nil, fmt.Errorf("the `default_timeouts` for the Service %q are specified more than once", name)
+		}
+
+		timeouts, err := expandTimeoutDurations(service, fmt.Sprintf("default_timeouts.0.service.%d", i))
+		if err != nil {
+			return nil, err
+		}
+
+		if output.Services == nil {
+			output.Services = make(map[string]defaultTimeouts)
+		}
+		output.Services[name] = *timeouts
+	}
+
+	return output, nil
+}
+
+func expandTimeoutDurations(raw map[string]interface{}, path string) (*defaultTimeouts, error) {
+	parse := func(key string) (*time.Duration, error) {
+		v, ok := raw[key].(string)
+		if !ok || v == "" {
+			return nil, nil
+		}
+
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `%s.%s`: %+v", path, key, err)
+		}
+
+		return &duration, nil
+	}
+
+	var err error
+	output := defaultTimeouts{}
+	if output.Create, err = parse("create"); err != nil {
+		return nil, err
+	}
+	if output.Read, err = parse("read"); err != nil {
+		return nil, err
+	}
+	if output.Update, err = parse("update"); err != nil {
+		return nil, err
+	}
+	if output.Delete, err = parse("delete"); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// applyDefaultTimeouts overrides the default timeouts defined by each Resource and Data Source with the
+// `default_timeouts` specified in the Provider block. Since these are only used as the defaults, any timeouts
+// specified in the `timeouts` block of a resource continue to take precedence.
+//
+// Timeouts specified for a Service take precedence over those specified for all Resources/Data Sources.
+//
+// NOTE: only the operations which the Resource/Data Source defines a timeout for are overridden, since
+// defining a timeout for an operation which isn't supported fails validation.
+func applyDefaultTimeouts(p *schema.Provider, input *defaultTimeouts) {
+	if input == nil {
+		return
+	}
+
+	for _, resource := range p.ResourcesMap {
+		applyTimeouts(resource.Timeouts, *input)
+	}
+
+	for _, dataSource := range p.DataSourcesMap {
+		applyTimeouts(dataSource.Timeouts, *input)
+	}
+
+	if len(input.Services) == 0 {
+		return
+	}
+
+	applyServiceTimeouts := func(serviceName string, resourceTypes []string, dataSourceTypes []string) {
+		timeouts, ok := input.Services[serviceName]
+		if !ok {
+			return
+		}
+
+		for _, resourceType := range resourceTypes {
+			if resource, ok := p.ResourcesMap[resourceType]; ok {
+				applyTimeouts(resource.Timeouts, timeouts)
+			}
+		}
+
+		for _, dataSourceType := range dataSourceTypes {
+			if dataSource, ok := p.DataSourcesMap[dataSourceType]; ok {
+				applyTimeouts(dataSource.Timeouts, timeouts)
+			}
+		}
+	}
+
+	for _, service := range SupportedTypedServices() {
+		resourceTypes := make([]string, 0)
+		for _, r := range service.Resources() {
+			resourceTypes = append(resourceTypes, r.ResourceType())
+		}
+
+		dataSourceTypes := make([]string, 0)
+		for _, ds := range service.DataSources() {
+			dataSourceTypes = append(dataSourceTypes, ds.ResourceType())
+		}
+
+		applyServiceTimeouts(service.Name(), resourceTypes, dataSourceTypes)
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		resourceTypes := make([]string, 0)
+		for k := range service.SupportedResources() {
+			resourceTypes = append(resourceTypes, k)
+		}
+
+		dataSourceTypes := make([]string, 0)
+		for k := range service.SupportedDataSources() {
+			dataSourceTypes = append(dataSourceTypes, k)
+		}
+
+		applyServiceTimeouts(service.Name(), resourceTypes, dataSourceTypes)
+	}
+}
+
+func applyTimeouts(timeouts *pluginsdk.ResourceTimeout, input defaultTimeouts) {
+	if timeouts == nil {
+		return
+	}
+
+	if input.Create != nil && timeouts.Create != nil {
+		timeouts.Create = pluginsdk.DefaultTimeout(*input.Create)
+	}
+	if input.Read != nil && timeouts.Read != nil {
+		timeouts.Read = pluginsdk.DefaultTimeout(*input.Read)
+	}
+	if input.Update != nil && timeouts.Update != nil {
+		timeouts.Update = pluginsdk.DefaultTimeout(*input.Update)
+	}
+	if input.Delete != nil && timeouts.Delete != nil {
+		timeouts.Delete = pluginsdk.DefaultTimeout(*input.Delete)
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *defaultTimeouts
+		Error    bool
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "Some Timeouts",
+			Input: []interface{}{
+				map[string]interface{}{
+					"create": "2h",
+					"read":   "",
+					"update": "90m",
+					"delete": "",
+				},
+			},
+			Expected: &defaultTimeouts{
+				Create: durationPointer(2 * time.Hour),
+				Update: durationPointer(90 * time.Minute),
+			},
+		},
+		{
+			Name: "Invalid Timeout",
+			Input: []interface{}{
+				map[string]interface{}{
+					"create": "2 hours",
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := expandDefaultTimeouts(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if v.Expected == nil || actual == nil {
+			if v.Expected != actual {
+				t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+			}
+			continue
+		}
+
+		for name, pair := range map[string][2]*time.Duration{
+			"create": {v.Expected.Create, actual.Create},
+			"read":   {v.Expected.Read, actual.Read},
+			"update": {v.Expected.Update, actual.Update},
+			"delete": {v.Expected.Delete, actual.Delete},
+		} {
+			if (pair[0] == nil) != (pair[1] == nil) || (pair[0] != nil && *pair[0] != *pair[1]) {
+				t.Fatalf("expected %q to be %v but got %v", name, pair[0], pair[1])
+			}
+		}
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	provider := TestAzureProvider()
+	applyDefaultTimeouts(provider, &defaultTimeouts{
+		Create: durationPointer(2 * time.Hour),
+		Read:   durationPointer(10 * time.Minute),
+		Update: durationPointer(7*time.Hour + 13*time.Minute),
+	})
+
+	for resourceName, resource := range provider.ResourcesMap {
+		if resource.Timeouts == nil {
+			continue
+		}
+
+		if resource.Timeouts.Create != nil && *resource.Timeouts.Create != 2*time.Hour {
+			t.Fatalf("expected the Create timeout for %q to be overridden but got %s", resourceName, *resource.Timeouts.Create)
+		}
+		if resource.Timeouts.Read != nil && *resource.Timeouts.Read != 10*time.Minute {
+			t.Fatalf("expected the Read timeout for %q to be overridden but got %s", resourceName, *resource.Timeouts.Read)
+		}
+		if resource.Timeouts.Update != nil && *resource.Timeouts.Update != 7*time.Hour+13*time.Minute {
+			t.Fatalf("expected the Update timeout for %q to be overridden but got %s", resourceName, *resource.Timeouts.Update)
+		}
+		if resource.Timeouts.Delete != nil && *resource.Timeouts.Delete == 7*time.Hour+13*time.Minute {
+			t.Fatalf("expected the Delete timeout for %q not to be overridden", resourceName)
+		}
+	}
+
+	for dataSourceName, dataSource := range provider.DataSourcesMap {
+		if dataSource.Timeouts != nil && dataSource.Timeouts.Read != nil && *dataSource.Timeouts.Read != 10*time.Minute {
+			t.Fatalf("expected the Read timeout for %q to be overridden but got %s", dataSourceName, *dataSource.Timeouts.Read)
+		}
+	}
+
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatalf("validating the provider: %+v", err)
+	}
+}
+
+func TestExpandDefaultTimeoutsServices(t *testing.T) {
+	actual, err := expandDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"create": "2h",
+			"service": []interface{}{
+				map[string]interface{}{
+					"name":   "Container Services",
+					"create": "3h",
+					"delete": "90m",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	service, ok := actual.Services["Container Services"]
+	if !ok {
+		t.Fatalf("expected the timeouts for the Service %q to be expanded", "Container Services")
+	}
+	if service.Create == nil || *service.Create != 3*time.Hour {
+		t.Fatalf("expected the Create timeout for the Service to be 3h but got %v", service.Create)
+	}
+	if service.Delete == nil || *service.Delete != 90*time.Minute {
+		t.Fatalf("expected the Delete timeout for the Service to be 90m but got %v", service.Delete)
+	}
+	if service.Read != nil || service.Update != nil {
+		t.Fatalf("expected the Read and Update timeouts for the Service not to be set")
+	}
+
+	_, err = expandDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"service": []interface{}{
+				map[string]interface{}{
+					"name":   "Container Services",
+					"create": "3h",
+				},
+				map[string]interface{}{
+					"name":   "Container Services",
+					"delete": "3h",
+				},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error when a Service is specified more than once but didn't get one")
+	}
+}
+
+func TestApplyDefaultTimeoutsServices(t *testing.T) {
+	provider := TestAzureProvider()
+	applyDefaultTimeouts(provider, &defaultTimeouts{
+		Create: durationPointer(2 * time.Hour),
+		Services: map[string]defaultTimeouts{
+			"Container Services": {
+				Create: durationPointer(3 * time.Hour),
+			},
+		},
+	})
+
+	if v := provider.ResourcesMap["azurerm_kubernetes_cluster"].Timeouts.Create; v == nil || *v != 3*time.Hour {
+		t.Fatalf("expected the Create timeout for `azurerm_kubernetes_cluster` to be 3h but got %v", v)
+	}
+	if v := provider.ResourcesMap["azurerm_resource_group"].Timeouts.Create; v == nil || *v != 2*time.Hour {
+		t.Fatalf("expected the Create timeout for `azurerm_resource_group` to be 2h but got %v", v)
+	}
+
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatalf("validating the provider: %+v", err)
+	}
+}
+
+func durationPointer(input time.Duration) *time.Duration {
+	return &input
+}
@@ -0,0 +1,118 @@
+package resourceproviders
+
+import (
+	"fmt"
+)
+
+const (
+	// ProviderRegistrationsNone doesn't register any Resource Providers automatically
+	ProviderRegistrationsNone = "none"
+
+	// ProviderRegistrationsCore registers the Resource Providers required for the most commonly used resources
+	ProviderRegistrationsCore = "core"
+
+	// ProviderRegistrationsExtended registers the Resource Providers which have historically been registered by the
+	// Provider (see Required), this is the default
+	ProviderRegistrationsExtended = "extended"
+
+	// ProviderRegistrationsAll registers all of the Resource Providers used by resources in the Provider
+	ProviderRegistrationsAll = "all"
+)
+
+// ProviderRegistrationModes returns the supported values for `resource_provider_registrations`
+func ProviderRegistrationModes() []string {
+	return []string{
+		ProviderRegistrationsNone,
+		ProviderRegistrationsCore,
+		ProviderRegistrationsExtended,
+		ProviderRegistrationsAll,
+	}
+}
+
+// Core returns the Resource Providers required for the most commonly used resources, which should be registrable
+// in most (restricted) Subscriptions
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":       {},
+		"Microsoft.Compute":             {},
+		"Microsoft.CostManagement":      {},
+		"Microsoft.ManagedIdentity":     {},
+		"Microsoft.MarketplaceOrdering": {},
+		"Microsoft.Network":             {},
+		"Microsoft.PolicyInsights":      {},
+		"Microsoft.Resources":           {},
+		"Microsoft.Storage":             {},
+	}
+}
+
+// All returns the Resource Providers used by resources in the Provider, which is a superset of Required
+func All() map[string]struct{} {
+	providers := Required()
+
+	// NOTE: Resource Providers in this list are case sensitive
+	additional := []string{
+		"Microsoft.AAD",
+		"Microsoft.AnalysisServices",
+		"Microsoft.App",
+		"Microsoft.AppConfiguration",
+		"Microsoft.Attestation",
+		"Microsoft.AzureActiveDirectory",
+		"Microsoft.AzureStackHCI",
+		"Microsoft.Batch",
+		"Microsoft.Communication",
+		"Microsoft.ConfidentialLedger",
+		"Microsoft.Dashboard",
+		"Microsoft.DataBoxEdge",
+		"Microsoft.Datadog",
+		"Microsoft.DataFactory",
+		"Microsoft.DataShare",
+		"Microsoft.DigitalTwins",
+		"Microsoft.Elastic",
+		"Microsoft.FluidRelay",
+		"Microsoft.HardwareSecurityModules",
+		"Microsoft.HybridCompute",
+		"Microsoft.IoTCentral",
+		"Microsoft.LabServices",
+		"Microsoft.LoadTestService",
+		"Microsoft.Logz",
+		"Microsoft.MobileNetwork",
+		"Microsoft.NetApp",
+		"Microsoft.Orbital",
+		"Microsoft.Purview",
+		"Microsoft.ServiceLinker",
+		"Microsoft.SignalRService",
+		"Microsoft.StorageCache",
+		"Microsoft.Synapse",
+		"Nginx.NginxPlus",
+	}
+	for _, v := range additional {
+		providers[v] = struct{}{}
+	}
+
+	return providers
+}
+
+// ForRegistration returns the Resource Providers which should be automatically registered for the specified
+// registration mode, combined with any additional Resource Providers which have been explicitly specified
+func ForRegistration(mode string, additional []string) (map[string]struct{}, error) {
+	var providers map[string]struct{}
+	switch mode {
+	case ProviderRegistrationsNone:
+		providers = map[string]struct{}{}
+	case ProviderRegistrationsCore:
+		providers = Core()
+	case ProviderRegistrationsExtended:
+		providers = Required()
+	case ProviderRegistrationsAll:
+		providers = All()
+	default:
+		return nil, fmt.Errorf("unsupported Resource Provider registration mode %q", mode)
+	}
+
+	for _, v := range additional {
+		providers[v] = struct{}{}
+	}
+
+	return providers, nil
+}
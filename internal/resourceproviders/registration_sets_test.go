@@ -0,0 +1,99 @@
+package resourceproviders
+
+import (
+	"testing"
+)
+
+func TestForRegistration(t *testing.T) {
+	testCases := []struct {
+		mode       string
+		additional []string
+		expected   int
+		contains   []string
+		excludes   []string
+		error      bool
+	}{
+		{
+			mode:     ProviderRegistrationsNone,
+			expected: 0,
+		},
+		{
+			mode:       ProviderRegistrationsNone,
+			additional: []string{"Microsoft.Compute", "Microsoft.Network"},
+			expected:   2,
+			contains:   []string{"Microsoft.Compute", "Microsoft.Network"},
+		},
+		{
+			mode:     ProviderRegistrationsCore,
+			expected: len(Core()),
+			contains: []string{"Microsoft.Compute", "Microsoft.Resources"},
+			excludes: []string{"Microsoft.Web"},
+		},
+		{
+			mode:       ProviderRegistrationsCore,
+			additional: []string{"Microsoft.Web", "Microsoft.Compute"},
+			expected:   len(Core()) + 1,
+			contains:   []string{"Microsoft.Web"},
+		},
+		{
+			mode:     ProviderRegistrationsExtended,
+			expected: len(Required()),
+			contains: []string{"Microsoft.Web"},
+			excludes: []string{"Microsoft.Synapse"},
+		},
+		{
+			mode:     ProviderRegistrationsAll,
+			expected: len(All()),
+			contains: []string{"Microsoft.Web", "Microsoft.Synapse"},
+		},
+		{
+			mode:  "some",
+			error: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q with %+v..", testCase.mode, testCase.additional)
+
+		actual, err := ForRegistration(testCase.mode, testCase.additional)
+		if testCase.error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if len(actual) != testCase.expected {
+			t.Fatalf("expected %d Resource Providers but got %d", testCase.expected, len(actual))
+		}
+		for _, v := range testCase.contains {
+			if _, ok := actual[v]; !ok {
+				t.Fatalf("expected %q to be registered", v)
+			}
+		}
+		for _, v := range testCase.excludes {
+			if _, ok := actual[v]; ok {
+				t.Fatalf("expected %q not to be registered", v)
+			}
+		}
+	}
+}
+
+func TestRegistrationSetsAreSupersets(t *testing.T) {
+	required := Required()
+	for v := range Core() {
+		if _, ok := required[v]; !ok {
+			t.Fatalf("%q is a Core Resource Provider but isn't in the Required list", v)
+		}
+	}
+
+	all := All()
+	for v := range required {
+		if _, ok := all[v]; !ok {
+			t.Fatalf("%q is a Required Resource Provider but isn't in the All list", v)
+		}
+	}
+}
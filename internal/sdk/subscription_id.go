@@ -0,0 +1,32 @@
+package sdk
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// SubscriptionIdOverrideSchema returns the schema for an optional `subscription_id` argument, which allows a resource
+// to be managed within a different Subscription to the one the Provider is configured to use.
+func SubscriptionIdOverrideSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+}
+
+type subscriptionIdGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// SubscriptionIdOverride returns the Subscription ID specified in the `subscription_id` argument when this is set,
+// otherwise falling back to the Subscription ID which the Provider is configured to use.
+func SubscriptionIdOverride(d subscriptionIdGetter, defaultSubscriptionId string) string {
+	if v, ok := d.GetOk("subscription_id"); ok && v.(string) != "" {
+		return v.(string)
+	}
+
+	return defaultSubscriptionId
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// ProtectDeletion wraps the Delete function of the specified (untyped) Resource so that deleting a resource
+// which is tagged as protected (as configured in the `protected_resource` block within the `features` block)
+// is refused - this is a no-op for Resources which don't support `tags`.
+func ProtectDeletion(resource *schema.Resource) {
+	if _, ok := resource.Schema["tags"]; !ok {
+		return
+	}
+
+	if deleteFunc := resource.DeleteContext; deleteFunc != nil {
+		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := ensureDeletionIsAllowed(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return deleteFunc(ctx, d, meta)
+		}
+		return
+	}
+
+	if deleteFunc := resource.Delete; deleteFunc != nil { // nolint: staticcheck
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error { // nolint: staticcheck
+			if err := ensureDeletionIsAllowed(d, meta); err != nil {
+				return err
+			}
+			return deleteFunc(d, meta)
+		}
+	}
+}
+
+// ensureDeletionIsAllowed returns an error if the resource being deleted is tagged as protected and deleting
+// protected resources hasn't been explicitly allowed.
+func ensureDeletionIsAllowed(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Features.ProtectedResource.AllowDeletion {
+		return nil
+	}
+
+	tags, ok := d.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if isProtected(client.Features.ProtectedResource, tags) {
+		return fmt.Errorf("refusing to delete %q since it's tagged as protected (`%s` is `%s`) - to delete this resource either remove this tag, or set `allow_deletion` to `true` within the `protected_resource` block of the `features` block (or the Environment Variable `ARM_ALLOW_PROTECTED_RESOURCE_DELETION` to `true`)", d.Id(), client.Features.ProtectedResource.TagName, client.Features.ProtectedResource.TagValue)
+	}
+
+	return nil
+}
+
+func isProtected(input features.ProtectedResourceFeatures, tags map[string]interface{}) bool {
+	if input.TagName == "" {
+		return false
+	}
+
+	for k, v := range tags {
+		// Tag Names are case-insensitive in Azure, however Tag Values are case-sensitive
+		if !strings.EqualFold(k, input.TagName) {
+			continue
+		}
+
+		if value, ok := v.(string); ok && value == input.TagValue {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestIsProtected(t *testing.T) {
+	testData := []struct {
+		name     string
+		features features.ProtectedResourceFeatures
+		tags     map[string]interface{}
+		expected bool
+	}{
+		{
+			name: "not configured",
+			features: features.ProtectedResourceFeatures{
+				TagValue: "true",
+			},
+			tags: map[string]interface{}{
+				"protected": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "no tags",
+			features: features.ProtectedResourceFeatures{
+				TagName:  "protected",
+				TagValue: "true",
+			},
+			tags:     map[string]interface{}{},
+			expected: false,
+		},
+		{
+			name: "matching tag",
+			features: features.ProtectedResourceFeatures{
+				TagName:  "protected",
+				TagValue: "true",
+			},
+			tags: map[string]interface{}{
+				"environment": "production",
+				"protected":   "true",
+			},
+			expected: true,
+		},
+		{
+			name: "matching tag with a different casing",
+			features: features.ProtectedResourceFeatures{
+				TagName:  "protected",
+				TagValue: "true",
+			},
+			tags: map[string]interface{}{
+				"Protected": "true",
+			},
+			expected: true,
+		},
+		{
+			name: "different tag value",
+			features: features.ProtectedResourceFeatures{
+				TagName:  "protected",
+				TagValue: "true",
+			},
+			tags: map[string]interface{}{
+				"protected": "false",
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := isProtected(v.features, v.tags); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -65,6 +65,12 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			if _, ok := (*resourceSchema)["tags"]; ok {
+				if err := ensureDeletionIsAllowed(d, meta); err != nil {
+					return err
+				}
+			}
+
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
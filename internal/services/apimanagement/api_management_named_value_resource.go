@@ -1,6 +1,7 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -66,6 +68,41 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IsUUID,
 						},
+						"refresh_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  namedValueRefreshModeAutomatic,
+							ValidateFunc: validation.StringInSlice([]string{
+								namedValueRefreshModeAutomatic,
+								namedValueRefreshModeLatestVersion,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"key_vault_secret_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"key_vault_last_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -92,7 +129,57 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceApiManagementNamedValueCustomizeDiff),
+	}
+}
+
+const (
+	// namedValueRefreshModeAutomatic leaves refreshing the secret to API Management, which polls versionless secrets every few hours
+	namedValueRefreshModeAutomatic = "Automatic"
+	// namedValueRefreshModeLatestVersion tracks the latest version of the secret and forces API Management to re-sync once it rotates
+	namedValueRefreshModeLatestVersion = "LatestVersion"
+)
+
+func resourceApiManagementNamedValueCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	keyVault := d.Get("value_from_key_vault").([]interface{})
+	if len(keyVault) == 0 || keyVault[0] == nil {
+		return nil
 	}
+	config := keyVault[0].(map[string]interface{})
+	if config["refresh_mode"].(string) != namedValueRefreshModeLatestVersion {
+		return nil
+	}
+
+	secretIdRaw := config["secret_id"].(string)
+	if secretIdRaw == "" {
+		// the secret ID isn't known until apply
+		return nil
+	}
+
+	secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretIdRaw)
+	if err != nil {
+		return err
+	}
+	if secretId.Version != "" {
+		return fmt.Errorf("`refresh_mode` can only be set to `%s` when `secret_id` is a versionless Key Vault Secret ID", namedValueRefreshModeLatestVersion)
+	}
+
+	// the version is looked up once the Named Value has been created
+	if d.Id() == "" || d.HasChange("value_from_key_vault.0.secret_id") {
+		return d.SetNewComputed("key_vault_secret_version")
+	}
+
+	latestVersion, err := apiManagementNamedValueLatestSecretVersion(ctx, meta.(*clients.Client), *secretId)
+	if err != nil {
+		return err
+	}
+
+	if latestVersion != d.Get("key_vault_secret_version").(string) {
+		return d.SetNewComputed("key_vault_secret_version")
+	}
+
+	return nil
 }
 
 func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -143,6 +230,34 @@ func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta
 
 	d.SetId(id.ID())
 
+	secretVersion := ""
+	if keyVault := d.Get("value_from_key_vault").([]interface{}); len(keyVault) > 0 && keyVault[0] != nil {
+		config := keyVault[0].(map[string]interface{})
+		if config["refresh_mode"].(string) == namedValueRefreshModeLatestVersion {
+			secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(config["secret_id"].(string))
+			if err != nil {
+				return err
+			}
+
+			if secretVersion, err = apiManagementNamedValueLatestSecretVersion(ctx, meta.(*clients.Client), *secretId); err != nil {
+				return err
+			}
+
+			// a new Named Value has only just fetched the secret, so an explicit refresh is only needed on update
+			if !d.IsNewResource() {
+				refreshFuture, err := client.RefreshSecret(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+				if err != nil {
+					return fmt.Errorf("refreshing the Key Vault secret for %s: %+v", id, err)
+				}
+
+				if err = refreshFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for the Key Vault secret for %s to be refreshed: %+v", id, err)
+				}
+			}
+		}
+	}
+	d.Set("key_vault_secret_version", secretVersion)
+
 	return resourceApiManagementNamedValueRead(d, meta)
 }
 
@@ -178,9 +293,16 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 		if properties.Secret != nil && !*properties.Secret {
 			d.Set("value", properties.Value)
 		}
-		if err := d.Set("value_from_key_vault", flattenApiManagementNamedValueKeyVault(properties.KeyVault)); err != nil {
+		if err := d.Set("value_from_key_vault", flattenApiManagementNamedValueKeyVault(d, properties.KeyVault)); err != nil {
 			return fmt.Errorf("setting `value_from_key_vault`: %+v", err)
 		}
+		var lastStatus *apimanagement.KeyVaultLastAccessStatusContractProperties
+		if properties.KeyVault != nil {
+			lastStatus = properties.KeyVault.LastStatus
+		}
+		if err := d.Set("key_vault_last_status", flattenApiManagementNamedValueKeyVaultLastStatus(lastStatus)); err != nil {
+			return fmt.Errorf("setting `key_vault_last_status`: %+v", err)
+		}
 		d.Set("tags", properties.Tags)
 	}
 
@@ -223,7 +345,7 @@ func expandApiManagementNamedValueKeyVault(inputs []interface{}) *apimanagement.
 	return &result
 }
 
-func flattenApiManagementNamedValueKeyVault(input *apimanagement.KeyVaultContractProperties) []interface{} {
+func flattenApiManagementNamedValueKeyVault(d *pluginsdk.ResourceData, input *apimanagement.KeyVaultContractProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -237,10 +359,62 @@ func flattenApiManagementNamedValueKeyVault(input *apimanagement.KeyVaultContrac
 		clientId = *input.IdentityClientID
 	}
 
+	// the refresh mode isn't returned by the API, so we pull it from the config
+	refreshMode := namedValueRefreshModeAutomatic
+	if v, ok := d.GetOk("value_from_key_vault.0.refresh_mode"); ok {
+		refreshMode = v.(string)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"secret_id":          secretId,
 			"identity_client_id": clientId,
+			"refresh_mode":       refreshMode,
 		},
 	}
 }
+
+func flattenApiManagementNamedValueKeyVaultLastStatus(input *apimanagement.KeyVaultLastAccessStatusContractProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var code, message, timestamp string
+	if input.Code != nil {
+		code = *input.Code
+	}
+
+	if input.Message != nil {
+		message = *input.Message
+	}
+
+	if input.TimeStampUtc != nil {
+		timestamp = input.TimeStampUtc.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code":      code,
+			"message":   message,
+			"timestamp": timestamp,
+		},
+	}
+}
+
+func apiManagementNamedValueLatestSecretVersion(ctx context.Context, client *clients.Client, secretId keyVaultParse.NestedItemId) (string, error) {
+	resp, err := client.KeyVault.ManagementClient.GetSecret(ctx, secretId.KeyVaultBaseUrl, secretId.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving the latest version of Key Vault Secret %q: %+v", secretId.VersionlessID(), err)
+	}
+
+	if resp.ID == nil {
+		return "", fmt.Errorf("retrieving the latest version of Key Vault Secret %q: `id` was nil", secretId.VersionlessID())
+	}
+
+	latest, err := keyVaultParse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return latest.Version, nil
+}
@@ -45,6 +45,23 @@ func TestAccApiManagementNamedValue_keyVault(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementNamedValue_keyVaultLatestVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_named_value", "test")
+	r := ApiManagementNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultLatestVersion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_vault_secret_version").IsNotEmpty(),
+				check.That(data.ResourceName).Key("key_vault_last_status.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("value_from_key_vault.0.refresh_mode", "key_vault_secret_version"),
+	})
+}
+
 func TestAccApiManagementNamedValue_keyVaultSystemAssigned(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_named_value", "test")
 	r := ApiManagementNamedValueResource{}
@@ -306,6 +323,27 @@ resource "azurerm_api_management_named_value" "test" {
 `, r.keyVaultTemplate(data), data.RandomInteger)
 }
 
+func (r ApiManagementNamedValueResource) keyVaultLatestVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_api_management_named_value" "test" {
+  name                = "acctestAMProperty-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "TestKeyVault%[2]d"
+  secret              = true
+  value_from_key_vault {
+    secret_id          = azurerm_key_vault_secret.test.versionless_id
+    identity_client_id = azurerm_user_assigned_identity.test.client_id
+    refresh_mode       = "LatestVersion"
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.test2]
+}
+`, r.keyVaultTemplate(data), data.RandomInteger)
+}
+
 func (r ApiManagementNamedValueResource) keyVaultUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -2,9 +2,12 @@ package appconfiguration
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2022-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -37,3 +40,32 @@ func appConfigurationGetKeyRefreshFunc(ctx context.Context, client *appconfigura
 		return res, "Exists", nil
 	}
 }
+
+// appConfigurationUpdateKeyLock locks or unlocks the specified key/label pair. Lock changes are serialised per
+// Configuration Store since the data plane rejects concurrent lock changes against the same store with a 409,
+// any conflicts which still occur (e.g. from changes made outside of Terraform) are retried until the deadline.
+func appConfigurationUpdateKeyLock(ctx context.Context, client *appconfiguration.BaseClient, configurationStoreId configurationstores.ConfigurationStoreId, key, label string, locked bool) error {
+	locks.ByID(configurationStoreId.ID())
+	defer locks.UnlockByID(configurationStoreId.ID())
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	return pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		var err error
+		if locked {
+			_, err = client.PutLock(ctx, key, label, "", "")
+		} else {
+			_, err = client.DeleteLock(ctx, key, label, "", "")
+		}
+		if err != nil {
+			if v, ok := err.(autorest.DetailedError); ok && utils.ResponseWasConflict(autorest.Response{Response: v.Response}) {
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+		return nil
+	})
+}
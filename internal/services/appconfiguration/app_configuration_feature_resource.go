@@ -179,6 +179,11 @@ func (k FeatureResource) Create() sdk.ResourceFunc {
 				Label:                model.Label,
 			}
 
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(appCfgFeatureResourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
 			featureKey := fmt.Sprintf("%s/%s", FeatureKeyPrefix, model.Name)
 
 			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
@@ -209,7 +214,7 @@ func (k FeatureResource) Create() sdk.ResourceFunc {
 				return tf.ImportAsExistsError(k.ResourceType(), appCfgFeatureResourceID.ID())
 			}
 
-			err = createOrUpdateFeature(ctx, client, model)
+			err = createOrUpdateFeature(ctx, client, *configurationStoreId, model)
 			if err != nil {
 				return fmt.Errorf("while creating feature: %+v", err)
 			}
@@ -310,6 +315,11 @@ func (k FeatureResource) Update() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
 			featureKey := fmt.Sprintf("%s/%s", FeatureKeyPrefix, resourceID.Name)
 
 			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
@@ -327,10 +337,10 @@ func (k FeatureResource) Update() sdk.ResourceFunc {
 
 			if metadata.ResourceData.HasChange("tags") || metadata.ResourceData.HasChange("enabled") || metadata.ResourceData.HasChange("locked") || metadata.ResourceData.HasChange("description") {
 				// Remove the lock, if any. We will put it back again if the model says so.
-				if _, err = client.DeleteLock(ctx, featureKey, resourceID.Label, "", ""); err != nil {
+				if err = appConfigurationUpdateKeyLock(ctx, client, *configurationStoreId, featureKey, resourceID.Label, false); err != nil {
 					return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", resourceID.Name, resourceID.Label, err)
 				}
-				err = createOrUpdateFeature(ctx, client, model)
+				err = createOrUpdateFeature(ctx, client, *configurationStoreId, model)
 				if err != nil {
 					return fmt.Errorf("while updating feature: %+v", err)
 				}
@@ -352,6 +362,11 @@ func (k FeatureResource) Delete() sdk.ResourceFunc {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
 
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
 			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
 			if client == nil {
 				return fmt.Errorf("app configuration %q was not found", resourceID.ConfigurationStoreId)
@@ -369,7 +384,7 @@ func (k FeatureResource) Delete() sdk.ResourceFunc {
 				return nil
 			}
 
-			if _, err = client.DeleteLock(ctx, featureKey, resourceID.Label, "", ""); err != nil {
+			if err = appConfigurationUpdateKeyLock(ctx, client, *configurationStoreId, featureKey, resourceID.Label, false); err != nil {
 				return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", resourceID.Name, resourceID.Label, err)
 			}
 
@@ -388,7 +403,7 @@ func (k FeatureResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.AppConfigurationFeatureID
 }
 
-func createOrUpdateFeature(ctx context.Context, client *appconfiguration.BaseClient, model FeatureResourceModel) error {
+func createOrUpdateFeature(ctx context.Context, client *appconfiguration.BaseClient, configurationStoreId configurationstores.ConfigurationStoreId, model FeatureResourceModel) error {
 	featureKey := fmt.Sprintf("%s/%s", FeatureKeyPrefix, model.Name)
 	entity := appconfiguration.KeyValue{
 		Key:         utils.String(featureKey),
@@ -439,14 +454,8 @@ func createOrUpdateFeature(ctx context.Context, client *appconfiguration.BaseCli
 		return err
 	}
 
-	if model.Locked {
-		if _, err = client.PutLock(ctx, featureKey, model.Label, "", ""); err != nil {
-			return fmt.Errorf("while locking key/label pair %s/%s: %+v", model.Name, model.Label, err)
-		}
-	} else {
-		if _, err = client.DeleteLock(ctx, featureKey, model.Label, "", ""); err != nil {
-			return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", model.Name, model.Label, err)
-		}
+	if err = appConfigurationUpdateKeyLock(ctx, client, configurationStoreId, featureKey, model.Label, model.Locked); err != nil {
+		return fmt.Errorf("while updating lock on key/label pair %s/%s: %+v", model.Name, model.Label, err)
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"time"
 
@@ -43,6 +44,7 @@ type KeyResourceModel struct {
 	Locked               bool                   `tfschema:"locked"`
 	Tags                 map[string]interface{} `tfschema:"tags"`
 	Type                 string                 `tfschema:"type"`
+	UseEtagConcurrency   bool                   `tfschema:"use_etag_concurrency"`
 	VaultKeyReference    string                 `tfschema:"vault_key_reference"`
 }
 
@@ -95,6 +97,11 @@ func (k KeyResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:      "kv",
 			ValidateFunc: validation.StringInSlice([]string{KeyTypeVault, KeyTypeKV}, false),
 		},
+		"use_etag_concurrency": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 		"vault_key_reference": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -138,6 +145,11 @@ func (k KeyResource) Create() sdk.ResourceFunc {
 				Label:                model.Label,
 			}
 
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(appCfgKeyResourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
 			// from https://learn.microsoft.com/en-us/azure/azure-app-configuration/concept-enable-rbac#azure-built-in-roles-for-azure-app-configuration
 			// allow up to 15 min for role permission to be done propagated
 			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Key %q read permission to be done propagated", model.Key)
@@ -190,8 +202,7 @@ func (k KeyResource) Create() sdk.ResourceFunc {
 			}
 
 			if model.Locked {
-				_, err = client.PutLock(ctx, model.Key, model.Label, "", "")
-				if err != nil {
+				if err = appConfigurationUpdateKeyLock(ctx, client, *configurationStoreId, model.Key, model.Label, true); err != nil {
 					return fmt.Errorf("while locking key/label pair %q/%q: %+v", model.Key, model.Label, err)
 				}
 			}
@@ -250,6 +261,8 @@ func (k KeyResource) Read() sdk.ResourceFunc {
 				Etag:                 utils.NormalizeNilableString(kv.Etag),
 				Label:                utils.NormalizeNilableString(kv.Label),
 				Tags:                 tags.Flatten(kv.Tags),
+				// this isn't returned by the API, so pull this from the existing state
+				UseEtagConcurrency: metadata.ResourceData.Get("use_etag_concurrency").(bool),
 			}
 
 			if utils.NormalizeNilableString(kv.ContentType) != VaultKeyContentType {
@@ -286,6 +299,11 @@ func (k KeyResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
 
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
 			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
 			if err != nil {
 				return err
@@ -318,20 +336,25 @@ func (k KeyResource) Update() sdk.ResourceFunc {
 					}
 					entity.Value = utils.String(string(ref))
 				}
-				if _, err = client.PutKeyValue(ctx, model.Key, model.Label, &entity, "", ""); err != nil {
+				ifMatch := ""
+				if model.UseEtagConcurrency {
+					// only update the key/label pair if it's not been modified since it was last read
+					lastReadEtag, _ := metadata.ResourceData.GetChange("etag")
+					if v := lastReadEtag.(string); v != "" {
+						ifMatch = fmt.Sprintf("%q", v)
+					}
+				}
+				if _, err = client.PutKeyValue(ctx, model.Key, model.Label, &entity, ifMatch, ""); err != nil {
+					if v, ok := err.(autorest.DetailedError); ok && v.Response != nil && v.Response.StatusCode == http.StatusPreconditionFailed {
+						return fmt.Errorf("while updating key/label pair %s/%s: the key/label pair has been modified outside of Terraform since it was last read (the ETag no longer matches %s) - refresh the state and review the changes before applying again", model.Key, model.Label, ifMatch)
+					}
 					return fmt.Errorf("while updating key/label pair %s/%s: %+v", model.Key, model.Label, err)
 				}
 			}
 
 			if metadata.ResourceData.HasChange("locked") {
-				if model.Locked {
-					if _, err = client.PutLock(ctx, model.Key, model.Label, "", ""); err != nil {
-						return fmt.Errorf("while locking key/label pair %s/%s: %+v", model.Key, model.Label, err)
-					}
-				} else {
-					if _, err = client.DeleteLock(ctx, model.Key, model.Label, "", ""); err != nil {
-						return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", model.Key, model.Label, err)
-					}
+				if err = appConfigurationUpdateKeyLock(ctx, client, *configurationStoreId, model.Key, model.Label, model.Locked); err != nil {
+					return fmt.Errorf("while updating lock on key/label pair %s/%s: %+v", model.Key, model.Label, err)
 				}
 			}
 			return nil
@@ -348,6 +371,11 @@ func (k KeyResource) Delete() sdk.ResourceFunc {
 				return fmt.Errorf("while parsing resource ID: %+v", err)
 			}
 
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreIDInsensitively(resourceID.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
 			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, resourceID.ConfigurationStoreId)
 			if err != nil {
 				return err
@@ -367,7 +395,7 @@ func (k KeyResource) Delete() sdk.ResourceFunc {
 				return fmt.Errorf("while decoding label of resource ID: %+v", err)
 			}
 
-			if _, err = client.DeleteLock(ctx, decodedKey, decodedLabel, "", ""); err != nil {
+			if err = appConfigurationUpdateKeyLock(ctx, client, *configurationStoreId, decodedKey, decodedLabel, false); err != nil {
 				return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", decodedKey, resourceID.Label, err)
 			}
 
@@ -143,6 +143,28 @@ func TestAccAppConfigurationKey_lockUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigurationKey_useEtagConcurrency(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key", "test")
+	r := AppConfigurationKeyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.useEtagConcurrency(data, "a test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").IsSet(),
+			),
+		},
+		data.ImportStep("use_etag_concurrency"),
+		{
+			Config: r.useEtagConcurrency(data, "an updated test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("use_etag_concurrency"),
+	})
+}
+
 func (t AppConfigurationKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resourceID, err := parse.KeyId(state.ID)
 	if err != nil {
@@ -212,6 +234,21 @@ resource "azurerm_app_configuration_key" "test" {
 `, t.base(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (t AppConfigurationKeyResource) useEtagConcurrency(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-ackey-%d"
+  content_type           = "test"
+  label                  = "acctest-ackeylabel-%d"
+  value                  = "%s"
+  use_etag_concurrency   = true
+}
+`, t.base(data), data.RandomInteger, data.RandomInteger, value)
+}
+
 func (t AppConfigurationKeyResource) slash(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
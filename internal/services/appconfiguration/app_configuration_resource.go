@@ -1,6 +1,7 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -43,6 +45,8 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAppConfigurationCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -62,15 +66,21 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_key_identifier": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsURLWithHTTPorHTTPS,
+							DiffSuppressFunc: appConfigurationEncryptionKeyDiffSuppress,
 						},
 						"identity_client_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ValidateFunc: validation.IsUUID,
 						},
+						"auto_key_rotation_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -270,11 +280,16 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		Properties: &configurationstores.ConfigurationStoreProperties{
 			EnablePurgeProtection: utils.Bool(d.Get("purge_protection_enabled").(bool)),
 			DisableLocalAuth:      utils.Bool(!d.Get("local_auth_enabled").(bool)),
-			Encryption:            expandAppConfigurationEncryption(d.Get("encryption").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	encryption, err := expandAppConfigurationEncryption(d.Get("encryption").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `encryption`: %+v", err)
+	}
+	parameters.Properties.Encryption = encryption
+
 	if v, ok := d.Get("soft_delete_retention_days").(int); ok && v != 7 {
 		parameters.Properties.SoftDeleteRetentionInDays = utils.Int64(int64(v))
 	}
@@ -351,7 +366,11 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		if update.Properties == nil {
 			update.Properties = &configurationstores.ConfigurationStorePropertiesUpdateParameters{}
 		}
-		update.Properties.Encryption = expandAppConfigurationEncryption(d.Get("encryption").([]interface{}))
+		encryption, err := expandAppConfigurationEncryption(d.Get("encryption").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `encryption`: %+v", err)
+		}
+		update.Properties.Encryption = encryption
 	}
 
 	if d.HasChange("local_auth_enabled") {
@@ -447,7 +466,9 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 		if props := model.Properties; props != nil {
 			d.Set("endpoint", props.Endpoint)
-			d.Set("encryption", flattenAppConfigurationEncryption(props.Encryption))
+			if err := d.Set("encryption", flattenAppConfigurationResourceEncryption(props.Encryption, d.Get("encryption").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `encryption`: %+v", err)
+			}
 			d.Set("public_network_access", props.PublicNetworkAccess)
 
 			localAuthEnabled := true
@@ -568,9 +589,9 @@ type flattenedAccessKeys struct {
 	secondaryWriteKey []interface{}
 }
 
-func expandAppConfigurationEncryption(input []interface{}) *configurationstores.EncryptionProperties {
+func expandAppConfigurationEncryption(input []interface{}) (*configurationstores.EncryptionProperties, error) {
 	if len(input) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	encryptionParam := input[0].(map[string]interface{})
@@ -582,11 +603,111 @@ func expandAppConfigurationEncryption(input []interface{}) *configurationstores.
 		result.KeyVaultProperties.IdentityClientId = &v
 	}
 	if v, ok := encryptionParam["key_vault_key_identifier"].(string); ok && v != "" {
+		// when a versionless Key Identifier is used the App Configuration automatically uses the latest version of the Key
+		if encryptionParam["auto_key_rotation_enabled"].(bool) {
+			keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v)
+			if err != nil {
+				return nil, err
+			}
+			v = keyId.VersionlessID()
+		}
 		result.KeyVaultProperties.KeyIdentifier = &v
 	}
+	return result, nil
+}
+
+// flattenAppConfigurationResourceEncryption flattens the `encryption` block whilst retaining the configured
+// `key_vault_key_identifier` when automatic key rotation is enabled, since the API returns the versionless Key Identifier
+func flattenAppConfigurationResourceEncryption(input *configurationstores.EncryptionProperties, existing []interface{}) []interface{} {
+	result := flattenAppConfigurationEncryption(input)
+	if len(result) == 0 {
+		return result
+	}
+
+	encryption := result[0].(map[string]interface{})
+	encryption["auto_key_rotation_enabled"] = false
+
+	keyIdentifier := ""
+	if v, ok := encryption["key_vault_key_identifier"].(*string); ok && v != nil {
+		keyIdentifier = *v
+	}
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyIdentifier)
+	if err != nil || keyId.Version != "" {
+		return result
+	}
+	encryption["auto_key_rotation_enabled"] = true
+
+	if len(existing) > 0 && existing[0] != nil {
+		if v, ok := existing[0].(map[string]interface{})["key_vault_key_identifier"].(string); ok && appConfigurationKeyIdentifiersMatchVersionless(v, keyIdentifier) {
+			encryption["key_vault_key_identifier"] = v
+		}
+	}
+
 	return result
 }
 
+// appConfigurationEncryptionKeyDiffSuppress suppresses changes to the version of the Key when automatic key rotation
+// is enabled, since the App Configuration always uses the latest version of the Key
+func appConfigurationEncryptionKeyDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if !d.Get("encryption.0.auto_key_rotation_enabled").(bool) || old == "" || new == "" {
+		return false
+	}
+
+	return appConfigurationKeyIdentifiersMatchVersionless(old, new)
+}
+
+func appConfigurationKeyIdentifiersMatchVersionless(first, second string) bool {
+	firstId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(first)
+	if err != nil {
+		return false
+	}
+	secondId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(second)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(firstId.VersionlessID(), secondId.VersionlessID())
+}
+
+func resourceAppConfigurationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	encryption := d.Get("encryption").([]interface{})
+	if len(encryption) == 0 || encryption[0] == nil {
+		return nil
+	}
+	encryptionParam := encryption[0].(map[string]interface{})
+
+	keyIdentifier := encryptionParam["key_vault_key_identifier"].(string)
+	keyIdentifierKnown := d.NewValueKnown("encryption.0.key_vault_key_identifier")
+	if keyIdentifier == "" && keyIdentifierKnown {
+		return nil
+	}
+
+	if keyIdentifierKnown && encryptionParam["auto_key_rotation_enabled"].(bool) {
+		if _, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyIdentifier); err != nil {
+			return fmt.Errorf("`key_vault_key_identifier` must be a Key Vault Key ID when `auto_key_rotation_enabled` is set to `true`: %+v", err)
+		}
+	}
+
+	// the identity used to access the Key must be assigned to the App Configuration, otherwise the store can't
+	// wrap/unwrap its data encryption key and becomes inaccessible
+	if !d.NewValueKnown("identity.0.type") {
+		return nil
+	}
+	identityType := ""
+	if v := d.Get("identity").([]interface{}); len(v) > 0 && v[0] != nil {
+		identityType = v[0].(map[string]interface{})["type"].(string)
+	}
+	if encryptionParam["identity_client_id"].(string) != "" || !d.NewValueKnown("encryption.0.identity_client_id") {
+		if !strings.Contains(identityType, string(identity.TypeUserAssigned)) {
+			return fmt.Errorf("a `UserAssigned` identity must be specified in the `identity` block when `encryption.0.identity_client_id` is set")
+		}
+	} else if !strings.Contains(identityType, string(identity.TypeSystemAssigned)) {
+		return fmt.Errorf("a `SystemAssigned` identity must be specified in the `identity` block when `encryption.0.identity_client_id` isn't set")
+	}
+
+	return nil
+}
+
 func flattenAppConfigurationAccessKeys(values []configurationstores.ApiKey) flattenedAccessKeys {
 	result := flattenedAccessKeys{
 		primaryReadKey:    make([]interface{}, 0),
@@ -204,6 +204,36 @@ func TestAccAppConfiguration_encryptionUpdated(t *testing.T) {
 	})
 }
 
+func TestAccAppConfiguration_encryptionAutoKeyRotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration", "test")
+	r := AppConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encryption(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.encryptionWithKeyRotation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("encryption.0.auto_key_rotation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("encryption.0.key_vault_key_identifier"),
+		{
+			Config: r.encryption(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppConfiguration_softDeleteRecovery(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_app_configuration", "test")
 	r := AppConfigurationResource{}
@@ -711,7 +741,11 @@ resource "azurerm_app_configuration" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
-func (AppConfigurationResource) encryption(data acceptance.TestData) string {
+func (r AppConfigurationResource) encryption(data acceptance.TestData) string {
+	return r.encryptionWithKeyRotation(data, false)
+}
+
+func (AppConfigurationResource) encryptionWithKeyRotation(data acceptance.TestData, autoKeyRotationEnabled bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {
@@ -798,8 +832,9 @@ resource "azurerm_app_configuration" "test" {
   }
 
   encryption {
-    key_vault_key_identifier = azurerm_key_vault_key.test.id
-    identity_client_id       = azurerm_user_assigned_identity.test.client_id
+    key_vault_key_identifier  = azurerm_key_vault_key.test.id
+    identity_client_id        = azurerm_user_assigned_identity.test.client_id
+    auto_key_rotation_enabled = %[3]t
   }
 
   depends_on = [
@@ -807,7 +842,7 @@ resource "azurerm_app_configuration" "test" {
     azurerm_key_vault_access_policy.server,
   ]
 }
-`, data.RandomInteger, data.Locations.Primary)
+`, data.RandomInteger, data.Locations.Primary, autoKeyRotationEnabled)
 }
 
 func (AppConfigurationResource) purgeProtection(data acceptance.TestData, enabled bool) string {
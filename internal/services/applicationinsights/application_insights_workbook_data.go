@@ -0,0 +1,168 @@
+package applicationinsights
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// workbookParameterStepType is the `type` of a workbook item which defines parameters
+const workbookParameterStepType = 9
+
+// workbookGroupStepType is the `type` of a workbook item which contains other items
+const workbookGroupStepType = 12
+
+// normalizeWorkbookDataJson returns a canonical form of the serialized data of a workbook, so that it can be compared
+// regardless of the ordering of keys and the fields which are populated by the service
+func normalizeWorkbookDataJson(input string) (string, error) {
+	data, err := decodeWorkbookDataJson(input)
+	if err != nil {
+		return "", err
+	}
+
+	// the service populates `fallbackResourceIds` based on where the workbook was last opened from
+	delete(data, "fallbackResourceIds")
+
+	// maps are encoded with their keys sorted, which gives a stable ordering
+	output, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+func suppressWorkbookDataJsonDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return workbookDataJsonEquivalent(old, new)
+}
+
+func workbookDataJsonEquivalent(first, second string) bool {
+	if first == "" || second == "" {
+		return first == second
+	}
+
+	firstNormalized, err := normalizeWorkbookDataJson(first)
+	if err != nil {
+		return false
+	}
+	secondNormalized, err := normalizeWorkbookDataJson(second)
+	if err != nil {
+		return false
+	}
+	return firstNormalized == secondNormalized
+}
+
+// applyWorkbookTemplateParameters returns the serialized data of a workbook created from the template data, where the
+// default value of each named parameter is replaced by the specified value
+func applyWorkbookTemplateParameters(templateData string, templateId string, parameters map[string]string) (string, error) {
+	data, err := decodeWorkbookDataJson(templateData)
+	if err != nil {
+		return "", fmt.Errorf("parsing the template data: %+v", err)
+	}
+
+	data["fromTemplateId"] = templateId
+
+	found := make(map[string]struct{})
+	if items, ok := data["items"].([]interface{}); ok {
+		if err := setWorkbookParameterValues(items, parameters, found); err != nil {
+			return "", err
+		}
+	}
+
+	missing := make([]string, 0)
+	for name := range parameters {
+		if _, ok := found[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("the parameters %q were not found in the template", strings.Join(missing, ", "))
+	}
+
+	output, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+func setWorkbookParameterValues(items []interface{}, parameters map[string]string, found map[string]struct{}) error {
+	for _, raw := range items {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		content, ok := item["content"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch workbookItemType(item) {
+		case workbookParameterStepType:
+			definitions, ok := content["parameters"].([]interface{})
+			if !ok {
+				continue
+			}
+			for _, v := range definitions {
+				definition, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, ok := definition["name"].(string)
+				if !ok {
+					continue
+				}
+				value, ok := parameters[name]
+				if !ok {
+					continue
+				}
+
+				// parameters which allow multiple selections take a list of values, which is specified as a JSON array
+				var parsed interface{} = value
+				if strings.HasPrefix(strings.TrimSpace(value), "[") {
+					var values []interface{}
+					if err := json.Unmarshal([]byte(value), &values); err != nil {
+						return fmt.Errorf("parsing the value of the parameter %q: %+v", name, err)
+					}
+					parsed = values
+				}
+				definition["value"] = parsed
+				found[name] = struct{}{}
+			}
+
+		case workbookGroupStepType:
+			if nested, ok := content["items"].([]interface{}); ok {
+				if err := setWorkbookParameterValues(nested, parameters, found); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func workbookItemType(item map[string]interface{}) int64 {
+	if v, ok := item["type"].(json.Number); ok {
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+	}
+	return 0
+}
+
+func decodeWorkbookDataJson(input string) (map[string]interface{}, error) {
+	// numbers are kept as they were specified, rather than being converted to floats
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.UseNumber()
+
+	data := make(map[string]interface{})
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
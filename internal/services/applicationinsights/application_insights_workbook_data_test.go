@@ -0,0 +1,136 @@
+package applicationinsights
+
+import (
+	"testing"
+)
+
+func TestWorkbookDataJsonEquivalent(t *testing.T) {
+	testData := []struct {
+		First    string
+		Second   string
+		Expected bool
+	}{
+		{
+			First:    `{"version":"Notebook/1.0","items":[]}`,
+			Second:   `{"items":[],"version":"Notebook/1.0"}`,
+			Expected: true,
+		},
+		{
+			First:    `{"version":"Notebook/1.0","items":[]}`,
+			Second:   `{"version":"Notebook/1.0","items":[],"fallbackResourceIds":["Azure Monitor"]}`,
+			Expected: true,
+		},
+		{
+			First:    `{"version":"Notebook/1.0","items":[{"type":1,"name":"a"},{"type":1,"name":"b"}]}`,
+			Second:   `{"version":"Notebook/1.0","items":[{"type":1,"name":"b"},{"type":1,"name":"a"}]}`,
+			Expected: false,
+		},
+		{
+			First:    `{"version":"Notebook/1.0","isLocked":false}`,
+			Second:   `{"version":"Notebook/1.0","isLocked":true}`,
+			Expected: false,
+		},
+		{
+			First:    `{"version":"Notebook/1.0"}`,
+			Second:   "",
+			Expected: false,
+		},
+		{
+			First:    `{"version":"Notebook/1.0"}`,
+			Second:   "not-json",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q..", v.First, v.Second)
+
+		actual := workbookDataJsonEquivalent(v.First, v.Second)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestApplyWorkbookTemplateParameters(t *testing.T) {
+	templateData := `{
+  "version": "Notebook/1.0",
+  "items": [
+    {
+      "type": 9,
+      "content": {
+        "parameters": [
+          {"name": "TimeRange", "type": 4, "value": {"durationMs": 86400000}},
+          {"name": "Subscriptions", "type": 6, "multiSelect": true}
+        ]
+      }
+    },
+    {
+      "type": 12,
+      "content": {
+        "items": [
+          {
+            "type": 9,
+            "content": {
+              "parameters": [
+                {"name": "Environment", "type": 1}
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ]
+}`
+	templateId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/workbookTemplates/template1"
+
+	testData := []struct {
+		Parameters map[string]string
+		Expected   string
+		Error      bool
+	}{
+		{
+			Parameters: map[string]string{},
+			Expected:   `{"fromTemplateId":"` + templateId + `","items":[{"content":{"parameters":[{"name":"TimeRange","type":4,"value":{"durationMs":86400000}},{"multiSelect":true,"name":"Subscriptions","type":6}]},"type":9},{"content":{"items":[{"content":{"parameters":[{"name":"Environment","type":1}]},"type":9}]},"type":12}],"version":"Notebook/1.0"}`,
+		},
+		{
+			Parameters: map[string]string{
+				"Environment":   "production",
+				"Subscriptions": `["/subscriptions/12345678-1234-9876-4563-123456789012"]`,
+			},
+			Expected: `{"fromTemplateId":"` + templateId + `","items":[{"content":{"parameters":[{"name":"TimeRange","type":4,"value":{"durationMs":86400000}},{"multiSelect":true,"name":"Subscriptions","type":6,"value":["/subscriptions/12345678-1234-9876-4563-123456789012"]}]},"type":9},{"content":{"items":[{"content":{"parameters":[{"name":"Environment","type":1,"value":"production"}]},"type":9}]},"type":12}],"version":"Notebook/1.0"}`,
+		},
+		{
+			Parameters: map[string]string{
+				"Region": "westeurope",
+			},
+			Error: true,
+		},
+		{
+			Parameters: map[string]string{
+				"Subscriptions": `[not-json`,
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v..", v.Parameters)
+
+		actual, err := applyWorkbookTemplateParameters(templateData, templateId, v.Parameters)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
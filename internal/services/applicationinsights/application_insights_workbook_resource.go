@@ -2,6 +2,7 @@ package applicationinsights
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
 	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
@@ -18,16 +20,22 @@ import (
 )
 
 type ApplicationInsightsWorkbookModel struct {
-	Name               string            `tfschema:"name"`
-	ResourceGroupName  string            `tfschema:"resource_group_name"`
-	Category           string            `tfschema:"category"`
-	Description        string            `tfschema:"description"`
-	DisplayName        string            `tfschema:"display_name"`
-	Location           string            `tfschema:"location"`
-	DataJson           string            `tfschema:"data_json"`
-	SourceId           string            `tfschema:"source_id"`
-	StorageContainerId string            `tfschema:"storage_container_id"`
-	Tags               map[string]string `tfschema:"tags"`
+	Name               string             `tfschema:"name"`
+	ResourceGroupName  string             `tfschema:"resource_group_name"`
+	Category           string             `tfschema:"category"`
+	Description        string             `tfschema:"description"`
+	DisplayName        string             `tfschema:"display_name"`
+	Location           string             `tfschema:"location"`
+	DataJson           string             `tfschema:"data_json"`
+	SourceId           string             `tfschema:"source_id"`
+	StorageContainerId string             `tfschema:"storage_container_id"`
+	Template           []WorkbookTemplate `tfschema:"template"`
+	Tags               map[string]string  `tfschema:"tags"`
+}
+
+type WorkbookTemplate struct {
+	TemplateId string            `tfschema:"template_id"`
+	Parameters map[string]string `tfschema:"parameters"`
 }
 
 type ApplicationInsightsWorkbookResource struct{}
@@ -70,9 +78,41 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 
 		"data_json": {
 			Type:             pluginsdk.TypeString,
-			Required:         true,
+			Optional:         true,
+			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			DiffSuppressFunc: suppressWorkbookDataJsonDiff,
+			ExactlyOneOf: []string{
+				"data_json",
+				"template",
+			},
+		},
+
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"template_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: workbooktemplates.ValidateWorkbookTemplateID,
+					},
+
+					"parameters": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+			ExactlyOneOf: []string{
+				"data_json",
+				"template",
+			},
 		},
 
 		"source_id": {
@@ -148,6 +188,14 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			dataJson := model.DataJson
+			if len(model.Template) > 0 {
+				dataJson, err = r.renderTemplate(ctx, metadata, model.Template[0])
+				if err != nil {
+					return err
+				}
+			}
+
 			kindValue := workbooks.WorkbookSharedTypeKindShared
 			properties := &workbooks.Workbook{
 				Identity: identityValue,
@@ -156,7 +204,7 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 				Properties: &workbooks.WorkbookProperties{
 					Category:       model.Category,
 					DisplayName:    model.DisplayName,
-					SerializedData: model.DataJson,
+					SerializedData: dataJson,
 					SourceId:       &model.SourceId,
 				},
 
@@ -219,7 +267,13 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				properties.Properties.DisplayName = model.DisplayName
 			}
 
-			if metadata.ResourceData.HasChange("data_json") {
+			if metadata.ResourceData.HasChange("template") && len(model.Template) > 0 {
+				dataJson, err := r.renderTemplate(ctx, metadata, model.Template[0])
+				if err != nil {
+					return err
+				}
+				properties.Properties.SerializedData = dataJson
+			} else if metadata.ResourceData.HasChange("data_json") && len(model.Template) == 0 {
 				properties.Properties.SerializedData = model.DataJson
 			}
 
@@ -261,10 +315,17 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: model was nil", id)
 			}
 
+			var existing ApplicationInsightsWorkbookModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			state := ApplicationInsightsWorkbookModel{
 				Name:              id.WorkbookName,
 				ResourceGroupName: id.ResourceGroupName,
 				Location:          location.NormalizeNilable(model.Location),
+				// the parameters used to render the template aren't returned by the API
+				Template: existing.Template,
 			}
 
 			identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
@@ -285,7 +346,12 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 
 				state.DisplayName = properties.DisplayName
 
+				// the service reorders the serialized data and populates `fallbackResourceIds`, so the existing value is kept
+				// when the two are equivalent to avoid a diff
 				state.DataJson = properties.SerializedData
+				if workbookDataJsonEquivalent(existing.DataJson, properties.SerializedData) {
+					state.DataJson = existing.DataJson
+				}
 
 				if properties.SourceId != nil {
 					state.SourceId = *properties.SourceId
@@ -307,6 +373,36 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r ApplicationInsightsWorkbookResource) renderTemplate(ctx context.Context, metadata sdk.ResourceMetaData, template WorkbookTemplate) (string, error) {
+	client := metadata.Client.AppInsights.WorkbookTemplateClient
+
+	templateId, err := workbooktemplates.ParseWorkbookTemplateID(template.TemplateId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.WorkbookTemplatesGet(ctx, *templateId)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", *templateId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.TemplateData == nil {
+		return "", fmt.Errorf("retrieving %s: `properties.templateData` was nil", *templateId)
+	}
+
+	templateData, err := json.Marshal(resp.Model.Properties.TemplateData)
+	if err != nil {
+		return "", fmt.Errorf("marshaling the template data of %s: %+v", *templateId, err)
+	}
+
+	dataJson, err := applyWorkbookTemplateParameters(string(templateData), templateId.ID(), template.Parameters)
+	if err != nil {
+		return "", fmt.Errorf("rendering %s: %+v", *templateId, err)
+	}
+
+	return dataJson, nil
+}
+
 func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -80,6 +80,28 @@ func TestAccApplicationInsightsWorkbook_update(t *testing.T) {
 	})
 }
 
+func TestAccApplicationInsightsWorkbook_fromTemplate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := ApplicationInsightsWorkbookResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromTemplate(data, "production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_json").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("template"),
+		{
+			Config: r.fromTemplate(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template"),
+	})
+}
+
 func TestAccApplicationInsightsWorkbook_hiddenTitleInTags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
 	r := ApplicationInsightsWorkbookResource{}
@@ -151,6 +173,59 @@ resource "azurerm_application_insights_workbook" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ApplicationInsightsWorkbookResource) fromTemplate(data acceptance.TestData, environment string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook_template" "test" {
+  name                = "acctest-aiwt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  galleries {
+    category = "workbook"
+    name     = "test"
+  }
+
+  template_data = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 9,
+        "content" = {
+          "version" = "KqlParameterItem/1.0",
+          "parameters" = [
+            {
+              "name"  = "Environment",
+              "type"  = 1,
+              "value" = "development"
+            }
+          ]
+        },
+        "name" = "parameters - 0"
+      }
+    ],
+    "isLocked" = false
+  })
+}
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+
+  template {
+    template_id = azurerm_application_insights_workbook_template.test.id
+    parameters = {
+      Environment = "%s"
+    }
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, environment)
+}
+
 func (r ApplicationInsightsWorkbookResource) hiddenTitleInTags(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,173 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// TODO 4.0: check if this can be removed
+// the `minTlsCipherSuite` Site Config property and the `endToEndEncryptionEnabled` Site property were introduced in
+// API version `2022-03-01` which isn't available in the vendored SDK - so these are sent/retrieved separately using
+// the newer API version.
+
+const siteTlsSettingsAPIVersion = "2022-03-01"
+
+type SiteTlsSettings struct {
+	// MinTlsCipherSuite is the minimum strength TLS cipher suite allowed for the app, an empty value means the platform default
+	MinTlsCipherSuite string
+
+	EndToEndEncryptionEnabled bool
+}
+
+// UpdateSiteTlsSettings updates the TLS settings of the Web App, or of the Slot of the Web App when `slotName` is specified
+func UpdateSiteTlsSettings(ctx context.Context, client *web.AppsClient, resourceGroupName string, name string, slotName string, settings SiteTlsSettings) error {
+	var minTlsCipherSuite interface{}
+	if settings.MinTlsCipherSuite != "" {
+		minTlsCipherSuite = settings.MinTlsCipherSuite
+	}
+
+	siteBody := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"endToEndEncryptionEnabled": settings.EndToEndEncryptionEnabled,
+		},
+	}
+	if err := patchSiteResource(ctx, client, sitePath(resourceGroupName, name, slotName, ""), siteBody); err != nil {
+		return err
+	}
+
+	configBody := map[string]interface{}{
+		"properties": map[string]interface{}{
+			// an explicit `null` resets the cipher suite to the platform default
+			"minTlsCipherSuite": minTlsCipherSuite,
+		},
+	}
+	return patchSiteResource(ctx, client, sitePath(resourceGroupName, name, slotName, "/config/web"), configBody)
+}
+
+// GetSiteTlsSettings retrieves the TLS settings of the Web App, or of the Slot of the Web App when `slotName` is specified
+func GetSiteTlsSettings(ctx context.Context, client *web.AppsClient, resourceGroupName string, name string, slotName string) (*SiteTlsSettings, error) {
+	var site struct {
+		Properties *struct {
+			EndToEndEncryptionEnabled *bool `json:"endToEndEncryptionEnabled,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	if err := getSiteResource(ctx, client, sitePath(resourceGroupName, name, slotName, ""), &site); err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		Properties *struct {
+			MinTlsCipherSuite *string `json:"minTlsCipherSuite,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	if err := getSiteResource(ctx, client, sitePath(resourceGroupName, name, slotName, "/config/web"), &config); err != nil {
+		return nil, err
+	}
+
+	result := SiteTlsSettings{}
+	if site.Properties != nil && site.Properties.EndToEndEncryptionEnabled != nil {
+		result.EndToEndEncryptionEnabled = *site.Properties.EndToEndEncryptionEnabled
+	}
+	if config.Properties != nil && config.Properties.MinTlsCipherSuite != nil {
+		result.MinTlsCipherSuite = *config.Properties.MinTlsCipherSuite
+	}
+
+	return &result, nil
+}
+
+func sitePath(resourceGroupName string, name string, slotName string, suffix string) sitePathParameters {
+	return sitePathParameters{
+		resourceGroupName: resourceGroupName,
+		name:              name,
+		slotName:          slotName,
+		suffix:            suffix,
+	}
+}
+
+type sitePathParameters struct {
+	resourceGroupName string
+	name              string
+	slotName          string
+	suffix            string
+}
+
+func (p sitePathParameters) decorator(client *web.AppsClient) autorest.PrepareDecorator {
+	pathParameters := map[string]interface{}{
+		"name":              autorest.Encode("path", p.name),
+		"resourceGroupName": autorest.Encode("path", p.resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	path := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/sites/{name}"
+	if p.slotName != "" {
+		pathParameters["slot"] = autorest.Encode("path", p.slotName)
+		path += "/slots/{slot}"
+	}
+
+	return autorest.WithPathParameters(path+p.suffix, pathParameters)
+}
+
+func patchSiteResource(ctx context.Context, client *web.AppsClient, path sitePathParameters, body map[string]interface{}) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		path.decorator(client),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": siteTlsSettingsAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Update", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Update", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Update", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func getSiteResource(ctx context.Context, client *web.AppsClient, path sitePathParameters, result interface{}) error {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		path.decorator(client),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": siteTlsSettingsAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "web.AppsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return nil
+}
@@ -1306,6 +1306,9 @@ func flattenVirtualApplications(appVirtualApplications *[]web.VirtualApplication
 }
 
 func onlyDefaultVirtualApplication(input []web.VirtualApplication) bool {
+	if len(input) == 0 {
+		return true
+	}
 	if len(input) > 1 {
 		return false
 	}
@@ -1313,7 +1316,7 @@ func onlyDefaultVirtualApplication(input []web.VirtualApplication) bool {
 	if app.VirtualPath == nil || app.PhysicalPath == nil {
 		return false
 	}
-	if *app.VirtualPath == "/" && *app.PhysicalPath == "site\\wwwroot" && *app.PreloadEnabled && app.VirtualDirectories == nil {
+	if *app.VirtualPath == "/" && *app.PhysicalPath == "site\\wwwroot" && pointer.From(app.PreloadEnabled) && app.VirtualDirectories == nil {
 		return true
 	}
 	return false
@@ -0,0 +1,100 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenVirtualApplications(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    *[]web.VirtualApplication
+		expected []VirtualApplication
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			input:    &[]web.VirtualApplication{},
+			expected: nil,
+		},
+		{
+			name: "default without preload",
+			input: &[]web.VirtualApplication{
+				{
+					VirtualPath:  utils.String("/"),
+					PhysicalPath: utils.String("site\\wwwroot"),
+				},
+			},
+			expected: []VirtualApplication{
+				{
+					VirtualPath:  "/",
+					PhysicalPath: "site\\wwwroot",
+				},
+			},
+		},
+		{
+			name: "default",
+			input: &[]web.VirtualApplication{
+				{
+					VirtualPath:    utils.String("/"),
+					PhysicalPath:   utils.String("site\\wwwroot"),
+					PreloadEnabled: utils.Bool(true),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "custom",
+			input: &[]web.VirtualApplication{
+				{
+					VirtualPath:    utils.String("/"),
+					PhysicalPath:   utils.String("site\\wwwroot"),
+					PreloadEnabled: utils.Bool(true),
+				},
+				{
+					VirtualPath:  utils.String("/app"),
+					PhysicalPath: utils.String("site\\app"),
+					VirtualDirectories: &[]web.VirtualDirectory{
+						{
+							VirtualPath:  utils.String("/static"),
+							PhysicalPath: utils.String("site\\app\\static"),
+						},
+					},
+				},
+			},
+			expected: []VirtualApplication{
+				{
+					VirtualPath:  "/",
+					PhysicalPath: "site\\wwwroot",
+					Preload:      true,
+				},
+				{
+					VirtualPath:  "/app",
+					PhysicalPath: "site\\app",
+					VirtualDirectories: []VirtualDirectory{
+						{
+							VirtualPath:  "/static",
+							PhysicalPath: "site\\app\\static",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Logf("[DEBUG] Testing %q", c.name)
+
+		actual := flattenVirtualApplications(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected %+v for %q but got %+v", c.expected, c.name, actual)
+		}
+	}
+}
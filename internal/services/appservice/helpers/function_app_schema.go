@@ -53,6 +53,8 @@ type SiteConfigLinuxFunctionApp struct {
 	ApplicationStack              []ApplicationStackLinuxFunctionApp `tfschema:"application_stack"`
 	MinTlsVersion                 string                             `tfschema:"minimum_tls_version"`
 	ScmMinTlsVersion              string                             `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite             string                             `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled     bool                               `tfschema:"end_to_end_encryption_enabled"`
 	Cors                          []CorsSetting                      `tfschema:"cors"`
 	DetailedErrorLogging          bool                               `tfschema:"detailed_error_logging_enabled"`
 	LinuxFxVersion                string                             `tfschema:"linux_fx_version"`
@@ -306,6 +308,10 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 					Description: "Configures the minimum version of TLS required for SSL requests to the SCM site Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"vnet_route_all_enabled": {
@@ -488,6 +494,10 @@ func SiteConfigSchemaLinuxFunctionAppComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchemaComputed(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchemaComputed(),
+
 				"cors": CorsSettingsSchemaComputed(),
 
 				"vnet_route_all_enabled": {
@@ -540,6 +550,8 @@ type SiteConfigWindowsFunctionApp struct {
 	ApplicationStack              []ApplicationStackWindowsFunctionApp `tfschema:"application_stack"`
 	MinTlsVersion                 string                               `tfschema:"minimum_tls_version"`
 	ScmMinTlsVersion              string                               `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite             string                               `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled     bool                                 `tfschema:"end_to_end_encryption_enabled"`
 	Cors                          []CorsSetting                        `tfschema:"cors"`
 	DetailedErrorLogging          bool                                 `tfschema:"detailed_error_logging_enabled"`
 	WindowsFxVersion              string                               `tfschema:"windows_fx_version"`
@@ -779,6 +791,10 @@ func SiteConfigSchemaWindowsFunctionApp() *pluginsdk.Schema {
 					Description: "Configures the minimum version of TLS required for SSL requests to the SCM site Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"vnet_route_all_enabled": {
@@ -952,6 +968,10 @@ func SiteConfigSchemaWindowsFunctionAppComputed() *pluginsdk.Schema {
 					Computed: true,
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchemaComputed(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchemaComputed(),
+
 				"cors": CorsSettingsSchemaComputed(),
 
 				"vnet_route_all_enabled": {
@@ -46,6 +46,8 @@ type SiteConfigWindowsFunctionAppSlot struct {
 	ApplicationStack              []ApplicationStackWindowsFunctionApp `tfschema:"application_stack"`
 	MinTlsVersion                 string                               `tfschema:"minimum_tls_version"`
 	ScmMinTlsVersion              string                               `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite             string                               `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled     bool                                 `tfschema:"end_to_end_encryption_enabled"`
 	Cors                          []CorsSetting                        `tfschema:"cors"`
 	DetailedErrorLogging          bool                                 `tfschema:"detailed_error_logging_enabled"`
 	WindowsFxVersion              string                               `tfschema:"windows_fx_version"`
@@ -291,6 +293,10 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 					Description: "Configures the minimum version of TLS required for SSL requests to the SCM site Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"vnet_route_all_enabled": {
@@ -350,6 +356,8 @@ type SiteConfigLinuxFunctionAppSlot struct {
 	ApplicationStack              []ApplicationStackLinuxFunctionApp `tfschema:"application_stack"`
 	MinTlsVersion                 string                             `tfschema:"minimum_tls_version"`
 	ScmMinTlsVersion              string                             `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite             string                             `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled     bool                               `tfschema:"end_to_end_encryption_enabled"`
 	Cors                          []CorsSetting                      `tfschema:"cors"`
 	DetailedErrorLogging          bool                               `tfschema:"detailed_error_logging_enabled"`
 	LinuxFxVersion                string                             `tfschema:"linux_fx_version"`
@@ -609,6 +617,10 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 					Description: "Configures the minimum version of TLS required for SSL requests to the SCM site Possible values include: `1.0`, `1.1`, and  `1.2`. Defaults to `1.2`.",
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"vnet_route_all_enabled": {
@@ -0,0 +1,180 @@
+package helpers
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// siteConfigInheritanceExcludedKeys are the `site_config` keys which are always taken from the Slot, since they're either
+// specific to the Slot, or are stored in the App Settings / other configuration endpoints rather than the Site Config.
+var siteConfigInheritanceExcludedKeys = map[string]bool{
+	"app_service_logs":                       true,
+	"application_insights_connection_string": true,
+	"application_insights_key":               true,
+	"application_stack":                      true,
+	"auto_swap_slot_name":                    true,
+	"end_to_end_encryption_enabled":          true,
+	"health_check_eviction_time_in_min":      true,
+	"linux_fx_version":                       true,
+	"minimum_tls_cipher_suite":               true,
+	"windows_fx_version":                     true,
+}
+
+// ExplicitSiteConfigKeys returns the keys within the `site_config` block which have been explicitly set in the
+// configuration, these are treated as overrides when the Slot inherits the Site Config of the parent Function App.
+func ExplicitSiteConfigKeys(rawConfig cty.Value) map[string]bool {
+	output := make(map[string]bool)
+
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("site_config") {
+		return output
+	}
+
+	siteConfig := rawConfig.GetAttr("site_config")
+	if siteConfig.IsNull() || !siteConfig.IsKnown() || siteConfig.LengthInt() == 0 {
+		return output
+	}
+
+	block := siteConfig.AsValueSlice()[0]
+	if block.IsNull() || !block.IsKnown() {
+		return output
+	}
+
+	for k, v := range block.AsValueMap() {
+		if !v.IsKnown() {
+			output[k] = true
+			continue
+		}
+		if v.IsNull() {
+			continue
+		}
+		if t := v.Type(); (t.IsListType() || t.IsSetType() || t.IsTupleType()) && v.LengthInt() == 0 {
+			continue
+		}
+		output[k] = true
+	}
+
+	return output
+}
+
+// MergeInheritedSiteConfig overwrites each field within the Slot's Site Config which hasn't been explicitly set with the
+// value from the parent Function App's Site Config. Both `parent` and `slot` must be pointers to the same Site Config model.
+func MergeInheritedSiteConfig(parent, slot interface{}, explicitKeys map[string]bool) {
+	forEachInheritableSiteConfigField(parent, slot, func(key string, parentField, slotField reflect.Value) {
+		if explicitKeys[key] {
+			return
+		}
+		slotField.Set(parentField)
+	})
+}
+
+// RetainInheritedSiteConfig replaces each inherited field within the Slot's Site Config (as returned from the API) with the
+// value from the existing state, so that inherited values don't show a diff. The returned bool is false when any of the
+// inherited fields have drifted from the parent Function App's Site Config and need to be brought back in sync.
+func RetainInheritedSiteConfig(parent, slot, existing interface{}, overriddenKeys map[string]bool) bool {
+	existingVal := reflect.ValueOf(existing).Elem()
+	if existingVal.Type() != reflect.ValueOf(slot).Elem().Type() {
+		return true
+	}
+
+	inSync := true
+	forEachInheritableSiteConfigField(parent, slot, func(key string, parentField, slotField reflect.Value) {
+		if overriddenKeys[key] {
+			return
+		}
+		if !reflect.DeepEqual(parentField.Interface(), slotField.Interface()) {
+			inSync = false
+		}
+		slotField.Set(existingVal.FieldByName(slotFieldName(slot, key)))
+	})
+
+	return inSync
+}
+
+// InheritSiteConfigFields copies the fields from the parent Function App's Site Config into the expanded Site Config of the
+// Slot for each key which isn't overridden. This is needed since these fields are otherwise only sent when they've changed.
+func InheritSiteConfigFields(expanded, parent *web.SiteConfig, overriddenKeys map[string]bool) {
+	if expanded == nil || parent == nil {
+		return
+	}
+
+	for key, inherit := range siteConfigInheritedFields {
+		if !overriddenKeys[key] {
+			inherit(expanded, parent)
+		}
+	}
+}
+
+// InheritableSiteConfigKeys filters the keys down to those which can be inherited from the parent Function App.
+func InheritableSiteConfigKeys(keys map[string]bool) []string {
+	output := make([]string, 0)
+	for k := range keys {
+		if !siteConfigInheritanceExcludedKeys[k] {
+			output = append(output, k)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+var siteConfigInheritedFields = map[string]func(expanded, parent *web.SiteConfig){
+	"api_definition_url":                            func(e, p *web.SiteConfig) { e.APIDefinition = p.APIDefinition },
+	"api_management_api_id":                         func(e, p *web.SiteConfig) { e.APIManagementConfig = p.APIManagementConfig },
+	"app_command_line":                              func(e, p *web.SiteConfig) { e.AppCommandLine = p.AppCommandLine },
+	"app_scale_limit":                               func(e, p *web.SiteConfig) { e.FunctionAppScaleLimit = p.FunctionAppScaleLimit },
+	"container_registry_managed_identity_client_id": func(e, p *web.SiteConfig) { e.AcrUserManagedIdentityID = p.AcrUserManagedIdentityID },
+	"cors":                      func(e, p *web.SiteConfig) { e.Cors = p.Cors },
+	"default_documents":         func(e, p *web.SiteConfig) { e.DefaultDocuments = p.DefaultDocuments },
+	"ftps_state":                func(e, p *web.SiteConfig) { e.FtpsState = p.FtpsState },
+	"health_check_path":         func(e, p *web.SiteConfig) { e.HealthCheckPath = p.HealthCheckPath },
+	"ip_restriction":            func(e, p *web.SiteConfig) { e.IPSecurityRestrictions = p.IPSecurityRestrictions },
+	"load_balancing_mode":       func(e, p *web.SiteConfig) { e.LoadBalancing = p.LoadBalancing },
+	"managed_pipeline_mode":     func(e, p *web.SiteConfig) { e.ManagedPipelineMode = p.ManagedPipelineMode },
+	"minimum_tls_version":       func(e, p *web.SiteConfig) { e.MinTLSVersion = p.MinTLSVersion },
+	"pre_warmed_instance_count": func(e, p *web.SiteConfig) { e.PreWarmedInstanceCount = p.PreWarmedInstanceCount },
+	"remote_debugging_enabled":  func(e, p *web.SiteConfig) { e.RemoteDebuggingEnabled = p.RemoteDebuggingEnabled },
+	"remote_debugging_version":  func(e, p *web.SiteConfig) { e.RemoteDebuggingVersion = p.RemoteDebuggingVersion },
+	"runtime_scale_monitoring_enabled": func(e, p *web.SiteConfig) {
+		e.FunctionsRuntimeScaleMonitoringEnabled = p.FunctionsRuntimeScaleMonitoringEnabled
+	},
+	"scm_ip_restriction":      func(e, p *web.SiteConfig) { e.ScmIPSecurityRestrictions = p.ScmIPSecurityRestrictions },
+	"scm_minimum_tls_version": func(e, p *web.SiteConfig) { e.ScmMinTLSVersion = p.ScmMinTLSVersion },
+	"worker_count":            func(e, p *web.SiteConfig) { e.NumberOfWorkers = p.NumberOfWorkers },
+}
+
+func forEachInheritableSiteConfigField(parent, slot interface{}, f func(key string, parentField, slotField reflect.Value)) {
+	parentVal := reflect.ValueOf(parent).Elem()
+	slotVal := reflect.ValueOf(slot).Elem()
+	if parentVal.Type() != slotVal.Type() {
+		return
+	}
+
+	for i := 0; i < slotVal.NumField(); i++ {
+		key := tfSchemaKey(slotVal.Type().Field(i))
+		if key == "" || siteConfigInheritanceExcludedKeys[key] {
+			continue
+		}
+		f(key, parentVal.Field(i), slotVal.Field(i))
+	}
+}
+
+func slotFieldName(slot interface{}, key string) string {
+	t := reflect.TypeOf(slot).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		if tfSchemaKey(t.Field(i)) == key {
+			return t.Field(i).Name
+		}
+	}
+	return ""
+}
+
+func tfSchemaKey(field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup("tfschema")
+	if !ok {
+		return ""
+	}
+	return strings.Split(tag, ",")[0]
+}
@@ -13,39 +13,41 @@ import (
 )
 
 type SiteConfigLinuxWebAppSlot struct {
-	AlwaysOn                bool                    `tfschema:"always_on"`
-	ApiManagementConfigId   string                  `tfschema:"api_management_api_id"`
-	ApiDefinition           string                  `tfschema:"api_definition_url"`
-	AppCommandLine          string                  `tfschema:"app_command_line"`
-	AutoHeal                bool                    `tfschema:"auto_heal_enabled"`
-	AutoHealSettings        []AutoHealSettingLinux  `tfschema:"auto_heal_setting"`
-	AutoSwapSlotName        string                  `tfschema:"auto_swap_slot_name"`
-	UseManagedIdentityACR   bool                    `tfschema:"container_registry_use_managed_identity"`
-	ContainerRegistryMSI    string                  `tfschema:"container_registry_managed_identity_client_id"`
-	DefaultDocuments        []string                `tfschema:"default_documents"`
-	Http2Enabled            bool                    `tfschema:"http2_enabled"`
-	IpRestriction           []IpRestriction         `tfschema:"ip_restriction"`
-	ScmUseMainIpRestriction bool                    `tfschema:"scm_use_main_ip_restriction"`
-	ScmIpRestriction        []IpRestriction         `tfschema:"scm_ip_restriction"`
-	LoadBalancing           string                  `tfschema:"load_balancing_mode"`
-	LocalMysql              bool                    `tfschema:"local_mysql_enabled"`
-	ManagedPipelineMode     string                  `tfschema:"managed_pipeline_mode"`
-	RemoteDebugging         bool                    `tfschema:"remote_debugging_enabled"`
-	RemoteDebuggingVersion  string                  `tfschema:"remote_debugging_version"`
-	ScmType                 string                  `tfschema:"scm_type"`
-	Use32BitWorker          bool                    `tfschema:"use_32_bit_worker"`
-	WebSockets              bool                    `tfschema:"websockets_enabled"`
-	FtpsState               string                  `tfschema:"ftps_state"`
-	HealthCheckPath         string                  `tfschema:"health_check_path"`
-	HealthCheckEvictionTime int                     `tfschema:"health_check_eviction_time_in_min"`
-	WorkerCount             int                     `tfschema:"worker_count"`
-	ApplicationStack        []ApplicationStackLinux `tfschema:"application_stack"`
-	MinTlsVersion           string                  `tfschema:"minimum_tls_version"`
-	ScmMinTlsVersion        string                  `tfschema:"scm_minimum_tls_version"`
-	Cors                    []CorsSetting           `tfschema:"cors"`
-	DetailedErrorLogging    bool                    `tfschema:"detailed_error_logging_enabled"`
-	LinuxFxVersion          string                  `tfschema:"linux_fx_version"`
-	VnetRouteAllEnabled     bool                    `tfschema:"vnet_route_all_enabled"`
+	AlwaysOn                  bool                    `tfschema:"always_on"`
+	ApiManagementConfigId     string                  `tfschema:"api_management_api_id"`
+	ApiDefinition             string                  `tfschema:"api_definition_url"`
+	AppCommandLine            string                  `tfschema:"app_command_line"`
+	AutoHeal                  bool                    `tfschema:"auto_heal_enabled"`
+	AutoHealSettings          []AutoHealSettingLinux  `tfschema:"auto_heal_setting"`
+	AutoSwapSlotName          string                  `tfschema:"auto_swap_slot_name"`
+	UseManagedIdentityACR     bool                    `tfschema:"container_registry_use_managed_identity"`
+	ContainerRegistryMSI      string                  `tfschema:"container_registry_managed_identity_client_id"`
+	DefaultDocuments          []string                `tfschema:"default_documents"`
+	Http2Enabled              bool                    `tfschema:"http2_enabled"`
+	IpRestriction             []IpRestriction         `tfschema:"ip_restriction"`
+	ScmUseMainIpRestriction   bool                    `tfschema:"scm_use_main_ip_restriction"`
+	ScmIpRestriction          []IpRestriction         `tfschema:"scm_ip_restriction"`
+	LoadBalancing             string                  `tfschema:"load_balancing_mode"`
+	LocalMysql                bool                    `tfschema:"local_mysql_enabled"`
+	ManagedPipelineMode       string                  `tfschema:"managed_pipeline_mode"`
+	RemoteDebugging           bool                    `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion    string                  `tfschema:"remote_debugging_version"`
+	ScmType                   string                  `tfschema:"scm_type"`
+	Use32BitWorker            bool                    `tfschema:"use_32_bit_worker"`
+	WebSockets                bool                    `tfschema:"websockets_enabled"`
+	FtpsState                 string                  `tfschema:"ftps_state"`
+	HealthCheckPath           string                  `tfschema:"health_check_path"`
+	HealthCheckEvictionTime   int                     `tfschema:"health_check_eviction_time_in_min"`
+	WorkerCount               int                     `tfschema:"worker_count"`
+	ApplicationStack          []ApplicationStackLinux `tfschema:"application_stack"`
+	MinTlsVersion             string                  `tfschema:"minimum_tls_version"`
+	ScmMinTlsVersion          string                  `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite         string                  `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled bool                    `tfschema:"end_to_end_encryption_enabled"`
+	Cors                      []CorsSetting           `tfschema:"cors"`
+	DetailedErrorLogging      bool                    `tfschema:"detailed_error_logging_enabled"`
+	LinuxFxVersion            string                  `tfschema:"linux_fx_version"`
+	VnetRouteAllEnabled       bool                    `tfschema:"vnet_route_all_enabled"`
 	// SiteLimits []SiteLimitsSettings `tfschema:"site_limits"` // TODO - New block to (possibly) support? No way to configure this in the portal?
 }
 
@@ -245,6 +247,10 @@ func SiteConfigSchemaLinuxWebAppSlot() *pluginsdk.Schema {
 					}, false),
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"auto_swap_slot_name": {
@@ -275,40 +281,42 @@ func SiteConfigSchemaLinuxWebAppSlot() *pluginsdk.Schema {
 }
 
 type SiteConfigWindowsWebAppSlot struct {
-	AlwaysOn                 bool                      `tfschema:"always_on"`
-	ApiManagementConfigId    string                    `tfschema:"api_management_api_id"`
-	ApiDefinition            string                    `tfschema:"api_definition_url"`
-	ApplicationStack         []ApplicationStackWindows `tfschema:"application_stack"`
-	AppCommandLine           string                    `tfschema:"app_command_line"`
-	AutoHeal                 bool                      `tfschema:"auto_heal_enabled"`
-	AutoHealSettings         []AutoHealSettingWindows  `tfschema:"auto_heal_setting"`
-	AutoSwapSlotName         string                    `tfschema:"auto_swap_slot_name"`
-	UseManagedIdentityACR    bool                      `tfschema:"container_registry_use_managed_identity"`
-	ContainerRegistryUserMSI string                    `tfschema:"container_registry_managed_identity_client_id"`
-	DefaultDocuments         []string                  `tfschema:"default_documents"`
-	Http2Enabled             bool                      `tfschema:"http2_enabled"`
-	IpRestriction            []IpRestriction           `tfschema:"ip_restriction"`
-	ScmUseMainIpRestriction  bool                      `tfschema:"scm_use_main_ip_restriction"`
-	ScmIpRestriction         []IpRestriction           `tfschema:"scm_ip_restriction"`
-	LoadBalancing            string                    `tfschema:"load_balancing_mode"`
-	LocalMysql               bool                      `tfschema:"local_mysql_enabled"`
-	ManagedPipelineMode      string                    `tfschema:"managed_pipeline_mode"`
-	RemoteDebugging          bool                      `tfschema:"remote_debugging_enabled"`
-	RemoteDebuggingVersion   string                    `tfschema:"remote_debugging_version"`
-	ScmType                  string                    `tfschema:"scm_type"`
-	Use32BitWorker           bool                      `tfschema:"use_32_bit_worker"`
-	WebSockets               bool                      `tfschema:"websockets_enabled"`
-	FtpsState                string                    `tfschema:"ftps_state"`
-	HealthCheckPath          string                    `tfschema:"health_check_path"`
-	HealthCheckEvictionTime  int                       `tfschema:"health_check_eviction_time_in_min"`
-	WorkerCount              int                       `tfschema:"worker_count"`
-	VirtualApplications      []VirtualApplication      `tfschema:"virtual_application"`
-	MinTlsVersion            string                    `tfschema:"minimum_tls_version"`
-	ScmMinTlsVersion         string                    `tfschema:"scm_minimum_tls_version"`
-	Cors                     []CorsSetting             `tfschema:"cors"`
-	DetailedErrorLogging     bool                      `tfschema:"detailed_error_logging_enabled"`
-	WindowsFxVersion         string                    `tfschema:"windows_fx_version"`
-	VnetRouteAllEnabled      bool                      `tfschema:"vnet_route_all_enabled"`
+	AlwaysOn                  bool                      `tfschema:"always_on"`
+	ApiManagementConfigId     string                    `tfschema:"api_management_api_id"`
+	ApiDefinition             string                    `tfschema:"api_definition_url"`
+	ApplicationStack          []ApplicationStackWindows `tfschema:"application_stack"`
+	AppCommandLine            string                    `tfschema:"app_command_line"`
+	AutoHeal                  bool                      `tfschema:"auto_heal_enabled"`
+	AutoHealSettings          []AutoHealSettingWindows  `tfschema:"auto_heal_setting"`
+	AutoSwapSlotName          string                    `tfschema:"auto_swap_slot_name"`
+	UseManagedIdentityACR     bool                      `tfschema:"container_registry_use_managed_identity"`
+	ContainerRegistryUserMSI  string                    `tfschema:"container_registry_managed_identity_client_id"`
+	DefaultDocuments          []string                  `tfschema:"default_documents"`
+	Http2Enabled              bool                      `tfschema:"http2_enabled"`
+	IpRestriction             []IpRestriction           `tfschema:"ip_restriction"`
+	ScmUseMainIpRestriction   bool                      `tfschema:"scm_use_main_ip_restriction"`
+	ScmIpRestriction          []IpRestriction           `tfschema:"scm_ip_restriction"`
+	LoadBalancing             string                    `tfschema:"load_balancing_mode"`
+	LocalMysql                bool                      `tfschema:"local_mysql_enabled"`
+	ManagedPipelineMode       string                    `tfschema:"managed_pipeline_mode"`
+	RemoteDebugging           bool                      `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion    string                    `tfschema:"remote_debugging_version"`
+	ScmType                   string                    `tfschema:"scm_type"`
+	Use32BitWorker            bool                      `tfschema:"use_32_bit_worker"`
+	WebSockets                bool                      `tfschema:"websockets_enabled"`
+	FtpsState                 string                    `tfschema:"ftps_state"`
+	HealthCheckPath           string                    `tfschema:"health_check_path"`
+	HealthCheckEvictionTime   int                       `tfschema:"health_check_eviction_time_in_min"`
+	WorkerCount               int                       `tfschema:"worker_count"`
+	VirtualApplications       []VirtualApplication      `tfschema:"virtual_application"`
+	MinTlsVersion             string                    `tfschema:"minimum_tls_version"`
+	ScmMinTlsVersion          string                    `tfschema:"scm_minimum_tls_version"`
+	MinTlsCipherSuite         string                    `tfschema:"minimum_tls_cipher_suite"`
+	EndToEndEncryptionEnabled bool                      `tfschema:"end_to_end_encryption_enabled"`
+	Cors                      []CorsSetting             `tfschema:"cors"`
+	DetailedErrorLogging      bool                      `tfschema:"detailed_error_logging_enabled"`
+	WindowsFxVersion          string                    `tfschema:"windows_fx_version"`
+	VnetRouteAllEnabled       bool                      `tfschema:"vnet_route_all_enabled"`
 }
 
 func SiteConfigSchemaWindowsWebAppSlot() *pluginsdk.Schema {
@@ -513,6 +521,10 @@ func SiteConfigSchemaWindowsWebAppSlot() *pluginsdk.Schema {
 					}, false),
 				},
 
+				"minimum_tls_cipher_suite": MinTlsCipherSuiteSchema(),
+
+				"end_to_end_encryption_enabled": EndToEndEncryptionEnabledSchema(),
+
 				"cors": CorsSettingsSchema(),
 
 				"virtual_application": virtualApplicationsSchema(),
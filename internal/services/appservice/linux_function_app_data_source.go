@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -352,6 +353,15 @@ func (d LinuxFunctionAppDataSource) Read() sdk.ResourceFunc {
 			}
 			state.SiteConfig = []helpers.SiteConfigLinuxFunctionApp{*siteConfig}
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				state.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			state.unpackLinuxFunctionAppSettings(appSettingsResp, metadata)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
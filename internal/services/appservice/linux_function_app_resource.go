@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -555,6 +556,16 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if functionApp.SiteConfig[0].MinTlsCipherSuite != "" || functionApp.SiteConfig[0].EndToEndEncryptionEnabled {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         functionApp.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: functionApp.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "", tlsSettings); err != nil {
+					return fmt.Errorf("setting TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -679,6 +690,15 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 			}
 			state.SiteConfig = []helpers.SiteConfigLinuxFunctionApp{*siteConfig}
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				state.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			state.unpackLinuxFunctionAppSettings(appSettingsResp, metadata)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -981,6 +1001,16 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChanges("site_config.0.minimum_tls_cipher_suite", "site_config.0.end_to_end_encryption_enabled") {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         state.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: state.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "", tlsSettings); err != nil {
+					return fmt.Errorf("updating TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -3,6 +3,7 @@ package appservice
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -52,6 +54,9 @@ type LinuxFunctionAppSlotModel struct {
 	HttpsOnly                     bool                                     `tfschema:"https_only"`
 	KeyVaultReferenceIdentityID   string                                   `tfschema:"key_vault_reference_identity_id"`
 	SiteConfig                    []helpers.SiteConfigLinuxFunctionAppSlot `tfschema:"site_config"`
+	SiteConfigInheritanceEnabled  bool                                     `tfschema:"site_config_inheritance_enabled"`
+	SiteConfigOverrides           []string                                 `tfschema:"site_config_overrides"`
+	SiteConfigInheritedInSync     bool                                     `tfschema:"site_config_inherited_in_sync"`
 	Tags                          map[string]string                        `tfschema:"tags"`
 	VirtualNetworkSubnetID        string                                   `tfschema:"virtual_network_subnet_id"`
 	CustomDomainVerificationId    string                                   `tfschema:"custom_domain_verification_id"`
@@ -65,7 +70,10 @@ type LinuxFunctionAppSlotModel struct {
 	StorageAccounts               []helpers.StorageAccount                 `tfschema:"storage_account"`
 }
 
-var _ sdk.ResourceWithUpdate = LinuxFunctionAppSlotResource{}
+var (
+	_ sdk.ResourceWithUpdate        = LinuxFunctionAppSlotResource{}
+	_ sdk.ResourceWithCustomizeDiff = LinuxFunctionAppSlotResource{}
+)
 
 func (r LinuxFunctionAppSlotResource) ModelObject() interface{} {
 	return &LinuxFunctionAppSlotModel{}
@@ -245,6 +253,13 @@ func (r LinuxFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinuxFunctionAppSlot(),
 
+		"site_config_inheritance_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should the `site_config` be inherited from the parent Function App? When enabled only the `site_config` values which are explicitly set on this Slot override those of the parent Function App.",
+		},
+
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"tags": tags.Schema(),
@@ -259,6 +274,19 @@ func (r LinuxFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 func (r LinuxFunctionAppSlotResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
+		"site_config_overrides": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_config_inherited_in_sync": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
 		"custom_domain_verification_id": {
 			Type:      pluginsdk.TypeString,
 			Computed:  true,
@@ -423,10 +451,16 @@ func (r LinuxFunctionAppSlotResource) Create() sdk.ResourceFunc {
 					storageString = fmt.Sprintf(helpers.StorageStringFmt, functionAppSlot.StorageAccountName, functionAppSlot.StorageAccountKey, *storageDomainSuffix)
 				}
 			}
+			parentSiteConfig, overriddenKeys, err := inheritLinuxFunctionAppSlotSiteConfig(ctx, metadata, *functionAppId, &functionAppSlot)
+			if err != nil {
+				return fmt.Errorf("inheriting site_config for Linux %s: %+v", id, err)
+			}
+
 			siteConfig, err := helpers.ExpandSiteConfigLinuxFunctionAppSlot(functionAppSlot.SiteConfig, nil, metadata, functionAppSlot.FunctionExtensionsVersion, storageString, functionAppSlot.StorageUsesMSI)
 			if err != nil {
 				return fmt.Errorf("expanding site_config for Linux %s: %+v", id, err)
 			}
+			helpers.InheritSiteConfigFields(siteConfig, parentSiteConfig, overriddenKeys)
 
 			if functionAppSlot.BuiltinLogging {
 				if functionAppSlot.AppSettings == nil {
@@ -559,6 +593,16 @@ func (r LinuxFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if functionAppSlot.SiteConfig[0].MinTlsCipherSuite != "" || functionAppSlot.SiteConfig[0].EndToEndEncryptionEnabled {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         functionAppSlot.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: functionAppSlot.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, tlsSettings); err != nil {
+					return fmt.Errorf("setting TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -681,8 +725,47 @@ func (r LinuxFunctionAppSlotResource) Read() sdk.ResourceFunc {
 			}
 			state.SiteConfig = []helpers.SiteConfigLinuxFunctionAppSlot{*siteConfig}
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				state.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			state.unpackLinuxFunctionAppSettings(appSettingsResp, metadata)
 
+			state.SiteConfigInheritanceEnabled = metadata.ResourceData.Get("site_config_inheritance_enabled").(bool)
+			state.SiteConfigInheritedInSync = true
+			if state.SiteConfigInheritanceEnabled {
+				var existing LinuxFunctionAppSlotModel
+				if err := metadata.Decode(&existing); err != nil {
+					return fmt.Errorf("decoding: %+v", err)
+				}
+				state.SiteConfigOverrides = existing.SiteConfigOverrides
+
+				parentConfigResp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil {
+					return fmt.Errorf("reading Site Config for the parent Function App of Linux %s: %+v", id, err)
+				}
+				parentSiteConfig, err := helpers.FlattenSiteConfigLinuxFunctionAppSlot(parentConfigResp.SiteConfig)
+				if err != nil {
+					return fmt.Errorf("flattening Site Config for the parent Function App of Linux %s: %+v", id, err)
+				}
+
+				existingSiteConfig := helpers.SiteConfigLinuxFunctionAppSlot{}
+				if len(existing.SiteConfig) > 0 {
+					existingSiteConfig = existing.SiteConfig[0]
+				}
+
+				overriddenKeys := make(map[string]bool)
+				for _, k := range state.SiteConfigOverrides {
+					overriddenKeys[k] = true
+				}
+				state.SiteConfigInheritedInSync = helpers.RetainInheritedSiteConfig(parentSiteConfig, &state.SiteConfig[0], &existingSiteConfig, overriddenKeys)
+			}
+
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
@@ -722,6 +805,36 @@ func (r LinuxFunctionAppSlotResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r LinuxFunctionAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			overrides := make([]string, 0)
+			if rd.Get("site_config_inheritance_enabled").(bool) {
+				overrides = helpers.InheritableSiteConfigKeys(helpers.ExplicitSiteConfigKeys(rd.GetRawConfig()))
+			}
+
+			existing := utils.ExpandStringSlice(rd.Get("site_config_overrides").([]interface{}))
+			if rd.Id() == "" || !reflect.DeepEqual(*existing, overrides) {
+				if err := rd.SetNew("site_config_overrides", overrides); err != nil {
+					return err
+				}
+			}
+
+			// when the inherited `site_config` has drifted from the parent Function App an update is needed to bring it back in sync
+			if rd.Id() != "" && rd.Get("site_config_inheritance_enabled").(bool) && !rd.Get("site_config_inherited_in_sync").(bool) {
+				if err := rd.SetNew("site_config_inherited_in_sync", true); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r LinuxFunctionAppSlotResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -874,8 +987,16 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				state.AppSettings = helpers.ParseContentSettings(appSettingsResp, state.AppSettings)
 			}
 
+			parentSiteConfig, overriddenKeys, err := inheritLinuxFunctionAppSlotSiteConfig(ctx, metadata, parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName), &state)
+			if err != nil {
+				return fmt.Errorf("inheriting site_config for Linux %s: %+v", id, err)
+			}
+
 			// Note: We process this regardless to give us a "clean" view of service-side app_settings, so we can reconcile the user-defined entries later
 			siteConfig, err := helpers.ExpandSiteConfigLinuxFunctionAppSlot(state.SiteConfig, existing.SiteConfig, metadata, state.FunctionExtensionsVersion, storageString, state.StorageUsesMSI)
+			if err == nil {
+				helpers.InheritSiteConfigFields(siteConfig, parentSiteConfig, overriddenKeys)
+			}
 			if state.BuiltinLogging {
 				if state.AppSettings == nil && !state.StorageUsesMSI {
 					state.AppSettings = make(map[string]string)
@@ -973,6 +1094,16 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChanges("site_config.0.minimum_tls_cipher_suite", "site_config.0.end_to_end_encryption_enabled") {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         state.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: state.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, tlsSettings); err != nil {
+					return fmt.Errorf("updating TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -1075,3 +1206,26 @@ func (m *LinuxFunctionAppSlotModel) unpackLinuxFunctionAppSettings(input web.Str
 
 	m.AppSettings = appSettings
 }
+
+// inheritLinuxFunctionAppSlotSiteConfig merges the Site Config of the parent Function App into the `site_config` of the Slot
+// when `site_config_inheritance_enabled` is set, returning the parent Site Config and the keys explicitly set on the Slot
+func inheritLinuxFunctionAppSlotSiteConfig(ctx context.Context, metadata sdk.ResourceMetaData, functionAppId parse.FunctionAppId, model *LinuxFunctionAppSlotModel) (*web.SiteConfig, map[string]bool, error) {
+	overriddenKeys := helpers.ExplicitSiteConfigKeys(metadata.ResourceData.GetRawConfig())
+	if !model.SiteConfigInheritanceEnabled || len(model.SiteConfig) == 0 {
+		return nil, overriddenKeys, nil
+	}
+
+	configResp, err := metadata.Client.AppService.WebAppsClient.GetConfiguration(ctx, functionAppId.ResourceGroup, functionAppId.SiteName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading Site Config for the parent %s: %+v", functionAppId, err)
+	}
+
+	parent, err := helpers.FlattenSiteConfigLinuxFunctionAppSlot(configResp.SiteConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("flattening Site Config for the parent %s: %+v", functionAppId, err)
+	}
+
+	helpers.MergeInheritedSiteConfig(parent, &model.SiteConfig[0], overriddenKeys)
+
+	return configResp.SiteConfig, overriddenKeys, nil
+}
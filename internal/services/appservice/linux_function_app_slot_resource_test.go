@@ -1211,6 +1211,30 @@ func TestAccLinuxFunctionAppSlot_withStorageAccountBlockUpdate(t *testing.T) {
 
 // Configs
 
+func TestAccLinuxFunctionAppSlot_siteConfigInheritance(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_function_app_slot", "test")
+	r := LinuxFunctionAppSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.siteConfigInheritance(data, SkuStandardPlan, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config_inherited_in_sync").HasValue("true"),
+				check.That(data.ResourceName).Key("site_config_overrides.#").HasValue("1"),
+				check.That(data.ResourceName).Key("site_config_overrides.0").HasValue("websockets_enabled"),
+			),
+		},
+		{
+			Config: r.siteConfigInheritance(data, SkuStandardPlan, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config_inherited_in_sync").HasValue("true"),
+			),
+		},
+	})
+}
+
 func (r LinuxFunctionAppSlotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FunctionAppSlotID(state.ID)
 	if err != nil {
@@ -3252,3 +3276,60 @@ data "azurerm_storage_account_sas" "test" {
 }
 `, r.template(data, planSKU), data.RandomInteger)
 }
+
+func (r LinuxFunctionAppSlotResource) siteConfigInheritance(data acceptance.TestData, planSku string, http2Enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-LFA-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "%[4]s"
+}
+
+resource "azurerm_linux_function_app" "test" {
+  name                = "acctest-LFA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    http2_enabled      = %[5]t
+    websockets_enabled = true
+  }
+}
+
+resource "azurerm_linux_function_app_slot" "test" {
+  name                       = "acctest-LFAS-%[1]d"
+  function_app_id            = azurerm_linux_function_app.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config_inheritance_enabled = true
+
+  site_config {
+    websockets_enabled = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, planSku, http2Enabled)
+}
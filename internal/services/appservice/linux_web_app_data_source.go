@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -334,6 +335,15 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 
 			webApp.SiteConfig = helpers.FlattenSiteConfigLinux(webAppSiteConfig.SiteConfig, healthCheckCount)
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(webApp.SiteConfig) > 0 {
+				webApp.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				webApp.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			webApp.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
 
 			webApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -430,6 +431,16 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if webApp.SiteConfig[0].MinTlsCipherSuite != "" || webApp.SiteConfig[0].EndToEndEncryptionEnabled {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         webApp.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: webApp.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "", tlsSettings); err != nil {
+					return fmt.Errorf("setting TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -570,6 +581,15 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.SiteConfig = helpers.FlattenSiteConfigLinux(webAppSiteConfig.SiteConfig, healthCheckCount)
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				state.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			state.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -847,6 +867,16 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChanges("site_config.0.minimum_tls_cipher_suite", "site_config.0.end_to_end_encryption_enabled") {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         state.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: state.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "", tlsSettings); err != nil {
+					return fmt.Errorf("updating TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
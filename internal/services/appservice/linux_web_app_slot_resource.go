@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -409,6 +410,16 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if webAppSlot.SiteConfig[0].MinTlsCipherSuite != "" || webAppSlot.SiteConfig[0].EndToEndEncryptionEnabled {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         webAppSlot.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: webAppSlot.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, tlsSettings); err != nil {
+					return fmt.Errorf("setting TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -558,6 +569,15 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 
 			state.SiteConfig = helpers.FlattenSiteConfigLinuxWebAppSlot(webAppSiteConfig.SiteConfig, healthCheckCount)
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				state.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			state.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -804,6 +824,16 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChanges("site_config.0.minimum_tls_cipher_suite", "site_config.0.end_to_end_encryption_enabled") {
+				tlsSettings := azuresdkhacks.SiteTlsSettings{
+					MinTlsCipherSuite:         state.SiteConfig[0].MinTlsCipherSuite,
+					EndToEndEncryptionEnabled: state.SiteConfig[0].EndToEndEncryptionEnabled,
+				}
+				if err := azuresdkhacks.UpdateSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, tlsSettings); err != nil {
+					return fmt.Errorf("updating TLS Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -248,6 +248,11 @@ func (r SourceControlResource) Read() sdk.ResourceFunc {
 
 			props := *appSourceControl.SiteSourceControlProperties
 
+			var existing SourceControlModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			state := SourceControlModel{
 				AppID:                     id.ID(),
 				SCMType:                   string(siteConfig.ScmType),
@@ -257,7 +262,7 @@ func (r SourceControlResource) Read() sdk.ResourceFunc {
 				UseMercurial:              utils.NormaliseNilableBool(props.IsMercurial),
 				RollbackEnabled:           utils.NormaliseNilableBool(props.DeploymentRollbackEnabled),
 				UsesGithubAction:          utils.NormaliseNilableBool(props.IsGitHubAction),
-				GithubActionConfiguration: flattenGitHubActionConfiguration(props.GitHubActionConfiguration),
+				GithubActionConfiguration: flattenGitHubActionConfiguration(props.GitHubActionConfiguration, existing.GithubActionConfiguration),
 				LocalGitSCM:               siteConfig.ScmType == web.ScmTypeLocalGit,
 			}
 
@@ -155,6 +155,26 @@ func TestAccSourceControlResource_linuxGitHubAction(t *testing.T) {
 	})
 }
 
+func TestAccSourceControlResource_linuxGitHubActionContainer(t *testing.T) {
+	if ok := os.Getenv("ARM_GITHUB_ACCESS_TOKEN"); ok == "" {
+		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_source_control", "test")
+	r := AppServiceSourceControlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxGitHubActionContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uses_github_action").HasValue("true"),
+			),
+		},
+		data.ImportStep("github_action_configuration.0.container_configuration.0.registry_password"),
+	})
+}
+
 func TestAccSourceControlResource_linuxGitHub(t *testing.T) {
 	if ok := os.Getenv("ARM_GITHUB_ACCESS_TOKEN"); ok == "" {
 		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
@@ -359,6 +379,51 @@ resource "azurerm_app_service_source_control" "test" {
 `, r.baseLinuxAppTemplate(data), token)
 }
 
+func (r AppServiceSourceControlResource) linuxGitHubActionContainer(data acceptance.TestData) string {
+	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_registry" "test" {
+  name                = "acctestacr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+  admin_enabled       = true
+}
+
+resource "azurerm_source_control_token" "test" {
+  type  = "GitHub"
+  token = "%s"
+}
+
+resource "azurerm_app_service_source_control" "test" {
+  app_id   = azurerm_linux_web_app.test.id
+  repo_url = "https://github.com/Azure-Samples/python-docs-hello-world.git"
+  branch   = "master"
+
+  github_action_configuration {
+    generate_workflow_file = true
+
+    container_configuration {
+      registry_url      = "https://${azurerm_container_registry.test.login_server}"
+      image_name        = "python-docs-hello-world"
+      registry_username = azurerm_container_registry.test.admin_username
+      registry_password = azurerm_container_registry.test.admin_password
+    }
+  }
+
+  depends_on = [
+    azurerm_source_control_token.test,
+  ]
+}
+`, r.baseLinuxAppTemplate(data), data.RandomInteger, token)
+}
+
 func (r AppServiceSourceControlResource) linuxGitHub(data acceptance.TestData) string {
 	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
 	return fmt.Sprintf(`
@@ -2,6 +2,7 @@ package appservice
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -19,7 +20,7 @@ type GitHubActionCodeConfig struct {
 	RuntimeVersion string `tfschema:"runtime_version"`
 }
 
-type GitHubActionContainerConfig struct { // TODO - May need to remove this, cannot find a valid way to use it?
+type GitHubActionContainerConfig struct {
 	RegistryURL      string `tfschema:"registry_url"`
 	ImageName        string `tfschema:"image_name"`
 	RegistryUsername string `tfschema:"registry_username"`
@@ -38,22 +39,29 @@ func githubActionConfigSchema() *pluginsdk.Schema {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
 					MaxItems: 1,
+					// TODO 4.0: only one of the workflow templates can be generated, so these become mutually exclusive
+					ConflictsWith: func() []string {
+						if features.FourPointOhBeta() {
+							return []string{"github_action_configuration.0.code_configuration"}
+						}
+						return nil
+					}(),
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"registry_url": {
-								Type:        pluginsdk.TypeString,
-								Required:    true,
-								ForceNew:    true,
-								Description: "The server URL for the container registry where the build will be hosted.",
-								// ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The server URL for the container registry where the build will be hosted.",
 							},
 
 							"image_name": {
-								Type:        pluginsdk.TypeString,
-								Required:    true,
-								ForceNew:    true,
-								Description: "The image name for the build.",
-								// ValidateFunc: validate.NoEmptyStrings,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The image name for the build.",
 							},
 
 							"registry_username": {
@@ -79,6 +87,12 @@ func githubActionConfigSchema() *pluginsdk.Schema {
 					Optional: true,
 					MaxItems: 1,
 					ForceNew: true,
+					ConflictsWith: func() []string {
+						if features.FourPointOhBeta() {
+							return []string{"github_action_configuration.0.container_configuration"}
+						}
+						return nil
+					}(),
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"runtime_stack": {
@@ -158,7 +172,9 @@ func expandGithubActionConfig(input []GithubActionConfiguration, usesLinux bool)
 	return output
 }
 
-func flattenGitHubActionConfiguration(input *web.GitHubActionConfiguration) []GithubActionConfiguration {
+// flattenGitHubActionConfiguration flattens the GitHub Action Configuration returned from the API, the registry password
+// isn't returned by the service so is taken from the existing configuration
+func flattenGitHubActionConfiguration(input *web.GitHubActionConfiguration, existing []GithubActionConfiguration) []GithubActionConfiguration {
 	output := make([]GithubActionConfiguration, 0)
 	if input == nil {
 		return output
@@ -186,8 +202,12 @@ func flattenGitHubActionConfiguration(input *web.GitHubActionConfiguration) []Gi
 	}
 
 	if containerConfig := input.ContainerConfiguration; containerConfig != nil {
+		registryPassword := utils.NormalizeNilableString(containerConfig.Password)
+		if registryPassword == "" && len(existing) > 0 && len(existing[0].ContainerConfig) > 0 {
+			registryPassword = existing[0].ContainerConfig[0].RegistryPassword
+		}
 		ghContainerConfig := []GitHubActionContainerConfig{{
-			RegistryPassword: utils.NormalizeNilableString(containerConfig.Password),
+			RegistryPassword: registryPassword,
 			RegistryUsername: utils.NormalizeNilableString(containerConfig.Username),
 			RegistryURL:      utils.NormalizeNilableString(containerConfig.ServerURL),
 			ImageName:        utils.NormalizeNilableString(containerConfig.ImageName),
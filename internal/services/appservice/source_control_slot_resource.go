@@ -253,6 +253,11 @@ func (r SourceControlSlotResource) Read() sdk.ResourceFunc {
 
 			props := *appSourceControl.SiteSourceControlProperties
 
+			var existing SourceControlSlotModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			state := SourceControlSlotModel{
 				SlotID:                    id.ID(),
 				SCMType:                   string(siteConfig.ScmType),
@@ -262,7 +267,7 @@ func (r SourceControlSlotResource) Read() sdk.ResourceFunc {
 				UseMercurial:              utils.NormaliseNilableBool(props.IsMercurial),
 				RollbackEnabled:           utils.NormaliseNilableBool(props.DeploymentRollbackEnabled),
 				UsesGithubAction:          utils.NormaliseNilableBool(props.IsGitHubAction),
-				GithubActionConfiguration: flattenGitHubActionConfiguration(props.GitHubActionConfiguration),
+				GithubActionConfiguration: flattenGitHubActionConfiguration(props.GitHubActionConfiguration, existing.GithubActionConfiguration),
 				LocalGitSCM:               siteConfig.ScmType == web.ScmTypeLocalGit,
 			}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -348,6 +349,15 @@ func (d WindowsFunctionAppDataSource) Read() sdk.ResourceFunc {
 
 			functionApp.SiteConfig = []helpers.SiteConfigWindowsFunctionApp{*siteConfig}
 
+			tlsSettings, err := azuresdkhacks.GetSiteTlsSettings(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading TLS Settings for Windows %s: %+v", id, err)
+			}
+			if len(functionApp.SiteConfig) > 0 {
+				functionApp.SiteConfig[0].MinTlsCipherSuite = tlsSettings.MinTlsCipherSuite
+				functionApp.SiteConfig[0].EndToEndEncryptionEnabled = tlsSettings.EndToEndEncryptionEnabled
+			}
+
 			functionApp.unpackWindowsFunctionAppSettings(appSettingsResp)
 
 			functionApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -8,6 +8,8 @@ import (
 type Client struct {
 	RoleAssignmentsClient *authorization.RoleAssignmentsClient
 	RoleDefinitionsClient *authorization.RoleDefinitionsClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -20,5 +22,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		RoleAssignmentsClient: &roleAssignmentsClient,
 		RoleDefinitionsClient: &roleDefinitionsClient,
+
+		options: o,
 	}
 }
+
+func (c Client) RoleAssignmentsClientForSubscription(subscriptionID string) *authorization.RoleAssignmentsClient {
+	roleAssignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&roleAssignmentsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &roleAssignmentsClient
+}
+
+func (c Client) RoleDefinitionsClientForSubscription(subscriptionID string) *authorization.RoleDefinitionsClient {
+	roleDefinitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&roleDefinitionsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &roleDefinitionsClient
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -72,6 +73,8 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				),
 			},
 
+			"subscription_id": sdk.SubscriptionIdOverrideSchema(),
+
 			"role_definition_id": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -145,10 +148,10 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	subscriptionId := sdk.SubscriptionIdOverride(d, meta.(*clients.Client).Account.SubscriptionId)
+	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClientForSubscription(subscriptionId)
+	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClientForSubscription(subscriptionId)
 	subscriptionClient := meta.(*clients.Client).Subscription.Client
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -232,7 +235,7 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
 	}
 
-	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
+	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, roleAssignmentsClient, scope, name, properties, meta, tenantId)); err != nil {
 		return err
 	}
 
@@ -271,6 +274,14 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("name", resp.Name)
 
+	// Role Assignments scoped to a Management Group or the Tenant aren't within a Subscription, in which case
+	// the Subscription the Role Assignment was managed through is retained
+	subscriptionId := id.SubscriptionID
+	if subscriptionId == "" {
+		subscriptionId = sdk.SubscriptionIdOverride(d, meta.(*clients.Client).Account.SubscriptionId)
+	}
+	d.Set("subscription_id", subscriptionId)
+
 	if props := resp.RoleAssignmentPropertiesWithScope; props != nil {
 		d.Set("scope", props.Scope)
 		d.Set("role_definition_id", props.RoleDefinitionID)
@@ -317,9 +328,8 @@ func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}
 	return nil
 }
 
-func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}, tenantId string) func() *pluginsdk.RetryError {
+func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, roleAssignmentsClient *authorization.RoleAssignmentsClient, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}, tenantId string) func() *pluginsdk.RetryError {
 	return func() *pluginsdk.RetryError {
-		roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
 		ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -108,6 +108,23 @@ func TestAccRoleAssignment_builtin(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_subscriptionId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.subscriptionIdConfig(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check"),
+	})
+}
+
 func TestAccRoleAssignment_custom(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
 	roleDefinitionId := uuid.New().String()
@@ -396,6 +413,32 @@ resource "azurerm_role_assignment" "test" {
 `, id)
 }
 
+func (RoleAssignmentResource) subscriptionIdConfig(id string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_client_config" "test" {
+}
+
+data "azurerm_role_definition" "test" {
+  name = "Site Recovery Reader"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name               = "%s"
+  subscription_id    = data.azurerm_subscription.primary.subscription_id
+  scope              = data.azurerm_subscription.primary.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = data.azurerm_client_config.test.object_id
+}
+`, id)
+}
+
 func (RoleAssignmentResource) customConfig(roleDefinitionId string, roleAssignmentId string, rInt int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2020-01-13-preview/automation" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2021-06-22/automationaccount"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validate4 "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -84,7 +86,8 @@ func updateTaskFromSDK(prop *softwareupdateconfiguration.TaskProperties) (res []
 		return
 	}
 	res = append(res, UpdateTask{
-		Source: utils.NormalizeNilableString(prop.Source),
+		Source:     utils.NormalizeNilableString(prop.Source),
+		Parameters: map[string]string{},
 	})
 	if prop.Parameters != nil {
 		for k, v := range *prop.Parameters {
@@ -316,6 +319,67 @@ func targetsFromSDK(prop *softwareupdateconfiguration.TargetProperties) []Target
 	return []Target{t}
 }
 
+type LastRun struct {
+	Id             string `tfschema:"id"`
+	Status         string `tfschema:"status"`
+	StartTime      string `tfschema:"start_time"`
+	EndTime        string `tfschema:"end_time"`
+	ComputerCount  int64  `tfschema:"computer_count"`
+	FailedCount    int64  `tfschema:"failed_count"`
+	PreTaskStatus  string `tfschema:"pre_task_status"`
+	PreTaskJobId   string `tfschema:"pre_task_job_id"`
+	PostTaskStatus string `tfschema:"post_task_status"`
+	PostTaskJobId  string `tfschema:"post_task_job_id"`
+}
+
+func lastRunFromSDK(runs *[]automation.SoftwareUpdateConfigurationRun) []LastRun {
+	if runs == nil {
+		return nil
+	}
+
+	// the API doesn't support ordering the runs, so pick the one which started most recently
+	var latest *automation.SoftwareUpdateConfigurationRun
+	for i := range *runs {
+		run := (*runs)[i]
+		if run.SoftwareUpdateConfigurationRunProperties == nil || run.StartTime == nil {
+			continue
+		}
+		if latest == nil || run.StartTime.After(latest.StartTime.Time) {
+			latest = &run
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+
+	res := LastRun{
+		Id:     utils.NormalizeNilableString(latest.Name),
+		Status: utils.NormalizeNilableString(latest.Status),
+	}
+	res.StartTime = latest.StartTime.Format(time.RFC3339)
+	if latest.EndTime != nil {
+		res.EndTime = latest.EndTime.Format(time.RFC3339)
+	}
+	if latest.ComputerCount != nil {
+		res.ComputerCount = int64(*latest.ComputerCount)
+	}
+	if latest.FailedCount != nil {
+		res.FailedCount = int64(*latest.FailedCount)
+	}
+	if tasks := latest.Tasks; tasks != nil {
+		if pre := tasks.PreTask; pre != nil {
+			res.PreTaskStatus = utils.NormalizeNilableString(pre.Status)
+			res.PreTaskJobId = utils.NormalizeNilableString(pre.JobID)
+		}
+		if post := tasks.PostTask; post != nil {
+			res.PostTaskStatus = utils.NormalizeNilableString(post.Status)
+			res.PostTaskJobId = utils.NormalizeNilableString(post.JobID)
+		}
+	}
+
+	return []LastRun{res}
+}
+
 type Windows struct {
 	// Classification Deprecated, use Classifications instead
 	Classification string `tfschema:"classification_included"`
@@ -342,6 +406,7 @@ type SoftwareUpdateConfigurationModel struct {
 	Schedule              []Schedule   `tfschema:"schedule"`
 	PreTask               []UpdateTask `tfschema:"pre_task"`
 	PostTask              []UpdateTask `tfschema:"post_task"`
+	LastRun               []LastRun    `tfschema:"last_run"`
 }
 
 func (s *SoftwareUpdateConfigurationModel) ToSDKModel() softwareupdateconfiguration.SoftwareUpdateConfiguration {
@@ -547,8 +612,9 @@ func (m SoftwareUpdateConfigurationResource) Arguments() map[string]*pluginsdk.S
 				Schema: map[string]*pluginsdk.Schema{
 
 					"reboot": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(possibleValuesForRebootSetting(), false),
 					},
 
 					"classification_included": {
@@ -635,8 +701,9 @@ func (m SoftwareUpdateConfigurationResource) Arguments() map[string]*pluginsdk.S
 					},
 
 					"reboot": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(possibleValuesForRebootSetting(), false),
 					},
 				},
 			},
@@ -892,6 +959,13 @@ func (m SoftwareUpdateConfigurationResource) Arguments() map[string]*pluginsdk.S
 		"pre_task": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
+			// only the first block is sent to the API, additional blocks are rejected from 4.0
+			MaxItems: func() int {
+				if features.FourPointOhBeta() {
+					return 1
+				}
+				return 0
+			}(),
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"source": {
@@ -915,6 +989,13 @@ func (m SoftwareUpdateConfigurationResource) Arguments() map[string]*pluginsdk.S
 		"post_task": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
+			// only the first block is sent to the API, additional blocks are rejected from 4.0
+			MaxItems: func() int {
+				if features.FourPointOhBeta() {
+					return 1
+				}
+				return 0
+			}(),
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 
@@ -956,6 +1037,64 @@ func (m SoftwareUpdateConfigurationResource) Attributes() map[string]*pluginsdk.
 			Type:     pluginsdk.TypeString,
 			Computed: true,
 		},
+
+		"last_run": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"computer_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"failed_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"pre_task_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"pre_task_job_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"post_task_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"post_task_job_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1029,6 +1168,14 @@ func (m SoftwareUpdateConfigurationResource) Read() sdk.ResourceFunc {
 			output.AutomationAccountID = softwareupdateconfiguration.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName).ID()
 			output.LoadSDKModel(resp.Model)
 
+			runClient := meta.Client.Automation.SoftwareUpdateConfigRunClient
+			filter := fmt.Sprintf("properties/softwareUpdateConfiguration/name eq '%s'", id.SoftwareUpdateConfigurationName)
+			runs, err := runClient.List(ctx, id.ResourceGroupName, id.AutomationAccountName, "", filter, "", "")
+			if err != nil {
+				return fmt.Errorf("listing runs for %s: %+v", *id, err)
+			}
+			output.LastRun = lastRunFromSDK(runs.Value)
+
 			return meta.Encode(&output)
 		},
 	}
@@ -1059,6 +1206,15 @@ func (m SoftwareUpdateConfigurationResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func possibleValuesForRebootSetting() []string {
+	return []string{
+		"IfRequired",
+		"Never",
+		"Always",
+		"RebootOnly",
+	}
+}
+
 func (m SoftwareUpdateConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return softwareupdateconfiguration.ValidateSoftwareUpdateConfigurationID
 }
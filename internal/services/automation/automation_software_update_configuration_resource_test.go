@@ -96,6 +96,31 @@ func TestAccSoftwareUpdateConfiguration_windows(t *testing.T) {
 	})
 }
 
+func TestAccSoftwareUpdateConfiguration_tasks(t *testing.T) {
+	data := acceptance.BuildTestData(t, automation.SoftwareUpdateConfigurationResource{}.ResourceType(), "test")
+	r := newSoftwareUpdateConfigurationResource()
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tasks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_task.0.source").HasValue(fmt.Sprintf("acctest-pre-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("post_task.0.parameters.%").HasValue("1"),
+			),
+		},
+		// scheduleInfo.advancedSchedule always return null
+		data.ImportStep("schedule.0.advanced", "schedule.0.monthly_occurrence"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// scheduleInfo.advancedSchedule always return null
+		data.ImportStep("schedule.0.advanced", "schedule.0.monthly_occurrence"),
+	})
+}
+
 func (a SoftwareUpdateConfigurationResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 
@@ -262,6 +287,81 @@ resource "azurerm_automation_software_update_configuration" "test" {
 `, a.template(data), data.RandomInteger, a.startTime, a.expireTime)
 }
 
+func (a SoftwareUpdateConfigurationResource) tasks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+
+
+%s
+
+resource "azurerm_automation_runbook" "pre" {
+  name                    = "acctest-pre-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  log_verbose             = "true"
+  log_progress            = "true"
+  runbook_type            = "PowerShell"
+  content                 = "Write-Output 'pre'"
+}
+
+resource "azurerm_automation_runbook" "post" {
+  name                    = "acctest-post-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  log_verbose             = "true"
+  log_progress            = "true"
+  runbook_type            = "PowerShell"
+  content                 = "param([string]$Message) Write-Output $Message"
+}
+
+resource "azurerm_automation_software_update_configuration" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  name                  = "acctest-suc-%[2]d"
+  operating_system      = "Linux"
+
+  linux {
+    classification_included = "Security"
+    excluded_packages       = ["apt"]
+    included_packages       = ["vim"]
+    reboot                  = "RebootOnly"
+  }
+
+  duration            = "PT1H1M1S"
+  virtual_machine_ids = []
+
+  target {
+    azure_query {
+      scope     = [azurerm_resource_group.test.id]
+      locations = [azurerm_resource_group.test.location]
+    }
+  }
+
+  schedule {
+    description = "foo-schedule"
+    start_time  = "%[3]s"
+    is_enabled  = true
+    interval    = 1
+    frequency   = "Hour"
+    time_zone   = "Etc/UTC"
+  }
+
+  pre_task {
+    source = azurerm_automation_runbook.pre.name
+  }
+
+  post_task {
+    source = azurerm_automation_runbook.post.name
+    parameters = {
+      Message = "done"
+    }
+  }
+
+  depends_on = [azurerm_log_analytics_linked_service.test]
+}
+`, a.template(data), data.RandomInteger, a.startTime)
+}
+
 // software update need log analytic location map correct, if use a random location like `East US` will cause
 // error like `chosen Azure Automation does not have a Log Analytics workspace linked for operation to succeed`.
 // so location hardcode as `West US`
@@ -24,27 +24,28 @@ import (
 )
 
 type Client struct {
-	AccountClient               *automationaccount.AutomationAccountClient
-	AgentRegistrationInfoClient *automation.AgentRegistrationInformationClient
-	CertificateClient           *certificate.CertificateClient
-	ConnectionClient            *connection.ConnectionClient
-	ConnectionTypeClient        *connectiontype.ConnectionTypeClient
-	CredentialClient            *credential.CredentialClient
-	DscConfigurationClient      *dscconfiguration.DscConfigurationClient
-	DscNodeConfigurationClient  *dscnodeconfiguration.DscNodeConfigurationClient
-	JobScheduleClient           *jobschedule.JobScheduleClient
-	ModuleClient                *module.ModuleClient
-	RunbookClient               *runbook.RunbookClient
-	RunbookClientHack           *automation.RunbookClient
-	RunbookDraftClient          *automation.RunbookDraftClient
-	RunBookWgClient             *hybridrunbookworkergroup.HybridRunbookWorkerGroupClient
-	RunbookWorkerClient         *hybridrunbookworker.HybridRunbookWorkerClient
-	ScheduleClient              *schedule.ScheduleClient
-	SoftwareUpdateConfigClient  *softwareupdateconfiguration.SoftwareUpdateConfigurationClient
-	SourceControlClient         *sourcecontrol.SourceControlClient
-	VariableClient              *variable.VariableClient
-	WatcherClient               *watcher.WatcherClient
-	WebhookClient               *webhook.WebhookClient
+	AccountClient                 *automationaccount.AutomationAccountClient
+	AgentRegistrationInfoClient   *automation.AgentRegistrationInformationClient
+	CertificateClient             *certificate.CertificateClient
+	ConnectionClient              *connection.ConnectionClient
+	ConnectionTypeClient          *connectiontype.ConnectionTypeClient
+	CredentialClient              *credential.CredentialClient
+	DscConfigurationClient        *dscconfiguration.DscConfigurationClient
+	DscNodeConfigurationClient    *dscnodeconfiguration.DscNodeConfigurationClient
+	JobScheduleClient             *jobschedule.JobScheduleClient
+	ModuleClient                  *module.ModuleClient
+	RunbookClient                 *runbook.RunbookClient
+	RunbookClientHack             *automation.RunbookClient
+	RunbookDraftClient            *automation.RunbookDraftClient
+	RunBookWgClient               *hybridrunbookworkergroup.HybridRunbookWorkerGroupClient
+	RunbookWorkerClient           *hybridrunbookworker.HybridRunbookWorkerClient
+	ScheduleClient                *schedule.ScheduleClient
+	SoftwareUpdateConfigClient    *softwareupdateconfiguration.SoftwareUpdateConfigurationClient
+	SoftwareUpdateConfigRunClient *automation.SoftwareUpdateConfigurationRunsClient
+	SourceControlClient           *sourcecontrol.SourceControlClient
+	VariableClient                *variable.VariableClient
+	WatcherClient                 *watcher.WatcherClient
+	WebhookClient                 *webhook.WebhookClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -102,6 +103,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	softUpClient := softwareupdateconfiguration.NewSoftwareUpdateConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&softUpClient.Client, o.ResourceManagerAuthorizer)
 
+	softUpRunClient := automation.NewSoftwareUpdateConfigurationRunsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&softUpRunClient.Client, o.ResourceManagerAuthorizer)
+
 	variableClient := variable.NewVariableClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&variableClient.Client, o.ResourceManagerAuthorizer)
 
@@ -112,26 +116,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&webhookClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:               &accountClient,
-		AgentRegistrationInfoClient: &agentRegistrationInfoClient,
-		CertificateClient:           &certificateClient,
-		ConnectionClient:            &connectionClient,
-		ConnectionTypeClient:        &connectionTypeClient,
-		CredentialClient:            &credentialClient,
-		DscConfigurationClient:      &dscConfigurationClient,
-		DscNodeConfigurationClient:  &dscNodeConfigurationClient,
-		JobScheduleClient:           &jobScheduleClient,
-		ModuleClient:                &moduleClient,
-		RunbookClient:               &runbookClient,
-		RunbookClientHack:           &runbookClient2,
-		RunbookDraftClient:          &runbookDraftClient,
-		RunBookWgClient:             &runbookWgClient,
-		RunbookWorkerClient:         &runbookWorkerClient,
-		ScheduleClient:              &scheduleClient,
-		SoftwareUpdateConfigClient:  &softUpClient,
-		SourceControlClient:         &sourceCtlClient,
-		VariableClient:              &variableClient,
-		WatcherClient:               &watcherClient,
-		WebhookClient:               &webhookClient,
+		AccountClient:                 &accountClient,
+		AgentRegistrationInfoClient:   &agentRegistrationInfoClient,
+		CertificateClient:             &certificateClient,
+		ConnectionClient:              &connectionClient,
+		ConnectionTypeClient:          &connectionTypeClient,
+		CredentialClient:              &credentialClient,
+		DscConfigurationClient:        &dscConfigurationClient,
+		DscNodeConfigurationClient:    &dscNodeConfigurationClient,
+		JobScheduleClient:             &jobScheduleClient,
+		ModuleClient:                  &moduleClient,
+		RunbookClient:                 &runbookClient,
+		RunbookClientHack:             &runbookClient2,
+		RunbookDraftClient:            &runbookDraftClient,
+		RunBookWgClient:               &runbookWgClient,
+		RunbookWorkerClient:           &runbookWorkerClient,
+		ScheduleClient:                &scheduleClient,
+		SoftwareUpdateConfigClient:    &softUpClient,
+		SoftwareUpdateConfigRunClient: &softUpRunClient,
+		SourceControlClient:           &sourceCtlClient,
+		VariableClient:                &variableClient,
+		WatcherClient:                 &watcherClient,
+		WebhookClient:                 &webhookClient,
 	}
 }
@@ -85,6 +85,36 @@ func resourceBotChannelDirectline() *pluginsdk.Resource {
 							Optional: true,
 						},
 
+						"endpoint_parameters_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"storage_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"user_upload_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"app_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"tenant_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
 						"trusted_origins": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
@@ -282,6 +312,21 @@ func expandDirectlineSites(input []interface{}) *[]botservice.DirectLineSite {
 		if v, ok := site["enhanced_authentication_enabled"].(bool); ok {
 			expanded.IsSecureSiteEnabled = &v
 		}
+		if v, ok := site["endpoint_parameters_enabled"].(bool); ok {
+			expanded.IsEndpointParametersEnabled = &v
+		}
+		if v, ok := site["storage_enabled"].(bool); ok {
+			expanded.IsNoStorageEnabled = utils.Bool(!v)
+		}
+		if v, ok := site["user_upload_enabled"].(bool); ok {
+			expanded.IsBlockUserUploadEnabled = utils.Bool(!v)
+		}
+		if v, ok := site["app_id"].(string); ok && v != "" {
+			expanded.AppID = utils.String(v)
+		}
+		if v, ok := site["tenant_id"].(string); ok && v != "" {
+			expanded.TenantID = utils.String(v)
+		}
 		if v, ok := site["trusted_origins"].(*pluginsdk.Set); ok {
 			origins := v.List()
 			items := make([]string, len(origins))
@@ -331,6 +376,30 @@ func flattenDirectlineSites(input []botservice.DirectLineSite) []interface{} {
 			site["enhanced_authentication_enabled"] = *element.IsSecureSiteEnabled
 		}
 
+		if element.IsEndpointParametersEnabled != nil {
+			site["endpoint_parameters_enabled"] = *element.IsEndpointParametersEnabled
+		}
+
+		storageEnabled := true
+		if element.IsNoStorageEnabled != nil {
+			storageEnabled = !*element.IsNoStorageEnabled
+		}
+		site["storage_enabled"] = storageEnabled
+
+		userUploadEnabled := true
+		if element.IsBlockUserUploadEnabled != nil {
+			userUploadEnabled = !*element.IsBlockUserUploadEnabled
+		}
+		site["user_upload_enabled"] = userUploadEnabled
+
+		if element.AppID != nil {
+			site["app_id"] = *element.AppID
+		}
+
+		if element.TenantID != nil {
+			site["tenant_id"] = *element.TenantID
+		}
+
 		if element.TrustedOrigins != nil {
 			site["trusted_origins"] = *element.TrustedOrigins
 		}
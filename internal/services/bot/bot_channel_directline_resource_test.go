@@ -120,6 +120,9 @@ resource "azurerm_bot_channel_directline" "test" {
     v3_allowed                      = true
     enhanced_authentication_enabled = true
     trusted_origins                 = ["https://example.com"]
+    endpoint_parameters_enabled     = true
+    storage_enabled                 = false
+    user_upload_enabled             = false
   }
 }
 `, BotChannelsRegistrationResource{}.basicConfig(data))
@@ -60,6 +60,16 @@ func resourceBotChannelMsTeams() *pluginsdk.Resource {
 				ValidateFunc: validate.BotMSTeamsCallingWebHook(),
 			},
 
+			"deployment_environment": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "CommercialDeployment",
+				ValidateFunc: validation.StringInSlice([]string{
+					"CommercialDeployment",
+					"GCCModerateDeployment",
+				}, false),
+			},
+
 			// TODO 4.0: change this from enable_* to *_enabled
 			"enable_calling": {
 				Type:     pluginsdk.TypeBool,
@@ -92,8 +102,9 @@ func resourceBotChannelMsTeamsCreate(d *pluginsdk.ResourceData, meta interface{}
 	channel := botservice.BotChannel{
 		Properties: botservice.MsTeamsChannel{
 			Properties: &botservice.MsTeamsChannelProperties{
-				EnableCalling: utils.Bool(d.Get("enable_calling").(bool)),
-				IsEnabled:     utils.Bool(true),
+				EnableCalling:         utils.Bool(d.Get("enable_calling").(bool)),
+				DeploymentEnvironment: utils.String(d.Get("deployment_environment").(string)),
+				IsEnabled:             utils.Bool(true),
 			},
 			ChannelName: botservice.ChannelNameBasicChannelChannelNameMsTeamsChannel,
 		},
@@ -144,6 +155,12 @@ func resourceBotChannelMsTeamsRead(d *pluginsdk.ResourceData, meta interface{})
 			if channelProps := channel.Properties; channelProps != nil {
 				d.Set("calling_web_hook", channelProps.CallingWebhook)
 				d.Set("enable_calling", channelProps.EnableCalling)
+
+				deploymentEnvironment := "CommercialDeployment"
+				if v := channelProps.DeploymentEnvironment; v != nil && *v != "" {
+					deploymentEnvironment = *v
+				}
+				d.Set("deployment_environment", deploymentEnvironment)
 			}
 		}
 	}
@@ -164,9 +181,10 @@ func resourceBotChannelMsTeamsUpdate(d *pluginsdk.ResourceData, meta interface{}
 	channel := botservice.BotChannel{
 		Properties: botservice.MsTeamsChannel{
 			Properties: &botservice.MsTeamsChannelProperties{
-				EnableCalling:  utils.Bool(d.Get("enable_calling").(bool)),
-				CallingWebhook: utils.String(d.Get("calling_web_hook").(string)),
-				IsEnabled:      utils.Bool(true),
+				EnableCalling:         utils.Bool(d.Get("enable_calling").(bool)),
+				CallingWebhook:        utils.String(d.Get("calling_web_hook").(string)),
+				DeploymentEnvironment: utils.String(d.Get("deployment_environment").(string)),
+				IsEnabled:             utils.Bool(true),
 			},
 			ChannelName: botservice.ChannelNameBasicChannelChannelNameMsTeamsChannel,
 		},
@@ -15,6 +15,7 @@ func TestAccBotChannelsRegistration(t *testing.T) {
 			"update":                   testAccBotChannelsRegistration_update,
 			"complete":                 testAccBotChannelsRegistration_complete,
 			"streamingEndpointEnabled": testAccBotChannelsRegistration_streamingEndpointEnabled,
+			"userAssignedIdentity":     testAccBotChannelsRegistration_userAssignedIdentity,
 		},
 		"bot": {
 			"basic":                    testAccBotServiceAzureBot_basic,
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -92,6 +93,32 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			"microsoft_app_msi_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+
+			"microsoft_app_tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"microsoft_app_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(botservice.MsaAppTypeMultiTenant),
+					string(botservice.MsaAppTypeSingleTenant),
+					string(botservice.MsaAppTypeUserAssignedMSI),
+				}, false),
+			},
+
 			"cmk_key_vault_url": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -152,6 +179,12 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -218,6 +251,7 @@ func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta inter
 			IconURL:                           utils.String(d.Get("icon_url").(string)),
 			IsCmekEnabled:                     utils.Bool(false),
 			IsStreamingSupported:              utils.Bool(d.Get("streaming_endpoint_enabled").(bool)),
+			DisableLocalAuth:                  utils.Bool(!d.Get("local_authentication_enabled").(bool)),
 		},
 		Location: utils.String(d.Get("location").(string)),
 		Sku: &botservice.Sku{
@@ -231,6 +265,18 @@ func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta inter
 		bot.Properties.IsCmekEnabled = utils.Bool(true)
 	}
 
+	if v, ok := d.GetOk("microsoft_app_type"); ok {
+		bot.Properties.MsaAppType = botservice.MsaAppType(v.(string))
+	}
+
+	if v, ok := d.GetOk("microsoft_app_tenant_id"); ok {
+		bot.Properties.MsaAppTenantID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("microsoft_app_msi_id"); ok {
+		bot.Properties.MsaAppMSIResourceID = utils.String(v.(string))
+	}
+
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
 		return fmt.Errorf("creating Bot Channels Registration %q (Resource Group %q): %+v", resourceId.Name, resourceId.ResourceGroup, err)
 	}
@@ -288,6 +334,9 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 	if props := resp.Properties; props != nil {
 		d.Set("cmk_key_vault_url", props.CmekKeyVaultURL)
 		d.Set("microsoft_app_id", props.MsaAppID)
+		d.Set("microsoft_app_type", string(props.MsaAppType))
+		d.Set("microsoft_app_tenant_id", props.MsaAppTenantID)
+		d.Set("microsoft_app_msi_id", props.MsaAppMSIResourceID)
 		d.Set("endpoint", props.Endpoint)
 		d.Set("description", props.Description)
 		d.Set("display_name", props.DisplayName)
@@ -296,6 +345,12 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("icon_url", props.IconURL)
 		d.Set("streaming_endpoint_enabled", props.IsStreamingSupported)
 
+		localAuthEnabled := true
+		if v := props.DisableLocalAuth; v != nil {
+			localAuthEnabled = !*v
+		}
+		d.Set("local_authentication_enabled", localAuthEnabled)
+
 		// `PublicNetworkAccess` is empty string when `public_network_access_enabled` or `isolated_network_enabled` isn't specified. So `public_network_access_enabled` and `isolated_network_enabled` shouldn't be set at this time to avoid diff
 		if props.PublicNetworkAccess != "" {
 			d.Set("public_network_access_enabled", props.PublicNetworkAccess == botservice.PublicNetworkAccessEnabled)
@@ -338,6 +393,7 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 			IconURL:                           utils.String(d.Get("icon_url").(string)),
 			IsCmekEnabled:                     utils.Bool(false),
 			IsStreamingSupported:              utils.Bool(d.Get("streaming_endpoint_enabled").(bool)),
+			DisableLocalAuth:                  utils.Bool(!d.Get("local_authentication_enabled").(bool)),
 		},
 		Location: utils.String(d.Get("location").(string)),
 		Sku: &botservice.Sku{
@@ -351,6 +407,18 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 		bot.Properties.IsCmekEnabled = utils.Bool(true)
 	}
 
+	if v, ok := d.GetOk("microsoft_app_type"); ok {
+		bot.Properties.MsaAppType = botservice.MsaAppType(v.(string))
+	}
+
+	if v, ok := d.GetOk("microsoft_app_tenant_id"); ok {
+		bot.Properties.MsaAppTenantID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("microsoft_app_msi_id"); ok {
+		bot.Properties.MsaAppMSIResourceID = utils.String(v.(string))
+	}
+
 	if !features.FourPointOhBeta() {
 		// d.GetOk cannot identify whether user sets the property that is bool type and `isolated_network_enabled` is set as `false`. So it has to identify it using `d.GetRawConfig()`
 		if v := d.GetRawConfig().AsValueMap()["isolated_network_enabled"]; !v.IsNull() {
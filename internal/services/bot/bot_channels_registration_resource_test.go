@@ -111,6 +111,22 @@ func testAccBotChannelsRegistration_streamingEndpointEnabled(t *testing.T) {
 	})
 }
 
+func testAccBotChannelsRegistration_userAssignedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bot_channels_registration", "test")
+	r := BotChannelsRegistrationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.userAssignedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("microsoft_app_type").HasValue("UserAssignedMSI"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t BotChannelsRegistrationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BotServiceID(state.ID)
 	if err != nil {
@@ -880,3 +896,34 @@ resource "azurerm_bot_channels_registration" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, streamingEndpointEnabled)
 }
+
+func (BotChannelsRegistrationResource) userAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_bot_channels_registration" "test" {
+  name                         = "acctestdf%d"
+  location                     = "global"
+  resource_group_name          = azurerm_resource_group.test.name
+  sku                          = "F0"
+  microsoft_app_id             = azurerm_user_assigned_identity.test.client_id
+  microsoft_app_msi_id         = azurerm_user_assigned_identity.test.id
+  microsoft_app_tenant_id      = azurerm_user_assigned_identity.test.tenant_id
+  microsoft_app_type           = "UserAssignedMSI"
+  local_authentication_enabled = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
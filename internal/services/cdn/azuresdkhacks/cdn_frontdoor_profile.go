@@ -0,0 +1,102 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// NOTE: this workaround exists since Managed Identities for Front Door Profiles were introduced in API version
+// `2023-05-01` which isn't available in the vendored SDK - so the identity is sent/retrieved separately using
+// the newer API version.
+
+const frontDoorProfileIdentityAPIVersion = "2023-05-01"
+
+// UpdateFrontDoorProfileIdentity updates the Managed Identity assigned to the Front Door Profile
+func UpdateFrontDoorProfileIdentity(ctx context.Context, client *cdn.ProfilesClient, resourceGroupName string, profileName string, input *identity.SystemAndUserAssignedMap) error {
+	if input == nil {
+		input = &identity.SystemAndUserAssignedMap{
+			Type: identity.TypeNone,
+		}
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		frontDoorProfilePath(client, resourceGroupName, profileName),
+		autorest.WithJSON(map[string]interface{}{
+			"identity": input,
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": frontDoorProfileIdentityAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", resp, "Failure sending request")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", resp, "Failure sending request")
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", future.Response(), "Failure waiting for completion")
+	}
+
+	return nil
+}
+
+// GetFrontDoorProfileIdentity retrieves the Managed Identity assigned to the Front Door Profile, if any
+func GetFrontDoorProfileIdentity(ctx context.Context, client *cdn.ProfilesClient, resourceGroupName string, profileName string) (*identity.SystemAndUserAssignedMap, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		frontDoorProfilePath(client, resourceGroupName, profileName),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": frontDoorProfileIdentityAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Identity *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result.Identity, nil
+}
+
+func frontDoorProfilePath(client *cdn.ProfilesClient, resourceGroupName string, profileName string) autorest.PrepareDecorator {
+	pathParameters := map[string]interface{}{
+		"profileName":       autorest.Encode("path", profileName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	return autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/profiles/{profileName}", pathParameters)
+}
@@ -0,0 +1,175 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround exists to allow approving the Private Endpoint Connection which Front Door creates on the
+// target of a Private Link Origin. Since the target can be one of several Resource Providers (each of which exposes
+// the Private Endpoint Connections using a different API version) these are sent as raw requests rather than using
+// each of the SDKs.
+
+var privateEndpointConnectionAPIVersions = map[string]string{
+	"microsoft.network/privatelinkservices": "2022-07-01",
+	"microsoft.storage/storageaccounts":     "2021-09-01",
+	"microsoft.web/sites":                   "2021-03-01",
+}
+
+type PrivateEndpointConnection struct {
+	ID          string
+	Name        string
+	Status      string
+	Description string
+}
+
+// PrivateEndpointConnectionApprovalSupported returns whether Private Endpoint Connections on the specified target
+// resource can be approved
+func PrivateEndpointConnectionApprovalSupported(targetId string) bool {
+	_, err := privateEndpointConnectionAPIVersion(targetId)
+	return err == nil
+}
+
+// ListPrivateEndpointConnections lists the Private Endpoint Connections on the specified target resource
+func ListPrivateEndpointConnections(ctx context.Context, client *cdn.AFDOriginsClient, targetId string) (*[]PrivateEndpointConnection, error) {
+	apiVersion, err := privateEndpointConnectionAPIVersion(targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(strings.TrimSuffix(targetId, "/")+"/privateEndpointConnections"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "privateEndpointConnections", "List", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "privateEndpointConnections", "List", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Value []struct {
+			ID         *string `json:"id,omitempty"`
+			Name       *string `json:"name,omitempty"`
+			Properties *struct {
+				PrivateLinkServiceConnectionState *struct {
+					Status      *string `json:"status,omitempty"`
+					Description *string `json:"description,omitempty"`
+				} `json:"privateLinkServiceConnectionState,omitempty"`
+			} `json:"properties,omitempty"`
+		} `json:"value,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "privateEndpointConnections", "List", resp, "Failure responding to request")
+	}
+
+	connections := make([]PrivateEndpointConnection, 0)
+	for _, v := range result.Value {
+		connection := PrivateEndpointConnection{}
+		if v.ID != nil {
+			connection.ID = *v.ID
+		}
+		if v.Name != nil {
+			connection.Name = *v.Name
+		}
+		if props := v.Properties; props != nil && props.PrivateLinkServiceConnectionState != nil {
+			if state := props.PrivateLinkServiceConnectionState; state.Status != nil {
+				connection.Status = *state.Status
+			}
+			if state := props.PrivateLinkServiceConnectionState; state.Description != nil {
+				connection.Description = *state.Description
+			}
+		}
+		connections = append(connections, connection)
+	}
+
+	return &connections, nil
+}
+
+// ApprovePrivateEndpointConnection approves the Private Endpoint Connection on the specified target resource
+func ApprovePrivateEndpointConnection(ctx context.Context, client *cdn.AFDOriginsClient, targetId string, connectionName string, description string) error {
+	apiVersion, err := privateEndpointConnectionAPIVersion(targetId)
+	if err != nil {
+		return err
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(fmt.Sprintf("%s/privateEndpointConnections/%s", strings.TrimSuffix(targetId, "/"), autorest.Encode("path", connectionName))),
+		autorest.WithJSON(map[string]interface{}{
+			"properties": map[string]interface{}{
+				"privateLinkServiceConnectionState": map[string]interface{}{
+					"status":      "Approved",
+					"description": description,
+				},
+			},
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "privateEndpointConnections", "Put", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "privateEndpointConnections", "Put", resp, "Failure sending request")
+	}
+
+	if resp.StatusCode == http.StatusAccepted {
+		future, err := azure.NewFutureFromResponse(resp)
+		if err != nil {
+			return autorest.NewErrorWithError(err, "privateEndpointConnections", "Put", resp, "Failure sending request")
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return autorest.NewErrorWithError(err, "privateEndpointConnections", "Put", future.Response(), "Failure waiting for completion")
+		}
+		return nil
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "privateEndpointConnections", "Put", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func privateEndpointConnectionAPIVersion(targetId string) (string, error) {
+	resourceId, err := azure.ParseResourceID(targetId)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", targetId, err)
+	}
+
+	resourceType := strings.ToLower(fmt.Sprintf("%s/%s", resourceId.Provider, resourceId.ResourceType))
+	apiVersion, ok := privateEndpointConnectionAPIVersions[resourceType]
+	if !ok {
+		return "", fmt.Errorf("approving Private Endpoint Connections isn't supported for resources of type %q", resourceType)
+	}
+
+	return apiVersion, nil
+}
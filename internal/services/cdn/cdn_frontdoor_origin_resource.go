@@ -1,7 +1,10 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
@@ -121,6 +124,12 @@ func resourceCdnFrontDoorOrigin() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"location": commonschema.Location(),
 
+						"auto_approve_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
 						"private_link_target_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
@@ -257,6 +266,10 @@ func resourceCdnFrontDoorOriginCreate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
+	if err := approveCdnFrontDoorOriginPrivateEndpointConnection(ctx, client, d.Get("private_link").([]interface{})); err != nil {
+		return fmt.Errorf("approving the Private Endpoint Connection for %s: %+v", id, err)
+	}
+
 	d.SetId(id.ID())
 	return resourceCdnFrontDoorOriginRead(d, meta)
 }
@@ -284,7 +297,13 @@ func resourceCdnFrontDoorOriginRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("cdn_frontdoor_origin_group_id", parse.NewFrontDoorOriginGroupID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName).ID())
 
 	if props := resp.AFDOriginProperties; props != nil {
-		if err := d.Set("private_link", flattenPrivateLinkSettings(props.SharedPrivateLinkResource)); err != nil {
+		// `auto_approve_enabled` isn't returned by the API, so we look this up from the config
+		autoApproveEnabled := false
+		if v := d.Get("private_link").([]interface{}); len(v) > 0 && v[0] != nil {
+			autoApproveEnabled = v[0].(map[string]interface{})["auto_approve_enabled"].(bool)
+		}
+
+		if err := d.Set("private_link", flattenPrivateLinkSettings(props.SharedPrivateLinkResource, autoApproveEnabled)); err != nil {
 			return fmt.Errorf("setting 'private_link': %+v", err)
 		}
 
@@ -402,6 +421,12 @@ func resourceCdnFrontDoorOriginUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
 	}
 
+	if d.HasChange("private_link") {
+		if err := approveCdnFrontDoorOriginPrivateEndpointConnection(ctx, client, d.Get("private_link").([]interface{})); err != nil {
+			return fmt.Errorf("approving the Private Endpoint Connection for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceCdnFrontDoorOriginRead(d, meta)
 }
 
@@ -459,6 +484,10 @@ func expandPrivateLinkSettings(input []interface{}, skuName cdn.SkuName, enableC
 		return nil, fmt.Errorf("the 'private_link' field can only be configured when 'certificate_name_check_enabled' is set to 'true'")
 	}
 
+	if settings := input[0].(map[string]interface{}); settings["auto_approve_enabled"].(bool) && !azuresdkhacks.PrivateEndpointConnectionApprovalSupported(settings["private_link_target_id"].(string)) {
+		return nil, fmt.Errorf("'auto_approve_enabled' can only be enabled when the 'private_link_target_id' is a Private Link Service, Storage Account or Web App")
+	}
+
 	// Check if this a Load Balancer Private Link or not, the Load Balancer Private Link requires
 	// that you stand up your own Private Link Service, which is why I am attempting to parse a
 	// Private Link Service ID here...
@@ -487,7 +516,7 @@ func expandPrivateLinkSettings(input []interface{}, skuName cdn.SkuName, enableC
 	}, nil
 }
 
-func flattenPrivateLinkSettings(input *cdn.SharedPrivateLinkResourceProperties) []interface{} {
+func flattenPrivateLinkSettings(input *cdn.SharedPrivateLinkResourceProperties, autoApproveEnabled bool) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -509,6 +538,7 @@ func flattenPrivateLinkSettings(input *cdn.SharedPrivateLinkResourceProperties)
 
 	return []interface{}{
 		map[string]interface{}{
+			"auto_approve_enabled":   autoApproveEnabled,
 			"location":               location.NormalizeNilable(input.PrivateLinkLocation),
 			"private_link_target_id": privateLinkTargetId,
 			"request_message":        requestMessage,
@@ -516,3 +546,65 @@ func flattenPrivateLinkSettings(input *cdn.SharedPrivateLinkResourceProperties)
 		},
 	}
 }
+
+// approveCdnFrontDoorOriginPrivateEndpointConnection approves the Private Endpoint Connection which Front Door creates
+// on the target of the Private Link, when `auto_approve_enabled` is set
+func approveCdnFrontDoorOriginPrivateEndpointConnection(ctx context.Context, client *cdn.AFDOriginsClient, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	settings := input[0].(map[string]interface{})
+	if !settings["auto_approve_enabled"].(bool) {
+		return nil
+	}
+
+	targetId := settings["private_link_target_id"].(string)
+	requestMessage := settings["request_message"].(string)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	// the Private Endpoint Connection is created asynchronously after the Origin, so we need to poll for it
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Found"},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			connections, err := azuresdkhacks.ListPrivateEndpointConnections(ctx, client, targetId)
+			if err != nil {
+				return nil, "", fmt.Errorf("listing Private Endpoint Connections for %q: %+v", targetId, err)
+			}
+
+			pending := make([]azuresdkhacks.PrivateEndpointConnection, 0)
+			for _, connection := range *connections {
+				if strings.EqualFold(connection.Status, "Pending") && connection.Description == requestMessage {
+					pending = append(pending, connection)
+				}
+			}
+
+			if len(pending) == 0 {
+				return connections, "NotFound", nil
+			}
+
+			return pending, "Found", nil
+		},
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for a pending Private Endpoint Connection on %q: %+v", targetId, err)
+	}
+
+	for _, connection := range result.([]azuresdkhacks.PrivateEndpointConnection) {
+		log.Printf("[DEBUG] Approving Private Endpoint Connection %q on %q..", connection.Name, targetId)
+		if err := azuresdkhacks.ApprovePrivateEndpointConnection(ctx, client, targetId, connection.Name, "Approved by Terraform"); err != nil {
+			return fmt.Errorf("approving Private Endpoint Connection %q on %q: %+v", connection.Name, targetId, err)
+		}
+	}
+
+	return nil
+}
@@ -122,6 +122,21 @@ func TestAccCdnFrontDoorOrigin_privateLinkBlobPrimary(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorOrigin_privateLinkBlobPrimaryAutoApprove(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_origin", "test")
+	r := CdnFrontDoorOriginResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateLinkBlobPrimaryAutoApprove(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("private_link.0.auto_approve_enabled"),
+	})
+}
+
 func TestAccCdnFrontDoorOrigin_privateLinkStorageStaticWebSite(t *testing.T) {
 	t.Skip("@tombuildsstuff: temporarily skipping until the private link is manually approved as part of the test step")
 
@@ -584,6 +599,37 @@ resource "azurerm_cdn_frontdoor_origin" "test" {
 }
 
 // nolint: unused
+func (r CdnFrontDoorOriginResource) privateLinkBlobPrimaryAutoApprove(data acceptance.TestData) string {
+	template := r.templatePrivateLinkStorage(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_origin" "test" {
+  name                          = "acctest-cdnfdorigin-%d"
+  cdn_frontdoor_origin_group_id = azurerm_cdn_frontdoor_origin_group.test.id
+  enabled                       = true
+
+  certificate_name_check_enabled = true
+  host_name                      = azurerm_storage_account.test.primary_blob_host
+  origin_host_header             = azurerm_storage_account.test.primary_blob_host
+  priority                       = 1
+  weight                         = 500
+
+  private_link {
+    auto_approve_enabled   = true
+    request_message        = "Request access for CDN Frontdoor Private Link Origin %d"
+    target_type            = "blob"
+    location               = azurerm_resource_group.test.location
+    private_link_target_id = azurerm_storage_account.test.id
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger)
+}
+
 func (r CdnFrontDoorOriginResource) privateLinkStaticWebSite(data acceptance.TestData) string {
 	template := r.templatePrivateLinkStorageStaticWebSite(data)
 	return fmt.Sprintf(`
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -31,6 +33,8 @@ func dataSourceCdnFrontDoorProfile() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
 
+			"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+
 			"response_timeout_seconds": {
 				Type:     pluginsdk.TypeInt,
 				Computed: true,
@@ -84,6 +88,20 @@ func dataSourceCdnFrontDoorProfileRead(d *pluginsdk.ResourceData, meta interface
 	}
 	d.Set("sku_name", skuName)
 
+	profileIdentity, err := azuresdkhacks.GetFrontDoorProfileIdentity(ctx, client, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		return fmt.Errorf("retrieving `identity` for %s: %+v", id, err)
+	}
+
+	flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(profileIdentity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+
+	if err := d.Set("identity", flattenedIdentity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
 	if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
 		return err
 	}
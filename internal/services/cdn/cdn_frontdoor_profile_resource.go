@@ -6,9 +6,11 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -47,6 +49,8 @@ func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
 			"response_timeout_seconds": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -112,6 +116,17 @@ func resourceCdnFrontDoorProfileCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
+	if v, ok := d.GetOk("identity"); ok {
+		expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		if err := azuresdkhacks.UpdateFrontDoorProfileIdentity(ctx, client, id.ResourceGroup, id.ProfileName, expandedIdentity); err != nil {
+			return fmt.Errorf("updating `identity` for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
@@ -152,6 +167,20 @@ func resourceCdnFrontDoorProfileRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("sku_name", skuName)
 
+	profileIdentity, err := azuresdkhacks.GetFrontDoorProfileIdentity(ctx, client, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		return fmt.Errorf("retrieving `identity` for %s: %+v", id, err)
+	}
+
+	flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(profileIdentity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+
+	if err := d.Set("identity", flattenedIdentity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -183,6 +212,17 @@ func resourceCdnFrontDoorProfileUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
 	}
 
+	if d.HasChange("identity") {
+		expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		if err := azuresdkhacks.UpdateFrontDoorProfileIdentity(ctx, client, id.ResourceGroup, id.ProfileName, expandedIdentity); err != nil {
+			return fmt.Errorf("updating `identity` for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
 
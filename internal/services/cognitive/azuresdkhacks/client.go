@@ -0,0 +1,29 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if it could be removed on 4.0
+// Commitment Plans are exposed by the `2022-10-01` API but are not included in the vendored SDK for this version,
+// so the client for the `commitmentPlans` endpoint of a Cognitive Account is defined here.
+
+const defaultApiVersion = "2022-10-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/commitmentplans/%s", defaultApiVersion)
+}
+
+type CommitmentPlansClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommitmentPlansClientWithBaseURI(endpoint string) CommitmentPlansClient {
+	return CommitmentPlansClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
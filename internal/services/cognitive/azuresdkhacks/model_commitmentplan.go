@@ -0,0 +1,44 @@
+package azuresdkhacks
+
+type HostingModel string
+
+const (
+	HostingModelConnectedContainer    HostingModel = "ConnectedContainer"
+	HostingModelDisconnectedContainer HostingModel = "DisconnectedContainer"
+	HostingModelProvisionedWeb        HostingModel = "ProvisionedWeb"
+	HostingModelWeb                   HostingModel = "Web"
+)
+
+type CommitmentPlan struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Kind       *string                   `json:"kind,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *CommitmentPlanProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type CommitmentPlanProperties struct {
+	AutoRenew          *bool             `json:"autoRenew,omitempty"`
+	CommitmentPlanGuid *string           `json:"commitmentPlanGuid,omitempty"`
+	Current            *CommitmentPeriod `json:"current,omitempty"`
+	HostingModel       *HostingModel     `json:"hostingModel,omitempty"`
+	Last               *CommitmentPeriod `json:"last,omitempty"`
+	Next               *CommitmentPeriod `json:"next,omitempty"`
+	PlanType           *string           `json:"planType,omitempty"`
+	ProvisioningState  *string           `json:"provisioningState,omitempty"`
+}
+
+type CommitmentPeriod struct {
+	Count     *int64           `json:"count,omitempty"`
+	EndDate   *string          `json:"endDate,omitempty"`
+	Quota     *CommitmentQuota `json:"quota,omitempty"`
+	StartDate *string          `json:"startDate,omitempty"`
+	Tier      *string          `json:"tier,omitempty"`
+}
+
+type CommitmentQuota struct {
+	Quantity *int64  `json:"quantity,omitempty"`
+	Unit     *string `json:"unit,omitempty"`
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/azuresdkhacks"
 )
 
 type Client struct {
-	AccountsClient    *cognitiveservicesaccounts.CognitiveServicesAccountsClient
-	DeploymentsClient *deployments.DeploymentsClient
+	AccountsClient        *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	CommitmentPlansClient *azuresdkhacks.CommitmentPlansClient
+	DeploymentsClient     *deployments.DeploymentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -16,10 +18,14 @@ func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := cognitiveservicesaccounts.NewCognitiveServicesAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	commitmentPlansClient := azuresdkhacks.NewCommitmentPlansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&commitmentPlansClient.Client, o.ResourceManagerAuthorizer)
+
 	deploymentsClient := deployments.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 	return &Client{
-		AccountsClient:    &accountsClient,
-		DeploymentsClient: &deploymentsClient,
+		AccountsClient:        &accountsClient,
+		CommitmentPlansClient: &commitmentPlansClient,
+		DeploymentsClient:     &deploymentsClient,
 	}
 }
@@ -0,0 +1,324 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type cognitiveAccountCommitmentPlanModel struct {
+	Name               string `tfschema:"name"`
+	CognitiveAccountId string `tfschema:"cognitive_account_id"`
+	HostingModel       string `tfschema:"hosting_model"`
+	PlanType           string `tfschema:"plan_type"`
+	Tier               string `tfschema:"tier"`
+	AutoRenewEnabled   bool   `tfschema:"auto_renew_enabled"`
+	CurrentTier        string `tfschema:"current_tier"`
+	CurrentCount       int64  `tfschema:"current_count"`
+	CurrentStartDate   string `tfschema:"current_start_date"`
+	CurrentEndDate     string `tfschema:"current_end_date"`
+}
+
+type CognitiveAccountCommitmentPlanResource struct{}
+
+var _ sdk.ResourceWithUpdate = CognitiveAccountCommitmentPlanResource{}
+
+func (r CognitiveAccountCommitmentPlanResource) ResourceType() string {
+	return "azurerm_cognitive_account_commitment_plan"
+}
+
+func (r CognitiveAccountCommitmentPlanResource) ModelObject() interface{} {
+	return &cognitiveAccountCommitmentPlanModel{}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.CommitmentPlanID
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+
+		"hosting_model": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.HostingModelConnectedContainer),
+				string(azuresdkhacks.HostingModelDisconnectedContainer),
+				string(azuresdkhacks.HostingModelProvisionedWeb),
+				string(azuresdkhacks.HostingModelWeb),
+			}, false),
+		},
+
+		"plan_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tier": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"auto_renew_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"current_tier": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"current_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"current_start_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"current_end_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model cognitiveAccountCommitmentPlanModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cognitive.CommitmentPlansClient
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(model.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewCommitmentPlanID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			hostingModel := azuresdkhacks.HostingModel(model.HostingModel)
+			properties := azuresdkhacks.CommitmentPlan{
+				Properties: &azuresdkhacks.CommitmentPlanProperties{
+					AutoRenew:    utils.Bool(model.AutoRenewEnabled),
+					HostingModel: &hostingModel,
+					PlanType:     utils.String(model.PlanType),
+					Current: &azuresdkhacks.CommitmentPeriod{
+						Tier: utils.String(model.Tier),
+					},
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.CommitmentPlansClient
+
+			id, err := parse.CommitmentPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := cognitiveAccountCommitmentPlanModel{
+				Name:               id.Name,
+				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				if v := properties.HostingModel; v != nil {
+					state.HostingModel = string(*v)
+				}
+				state.PlanType = utils.NormalizeNilableString(properties.PlanType)
+				state.AutoRenewEnabled = utils.NormaliseNilableBool(properties.AutoRenew)
+
+				if current := properties.Current; current != nil {
+					state.CurrentTier = utils.NormalizeNilableString(current.Tier)
+					state.CurrentStartDate = utils.NormalizeNilableString(current.StartDate)
+					state.CurrentEndDate = utils.NormalizeNilableString(current.EndDate)
+					if current.Count != nil {
+						state.CurrentCount = *current.Count
+					}
+				}
+
+				// a tier change only takes effect at the next renewal, so the configured tier is the one of the next period when set
+				state.Tier = state.CurrentTier
+				if next := properties.Next; next != nil && next.Tier != nil && *next.Tier != "" {
+					state.Tier = *next.Tier
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.CommitmentPlansClient
+
+			id, err := parse.CommitmentPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model cognitiveAccountCommitmentPlanModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", id)
+			}
+
+			if metadata.ResourceData.HasChange("auto_renew_enabled") {
+				properties.Properties.AutoRenew = utils.Bool(model.AutoRenewEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tier") {
+				properties.Properties.Next = &azuresdkhacks.CommitmentPeriod{
+					Tier: utils.String(model.Tier),
+				}
+			}
+
+			// the remaining periods are computed by the service and can't be sent back
+			properties.Properties.Current = nil
+			properties.Properties.Last = nil
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CognitiveAccountCommitmentPlanResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.CommitmentPlansClient
+
+			id, err := parse.CommitmentPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{"Exists"},
+				Target:       []string{"NotFound"},
+				Refresh:      cognitiveAccountCommitmentPlanDeleteRefreshFunc(ctx, client, *id),
+				PollInterval: 10 * time.Second,
+				Timeout:      time.Until(deadline),
+			}
+
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func cognitiveAccountCommitmentPlanDeleteRefreshFunc(ctx context.Context, client *azuresdkhacks.CommitmentPlansClient, id parse.CommitmentPlanId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return resp, "NotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		return resp, "Exists", nil
+	}
+}
@@ -0,0 +1,152 @@
+package cognitive_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CognitiveAccountCommitmentPlanTestResource struct{}
+
+func TestAccCognitiveAccountCommitmentPlan_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_tier").HasValue("T1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCognitiveAccountCommitmentPlan_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCognitiveAccountCommitmentPlan_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_account_commitment_plan", "test")
+	r := CognitiveAccountCommitmentPlanTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_tier").HasValue("T1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CognitiveAccountCommitmentPlanTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CommitmentPlanID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.Cognitive.CommitmentPlansClient
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CognitiveAccountCommitmentPlanTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%d"
+  location = "%s"
+}
+
+resource "azurerm_cognitive_account" "test" {
+  name                = "acctest-ca-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "SpeechServices"
+  sku_name            = "S0"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r CognitiveAccountCommitmentPlanTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "test" {
+  name                 = "acctest-cp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  hosting_model        = "Web"
+  plan_type            = "STT"
+  tier                 = "T1"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CognitiveAccountCommitmentPlanTestResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "test" {
+  name                 = "acctest-cp-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  hosting_model        = "Web"
+  plan_type            = "STT"
+  tier                 = "T2"
+  auto_renew_enabled   = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CognitiveAccountCommitmentPlanTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account_commitment_plan" "import" {
+  name                 = azurerm_cognitive_account_commitment_plan.test.name
+  cognitive_account_id = azurerm_cognitive_account_commitment_plan.test.cognitive_account_id
+  hosting_model        = azurerm_cognitive_account_commitment_plan.test.hosting_model
+  plan_type            = azurerm_cognitive_account_commitment_plan.test.plan_type
+  tier                 = azurerm_cognitive_account_commitment_plan.test.tier
+}
+`, r.basic(data))
+}
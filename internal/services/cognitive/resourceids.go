@@ -0,0 +1,3 @@
+package cognitive
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CommitmentPlan -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CognitiveServices/accounts/account1/commitmentPlans/plan1
@@ -45,6 +45,7 @@ type Client struct {
 	VirtualMachinesClient            *virtualmachines.VirtualMachinesClient
 	VMExtensionImageClient           *compute.VirtualMachineExtensionImagesClient
 	VMExtensionClient                *compute.VirtualMachineExtensionsClient
+	VMRunCommandsClient              *compute.VirtualMachineRunCommandsClient
 	VMScaleSetClient                 *compute.VirtualMachineScaleSetsClient
 	VMScaleSetExtensionsClient       *compute.VirtualMachineScaleSetExtensionsClient
 	VMScaleSetRollingUpgradesClient  *compute.VirtualMachineScaleSetRollingUpgradesClient
@@ -123,6 +124,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmExtensionClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmExtensionClient.Client, o.ResourceManagerAuthorizer)
 
+	vmRunCommandsClient := compute.NewVirtualMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	vmImageClient := compute.NewVirtualMachineImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmImageClient.Client, o.ResourceManagerAuthorizer)
 
@@ -164,6 +168,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		VirtualMachinesClient:            &virtualMachinesClient,
 		VMExtensionImageClient:           &vmExtensionImageClient,
 		VMExtensionClient:                &vmExtensionClient,
+		VMRunCommandsClient:              &vmRunCommandsClient,
 		VMScaleSetClient:                 &vmScaleSetClient,
 		VMScaleSetExtensionsClient:       &vmScaleSetExtensionsClient,
 		VMScaleSetRollingUpgradesClient:  &vmScaleSetRollingUpgradesClient,
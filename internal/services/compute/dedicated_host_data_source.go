@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -41,6 +42,22 @@ func dataSourceDedicatedHost() *pluginsdk.Resource {
 			"location": commonschema.LocationComputed(),
 
 			"tags": commonschema.TagsDataSource(),
+
+			"allocatable_virtual_machine": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: dedicatedHostAllocatableVirtualMachineSchema(),
+				},
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -53,7 +70,9 @@ func dataSourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 	id := dedicatedhosts.NewHostID(subscriptionId, d.Get("resource_group_name").(string), d.Get("dedicated_host_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id, dedicatedhosts.DefaultGetOperationOptions())
+	options := dedicatedhosts.DefaultGetOperationOptions()
+	options.Expand = pointer.To(dedicatedhosts.InstanceViewTypesInstanceView)
+	resp, err := client.Get(ctx, id, options)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("%s was not found", id)
@@ -70,6 +89,16 @@ func dataSourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
+		if props := model.Properties; props != nil {
+			if err := d.Set("allocatable_virtual_machine", flattenDedicatedHostAllocatableVirtualMachines(props.InstanceView)); err != nil {
+				return fmt.Errorf("setting `allocatable_virtual_machine`: %+v", err)
+			}
+
+			if err := d.Set("virtual_machine_ids", flattenDedicatedHostVirtualMachineIds(props.VirtualMachines)); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
+		}
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
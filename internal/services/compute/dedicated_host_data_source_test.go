@@ -20,6 +20,8 @@ func TestAccDataSourceAzureRMDedicatedHost_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("tags.%").Exists(),
+				check.That(data.ResourceName).Key("allocatable_virtual_machine.#").Exists(),
+				check.That(data.ResourceName).Key("virtual_machine_ids.#").HasValue("0"),
 			),
 		},
 	})
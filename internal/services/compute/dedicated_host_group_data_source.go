@@ -48,6 +48,14 @@ func dataSourceDedicatedHostGroup() *pluginsdk.Resource {
 
 			"tags": commonschema.TagsDataSource(),
 
+			"host_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"zones": commonschema.ZonesMultipleComputed(),
 		},
 	}
@@ -80,6 +88,10 @@ func dataSourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{
 		if props := model.Properties; props != nil {
 			d.Set("automatic_placement_enabled", props.SupportAutomaticPlacement)
 			d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
+
+			if err := d.Set("host_ids", flattenDedicatedHostGroupHostIds(props.Hosts)); err != nil {
+				return fmt.Errorf("setting `host_ids`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -66,6 +66,14 @@ func resourceDedicatedHostGroup() *pluginsdk.Resource {
 			"zone": commonschema.ZoneSingleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
+
+			"host_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -154,6 +162,10 @@ func resourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{})
 		if props := model.Properties; props != nil {
 			d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
 			d.Set("automatic_placement_enabled", props.SupportAutomaticPlacement)
+
+			if err := d.Set("host_ids", flattenDedicatedHostGroupHostIds(props.Hosts)); err != nil {
+				return fmt.Errorf("setting `host_ids`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -201,3 +213,18 @@ func resourceDedicatedHostGroupDelete(d *pluginsdk.ResourceData, meta interface{
 
 	return nil
 }
+
+func flattenDedicatedHostGroupHostIds(input *[]dedicatedhostgroups.SubResourceReadOnly) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			results = append(results, *v.Id)
+		}
+	}
+
+	return results
+}
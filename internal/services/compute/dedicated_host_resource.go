@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -135,6 +136,22 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 			},
 
 			"tags": commonschema.Tags(),
+
+			"allocatable_virtual_machine": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: dedicatedHostAllocatableVirtualMachineSchema(),
+				},
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -194,7 +211,10 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	resp, err := hostsClient.Get(ctx, *id, dedicatedhosts.DefaultGetOperationOptions())
+	// the instance view is needed to retrieve the capacity which is available on the Dedicated Host
+	options := dedicatedhosts.DefaultGetOperationOptions()
+	options.Expand = pointer.To(dedicatedhosts.InstanceViewTypesInstanceView)
+	resp, err := hostsClient.Get(ctx, *id, options)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", *id)
@@ -220,6 +240,14 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 				platformFaultDomain = int(*props.PlatformFaultDomain)
 			}
 			d.Set("platform_fault_domain", platformFaultDomain)
+
+			if err := d.Set("allocatable_virtual_machine", flattenDedicatedHostAllocatableVirtualMachines(props.InstanceView)); err != nil {
+				return fmt.Errorf("setting `allocatable_virtual_machine`: %+v", err)
+			}
+
+			if err := d.Set("virtual_machine_ids", flattenDedicatedHostVirtualMachineIds(props.VirtualMachines)); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -310,3 +338,53 @@ func dedicatedHostDeletedRefreshFunc(ctx context.Context, client *dedicatedhosts
 		return res, "Exists", nil
 	}
 }
+
+func dedicatedHostAllocatableVirtualMachineSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func flattenDedicatedHostAllocatableVirtualMachines(input *dedicatedhosts.DedicatedHostInstanceView) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.AvailableCapacity == nil || input.AvailableCapacity.AllocatableVMs == nil {
+		return results
+	}
+
+	for _, v := range *input.AvailableCapacity.AllocatableVMs {
+		count := 0
+		if v.Count != nil {
+			count = int(*v.Count)
+		}
+
+		results = append(results, map[string]interface{}{
+			"size":  pointer.From(v.VMSize),
+			"count": count,
+		})
+	}
+
+	return results
+}
+
+func flattenDedicatedHostVirtualMachineIds(input *[]dedicatedhosts.SubResourceReadOnly) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			results = append(results, *v.Id)
+		}
+	}
+
+	return results
+}
@@ -24,6 +24,8 @@ func TestAccDedicatedHost_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allocatable_virtual_machine.#").Exists(),
+				check.That(data.ResourceName).Key("virtual_machine_ids.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
@@ -0,0 +1,107 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type GalleryImageVersionReplicationStatusDataSource struct{}
+
+var _ sdk.DataSource = GalleryImageVersionReplicationStatusDataSource{}
+
+type GalleryImageVersionReplicationStatusDataSourceModel struct {
+	SharedImageVersionId       string                                         `tfschema:"shared_image_version_id"`
+	ReplicationMode            string                                         `tfschema:"replication_mode"`
+	AggregatedReplicationState string                                         `tfschema:"aggregated_replication_state"`
+	ReplicationStatus          []GalleryImageVersionRegionalReplicationStatus `tfschema:"replication_status"`
+}
+
+func (r GalleryImageVersionReplicationStatusDataSource) ResourceType() string {
+	return "azurerm_compute_gallery_image_version_replication_status"
+}
+
+func (r GalleryImageVersionReplicationStatusDataSource) ModelObject() interface{} {
+	return &GalleryImageVersionReplicationStatusDataSourceModel{}
+}
+
+func (r GalleryImageVersionReplicationStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"shared_image_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.SharedImageVersionID,
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"replication_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"aggregated_replication_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replication_status": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: sharedImageVersionRegionalReplicationStatusSchema(),
+			},
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryImageVersionsClient
+
+			var model GalleryImageVersionReplicationStatusDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.SharedImageVersionID(model.SharedImageVersionId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := GalleryImageVersionReplicationStatusDataSourceModel{
+				SharedImageVersionId: id.ID(),
+				ReplicationMode:      string(compute.ReplicationModeFull),
+			}
+
+			if props := resp.GalleryImageVersionProperties; props != nil {
+				if profile := props.PublishingProfile; profile != nil && profile.ReplicationMode != "" {
+					state.ReplicationMode = string(profile.ReplicationMode)
+				}
+
+				if status := props.ReplicationStatus; status != nil {
+					state.AggregatedReplicationState = string(status.AggregatedState)
+					state.ReplicationStatus = flattenSharedImageVersionRegionalReplicationStatus(status.Summary)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
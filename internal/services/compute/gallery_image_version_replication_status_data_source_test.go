@@ -0,0 +1,50 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type GalleryImageVersionReplicationStatusDataSource struct{}
+
+func TestAccDataSourceGalleryImageVersionReplicationStatus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_compute_gallery_image_version_replication_status", "test")
+	r := GalleryImageVersionReplicationStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: SharedImageVersionResource{}.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: SharedImageVersionResource{}.imageVersion(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("replication_mode").HasValue("Full"),
+				check.That(data.ResourceName).Key("aggregated_replication_state").HasValue("Completed"),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("1"),
+				check.That(data.ResourceName).Key("replication_status.0.state").HasValue("Completed"),
+				check.That(data.ResourceName).Key("replication_status.0.progress").HasValue("100"),
+			),
+		},
+	})
+}
+
+func (GalleryImageVersionReplicationStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_compute_gallery_image_version_replication_status" "test" {
+  shared_image_version_id = azurerm_shared_image_version.test.id
+}
+`, SharedImageVersionResource{}.imageVersion(data))
+}
@@ -0,0 +1,422 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type GalleryImageVersionReplicationTriggerResource struct{}
+
+var _ sdk.ResourceWithUpdate = GalleryImageVersionReplicationTriggerResource{}
+
+type GalleryImageVersionReplicationTriggerModel struct {
+	SharedImageVersionId       string                                         `tfschema:"shared_image_version_id"`
+	TargetRegion               []GalleryImageVersionReplicationTargetRegion   `tfschema:"target_region"`
+	Triggers                   map[string]string                              `tfschema:"triggers"`
+	AggregatedReplicationState string                                         `tfschema:"aggregated_replication_state"`
+	ReplicationStatus          []GalleryImageVersionRegionalReplicationStatus `tfschema:"replication_status"`
+}
+
+type GalleryImageVersionReplicationTargetRegion struct {
+	Name                 string `tfschema:"name"`
+	RegionalReplicaCount int    `tfschema:"regional_replica_count"`
+	StorageAccountType   string `tfschema:"storage_account_type"`
+}
+
+type GalleryImageVersionRegionalReplicationStatus struct {
+	Region   string `tfschema:"region"`
+	State    string `tfschema:"state"`
+	Details  string `tfschema:"details"`
+	Progress int    `tfschema:"progress"`
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) ResourceType() string {
+	return "azurerm_compute_gallery_image_version_replication_trigger"
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) ModelObject() interface{} {
+	return &GalleryImageVersionReplicationTriggerModel{}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SharedImageVersionID
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"shared_image_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SharedImageVersionID,
+		},
+
+		"target_region": {
+			// This needs to be a `TypeList` due to the `StateFunc` on the nested property `name`
+			// See: https://github.com/hashicorp/terraform-plugin-sdk/issues/160
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						StateFunc:        location.StateFunc,
+						DiffSuppressFunc: location.DiffSuppressFunc,
+					},
+
+					"regional_replica_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"storage_account_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(compute.StorageAccountTypePremiumLRS),
+							string(compute.StorageAccountTypeStandardLRS),
+							string(compute.StorageAccountTypeStandardZRS),
+						}, false),
+						Default: string(compute.StorageAccountTypeStandardLRS),
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"aggregated_replication_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replication_status": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: sharedImageVersionRegionalReplicationStatusSchema(),
+			},
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryImageVersionsClient
+
+			var model GalleryImageVersionReplicationTriggerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.SharedImageVersionID(model.SharedImageVersionId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if err := replicateSharedImageVersion(ctx, metadata, *id, existing, model.TargetRegion); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryImageVersionsClient
+
+			id, err := parse.SharedImageVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the triggers are only used to force the replication to be re-run, so these are taken from the config
+			var config GalleryImageVersionReplicationTriggerModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := GalleryImageVersionReplicationTriggerModel{
+				SharedImageVersionId: id.ID(),
+				Triggers:             config.Triggers,
+			}
+
+			if props := resp.GalleryImageVersionProperties; props != nil {
+				if profile := props.PublishingProfile; profile != nil {
+					state.TargetRegion = flattenGalleryImageVersionReplicationTargetRegions(profile.TargetRegions)
+				}
+
+				if status := props.ReplicationStatus; status != nil {
+					state.AggregatedReplicationState = string(status.AggregatedState)
+					state.ReplicationStatus = flattenSharedImageVersionRegionalReplicationStatus(status.Summary)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryImageVersionsClient
+
+			id, err := parse.SharedImageVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GalleryImageVersionReplicationTriggerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return replicateSharedImageVersion(ctx, metadata, *id, existing, model.TargetRegion)
+		},
+	}
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SharedImageVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the replicas are part of the Shared Image Version, so removing the trigger leaves them in place
+			metadata.Logger.Infof("removing the replication trigger for %s - the replicas are retained", *id)
+			return nil
+		},
+	}
+}
+
+// replicateSharedImageVersion updates the Target Regions of the Shared Image Version in-place and then waits for the
+// replication to each of the regions to complete, retaining the encryption settings for any region which already exists.
+func replicateSharedImageVersion(ctx context.Context, metadata sdk.ResourceMetaData, id parse.SharedImageVersionId, existing compute.GalleryImageVersion, input []GalleryImageVersionReplicationTargetRegion) error {
+	client := metadata.Client.Compute.GalleryImageVersionsClient
+
+	if existing.GalleryImageVersionProperties == nil || existing.GalleryImageVersionProperties.PublishingProfile == nil {
+		return fmt.Errorf("retrieving %s: `properties.publishingProfile` was nil", id)
+	}
+	profile := existing.GalleryImageVersionProperties.PublishingProfile
+
+	existingEncryption := make(map[string]*compute.EncryptionImages)
+	if profile.TargetRegions != nil {
+		for _, v := range *profile.TargetRegions {
+			if v.Name != nil {
+				existingEncryption[location.Normalize(*v.Name)] = v.Encryption
+			}
+		}
+	}
+
+	targetRegions := make([]compute.TargetRegion, 0)
+	for _, v := range input {
+		targetRegions = append(targetRegions, compute.TargetRegion{
+			Name:                 utils.String(location.Normalize(v.Name)),
+			RegionalReplicaCount: utils.Int32(int32(v.RegionalReplicaCount)),
+			StorageAccountType:   compute.StorageAccountType(v.StorageAccountType),
+			Encryption:           existingEncryption[location.Normalize(v.Name)],
+		})
+	}
+
+	payload := compute.GalleryImageVersionUpdate{
+		GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+			PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+				ExcludeFromLatest: profile.ExcludeFromLatest,
+				EndOfLifeDate:     profile.EndOfLifeDate,
+				ReplicationMode:   profile.ReplicationMode,
+				TargetRegions:     &targetRegions,
+			},
+			StorageProfile: existing.GalleryImageVersionProperties.StorageProfile,
+		},
+		Tags: existing.Tags,
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, payload)
+	if err != nil {
+		return fmt.Errorf("updating the Target Regions for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Target Regions for %s to be updated: %+v", id, err)
+	}
+
+	metadata.Logger.Infof("Waiting for the replication of %s to complete", id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(compute.AggregatedReplicationStateInProgress),
+			string(compute.AggregatedReplicationStateUnknown),
+		},
+		Target: []string{
+			string(compute.AggregatedReplicationStateCompleted),
+		},
+		Refresh:    sharedImageVersionReplicationStateRefreshFunc(ctx, metadata, id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the replication of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func sharedImageVersionReplicationStateRefreshFunc(ctx context.Context, metadata sdk.ResourceMetaData, id parse.SharedImageVersionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := metadata.Client.Compute.GalleryImageVersionsClient
+		resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the replication status for %s: %+v", id, err)
+		}
+
+		if resp.GalleryImageVersionProperties == nil || resp.GalleryImageVersionProperties.ReplicationStatus == nil {
+			return resp, string(compute.AggregatedReplicationStateUnknown), nil
+		}
+		status := resp.GalleryImageVersionProperties.ReplicationStatus
+
+		failed := make([]string, 0)
+		if status.Summary != nil {
+			for _, v := range *status.Summary {
+				region := utils.NormalizeNilableString(v.Region)
+				progress := 0
+				if v.Progress != nil {
+					progress = int(*v.Progress)
+				}
+				metadata.Logger.Infof("replication of %s to %q is %s (%d%%)", id, region, v.State, progress)
+
+				if v.State == compute.ReplicationStateFailed {
+					failed = append(failed, fmt.Sprintf("%s: %s", region, utils.NormalizeNilableString(v.Details)))
+				}
+			}
+		}
+
+		if status.AggregatedState == compute.AggregatedReplicationStateFailed {
+			return resp, string(status.AggregatedState), fmt.Errorf("replication failed for the regions [%s]", strings.Join(failed, ", "))
+		}
+
+		return resp, string(status.AggregatedState), nil
+	}
+}
+
+func sharedImageVersionRegionalReplicationStatusSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"region": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"details": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"progress": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func flattenGalleryImageVersionReplicationTargetRegions(input *[]compute.TargetRegion) []GalleryImageVersionReplicationTargetRegion {
+	results := make([]GalleryImageVersionReplicationTargetRegion, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := GalleryImageVersionReplicationTargetRegion{
+			Name:               location.NormalizeNilable(v.Name),
+			StorageAccountType: string(v.StorageAccountType),
+		}
+		if v.RegionalReplicaCount != nil {
+			output.RegionalReplicaCount = int(*v.RegionalReplicaCount)
+		}
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenSharedImageVersionRegionalReplicationStatus(input *[]compute.RegionalReplicationStatus) []GalleryImageVersionRegionalReplicationStatus {
+	results := make([]GalleryImageVersionRegionalReplicationStatus, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := GalleryImageVersionRegionalReplicationStatus{
+			Region:  location.NormalizeNilable(v.Region),
+			State:   string(v.State),
+			Details: utils.NormalizeNilableString(v.Details),
+		}
+		if v.Progress != nil {
+			output.Progress = int(*v.Progress)
+		}
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,143 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GalleryImageVersionReplicationTriggerResource struct{}
+
+func TestAccGalleryImageVersionReplicationTrigger_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_gallery_image_version_replication_trigger", "test")
+	r := GalleryImageVersionReplicationTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: SharedImageVersionResource{}.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aggregated_replication_state").HasValue("Completed"),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.multipleRegions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("aggregated_replication_state").HasValue("Completed"),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_status.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SharedImageVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.GalleryImageVersionsClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+
+  lifecycle {
+    ignore_changes = [target_region]
+  }
+}
+`, SharedImageVersionResource{}.provision(data))
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_gallery_image_version_replication_trigger" "test" {
+  shared_image_version_id = azurerm_shared_image_version.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 2
+  }
+
+  triggers = {
+    "version" = "1"
+  }
+}
+`, r.template(data))
+}
+
+func (r GalleryImageVersionReplicationTriggerResource) multipleRegions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_gallery_image_version_replication_trigger" "test" {
+  shared_image_version_id = azurerm_shared_image_version.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 2
+  }
+
+  target_region {
+    name                   = "%s"
+    regional_replica_count = 1
+    storage_account_type   = "Standard_ZRS"
+  }
+
+  triggers = {
+    "version" = "1"
+  }
+}
+`, r.template(data), data.Locations.Secondary)
+}
@@ -156,6 +156,8 @@ func TestAccLinuxVirtualMachineScaleSet_imagesRollingUpdate(t *testing.T) {
 			Config: r.imagesRollingUpdate(data, "18.04-LTS"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rolling_upgrade_status.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rolling_upgrade_status.0.status").Exists(),
 			),
 		},
 		data.ImportStep("admin_password"),
@@ -801,6 +801,7 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 
 func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	rollingUpgradesClient := meta.(*clients.Client).Compute.VMScaleSetRollingUpgradesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1063,6 +1064,23 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	// the status of the latest rolling upgrade is only available when the upgrade mode is `Automatic` or `Rolling`
+	rollingUpgradeStatus := make([]interface{}, 0)
+	if policy := props.UpgradePolicy; policy != nil && (policy.Mode == compute.UpgradeModeAutomatic || policy.Mode == compute.UpgradeModeRolling) {
+		upgradeResp, err := rollingUpgradesClient.GetLatest(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			// a 404 is returned when there hasn't been a rolling upgrade yet
+			if !utils.ResponseWasNotFound(upgradeResp.Response) {
+				return fmt.Errorf("retrieving the latest rolling upgrade for %s: %+v", *id, err)
+			}
+		} else {
+			rollingUpgradeStatus = FlattenVirtualMachineScaleSetRollingUpgradeStatus(upgradeResp.RollingUpgradeStatusInfoProperties)
+		}
+	}
+	if err := d.Set("rolling_upgrade_status", rollingUpgradeStatus); err != nil {
+		return fmt.Errorf("setting `rolling_upgrade_status`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1418,6 +1436,8 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 		"zones": commonschema.ZonesMultipleOptionalForceNew(),
 
 		// Computed
+		"rolling_upgrade_status": VirtualMachineScaleSetRollingUpgradeStatusSchema(),
+
 		"unique_id": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -78,12 +78,17 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		GalleryImageVersionReplicationStatusDataSource{},
+		VirtualMachineSizesDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
+		GalleryImageVersionReplicationTriggerResource{},
+		VirtualMachineRunCommandResource{},
 	}
 }
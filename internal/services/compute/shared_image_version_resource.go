@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -119,7 +120,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 				RequiredWith: []string{"storage_account_id"},
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "virtual_machine_capture"},
 			},
 
 			"storage_account_id": {
@@ -141,7 +142,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "virtual_machine_capture"},
 				// TODO -- add a validation function when snapshot has its own validation function
 			},
 
@@ -153,7 +154,39 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 					validate.ImageID,
 					validate.VirtualMachineID,
 				),
-				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "virtual_machine_capture"},
+			},
+
+			"virtual_machine_capture": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id", "virtual_machine_capture"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"virtual_machine_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.VirtualMachineID,
+						},
+
+						"generalization_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+
+						"restart_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
 			},
 
 			"replication_mode": {
@@ -236,6 +269,23 @@ func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	capture := expandSharedImageVersionVirtualMachineCapture(d.Get("virtual_machine_capture").([]interface{}))
+	if capture != nil {
+		if capture.generalizationEnabled && capture.restartEnabled {
+			return fmt.Errorf("`restart_enabled` cannot be set to `true` when `generalization_enabled` is `true` since a generalized Virtual Machine cannot be started")
+		}
+
+		if d.IsNewResource() {
+			if err := captureSharedImageVersionSourceVirtualMachine(ctx, meta.(*clients.Client).Compute.VMClient, capture); err != nil {
+				return err
+			}
+		}
+
+		version.GalleryImageVersionProperties.StorageProfile.Source = &compute.GalleryArtifactVersionSource{
+			ID: utils.String(capture.virtualMachineId.ID()),
+		}
+	}
+
 	if v, ok := d.GetOk("os_disk_snapshot_id"); ok {
 		version.GalleryImageVersionProperties.StorageProfile.OsDiskImage = &compute.GalleryOSDiskImage{
 			Source: &compute.GalleryArtifactVersionSource{
@@ -262,6 +312,18 @@ func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
+	if capture != nil && capture.restartEnabled && d.IsNewResource() {
+		vmClient := meta.(*clients.Client).Compute.VMClient
+		log.Printf("[DEBUG] Starting %s after capture..", capture.virtualMachineId)
+		startFuture, err := vmClient.Start(ctx, capture.virtualMachineId.ResourceGroup, capture.virtualMachineId.Name)
+		if err != nil {
+			return fmt.Errorf("starting %s after capture: %+v", capture.virtualMachineId, err)
+		}
+		if err = startFuture.WaitForCompletionRef(ctx, vmClient.Client); err != nil {
+			return fmt.Errorf("waiting for %s to start after capture: %+v", capture.virtualMachineId, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceSharedImageVersionRead(d, meta)
@@ -316,8 +378,18 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 
 		if profile := props.StorageProfile; profile != nil {
-			if source := profile.Source; source != nil {
-				d.Set("managed_image_id", source.ID)
+			managedImageId := ""
+			if source := profile.Source; source != nil && source.ID != nil {
+				managedImageId = *source.ID
+			}
+
+			// the captured Virtual Machine is returned as the source, so it's tracked within the `virtual_machine_capture` block when that's in use
+			if capture := d.Get("virtual_machine_capture").([]interface{}); len(capture) > 0 && capture[0] != nil {
+				if err := d.Set("virtual_machine_capture", flattenSharedImageVersionVirtualMachineCapture(capture, managedImageId)); err != nil {
+					return fmt.Errorf("setting `virtual_machine_capture`: %+v", err)
+				}
+			} else {
+				d.Set("managed_image_id", managedImageId)
 			}
 
 			blobURI := ""
@@ -465,3 +537,107 @@ func flattenSharedImageVersionTargetRegions(input *[]compute.TargetRegion) []int
 
 	return results
 }
+
+type sharedImageVersionVirtualMachineCapture struct {
+	virtualMachineId      parse.VirtualMachineId
+	generalizationEnabled bool
+	restartEnabled        bool
+}
+
+func expandSharedImageVersionVirtualMachineCapture(input []interface{}) *sharedImageVersionVirtualMachineCapture {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// the ID has already been validated by the schema
+	virtualMachineId, _ := parse.VirtualMachineID(raw["virtual_machine_id"].(string))
+
+	return &sharedImageVersionVirtualMachineCapture{
+		virtualMachineId:      *virtualMachineId,
+		generalizationEnabled: raw["generalization_enabled"].(bool),
+		restartEnabled:        raw["restart_enabled"].(bool),
+	}
+}
+
+func flattenSharedImageVersionVirtualMachineCapture(input []interface{}, sourceId string) []interface{} {
+	raw := input[0].(map[string]interface{})
+
+	// the API may return the ID of the source Virtual Machine with a different casing
+	virtualMachineId := raw["virtual_machine_id"].(string)
+	if sourceId != "" && !strings.EqualFold(sourceId, virtualMachineId) {
+		virtualMachineId = sourceId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"virtual_machine_id":     virtualMachineId,
+			"generalization_enabled": raw["generalization_enabled"].(bool),
+			"restart_enabled":        raw["restart_enabled"].(bool),
+		},
+	}
+}
+
+// captureSharedImageVersionSourceVirtualMachine prepares the Virtual Machine for capture: when generalization is
+// enabled the OS is generalized via a Run Command (sysprep on Windows, the Linux Agent on Linux) before the Virtual
+// Machine is deallocated and marked as Generalized, otherwise the Virtual Machine is only deallocated.
+func captureSharedImageVersionSourceVirtualMachine(ctx context.Context, client *compute.VirtualMachinesClient, capture *sharedImageVersionVirtualMachineCapture) error {
+	id := capture.virtualMachineId
+
+	if capture.generalizationEnabled {
+		vm, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		osType := compute.OperatingSystemTypesLinux
+		if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+			osType = props.StorageProfile.OsDisk.OsType
+		}
+
+		runCommand := compute.RunCommandInput{
+			CommandID: utils.String("RunShellScript"),
+			Script: &[]string{
+				"waagent -verbose -deprovision+user -force",
+			},
+		}
+		if osType == compute.OperatingSystemTypesWindows {
+			runCommand = compute.RunCommandInput{
+				CommandID: utils.String("RunPowerShellScript"),
+				Script: &[]string{
+					"$cmd = \"$Env:SystemRoot\\system32\\sysprep\\sysprep.exe\"",
+					"$args = \"/generalize /oobe /mode:vm /quit\"",
+					"Start-Process powershell -Argument \"$cmd $args\" -Wait",
+				},
+			}
+		}
+
+		log.Printf("[DEBUG] Running the generalization command on %s..", id)
+		runFuture, err := client.RunCommand(ctx, id.ResourceGroup, id.Name, runCommand)
+		if err != nil {
+			return fmt.Errorf("running the generalization command on %s: %+v", id, err)
+		}
+		if err = runFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the generalization command on %s: %+v", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deallocating %s..", id)
+	deallocateFuture, err := client.Deallocate(ctx, id.ResourceGroup, id.Name, utils.Bool(false))
+	if err != nil {
+		return fmt.Errorf("deallocating %s: %+v", id, err)
+	}
+	if err = deallocateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deallocation of %s: %+v", id, err)
+	}
+
+	if capture.generalizationEnabled {
+		log.Printf("[DEBUG] Generalizing %s..", id)
+		if _, err := client.Generalize(ctx, id.ResourceGroup, id.Name); err != nil {
+			return fmt.Errorf("generalizing %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
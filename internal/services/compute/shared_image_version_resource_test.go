@@ -152,6 +152,38 @@ func TestAccSharedImageVersion_specializedImageVersionByVM(t *testing.T) {
 	})
 }
 
+func TestAccSharedImageVersion_virtualMachineCapture(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualMachineCapture(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_image_id").IsEmpty(),
+			),
+		},
+		data.ImportStep("virtual_machine_capture", "managed_image_id"),
+	})
+}
+
+func TestAccSharedImageVersion_virtualMachineCaptureSpecialized(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
+	r := SharedImageVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualMachineCaptureSpecialized(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClientForResource(r.virtualMachineIsRunning, "azurerm_virtual_machine.testsource"),
+			),
+		},
+		data.ImportStep("virtual_machine_capture", "managed_image_id"),
+	})
+}
+
 func TestAccSharedImageVersion_diskEncryptionSetID(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_shared_image_version", "test")
 	r := SharedImageVersionResource{}
@@ -288,6 +320,28 @@ func (SharedImageVersionResource) revokeSnapshot(ctx context.Context, client *cl
 }
 
 // nolint: unparam
+func (SharedImageVersionResource) virtualMachineIsRunning(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.VirtualMachineID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Compute.VMClient.InstanceView(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving instance view for %s: %+v", *id, err)
+	}
+
+	if resp.Statuses != nil {
+		for _, status := range *resp.Statuses {
+			if status.Code != nil && *status.Code == "PowerState/running" {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%s was not running after capture", *id)
+}
+
 func (SharedImageVersionResource) setup(data acceptance.TestData) string {
 	return ImageResource{}.setupUnmanagedDisks(data, "LRS")
 }
@@ -686,3 +740,73 @@ resource "azurerm_shared_image_version" "test" {
 }
 `, template)
 }
+
+func (r SharedImageVersionResource) virtualMachineCapture(data acceptance.TestData) string {
+	template := ImageResource{}.setupManagedDisks(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  virtual_machine_capture {
+    virtual_machine_id = azurerm_virtual_machine.testsource.id
+  }
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r SharedImageVersionResource) virtualMachineCaptureSpecialized(data acceptance.TestData) string {
+	template := r.provisionSpecialized(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  virtual_machine_capture {
+    virtual_machine_id     = azurerm_virtual_machine.testsource.id
+    generalization_enabled = false
+    restart_enabled        = true
+  }
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+`, template)
+}
@@ -0,0 +1,156 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+func TestAccVirtualMachineRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("instance_view.0.output").HasValue("Hello from Terraform\n"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineRunCommandResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.VMRunCommandsClient.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VirtualMachineRunCommandProperties != nil), nil
+}
+
+func (r VirtualMachineRunCommandResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  source {
+    script = "echo 'Hello from Terraform'"
+  }
+}
+`, LinuxVirtualMachineResource{}.authSSH(data), data.RandomInteger)
+}
+
+func (r VirtualMachineRunCommandResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "import" {
+  name               = azurerm_virtual_machine_run_command.test.name
+  location           = azurerm_virtual_machine_run_command.test.location
+  virtual_machine_id = azurerm_virtual_machine_run_command.test.virtual_machine_id
+
+  source {
+    script = "echo 'Hello from Terraform'"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineRunCommandResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  timeout_in_seconds = 300
+
+  source {
+    script = "echo $GREETING $SECRET_NAME"
+  }
+
+  parameter {
+    name  = "GREETING"
+    value = "Hello"
+  }
+
+  protected_parameter {
+    name  = "SECRET_NAME"
+    value = "Terraform"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, LinuxVirtualMachineResource{}.authSSH(data), data.RandomInteger)
+}
@@ -3,6 +3,7 @@ package compute
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/galleryapplicationversions"
@@ -1893,6 +1894,112 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpg
 	}
 }
 
+func VirtualMachineScaleSetRollingUpgradeStatusSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"status": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"last_action": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"start_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"last_action_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"successful_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"failed_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"in_progress_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"pending_instance_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"error_message": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func FlattenVirtualMachineScaleSetRollingUpgradeStatus(input *compute.RollingUpgradeStatusInfoProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	status := ""
+	lastAction := ""
+	startTime := ""
+	lastActionTime := ""
+	if running := input.RunningStatus; running != nil {
+		status = string(running.Code)
+		lastAction = string(running.LastAction)
+		if running.StartTime != nil {
+			startTime = running.StartTime.Format(time.RFC3339)
+		}
+		if running.LastActionTime != nil {
+			lastActionTime = running.LastActionTime.Format(time.RFC3339)
+		}
+	}
+
+	successfulInstanceCount := 0
+	failedInstanceCount := 0
+	inProgressInstanceCount := 0
+	pendingInstanceCount := 0
+	if progress := input.Progress; progress != nil {
+		if progress.SuccessfulInstanceCount != nil {
+			successfulInstanceCount = int(*progress.SuccessfulInstanceCount)
+		}
+		if progress.FailedInstanceCount != nil {
+			failedInstanceCount = int(*progress.FailedInstanceCount)
+		}
+		if progress.InProgressInstanceCount != nil {
+			inProgressInstanceCount = int(*progress.InProgressInstanceCount)
+		}
+		if progress.PendingInstanceCount != nil {
+			pendingInstanceCount = int(*progress.PendingInstanceCount)
+		}
+	}
+
+	errorMessage := ""
+	if input.Error != nil && input.Error.Message != nil {
+		errorMessage = *input.Error.Message
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status":                     status,
+			"last_action":                lastAction,
+			"start_time":                 startTime,
+			"last_action_time":           lastActionTime,
+			"successful_instance_count":  successfulInstanceCount,
+			"failed_instance_count":      failedInstanceCount,
+			"in_progress_instance_count": inProgressInstanceCount,
+			"pending_instance_count":     pendingInstanceCount,
+			"error_message":              errorMessage,
+		},
+	}
+}
+
 // TODO remove VirtualMachineScaleSetTerminateNotificationSchema in 4.0
 func VirtualMachineScaleSetTerminateNotificationSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
@@ -0,0 +1,354 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+var _ sdk.DataSource = VirtualMachineSizesDataSource{}
+
+type VirtualMachineSizesDataSourceModel struct {
+	Location                     string               `tfschema:"location"`
+	MinimumVCPUs                 int                  `tfschema:"minimum_vcpus"`
+	MaximumVCPUs                 int                  `tfschema:"maximum_vcpus"`
+	MinimumMemoryGB              float64              `tfschema:"minimum_memory_gb"`
+	MaximumMemoryGB              float64              `tfschema:"maximum_memory_gb"`
+	AcceleratedNetworkingEnabled bool                 `tfschema:"accelerated_networking_enabled"`
+	PremiumIOEnabled             bool                 `tfschema:"premium_io_enabled"`
+	Zones                        []string             `tfschema:"zones"`
+	Names                        []string             `tfschema:"names"`
+	Sizes                        []VirtualMachineSize `tfschema:"sizes"`
+}
+
+type VirtualMachineSize struct {
+	Name                         string   `tfschema:"name"`
+	Family                       string   `tfschema:"family"`
+	VCPUs                        int      `tfschema:"vcpus"`
+	MemoryGB                     float64  `tfschema:"memory_gb"`
+	AcceleratedNetworkingEnabled bool     `tfschema:"accelerated_networking_enabled"`
+	PremiumIOEnabled             bool     `tfschema:"premium_io_enabled"`
+	Zones                        []string `tfschema:"zones"`
+}
+
+// virtualMachineSizeFilter contains the requirements a Virtual Machine Size must meet to be returned, where the
+// zero value of each field means that the requirement isn't checked
+type virtualMachineSizeFilter struct {
+	MinimumVCPUs                 int
+	MaximumVCPUs                 int
+	MinimumMemoryGB              float64
+	MaximumMemoryGB              float64
+	AcceleratedNetworkingEnabled bool
+	PremiumIOEnabled             bool
+	Zones                        []string
+}
+
+func (r VirtualMachineSizesDataSource) ResourceType() string {
+	return "azurerm_virtual_machine_sizes"
+}
+
+func (r VirtualMachineSizesDataSource) ModelObject() interface{} {
+	return &VirtualMachineSizesDataSourceModel{}
+}
+
+func (r VirtualMachineSizesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"minimum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"maximum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"minimum_memory_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"maximum_memory_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"accelerated_networking_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"premium_io_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"zones": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"sizes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vcpus": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"memory_gb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"accelerated_networking_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"premium_io_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"zones": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.SkusClient
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var model VirtualMachineSizesDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loc := location.Normalize(model.Location)
+			options := skus.DefaultResourceSkusListOperationOptions()
+			options.Filter = pointer.To(fmt.Sprintf("location eq '%s'", loc))
+			resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, options)
+			if err != nil {
+				return fmt.Errorf("listing the Virtual Machine Sizes available in %q: %+v", loc, err)
+			}
+
+			filter := virtualMachineSizeFilter{
+				MinimumVCPUs:                 model.MinimumVCPUs,
+				MaximumVCPUs:                 model.MaximumVCPUs,
+				MinimumMemoryGB:              model.MinimumMemoryGB,
+				MaximumMemoryGB:              model.MaximumMemoryGB,
+				AcceleratedNetworkingEnabled: model.AcceleratedNetworkingEnabled,
+				PremiumIOEnabled:             model.PremiumIOEnabled,
+				Zones:                        model.Zones,
+			}
+			model.Sizes = filterVirtualMachineSizes(resp.Items, loc, filter)
+			model.Names = make([]string, 0)
+			for _, size := range model.Sizes {
+				model.Names = append(model.Names, size.Name)
+			}
+			model.Location = loc
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/vmSizes", subscriptionId.ID(), loc))
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// filterVirtualMachineSizes returns the Virtual Machine Sizes which are available to this Subscription in the specified
+// location and meet the requirements of the filter, ordered by name
+func filterVirtualMachineSizes(input []skus.ResourceSku, loc string, filter virtualMachineSizeFilter) []VirtualMachineSize {
+	output := make([]VirtualMachineSize, 0)
+
+	for _, sku := range input {
+		if sku.Name == nil || sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, "virtualMachines") {
+			continue
+		}
+
+		availableInLocation := false
+		if sku.Locations != nil {
+			for _, v := range *sku.Locations {
+				if location.Normalize(v) == loc {
+					availableInLocation = true
+				}
+			}
+		}
+		if !availableInLocation {
+			continue
+		}
+
+		// SKUs can be restricted for a Subscription either across the whole location or in specific zones
+		restricted := false
+		restrictedZones := make(map[string]struct{})
+		if sku.Restrictions != nil {
+			for _, restriction := range *sku.Restrictions {
+				if restriction.Type == nil {
+					continue
+				}
+				switch *restriction.Type {
+				case skus.ResourceSkuRestrictionsTypeLocation:
+					restricted = true
+				case skus.ResourceSkuRestrictionsTypeZone:
+					if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+						for _, zone := range *info.Zones {
+							restrictedZones[zone] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+		if restricted {
+			continue
+		}
+
+		size := VirtualMachineSize{
+			Name:   *sku.Name,
+			Family: pointer.From(sku.Family),
+			Zones:  make([]string, 0),
+		}
+
+		if sku.LocationInfo != nil {
+			for _, info := range *sku.LocationInfo {
+				if info.Location == nil || location.Normalize(*info.Location) != loc || info.Zones == nil {
+					continue
+				}
+				for _, zone := range *info.Zones {
+					if _, ok := restrictedZones[zone]; !ok {
+						size.Zones = append(size.Zones, zone)
+					}
+				}
+			}
+		}
+		sort.Strings(size.Zones)
+
+		if sku.Capabilities != nil {
+			for _, capability := range *sku.Capabilities {
+				if capability.Name == nil || capability.Value == nil {
+					continue
+				}
+
+				switch strings.ToLower(*capability.Name) {
+				case "vcpus":
+					if v, err := strconv.Atoi(*capability.Value); err == nil {
+						size.VCPUs = v
+					}
+				case "memorygb":
+					if v, err := strconv.ParseFloat(*capability.Value, 64); err == nil {
+						size.MemoryGB = v
+					}
+				case "acceleratednetworkingenabled":
+					size.AcceleratedNetworkingEnabled = strings.EqualFold(*capability.Value, "True")
+				case "premiumio":
+					size.PremiumIOEnabled = strings.EqualFold(*capability.Value, "True")
+				}
+			}
+		}
+
+		if !filter.matches(size) {
+			continue
+		}
+
+		output = append(output, size)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+
+	return output
+}
+
+func (f virtualMachineSizeFilter) matches(size VirtualMachineSize) bool {
+	if f.MinimumVCPUs > 0 && size.VCPUs < f.MinimumVCPUs {
+		return false
+	}
+	if f.MaximumVCPUs > 0 && size.VCPUs > f.MaximumVCPUs {
+		return false
+	}
+	if f.MinimumMemoryGB > 0 && size.MemoryGB < f.MinimumMemoryGB {
+		return false
+	}
+	if f.MaximumMemoryGB > 0 && size.MemoryGB > f.MaximumMemoryGB {
+		return false
+	}
+	if f.AcceleratedNetworkingEnabled && !size.AcceleratedNetworkingEnabled {
+		return false
+	}
+	if f.PremiumIOEnabled && !size.PremiumIOEnabled {
+		return false
+	}
+
+	for _, zone := range f.Zones {
+		found := false
+		for _, v := range size.Zones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,73 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+func TestAccDataSourceVirtualMachineSizes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.name").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.vcpus").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineSizes_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.vcpus").HasValue("2"),
+				check.That(data.ResourceName).Key("sizes.0.accelerated_networking_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("sizes.0.premium_io_enabled").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineSizesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineSizesDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location                       = "%s"
+  minimum_vcpus                  = 2
+  maximum_vcpus                  = 2
+  minimum_memory_gb              = 4
+  accelerated_networking_enabled = true
+  premium_io_enabled             = true
+  zones                          = ["1"]
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,135 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestFilterVirtualMachineSizes(t *testing.T) {
+	capabilities := func(vcpus, memory, acceleratedNetworking, premiumIO string) *[]skus.ResourceSkuCapabilities {
+		return &[]skus.ResourceSkuCapabilities{
+			{Name: pointer.To("vCPUs"), Value: pointer.To(vcpus)},
+			{Name: pointer.To("MemoryGB"), Value: pointer.To(memory)},
+			{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To(acceleratedNetworking)},
+			{Name: pointer.To("PremiumIO"), Value: pointer.To(premiumIO)},
+		}
+	}
+	locationInfo := &[]skus.ResourceSkuLocationInfo{
+		{
+			Location: pointer.To("westeurope"),
+			Zones:    &[]string{"3", "1", "2"},
+		},
+	}
+
+	input := []skus.ResourceSku{
+		{
+			Name:         pointer.To("Standard_D2s_v3"),
+			Family:       pointer.To("standardDSv3Family"),
+			ResourceType: pointer.To("virtualMachines"),
+			Locations:    &[]string{"westeurope"},
+			LocationInfo: locationInfo,
+			Capabilities: capabilities("2", "8", "True", "True"),
+		},
+		{
+			Name:         pointer.To("Standard_B1s"),
+			Family:       pointer.To("standardBSFamily"),
+			ResourceType: pointer.To("virtualMachines"),
+			Locations:    &[]string{"westeurope"},
+			LocationInfo: locationInfo,
+			Capabilities: capabilities("1", "1", "False", "True"),
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type: pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Zones: &[]string{"2"},
+					},
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_A2_v2"),
+			Family:       pointer.To("standardAv2Family"),
+			ResourceType: pointer.To("virtualMachines"),
+			Locations:    &[]string{"westeurope"},
+			LocationInfo: locationInfo,
+			Capabilities: capabilities("2", "4", "False", "False"),
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type: pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Premium_LRS"),
+			ResourceType: pointer.To("disks"),
+			Locations:    &[]string{"westeurope"},
+		},
+	}
+
+	d2s := VirtualMachineSize{
+		Name:                         "Standard_D2s_v3",
+		Family:                       "standardDSv3Family",
+		VCPUs:                        2,
+		MemoryGB:                     8,
+		AcceleratedNetworkingEnabled: true,
+		PremiumIOEnabled:             true,
+		Zones:                        []string{"1", "2", "3"},
+	}
+	b1s := VirtualMachineSize{
+		Name:             "Standard_B1s",
+		Family:           "standardBSFamily",
+		VCPUs:            1,
+		MemoryGB:         1,
+		PremiumIOEnabled: true,
+		Zones:            []string{"1", "3"},
+	}
+
+	cases := []struct {
+		Name     string
+		Filter   virtualMachineSizeFilter
+		Expected []VirtualMachineSize
+	}{
+		{
+			Name:     "no filter",
+			Filter:   virtualMachineSizeFilter{},
+			Expected: []VirtualMachineSize{b1s, d2s},
+		},
+		{
+			Name:     "minimum vcpus",
+			Filter:   virtualMachineSizeFilter{MinimumVCPUs: 2},
+			Expected: []VirtualMachineSize{d2s},
+		},
+		{
+			Name:     "maximum memory",
+			Filter:   virtualMachineSizeFilter{MaximumMemoryGB: 4},
+			Expected: []VirtualMachineSize{b1s},
+		},
+		{
+			Name:     "accelerated networking",
+			Filter:   virtualMachineSizeFilter{AcceleratedNetworkingEnabled: true},
+			Expected: []VirtualMachineSize{d2s},
+		},
+		{
+			Name:     "restricted zone",
+			Filter:   virtualMachineSizeFilter{Zones: []string{"2"}},
+			Expected: []VirtualMachineSize{d2s},
+		},
+		{
+			Name:     "no matches",
+			Filter:   virtualMachineSizeFilter{MinimumVCPUs: 64},
+			Expected: []VirtualMachineSize{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := filterVirtualMachineSizes(input, "westeurope", tc.Filter)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
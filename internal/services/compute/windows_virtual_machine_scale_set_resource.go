@@ -819,6 +819,7 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 
 func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	rollingUpgradesClient := meta.(*clients.Client).Compute.VMScaleSetRollingUpgradesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -928,6 +929,23 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
+	// the status of the latest rolling upgrade is only available when the upgrade mode is `Automatic` or `Rolling`
+	rollingUpgradeStatus := make([]interface{}, 0)
+	if policy := props.UpgradePolicy; policy != nil && (policy.Mode == compute.UpgradeModeAutomatic || policy.Mode == compute.UpgradeModeRolling) {
+		upgradeResp, err := rollingUpgradesClient.GetLatest(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			// a 404 is returned when there hasn't been a rolling upgrade yet
+			if !utils.ResponseWasNotFound(upgradeResp.Response) {
+				return fmt.Errorf("retrieving the latest rolling upgrade for %s: %+v", *id, err)
+			}
+		} else {
+			rollingUpgradeStatus = FlattenVirtualMachineScaleSetRollingUpgradeStatus(upgradeResp.RollingUpgradeStatusInfoProperties)
+		}
+	}
+	if err := d.Set("rolling_upgrade_status", rollingUpgradeStatus); err != nil {
+		return fmt.Errorf("setting `rolling_upgrade_status`: %+v", err)
+	}
+
 	if profile := props.VirtualMachineProfile; profile != nil {
 		if err := d.Set("boot_diagnostics", flattenBootDiagnostics(profile.DiagnosticsProfile)); err != nil {
 			return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
@@ -1475,6 +1493,8 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 		"zones": commonschema.ZonesMultipleOptionalForceNew(),
 
 		// Computed
+		"rolling_upgrade_status": VirtualMachineScaleSetRollingUpgradeStatusSchema(),
+
 		"unique_id": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -0,0 +1,182 @@
+package connections
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/managedapis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceApiConnectionConsentLinks() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiConnectionConsentLinksRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"api_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: connections.ValidateConnectionID,
+			},
+
+			"redirect_url": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"object_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"consent_links": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"parameter_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"link": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"first_party_login_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiConnectionConsentLinksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Connections.ConnectionsClient
+	managedApisClient := meta.(*clients.Client).Connections.ManagedApisClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := connections.ParseConnectionID(d.Get("api_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Api == nil || resp.Model.Properties.Api.Id == nil {
+		return fmt.Errorf("retrieving %s: `properties.api.id` was nil", *id)
+	}
+	managedApiId, err := managedapis.ParseManagedApiIDInsensitively(*resp.Model.Properties.Api.Id)
+	if err != nil {
+		return err
+	}
+
+	managedApi, err := managedApisClient.ManagedApisGet(ctx, *managedApiId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *managedApiId, err)
+	}
+
+	// a consent link is only available for the connection parameters which use OAuth
+	parameterNames := make([]string, 0)
+	if model := managedApi.Model; model != nil && model.Properties != nil && model.Properties.ConnectionParameters != nil {
+		for name, parameter := range *model.Properties.ConnectionParameters {
+			if parameter.Type != nil && *parameter.Type == managedapis.ConnectionParameterTypeOauthSetting {
+				parameterNames = append(parameterNames, name)
+			}
+		}
+	}
+	sort.Strings(parameterNames)
+
+	consentLinks := make([]interface{}, 0)
+	for _, parameterName := range parameterNames {
+		parameter := connections.ConsentLinkParameterDefinition{
+			ParameterName: utils.String(parameterName),
+		}
+		if v := d.Get("redirect_url").(string); v != "" {
+			parameter.RedirectUrl = utils.String(v)
+		}
+		if v := d.Get("object_id").(string); v != "" {
+			parameter.ObjectId = utils.String(v)
+		}
+		if v := d.Get("tenant_id").(string); v != "" {
+			parameter.TenantId = utils.String(v)
+		}
+
+		// consent links are requested for a single parameter at a time, since the response doesn't include the
+		// name of the parameter each link is for
+		links, err := client.ListConsentLinks(ctx, *id, connections.ListConsentLinksDefinition{
+			Parameters: &[]connections.ConsentLinkParameterDefinition{parameter},
+		})
+		if err != nil {
+			return fmt.Errorf("listing the consent links for the %q parameter of %s: %+v", parameterName, *id, err)
+		}
+
+		if links.Model == nil || links.Model.Value == nil {
+			continue
+		}
+		for _, link := range *links.Model.Value {
+			status := ""
+			if link.Status != nil {
+				status = string(*link.Status)
+			}
+
+			consentLinks = append(consentLinks, map[string]interface{}{
+				"parameter_name":        parameterName,
+				"display_name":          utils.NormalizeNilableString(link.DisplayName),
+				"link":                  utils.NormalizeNilableString(link.Link),
+				"first_party_login_uri": utils.NormalizeNilableString(link.FirstPartyLoginUri),
+				"status":                status,
+			})
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("api_connection_id", id.ID())
+
+	if err := d.Set("consent_links", consentLinks); err != nil {
+		return fmt.Errorf("setting `consent_links`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package connections_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiConnectionConsentLinksTestDataSource struct{}
+
+func TestAccDataSourceApiConnectionConsentLinks_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_connection_consent_links", "test")
+	r := ApiConnectionConsentLinksTestDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("consent_links.#").HasValue("1"),
+				check.That(data.ResourceName).Key("consent_links.0.parameter_name").HasValue("token"),
+				check.That(data.ResourceName).Key("consent_links.0.link").Exists(),
+				check.That(data.ResourceName).Key("consent_links.0.status").HasValue("Unauthenticated"),
+			),
+		},
+	})
+}
+
+func (ApiConnectionConsentLinksTestDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-conn-%[1]d"
+  location = %[2]q
+}
+
+data "azurerm_managed_api" "test" {
+  name     = "office365"
+  location = azurerm_resource_group.test.location
+}
+
+resource "azurerm_api_connection" "test" {
+  name                = "acctestconn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  managed_api_id      = data.azurerm_managed_api.test.id
+  display_name        = "Office 365"
+}
+
+data "azurerm_api_connection_consent_links" "test" {
+  api_connection_id = azurerm_api_connection.test.id
+  redirect_url      = "https://portal.azure.com"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/connections/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -82,6 +83,36 @@ func resourceConnection() *pluginsdk.Resource {
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
+				ConflictsWith: []string{"parameter_value_set"},
+			},
+
+			"parameter_value_set": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				// the properties of an API Connection can't be patched, see `parameter_values` above
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:      pluginsdk.TypeMap,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+				ConflictsWith: []string{"parameter_values"},
 			},
 
 			"tags": commonschema.Tags(),
@@ -90,7 +121,8 @@ func resourceConnection() *pluginsdk.Resource {
 }
 
 func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Connections.ConnectionsClient
+	// the workaround client is used since the `parameterValueSet` isn't defined in the Swagger
+	client := meta.(*clients.Client).Connections.ConnectionsWorkaroundClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -112,14 +144,17 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	location := location.Normalize(managedAppId.LocationName)
 	parameterValues := expandConnectionParameterValues(d.Get("parameter_values").(map[string]interface{}))
-	model := connections.ApiConnectionDefinition{
+	model := azuresdkhacks.ApiConnectionDefinition{
 		Location: utils.String(location),
-		Properties: &connections.ApiConnectionDefinitionProperties{
-			Api: &connections.ApiReference{
-				Id: utils.String(managedAppId.ID()),
+		Properties: &azuresdkhacks.ApiConnectionDefinitionProperties{
+			ApiConnectionDefinitionProperties: connections.ApiConnectionDefinitionProperties{
+				Api: &connections.ApiReference{
+					Id: utils.String(managedAppId.ID()),
+				},
+				DisplayName:     utils.String(d.Get("display_name").(string)),
+				ParameterValues: parameterValues,
 			},
-			DisplayName:     utils.String(d.Get("display_name").(string)),
-			ParameterValues: parameterValues,
+			ParameterValueSet: expandConnectionParameterValueSet(d.Get("parameter_value_set").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -136,7 +171,7 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Connections.ConnectionsClient
+	client := meta.(*clients.Client).Connections.ConnectionsWorkaroundClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,6 +206,11 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("parameter_values", parameterValues); err != nil {
 				return fmt.Errorf("setting `parameter_values`: %+v", err)
 			}
+
+			parameterValueSet := flattenConnectionParameterValueSet(props.ParameterValueSet, d.Get("parameter_value_set").([]interface{}))
+			if err := d.Set("parameter_value_set", parameterValueSet); err != nil {
+				return fmt.Errorf("setting `parameter_value_set`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -242,3 +282,51 @@ func flattenConnectionParameterValues(input *map[string]string) map[string]inter
 	}
 	return parameterValues
 }
+
+func expandConnectionParameterValueSet(input []interface{}) *azuresdkhacks.ParameterValueSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	values := make(map[string]azuresdkhacks.ParameterValueSetValue)
+	for k, v := range raw["values"].(map[string]interface{}) {
+		values[k] = azuresdkhacks.ParameterValueSetValue{
+			Value: v.(string),
+		}
+	}
+
+	return &azuresdkhacks.ParameterValueSet{
+		Name:   raw["name"].(string),
+		Values: values,
+	}
+}
+
+func flattenConnectionParameterValueSet(input *azuresdkhacks.ParameterValueSet, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the values can contain secrets (for example a Client Secret) which aren't returned by the API, so these are
+	// taken from the existing state where possible
+	values := make(map[string]interface{})
+	for k, v := range input.Values {
+		if v.Value != "" {
+			values[k] = v.Value
+		}
+	}
+	if len(existing) > 0 && existing[0] != nil {
+		if raw, ok := existing[0].(map[string]interface{})["values"].(map[string]interface{}); ok {
+			for k, v := range raw {
+				values[k] = v
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":   input.Name,
+			"values": values,
+		},
+	}
+}
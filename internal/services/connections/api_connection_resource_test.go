@@ -67,6 +67,21 @@ func TestAccApiConnection_complete(t *testing.T) {
 	})
 }
 
+func TestAccApiConnection_parameterValueSet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_connection", "test")
+	r := ApiConnectionTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parameterValueSet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter_value_set.0.name").HasValue("managedIdentityAuth"),
+			),
+		},
+		data.ImportStep("parameter_value_set.0.values"),
+	})
+}
+
 func (t ApiConnectionTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := connections.ParseConnectionID(state.ID)
 	if err != nil {
@@ -176,6 +191,32 @@ resource "azurerm_api_connection" "test" {
 `, template, data.RandomInteger)
 }
 
+func (t ApiConnectionTestResource) parameterValueSet(data acceptance.TestData) string {
+	template := t.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_api_connection" "test" {
+  name                = "acctestconn-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  managed_api_id      = data.azurerm_managed_api.test.id
+  display_name        = "Managed Identity"
+
+  parameter_value_set {
+    name = "managedIdentityAuth"
+
+    values = {
+      namespaceEndpoint = "sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net/"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (ApiConnectionTestResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,32 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this could be removed
+// workaround for the `parameterValueSet` property of an API Connection, which is required to use some managed APIs
+// (for example those using an OAuth connection or a Managed Identity) but isn't defined in the 2016-06-01 Swagger.
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2016-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/connections/%s", defaultApiVersion)
+}
+
+type ConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewConnectionsClientWithBaseURI(endpoint string) ConnectionsClient {
+	return ConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
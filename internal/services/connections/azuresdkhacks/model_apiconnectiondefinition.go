@@ -0,0 +1,33 @@
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ApiConnectionDefinition struct {
+	Etag       *string                            `json:"etag,omitempty"`
+	Id         *string                            `json:"id,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *ApiConnectionDefinitionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
+
+type ApiConnectionDefinitionProperties struct {
+	connections.ApiConnectionDefinitionProperties
+
+	ParameterValueSet *ParameterValueSet `json:"parameterValueSet,omitempty"`
+}
+
+type ParameterValueSet struct {
+	Name   string                            `json:"name"`
+	Values map[string]ParameterValueSetValue `json:"values"`
+}
+
+type ParameterValueSetValue struct {
+	Value string `json:"value"`
+}
@@ -4,22 +4,28 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/managedapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/connections/azuresdkhacks"
 )
 
 type Client struct {
-	ConnectionsClient *connections.ConnectionsClient
-	ManagedApisClient *managedapis.ManagedAPIsClient
+	ConnectionsClient           *connections.ConnectionsClient
+	ConnectionsWorkaroundClient *azuresdkhacks.ConnectionsClient
+	ManagedApisClient           *managedapis.ManagedAPIsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	connectionsClient := connections.NewConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&connectionsClient.Client, o.ResourceManagerAuthorizer)
 
+	connectionsWorkaroundClient := azuresdkhacks.NewConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&connectionsWorkaroundClient.Client, o.ResourceManagerAuthorizer)
+
 	managedApisClient := managedapis.NewManagedAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&managedApisClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ConnectionsClient: &connectionsClient,
-		ManagedApisClient: &managedApisClient,
+		ConnectionsClient:           &connectionsClient,
+		ConnectionsWorkaroundClient: &connectionsWorkaroundClient,
+		ManagedApisClient:           &managedApisClient,
 	}
 }
@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_managed_api": dataSourceManagedApi(),
+		"azurerm_api_connection_consent_links": dataSourceApiConnectionConsentLinks(),
+		"azurerm_managed_api":                  dataSourceManagedApi(),
 	}
 }
 
@@ -0,0 +1,29 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes a Container Apps API Version with Jobs
+// Container Apps Jobs were introduced in API Version `2023-05-01`, however the vendored SDK only includes `2022-03-01`
+// so the client for the `jobs` endpoint is defined here. The models embed those from `2022-03-01` where these match.
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/jobs/%s", defaultApiVersion)
+}
+
+type JobsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobsClientWithBaseURI(endpoint string) JobsClient {
+	return JobsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
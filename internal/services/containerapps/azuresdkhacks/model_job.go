@@ -0,0 +1,83 @@
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
+)
+
+type TriggerType string
+
+const (
+	TriggerTypeEvent    TriggerType = "Event"
+	TriggerTypeManual   TriggerType = "Manual"
+	TriggerTypeSchedule TriggerType = "Schedule"
+)
+
+type Job struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *JobProperties                           `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                   `json:"systemData,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type JobProperties struct {
+	Configuration       *JobConfiguration `json:"configuration,omitempty"`
+	EnvironmentId       *string           `json:"environmentId,omitempty"`
+	EventStreamEndpoint *string           `json:"eventStreamEndpoint,omitempty"`
+	OutboundIPAddresses *[]string         `json:"outboundIpAddresses,omitempty"`
+	ProvisioningState   *string           `json:"provisioningState,omitempty"`
+	Template            *JobTemplate      `json:"template,omitempty"`
+	WorkloadProfileName *string           `json:"workloadProfileName,omitempty"`
+}
+
+type JobConfiguration struct {
+	EventTriggerConfig    *JobConfigurationEventTriggerConfig    `json:"eventTriggerConfig,omitempty"`
+	ManualTriggerConfig   *JobConfigurationManualTriggerConfig   `json:"manualTriggerConfig,omitempty"`
+	Registries            *[]containerapps.RegistryCredentials   `json:"registries,omitempty"`
+	ReplicaRetryLimit     *int64                                 `json:"replicaRetryLimit,omitempty"`
+	ReplicaTimeout        int64                                  `json:"replicaTimeout"`
+	ScheduleTriggerConfig *JobConfigurationScheduleTriggerConfig `json:"scheduleTriggerConfig,omitempty"`
+	Secrets               *[]containerapps.Secret                `json:"secrets,omitempty"`
+	TriggerType           TriggerType                            `json:"triggerType"`
+}
+
+type JobConfigurationEventTriggerConfig struct {
+	Parallelism            *int64    `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64    `json:"replicaCompletionCount,omitempty"`
+	Scale                  *JobScale `json:"scale,omitempty"`
+}
+
+type JobConfigurationManualTriggerConfig struct {
+	Parallelism            *int64 `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64 `json:"replicaCompletionCount,omitempty"`
+}
+
+type JobConfigurationScheduleTriggerConfig struct {
+	CronExpression         string `json:"cronExpression"`
+	Parallelism            *int64 `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64 `json:"replicaCompletionCount,omitempty"`
+}
+
+type JobScale struct {
+	MaxExecutions   *int64          `json:"maxExecutions,omitempty"`
+	MinExecutions   *int64          `json:"minExecutions,omitempty"`
+	PollingInterval *int64          `json:"pollingInterval,omitempty"`
+	Rules           *[]JobScaleRule `json:"rules,omitempty"`
+}
+
+type JobScaleRule struct {
+	Auth     *[]containerapps.ScaleRuleAuth `json:"auth,omitempty"`
+	Metadata *map[string]string             `json:"metadata,omitempty"`
+	Name     *string                        `json:"name,omitempty"`
+	Type     *string                        `json:"type,omitempty"`
+}
+
+type JobTemplate struct {
+	Containers *[]containerapps.Container `json:"containers,omitempty"`
+	Volumes    *[]containerapps.Volume    `json:"volumes,omitempty"`
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
 )
 
 type Client struct {
@@ -15,6 +16,7 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	JobsClient                 *azuresdkhacks.JobsClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 }
@@ -38,11 +40,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	daprComponentClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&daprComponentClient.Client, o.ResourceManagerAuthorizer)
 
+	jobsClient := azuresdkhacks.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CertificatesClient:         &certificatesClient,
 		ContainerAppClient:         &containerAppsClient,
 		ContainerAppRevisionClient: &containerAppsRevisionsClient,
 		DaprComponentsClient:       &daprComponentClient,
+		JobsClient:                 &jobsClient,
 		ManagedEnvironmentClient:   &managedEnvironmentClient,
 		StorageClient:              &managedEnvironmentStoragesClient,
 	}
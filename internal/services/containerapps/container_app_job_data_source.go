@@ -0,0 +1,306 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppJobDataSource struct{}
+
+type ContainerAppJobDataSourceModel struct {
+	Name          string `tfschema:"name"`
+	ResourceGroup string `tfschema:"resource_group_name"`
+
+	Location                string                                     `tfschema:"location"`
+	ManagedEnvironmentId    string                                     `tfschema:"container_app_environment_id"`
+	ReplicaTimeoutInSeconds int                                        `tfschema:"replica_timeout_in_seconds"`
+	ReplicaRetryLimit       int                                        `tfschema:"replica_retry_limit"`
+	ManualTriggerConfig     []helpers.JobManualTriggerConfig           `tfschema:"manual_trigger_config"`
+	ScheduleTriggerConfig   []helpers.JobScheduleTriggerConfig         `tfschema:"schedule_trigger_config"`
+	EventTriggerConfig      []helpers.JobEventTriggerConfig            `tfschema:"event_trigger_config"`
+	Registries              []helpers.Registry                         `tfschema:"registry"`
+	Secrets                 []helpers.Secret                           `tfschema:"secret"`
+	Template                []ContainerAppJobDataSourceTemplateModel   `tfschema:"template"`
+	Identity                []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags                    map[string]interface{}                     `tfschema:"tags"`
+	OutboundIpAddresses     []string                                   `tfschema:"outbound_ip_addresses"`
+	EventStreamEndpoint     string                                     `tfschema:"event_stream_endpoint"`
+}
+
+type ContainerAppJobDataSourceTemplateModel struct {
+	Containers []ContainerAppJobDataSourceContainerModel `tfschema:"container"`
+}
+
+type ContainerAppJobDataSourceContainerModel struct {
+	Name    string   `tfschema:"name"`
+	Image   string   `tfschema:"image"`
+	CPU     float64  `tfschema:"cpu"`
+	Memory  string   `tfschema:"memory"`
+	Args    []string `tfschema:"args"`
+	Command []string `tfschema:"command"`
+}
+
+var _ sdk.DataSource = ContainerAppJobDataSource{}
+
+func (r ContainerAppJobDataSource) ModelObject() interface{} {
+	return &ContainerAppJobDataSourceModel{}
+}
+
+func (r ContainerAppJobDataSource) ResourceType() string {
+	return "azurerm_container_app_job"
+}
+
+func (r ContainerAppJobDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name of the Container App Job.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+	}
+}
+
+func (r ContainerAppJobDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"container_app_environment_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The ID of the Container App Environment this Container App Job is hosted in.",
+		},
+
+		"replica_timeout_in_seconds": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The maximum number of seconds a replica is allowed to run.",
+		},
+
+		"replica_retry_limit": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The maximum number of times a replica is allowed to retry.",
+		},
+
+		"manual_trigger_config": helpers.ContainerAppJobManualTriggerConfigDataSourceSchema(),
+
+		"schedule_trigger_config": helpers.ContainerAppJobScheduleTriggerConfigDataSourceSchema(),
+
+		"event_trigger_config": helpers.ContainerAppJobEventTriggerConfigDataSourceSchema(),
+
+		"registry": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"username": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"password_secret_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"identity": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"secret": helpers.SecretsDataSourceSchema(),
+
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"image": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"cpu": {
+									Type:     pluginsdk.TypeFloat,
+									Computed: true,
+								},
+
+								"memory": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"args": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"command": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemOrUserAssignedIdentityComputed(),
+
+		"tags": commonschema.TagsDataSource(),
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"event_stream_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint for the Container App Job event stream.",
+		},
+	}
+}
+
+func (r ContainerAppJobDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var job ContainerAppJobDataSourceModel
+			if err := metadata.Decode(&job); err != nil {
+				return err
+			}
+
+			id := parse.NewContainerAppJobID(subscriptionId, job.ResourceGroup, job.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			if model := existing.Model; model != nil {
+				job.Location = location.Normalize(model.Location)
+				job.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					job.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.EnvironmentId))
+					if err != nil {
+						return err
+					}
+					job.ManagedEnvironmentId = envId.ID()
+					job.Template = flattenContainerAppJobDataSourceTemplate(props.Template)
+					job.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
+					job.EventStreamEndpoint = pointer.From(props.EventStreamEndpoint)
+
+					if config := props.Configuration; config != nil {
+						job.ReplicaTimeoutInSeconds = int(config.ReplicaTimeout)
+						job.ReplicaRetryLimit = int(pointer.From(config.ReplicaRetryLimit))
+						job.ManualTriggerConfig = helpers.FlattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+						job.ScheduleTriggerConfig = helpers.FlattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+						job.EventTriggerConfig = helpers.FlattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+						job.Registries = helpers.FlattenContainerAppRegistries(config.Registries)
+					}
+				}
+			}
+
+			secretsResp, err := client.ListSecrets(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving secrets for %s: %+v", id, err)
+			}
+			job.Secrets = helpers.FlattenContainerAppSecrets(secretsResp.Model)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&job)
+		},
+	}
+}
+
+func flattenContainerAppJobDataSourceTemplate(input *azuresdkhacks.JobTemplate) []ContainerAppJobDataSourceTemplateModel {
+	if input == nil {
+		return []ContainerAppJobDataSourceTemplateModel{}
+	}
+
+	containers := make([]ContainerAppJobDataSourceContainerModel, 0)
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			container := ContainerAppJobDataSourceContainerModel{
+				Name:    pointer.From(v.Name),
+				Image:   pointer.From(v.Image),
+				Args:    pointer.From(v.Args),
+				Command: pointer.From(v.Command),
+			}
+			if resources := v.Resources; resources != nil {
+				container.CPU = pointer.From(resources.Cpu)
+				container.Memory = pointer.From(resources.Memory)
+			}
+			containers = append(containers, container)
+		}
+	}
+
+	return []ContainerAppJobDataSourceTemplateModel{
+		{
+			Containers: containers,
+		},
+	}
+}
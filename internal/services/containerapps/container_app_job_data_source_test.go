@@ -0,0 +1,40 @@
+package containerapps_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerAppJobDataSource struct{}
+
+func TestAccContainerAppJobDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_container_app_job", "test")
+	r := ContainerAppJobDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").IsSet(),
+				check.That(data.ResourceName).Key("container_app_environment_id").IsSet(),
+				check.That(data.ResourceName).Key("replica_timeout_in_seconds").HasValue("20"),
+				check.That(data.ResourceName).Key("manual_trigger_config.0.parallelism").HasValue("3"),
+				check.That(data.ResourceName).Key("template.0.container.0.name").HasValue("testcontainerappsjob0"),
+			),
+		},
+	})
+}
+
+func (d ContainerAppJobDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_app_job" "test" {
+  name                = azurerm_container_app_job.test.name
+  resource_group_name = azurerm_container_app_job.test.resource_group_name
+}
+`, ContainerAppJobResource{}.complete(data))
+}
@@ -0,0 +1,398 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppJobResource struct{}
+
+type ContainerAppJobModel struct {
+	Name                 string `tfschema:"name"`
+	ResourceGroup        string `tfschema:"resource_group_name"`
+	ManagedEnvironmentId string `tfschema:"container_app_environment_id"`
+	Location             string `tfschema:"location"`
+
+	ReplicaTimeoutInSeconds int                                `tfschema:"replica_timeout_in_seconds"`
+	ReplicaRetryLimit       int                                `tfschema:"replica_retry_limit"`
+	ManualTriggerConfig     []helpers.JobManualTriggerConfig   `tfschema:"manual_trigger_config"`
+	ScheduleTriggerConfig   []helpers.JobScheduleTriggerConfig `tfschema:"schedule_trigger_config"`
+	EventTriggerConfig      []helpers.JobEventTriggerConfig    `tfschema:"event_trigger_config"`
+	Registries              []helpers.Registry                 `tfschema:"registry"`
+	Secrets                 []helpers.Secret                   `tfschema:"secret"`
+	Template                []helpers.JobTemplate              `tfschema:"template"`
+
+	Identity []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+
+	Tags map[string]interface{} `tfschema:"tags"`
+
+	OutboundIpAddresses []string `tfschema:"outbound_ip_addresses"`
+	EventStreamEndpoint string   `tfschema:"event_stream_endpoint"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppJobResource{}
+
+func (r ContainerAppJobResource) ModelObject() interface{} {
+	return &ContainerAppJobModel{}
+}
+
+func (r ContainerAppJobResource) ResourceType() string {
+	return "azurerm_container_app_job"
+}
+
+func (r ContainerAppJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerAppJobID
+}
+
+func (r ContainerAppJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name for this Container App Job.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to host this Container App Job.",
+		},
+
+		"replica_timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of seconds a replica is allowed to run.",
+		},
+
+		"replica_retry_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of times a replica is allowed to retry.",
+		},
+
+		"manual_trigger_config": helpers.ContainerAppJobManualTriggerConfigSchema(),
+
+		"schedule_trigger_config": helpers.ContainerAppJobScheduleTriggerConfigSchema(),
+
+		"event_trigger_config": helpers.ContainerAppJobEventTriggerConfigSchema(),
+
+		"template": helpers.ContainerAppJobTemplateSchema(),
+
+		"registry": helpers.ContainerAppRegistrySchema(),
+
+		"secret": helpers.SecretsSchema(),
+
+		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"event_stream_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint for the Container App Job event stream.",
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+			environmentClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var job ContainerAppJobModel
+			if err := metadata.Decode(&job); err != nil {
+				return err
+			}
+
+			id := parse.NewContainerAppJobID(subscriptionId, job.ResourceGroup, job.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(job.ManagedEnvironmentId)
+			if err != nil {
+				return fmt.Errorf("parsing Container App Environment ID for %s: %+v", id, err)
+			}
+
+			env, err := environmentClient.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+
+			registries, err := helpers.ExpandContainerAppRegistries(job.Registries)
+			if err != nil {
+				return fmt.Errorf("invalid registry config for %s: %+v", id, err)
+			}
+
+			configuration := expandContainerAppJobTriggerConfiguration(job)
+			configuration.Registries = registries
+			configuration.Secrets = helpers.ExpandContainerSecrets(job.Secrets)
+
+			payload := azuresdkhacks.Job{
+				Location: location.Normalize(env.Model.Location),
+				Properties: &azuresdkhacks.JobProperties{
+					Configuration: configuration,
+					EnvironmentId: pointer.To(job.ManagedEnvironmentId),
+					Template:      helpers.ExpandContainerAppJobTemplate(job.Template),
+				},
+				Tags: tags.Expand(job.Tags),
+			}
+
+			ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(job.Identity)
+			if err != nil {
+				return err
+			}
+			payload.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := parse.ContainerAppJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state := ContainerAppJobModel{
+				Name:          id.JobName,
+				ResourceGroup: id.ResourceGroup,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					state.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.EnvironmentId))
+					if err != nil {
+						return err
+					}
+					state.ManagedEnvironmentId = envId.ID()
+					state.Template = helpers.FlattenContainerAppJobTemplate(props.Template)
+
+					if config := props.Configuration; config != nil {
+						state.ReplicaTimeoutInSeconds = int(config.ReplicaTimeout)
+						state.ReplicaRetryLimit = int(pointer.From(config.ReplicaRetryLimit))
+						state.ManualTriggerConfig = helpers.FlattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+						state.ScheduleTriggerConfig = helpers.FlattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+						state.EventTriggerConfig = helpers.FlattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+						state.Registries = helpers.FlattenContainerAppRegistries(config.Registries)
+					}
+
+					state.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
+					state.EventStreamEndpoint = pointer.From(props.EventStreamEndpoint)
+				}
+			}
+
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
+			}
+
+			state.Secrets = helpers.FlattenContainerAppSecrets(secretsResp.Model)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := parse.ContainerAppJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerAppJobModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			model := existing.Model
+			if model == nil || model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			if model.Properties.Configuration == nil {
+				model.Properties.Configuration = &azuresdkhacks.JobConfiguration{}
+			}
+
+			// the secret values are not returned from the Get, so these need retrieving from the list API or they'd be removed
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil || secretsResp.Model == nil {
+				if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+					return fmt.Errorf("retrieving secrets for update for %s: %+v", *id, err)
+				}
+			}
+			model.Properties.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+			if metadata.ResourceData.HasChanges("replica_timeout_in_seconds", "replica_retry_limit", "manual_trigger_config", "schedule_trigger_config", "event_trigger_config") {
+				configuration := expandContainerAppJobTriggerConfiguration(state)
+				configuration.Registries = model.Properties.Configuration.Registries
+				configuration.Secrets = model.Properties.Configuration.Secrets
+				model.Properties.Configuration = configuration
+			}
+
+			if metadata.ResourceData.HasChange("registry") {
+				model.Properties.Configuration.Registries, err = helpers.ExpandContainerAppRegistries(state.Registries)
+				if err != nil {
+					return fmt.Errorf("invalid registry config for %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("secret") {
+				model.Properties.Configuration.Secrets = helpers.ExpandContainerSecrets(state.Secrets)
+			}
+
+			if metadata.ResourceData.HasChange("template") {
+				model.Properties.Template = helpers.ExpandContainerAppJobTemplate(state.Template)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(state.Identity)
+				if err != nil {
+					return err
+				}
+				model.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				model.Tags = tags.Expand(state.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := parse.ContainerAppJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppJobTriggerConfiguration(input ContainerAppJobModel) *azuresdkhacks.JobConfiguration {
+	configuration := &azuresdkhacks.JobConfiguration{
+		ReplicaRetryLimit: pointer.To(int64(input.ReplicaRetryLimit)),
+		ReplicaTimeout:    int64(input.ReplicaTimeoutInSeconds),
+	}
+
+	switch {
+	case len(input.ManualTriggerConfig) > 0:
+		configuration.TriggerType = azuresdkhacks.TriggerTypeManual
+		configuration.ManualTriggerConfig = helpers.ExpandContainerAppJobManualTriggerConfig(input.ManualTriggerConfig)
+	case len(input.ScheduleTriggerConfig) > 0:
+		configuration.TriggerType = azuresdkhacks.TriggerTypeSchedule
+		configuration.ScheduleTriggerConfig = helpers.ExpandContainerAppJobScheduleTriggerConfig(input.ScheduleTriggerConfig)
+	case len(input.EventTriggerConfig) > 0:
+		configuration.TriggerType = azuresdkhacks.TriggerTypeEvent
+		configuration.EventTriggerConfig = helpers.ExpandContainerAppJobEventTriggerConfig(input.EventTriggerConfig)
+	}
+
+	return configuration
+}
@@ -0,0 +1,361 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppJobResource struct{}
+
+func TestAccContainerAppJobResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("location").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJobResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppJobResource_scheduleTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJobResource_eventTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eventTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func TestAccContainerAppJobResource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJobResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerAppJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.JobsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 10
+
+  manual_trigger_config {}
+
+  template {
+    container {
+      name   = "testcontainerappsjob0"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "import" {
+  name                         = azurerm_container_app_job.test.name
+  resource_group_name          = azurerm_container_app_job.test.resource_group_name
+  container_app_environment_id = azurerm_container_app_job.test.container_app_environment_id
+  replica_timeout_in_seconds   = azurerm_container_app_job.test.replica_timeout_in_seconds
+
+  manual_trigger_config {}
+
+  template {
+    container {
+      name   = azurerm_container_app_job.test.template.0.container.0.name
+      image  = azurerm_container_app_job.test.template.0.container.0.image
+      cpu    = azurerm_container_app_job.test.template.0.container.0.cpu
+      memory = azurerm_container_app_job.test.template.0.container.0.memory
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppJobResource) scheduleTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 10
+  replica_retry_limit          = 1
+
+  schedule_trigger_config {
+    cron_expression          = "*/5 * * * *"
+    parallelism              = 2
+    replica_completion_count = 1
+  }
+
+  template {
+    container {
+      name    = "testcontainerappsjob0"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/sh", "-c", "echo hello"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) eventTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "acctestqueue"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 10
+
+  secret {
+    name  = "queue-connection-string"
+    value = azurerm_storage_account.test.primary_connection_string
+  }
+
+  event_trigger_config {
+    parallelism              = 1
+    replica_completion_count = 1
+
+    scale {
+      min_executions              = 0
+      max_executions              = 10
+      polling_interval_in_seconds = 60
+
+      rules {
+        name             = "azure-queue"
+        custom_rule_type = "azure-queue"
+        metadata = {
+          accountName = azurerm_storage_account.test.name
+          queueName   = azurerm_storage_queue.test.name
+          queueLength = "1"
+        }
+
+        authentication {
+          secret_name       = "queue-connection-string"
+          trigger_parameter = "connection"
+        }
+      }
+    }
+  }
+
+  template {
+    container {
+      name   = "testcontainerappsjob0"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+
+      env {
+        name        = "AZURE_STORAGE_CONNECTION_STRING"
+        secret_name = "queue-connection-string"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ContainerAppJobResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acct-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 20
+  replica_retry_limit          = 3
+
+  manual_trigger_config {
+    parallelism              = 3
+    replica_completion_count = 2
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  secret {
+    name  = "rick"
+    value = "morty"
+  }
+
+  template {
+    container {
+      name   = "testcontainerappsjob0"
+      image  = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu    = 0.5
+      memory = "1Gi"
+      args   = ["--verbose"]
+
+      env {
+        name        = "SECRET_VALUE"
+        secret_name = "rick"
+      }
+
+      env {
+        name  = "PLAIN_VALUE"
+        value = "summer"
+      }
+
+      volume_mounts {
+        name = "scratch"
+        path = "/tmp/scratch"
+      }
+    }
+
+    volume {
+      name         = "scratch"
+      storage_type = "EmptyDir"
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ContainerAppJobResource) template(data acceptance.TestData) string {
+	return ContainerAppEnvironmentResource{}.basic(data)
+}
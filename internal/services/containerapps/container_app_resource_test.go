@@ -233,6 +233,38 @@ func TestAccContainerAppResource_secretRemoveWithAddShouldFail(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppResource_scaleRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app", "test")
+	r := ContainerAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scaleRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template.0.azure_queue_scale_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("template.0.custom_scale_rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("template.0.http_scale_rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ContainerAppResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := containerapps.ParseContainerAppID(state.ID)
 	if err != nil {
@@ -272,6 +304,70 @@ resource "azurerm_container_app" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ContainerAppResource) scaleRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  secret {
+    name  = "queue-connection-string"
+    value = "DefaultEndpointsProtocol=https;AccountName=acctest;AccountKey=dGVzdA==;EndpointSuffix=core.windows.net"
+  }
+
+  secret {
+    name  = "kafka-password"
+    value = "Sup3rS3cr3t!"
+  }
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    azure_queue_scale_rule {
+      name         = "azq-1"
+      queue_name   = "foo"
+      queue_length = 10
+
+      authentication {
+        secret_name       = "queue-connection-string"
+        trigger_parameter = "connection"
+      }
+    }
+
+    custom_scale_rule {
+      name             = "kafka-1"
+      custom_rule_type = "kafka"
+      metadata = {
+        bootstrapServers = "kafka.example.com:9092"
+        consumerGroup    = "acctest"
+        topic            = "acctest"
+        lagThreshold     = "50"
+      }
+
+      authentication {
+        secret_name       = "kafka-password"
+        trigger_parameter = "password"
+      }
+    }
+
+    http_scale_rule {
+      name                = "http-1"
+      concurrent_requests = "100"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ContainerAppResource) withSystemIdentity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
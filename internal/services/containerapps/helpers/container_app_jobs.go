@@ -0,0 +1,478 @@
+package helpers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type JobTemplate struct {
+	Containers []Container       `tfschema:"container"`
+	Volumes    []ContainerVolume `tfschema:"volume"`
+}
+
+func ContainerAppJobTemplateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		MaxItems: 1,
+		Required: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": ContainerAppContainerSchema(),
+
+				"volume": ContainerVolumeSchema(),
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobTemplate(input []JobTemplate) *azuresdkhacks.JobTemplate {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	return &azuresdkhacks.JobTemplate{
+		Containers: expandContainerAppContainers(config.Containers),
+		Volumes:    expandContainerAppVolumes(config.Volumes),
+	}
+}
+
+func FlattenContainerAppJobTemplate(input *azuresdkhacks.JobTemplate) []JobTemplate {
+	if input == nil {
+		return []JobTemplate{}
+	}
+
+	return []JobTemplate{
+		{
+			Containers: flattenContainerAppContainers(input.Containers),
+			Volumes:    flattenContainerAppVolumes(input.Volumes),
+		},
+	}
+}
+
+type JobManualTriggerConfig struct {
+	Parallelism            int `tfschema:"parallelism"`
+	ReplicaCompletionCount int `tfschema:"replica_completion_count"`
+}
+
+type JobScheduleTriggerConfig struct {
+	CronExpression         string `tfschema:"cron_expression"`
+	Parallelism            int    `tfschema:"parallelism"`
+	ReplicaCompletionCount int    `tfschema:"replica_completion_count"`
+}
+
+type JobEventTriggerConfig struct {
+	Parallelism            int        `tfschema:"parallelism"`
+	ReplicaCompletionCount int        `tfschema:"replica_completion_count"`
+	Scale                  []JobScale `tfschema:"scale"`
+}
+
+type JobScale struct {
+	MinExecutions            int            `tfschema:"min_executions"`
+	MaxExecutions            int            `tfschema:"max_executions"`
+	PollingIntervalInSeconds int            `tfschema:"polling_interval_in_seconds"`
+	Rules                    []JobScaleRule `tfschema:"rules"`
+}
+
+type JobScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	CustomRuleType  string                    `tfschema:"custom_rule_type"`
+	Metadata        map[string]string         `tfschema:"metadata"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+func jobParallelismSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The number of parallel replicas of a Job that can run at a given time.",
+	}
+}
+
+func jobReplicaCompletionCountSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The minimum number of successful replica completions before the overall Job completion.",
+	}
+}
+
+func ContainerAppJobManualTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": jobParallelismSchema(),
+
+				"replica_completion_count": jobReplicaCompletionCountSchema(),
+			},
+		},
+	}
+}
+
+func ContainerAppJobScheduleTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cron_expression": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Cron formatted repeating schedule of the Job, for example `*/1 * * * *`.",
+				},
+
+				"parallelism": jobParallelismSchema(),
+
+				"replica_completion_count": jobReplicaCompletionCountSchema(),
+			},
+		},
+	}
+}
+
+func ContainerAppJobEventTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": jobParallelismSchema(),
+
+				"replica_completion_count": jobReplicaCompletionCountSchema(),
+
+				"scale": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"min_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The minimum number of Job executions to create for each polling interval.",
+							},
+
+							"max_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      100,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of Job executions to create for each polling interval.",
+							},
+
+							"polling_interval_in_seconds": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The interval in seconds at which each event source is checked.",
+							},
+
+							"rules": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+											Description:  "The name of the Scaling Rule.",
+										},
+
+										"custom_rule_type": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+											Description:  "The KEDA scaler type, for example `azure-servicebus` or `azure-queue`. See https://keda.sh/docs/scalers/ for the supported types.",
+										},
+
+										"metadata": {
+											Type:     pluginsdk.TypeMap,
+											Required: true,
+											Elem: &pluginsdk.Schema{
+												Type: pluginsdk.TypeString,
+											},
+											Description: "A map of string key-value pairs to configure the KEDA scaler.",
+										},
+
+										"authentication": scaleRuleAuthenticationSchema(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobManualTriggerConfigDataSourceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobScheduleTriggerConfigDataSourceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cron_expression": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobEventTriggerConfigDataSourceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"scale": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"min_executions": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"max_executions": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"polling_interval_in_seconds": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"rules": {
+								Type:     pluginsdk.TypeList,
+								Computed: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"custom_rule_type": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"metadata": {
+											Type:     pluginsdk.TypeMap,
+											Computed: true,
+											Elem: &pluginsdk.Schema{
+												Type: pluginsdk.TypeString,
+											},
+										},
+
+										"authentication": {
+											Type:     pluginsdk.TypeList,
+											Computed: true,
+											Elem: &pluginsdk.Resource{
+												Schema: map[string]*pluginsdk.Schema{
+													"secret_name": {
+														Type:     pluginsdk.TypeString,
+														Computed: true,
+													},
+
+													"trigger_parameter": {
+														Type:     pluginsdk.TypeString,
+														Computed: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobManualTriggerConfig(input []JobManualTriggerConfig) *azuresdkhacks.JobConfigurationManualTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &azuresdkhacks.JobConfigurationManualTriggerConfig{
+		Parallelism:            pointer.To(int64(input[0].Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(input[0].ReplicaCompletionCount)),
+	}
+}
+
+func FlattenContainerAppJobManualTriggerConfig(input *azuresdkhacks.JobConfigurationManualTriggerConfig) []JobManualTriggerConfig {
+	if input == nil {
+		return []JobManualTriggerConfig{}
+	}
+
+	return []JobManualTriggerConfig{
+		{
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+func ExpandContainerAppJobScheduleTriggerConfig(input []JobScheduleTriggerConfig) *azuresdkhacks.JobConfigurationScheduleTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &azuresdkhacks.JobConfigurationScheduleTriggerConfig{
+		CronExpression:         input[0].CronExpression,
+		Parallelism:            pointer.To(int64(input[0].Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(input[0].ReplicaCompletionCount)),
+	}
+}
+
+func FlattenContainerAppJobScheduleTriggerConfig(input *azuresdkhacks.JobConfigurationScheduleTriggerConfig) []JobScheduleTriggerConfig {
+	if input == nil {
+		return []JobScheduleTriggerConfig{}
+	}
+
+	return []JobScheduleTriggerConfig{
+		{
+			CronExpression:         input.CronExpression,
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+func ExpandContainerAppJobEventTriggerConfig(input []JobEventTriggerConfig) *azuresdkhacks.JobConfigurationEventTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	config := input[0]
+	result := &azuresdkhacks.JobConfigurationEventTriggerConfig{
+		Parallelism:            pointer.To(int64(config.Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(config.ReplicaCompletionCount)),
+	}
+
+	if len(config.Scale) == 1 {
+		scale := config.Scale[0]
+		rules := make([]azuresdkhacks.JobScaleRule, 0)
+		for _, v := range scale.Rules {
+			rules = append(rules, azuresdkhacks.JobScaleRule{
+				Name:     pointer.To(v.Name),
+				Type:     pointer.To(v.CustomRuleType),
+				Metadata: pointer.To(v.Metadata),
+				Auth:     expandContainerAppScaleRuleAuthentications(v.Authentications),
+			})
+		}
+
+		result.Scale = &azuresdkhacks.JobScale{
+			MinExecutions:   pointer.To(int64(scale.MinExecutions)),
+			MaxExecutions:   pointer.To(int64(scale.MaxExecutions)),
+			PollingInterval: pointer.To(int64(scale.PollingIntervalInSeconds)),
+			Rules:           &rules,
+		}
+	}
+
+	return result
+}
+
+func FlattenContainerAppJobEventTriggerConfig(input *azuresdkhacks.JobConfigurationEventTriggerConfig) []JobEventTriggerConfig {
+	if input == nil {
+		return []JobEventTriggerConfig{}
+	}
+
+	result := JobEventTriggerConfig{
+		Parallelism:            int(pointer.From(input.Parallelism)),
+		ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		Scale:                  []JobScale{},
+	}
+
+	if scale := input.Scale; scale != nil {
+		rules := make([]JobScaleRule, 0)
+		if scale.Rules != nil {
+			for _, v := range *scale.Rules {
+				rules = append(rules, JobScaleRule{
+					Name:            pointer.From(v.Name),
+					CustomRuleType:  pointer.From(v.Type),
+					Metadata:        pointer.From(v.Metadata),
+					Authentications: flattenContainerAppScaleRuleAuthentications(v.Auth),
+				})
+			}
+		}
+
+		result.Scale = []JobScale{
+			{
+				MinExecutions:            int(pointer.From(scale.MinExecutions)),
+				MaxExecutions:            int(pointer.From(scale.MaxExecutions)),
+				PollingIntervalInSeconds: int(pointer.From(scale.PollingInterval)),
+				Rules:                    rules,
+			},
+		}
+	}
+
+	return []JobEventTriggerConfig{result}
+}
@@ -526,11 +526,14 @@ func ContainerAppEnvironmentDaprMetadataDataSourceSchema() *pluginsdk.Schema {
 }
 
 type ContainerTemplate struct {
-	Containers  []Container       `tfschema:"container"`
-	Suffix      string            `tfschema:"revision_suffix"`
-	MinReplicas int               `tfschema:"min_replicas"`
-	MaxReplicas int               `tfschema:"max_replicas"`
-	Volumes     []ContainerVolume `tfschema:"volume"`
+	Containers           []Container           `tfschema:"container"`
+	Suffix               string                `tfschema:"revision_suffix"`
+	MinReplicas          int                   `tfschema:"min_replicas"`
+	MaxReplicas          int                   `tfschema:"max_replicas"`
+	AzureQueueScaleRules []AzureQueueScaleRule `tfschema:"azure_queue_scale_rule"`
+	CustomScaleRules     []CustomScaleRule     `tfschema:"custom_scale_rule"`
+	HTTPScaleRules       []HTTPScaleRule       `tfschema:"http_scale_rule"`
+	Volumes              []ContainerVolume     `tfschema:"volume"`
 }
 
 func ContainerTemplateSchema() *pluginsdk.Schema {
@@ -558,6 +561,12 @@ func ContainerTemplateSchema() *pluginsdk.Schema {
 					Description:  "The maximum number of replicas for this container.",
 				},
 
+				"azure_queue_scale_rule": AzureQueueScaleRuleSchema(),
+
+				"custom_scale_rule": CustomScaleRuleSchema(),
+
+				"http_scale_rule": HTTPScaleRuleSchema(),
+
 				"volume": ContainerVolumeSchema(),
 
 				"revision_suffix": {
@@ -596,6 +605,13 @@ func ExpandContainerAppTemplate(input []ContainerTemplate, metadata sdk.Resource
 		template.Scale.MinReplicas = pointer.To(int64(config.MinReplicas))
 	}
 
+	if rules := expandContainerAppScaleRules(config); len(rules) != 0 {
+		if template.Scale == nil {
+			template.Scale = &containerapps.Scale{}
+		}
+		template.Scale.Rules = &rules
+	}
+
 	if config.Suffix != "" {
 		if metadata.ResourceData.HasChange("template.0.revision_suffix") {
 			template.RevisionSuffix = pointer.To(config.Suffix)
@@ -618,11 +634,276 @@ func FlattenContainerAppTemplate(input *containerapps.Template) []ContainerTempl
 	if scale := input.Scale; scale != nil {
 		result.MaxReplicas = int(pointer.From(scale.MaxReplicas))
 		result.MinReplicas = int(pointer.From(scale.MinReplicas))
+		result.AzureQueueScaleRules, result.CustomScaleRules, result.HTTPScaleRules = flattenContainerAppScaleRules(scale.Rules)
 	}
 
 	return []ContainerTemplate{result}
 }
 
+type ScaleRuleAuthentication struct {
+	SecretRef        string `tfschema:"secret_name"`
+	TriggerParameter string `tfschema:"trigger_parameter"`
+}
+
+type AzureQueueScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	QueueName       string                    `tfschema:"queue_name"`
+	QueueLength     int                       `tfschema:"queue_length"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type CustomScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	CustomRuleType  string                    `tfschema:"custom_rule_type"`
+	Metadata        map[string]string         `tfschema:"metadata"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type HTTPScaleRule struct {
+	Name               string                    `tfschema:"name"`
+	ConcurrentRequests string                    `tfschema:"concurrent_requests"`
+	Authentications    []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+func scaleRuleAuthenticationSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"secret_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+					Description:  "The name of the Container App Secret to use for this Scale Rule Authentication.",
+				},
+
+				"trigger_parameter": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Trigger Parameter name to use the supply the value retrieved from the `secret_name`.",
+				},
+			},
+		},
+	}
+}
+
+func AzureQueueScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"queue_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Azure Queue",
+				},
+
+				"queue_length": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The value of the length of the queue to trigger scaling actions.",
+				},
+
+				"authentication": scaleRuleAuthenticationSchema(true),
+			},
+		},
+	}
+}
+
+func CustomScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"custom_rule_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Custom rule type, for example `azure-servicebus` or `kafka`. See https://keda.sh/docs/scalers/ for the supported types.",
+				},
+
+				"metadata": {
+					Type:     pluginsdk.TypeMap,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "A map of string key-value pairs to configure the Custom Scale Rule.",
+				},
+
+				"authentication": scaleRuleAuthenticationSchema(false),
+			},
+		},
+	}
+}
+
+func HTTPScaleRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Scaling Rule",
+				},
+
+				"concurrent_requests": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.ContainerAppScaleRuleConcurrentRequests,
+					Description:  "The number of concurrent requests to trigger scaling.",
+				},
+
+				"authentication": scaleRuleAuthenticationSchema(false),
+			},
+		},
+	}
+}
+
+func expandContainerAppScaleRuleAuthentications(input []ScaleRuleAuthentication) *[]containerapps.ScaleRuleAuth {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]containerapps.ScaleRuleAuth, 0)
+	for _, v := range input {
+		result = append(result, containerapps.ScaleRuleAuth{
+			SecretRef:        pointer.To(v.SecretRef),
+			TriggerParameter: pointer.To(v.TriggerParameter),
+		})
+	}
+
+	return &result
+}
+
+func flattenContainerAppScaleRuleAuthentications(input *[]containerapps.ScaleRuleAuth) []ScaleRuleAuthentication {
+	result := make([]ScaleRuleAuthentication, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ScaleRuleAuthentication{
+			SecretRef:        pointer.From(v.SecretRef),
+			TriggerParameter: pointer.From(v.TriggerParameter),
+		})
+	}
+
+	return result
+}
+
+func expandContainerAppScaleRules(input ContainerTemplate) []containerapps.ScaleRule {
+	result := make([]containerapps.ScaleRule, 0)
+
+	for _, v := range input.AzureQueueScaleRules {
+		result = append(result, containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			AzureQueue: &containerapps.QueueScaleRule{
+				QueueName:   pointer.To(v.QueueName),
+				QueueLength: pointer.To(int64(v.QueueLength)),
+				Auth:        expandContainerAppScaleRuleAuthentications(v.Authentications),
+			},
+		})
+	}
+
+	for _, v := range input.CustomScaleRules {
+		result = append(result, containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			Custom: &containerapps.CustomScaleRule{
+				Type:     pointer.To(v.CustomRuleType),
+				Metadata: pointer.To(v.Metadata),
+				Auth:     expandContainerAppScaleRuleAuthentications(v.Authentications),
+			},
+		})
+	}
+
+	for _, v := range input.HTTPScaleRules {
+		result = append(result, containerapps.ScaleRule{
+			Name: pointer.To(v.Name),
+			HTTP: &containerapps.HTTPScaleRule{
+				Metadata: &map[string]string{
+					"concurrentRequests": v.ConcurrentRequests,
+				},
+				Auth: expandContainerAppScaleRuleAuthentications(v.Authentications),
+			},
+		})
+	}
+
+	return result
+}
+
+func flattenContainerAppScaleRules(input *[]containerapps.ScaleRule) ([]AzureQueueScaleRule, []CustomScaleRule, []HTTPScaleRule) {
+	azureQueueScaleRules := make([]AzureQueueScaleRule, 0)
+	customScaleRules := make([]CustomScaleRule, 0)
+	httpScaleRules := make([]HTTPScaleRule, 0)
+	if input == nil {
+		return azureQueueScaleRules, customScaleRules, httpScaleRules
+	}
+
+	for _, v := range *input {
+		name := pointer.From(v.Name)
+
+		if rule := v.AzureQueue; rule != nil {
+			azureQueueScaleRules = append(azureQueueScaleRules, AzureQueueScaleRule{
+				Name:            name,
+				QueueName:       pointer.From(rule.QueueName),
+				QueueLength:     int(pointer.From(rule.QueueLength)),
+				Authentications: flattenContainerAppScaleRuleAuthentications(rule.Auth),
+			})
+			continue
+		}
+
+		if rule := v.Custom; rule != nil {
+			customScaleRules = append(customScaleRules, CustomScaleRule{
+				Name:            name,
+				CustomRuleType:  pointer.From(rule.Type),
+				Metadata:        pointer.From(rule.Metadata),
+				Authentications: flattenContainerAppScaleRuleAuthentications(rule.Auth),
+			})
+			continue
+		}
+
+		if rule := v.HTTP; rule != nil {
+			concurrentRequests := ""
+			if rule.Metadata != nil {
+				concurrentRequests = (*rule.Metadata)["concurrentRequests"]
+			}
+			httpScaleRules = append(httpScaleRules, HTTPScaleRule{
+				Name:               name,
+				ConcurrentRequests: concurrentRequests,
+				Authentications:    flattenContainerAppScaleRuleAuthentications(rule.Auth),
+			})
+		}
+	}
+
+	return azureQueueScaleRules, customScaleRules, httpScaleRules
+}
+
 type Container struct {
 	Name             string                       `tfschema:"name"`
 	Image            string                       `tfschema:"image"`
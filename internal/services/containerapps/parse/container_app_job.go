@@ -0,0 +1,113 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerAppJobId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	JobName        string
+}
+
+func NewContainerAppJobID(subscriptionId, resourceGroup, jobName string) ContainerAppJobId {
+	return ContainerAppJobId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		JobName:        jobName,
+	}
+}
+
+func (id ContainerAppJobId) String() string {
+	segments := []string{
+		fmt.Sprintf("Job Name %q", id.JobName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container App Job", segmentsStr)
+}
+
+func (id ContainerAppJobId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.App/jobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.JobName)
+}
+
+// ContainerAppJobID parses a ContainerAppJob ID into an ContainerAppJobId struct
+func ContainerAppJobID(input string) (*ContainerAppJobId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerAppJobId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.JobName, err = id.PopSegment("jobs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ContainerAppJobIDInsensitively parses an ContainerAppJob ID into an ContainerAppJobId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ContainerAppJobID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ContainerAppJobIDInsensitively(input string) (*ContainerAppJobId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerAppJobId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'jobs' segment
+	jobsKey := "jobs"
+	for key := range id.Path {
+		if strings.EqualFold(key, jobsKey) {
+			jobsKey = key
+			break
+		}
+	}
+	if resourceId.JobName, err = id.PopSegment(jobsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
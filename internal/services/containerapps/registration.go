@@ -22,6 +22,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ContainerAppEnvironmentDataSource{},
 		ContainerAppEnvironmentCertificateDataSource{},
+		ContainerAppJobDataSource{},
 	}
 }
 
@@ -31,6 +32,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerAppEnvironmentDaprComponentResource{},
 		ContainerAppEnvironmentResource{},
 		ContainerAppEnvironmentStorageResource{},
+		ContainerAppJobResource{},
 		ContainerAppResource{},
 	}
 }
@@ -0,0 +1,3 @@
+package containerapps
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ContainerAppJob -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.App/jobs/job1 -rewrite=true
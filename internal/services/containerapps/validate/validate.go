@@ -135,3 +135,17 @@ func ContainerAppContainerName(i interface{}, k string) (warnings []string, erro
 	}
 	return
 }
+
+func ContainerAppScaleRuleConcurrentRequests(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[1-9][0-9]*$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be a whole number greater than 0", k))
+	}
+
+	return
+}
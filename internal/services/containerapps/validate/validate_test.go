@@ -271,3 +271,45 @@ func TestValidateInitTimeout(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateContainerAppScaleRuleConcurrentRequests(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "0",
+			Valid: false,
+		},
+		{
+			Input: "-1",
+			Valid: false,
+		},
+		{
+			Input: "1.5",
+			Valid: false,
+		},
+		{
+			Input: "10",
+			Valid: true,
+		},
+		{
+			Input: "100",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ContainerAppScaleRuleConcurrentRequests(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s", tc.Valid, valid, tc.Input)
+		}
+	}
+}
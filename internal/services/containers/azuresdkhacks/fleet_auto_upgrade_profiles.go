@@ -0,0 +1,177 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes a newer Fleet API Version
+// Fleet Auto Upgrade Profiles aren't available in the vendored Fleet API Version (2022-09-02-preview), so the client for
+// the `autoUpgradeProfiles` endpoint of a Fleet is defined here against the first stable API Version which includes them.
+
+const fleetAutoUpgradeProfilesApiVersion = "2025-03-01"
+
+type FleetAutoUpgradeProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetAutoUpgradeProfilesClientWithBaseURI(endpoint string) FleetAutoUpgradeProfilesClient {
+	return FleetAutoUpgradeProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/autoupgradeprofiles/%s", fleetAutoUpgradeProfilesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type UpgradeChannel string
+
+const (
+	UpgradeChannelNodeImage UpgradeChannel = "NodeImage"
+	UpgradeChannelRapid     UpgradeChannel = "Rapid"
+	UpgradeChannelStable    UpgradeChannel = "Stable"
+)
+
+func PossibleValuesForUpgradeChannel() []string {
+	return []string{
+		string(UpgradeChannelNodeImage),
+		string(UpgradeChannelRapid),
+		string(UpgradeChannelStable),
+	}
+}
+
+type AutoUpgradeNodeImageSelectionType string
+
+const (
+	AutoUpgradeNodeImageSelectionTypeConsistent AutoUpgradeNodeImageSelectionType = "Consistent"
+	AutoUpgradeNodeImageSelectionTypeLatest     AutoUpgradeNodeImageSelectionType = "Latest"
+)
+
+func PossibleValuesForAutoUpgradeNodeImageSelectionType() []string {
+	return []string{
+		string(AutoUpgradeNodeImageSelectionTypeConsistent),
+		string(AutoUpgradeNodeImageSelectionTypeLatest),
+	}
+}
+
+type AutoUpgradeProfile struct {
+	ETag       *string                       `json:"eTag,omitempty"`
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *AutoUpgradeProfileProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type AutoUpgradeProfileProperties struct {
+	Channel            UpgradeChannel                 `json:"channel"`
+	Disabled           *bool                          `json:"disabled,omitempty"`
+	NodeImageSelection *AutoUpgradeNodeImageSelection `json:"nodeImageSelection,omitempty"`
+	ProvisioningState  *string                        `json:"provisioningState,omitempty"`
+	UpdateStrategyId   *string                        `json:"updateStrategyId,omitempty"`
+}
+
+type AutoUpgradeNodeImageSelection struct {
+	Type AutoUpgradeNodeImageSelectionType `json:"type"`
+}
+
+type FleetAutoUpgradeProfilesGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *AutoUpgradeProfile
+}
+
+// Get ...
+func (c FleetAutoUpgradeProfilesClient) Get(ctx context.Context, id parse.FleetAutoUpgradeProfileId) (result FleetAutoUpgradeProfilesGetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c FleetAutoUpgradeProfilesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.FleetAutoUpgradeProfileId, input AutoUpgradeProfile) error {
+	// these are read-only and are rejected by the API when sent back
+	input.Id = nil
+	input.Name = nil
+	input.Type = nil
+
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "CreateOrUpdate", nil, "Failure preparing request"))
+	}
+
+	poller, err := c.send(ctx, req)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "CreateOrUpdate", nil, "Failure sending request"))
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c FleetAutoUpgradeProfilesClient) DeleteThenPoll(ctx context.Context, id parse.FleetAutoUpgradeProfileId) error {
+	req, err := c.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "Delete", nil, "Failure preparing request"))
+	}
+
+	poller, err := c.send(ctx, req)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", autorest.NewErrorWithError(err, "autoupgradeprofiles.FleetAutoUpgradeProfilesClient", "Delete", nil, "Failure sending request"))
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c FleetAutoUpgradeProfilesClient) preparer(ctx context.Context, id parse.FleetAutoUpgradeProfileId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": fleetAutoUpgradeProfilesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// send sends the long-running request. The method will close the http.Response Body if it receives an error.
+func (c FleetAutoUpgradeProfilesClient) send(ctx context.Context, req *http.Request) (poller polling.LongRunningPoller, err error) {
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	return polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+}
@@ -0,0 +1,154 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes a newer Fleet API Version
+// Fleet Update Strategies aren't available in the vendored Fleet API Version (2022-09-02-preview), so the client for the
+// `updateStrategies` endpoint of a Fleet is defined here against the first stable API Version which includes them.
+
+const fleetUpdateStrategiesApiVersion = "2023-10-15"
+
+type FleetUpdateStrategiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetUpdateStrategiesClientWithBaseURI(endpoint string) FleetUpdateStrategiesClient {
+	return FleetUpdateStrategiesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/fleetupdatestrategies/%s", fleetUpdateStrategiesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type FleetUpdateStrategy struct {
+	ETag       *string                        `json:"eTag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *FleetUpdateStrategyProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
+
+type FleetUpdateStrategyProperties struct {
+	ProvisioningState *string           `json:"provisioningState,omitempty"`
+	Strategy          UpdateRunStrategy `json:"strategy"`
+}
+
+type UpdateRunStrategy struct {
+	Stages []UpdateStage `json:"stages"`
+}
+
+type UpdateStage struct {
+	AfterStageWaitInSeconds *int64         `json:"afterStageWaitInSeconds,omitempty"`
+	Groups                  *[]UpdateGroup `json:"groups,omitempty"`
+	Name                    string         `json:"name"`
+}
+
+type UpdateGroup struct {
+	Name string `json:"name"`
+}
+
+type FleetUpdateStrategiesGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FleetUpdateStrategy
+}
+
+// Get ...
+func (c FleetUpdateStrategiesClient) Get(ctx context.Context, id parse.FleetUpdateStrategyId) (result FleetUpdateStrategiesGetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c FleetUpdateStrategiesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.FleetUpdateStrategyId, input FleetUpdateStrategy) error {
+	// these are read-only and are rejected by the API when sent back
+	input.Id = nil
+	input.Name = nil
+	input.Type = nil
+
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "CreateOrUpdate", nil, "Failure preparing request"))
+	}
+
+	poller, err := c.send(ctx, req)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "CreateOrUpdate", nil, "Failure sending request"))
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c FleetUpdateStrategiesClient) DeleteThenPoll(ctx context.Context, id parse.FleetUpdateStrategyId) error {
+	req, err := c.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "Delete", nil, "Failure preparing request"))
+	}
+
+	poller, err := c.send(ctx, req)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", autorest.NewErrorWithError(err, "fleetupdatestrategies.FleetUpdateStrategiesClient", "Delete", nil, "Failure sending request"))
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c FleetUpdateStrategiesClient) preparer(ctx context.Context, id parse.FleetUpdateStrategyId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": fleetUpdateStrategiesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// send sends the long-running request. The method will close the http.Response Body if it receives an error.
+func (c FleetUpdateStrategiesClient) send(ctx context.Context, req *http.Request) (poller polling.LongRunningPoller, err error) {
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	return polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+}
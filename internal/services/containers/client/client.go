@@ -9,12 +9,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/managedclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
 )
 
 type Client struct {
 	AgentPoolsClient                  *agentpools.AgentPoolsClient
 	ContainerRegistryAgentPoolsClient *containerregistry.AgentPoolsClient
 	ContainerInstanceClient           *containerinstance.ContainerInstanceClient
+	FleetAutoUpgradeProfilesClient    *azuresdkhacks.FleetAutoUpgradeProfilesClient
+	FleetUpdateStrategiesClient       *azuresdkhacks.FleetUpdateStrategiesClient
 	KubernetesClustersClient          *managedclusters.ManagedClustersClient
 	MaintenanceConfigurationsClient   *maintenanceconfigurations.MaintenanceConfigurationsClient
 	RegistriesClient                  *containerregistry.RegistriesClient
@@ -71,6 +74,12 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 	servicesClient := containerservices.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
+	fleetAutoUpgradeProfilesClient := azuresdkhacks.NewFleetAutoUpgradeProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetAutoUpgradeProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetUpdateStrategiesClient := azuresdkhacks.NewFleetUpdateStrategiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetUpdateStrategiesClient.Client, o.ResourceManagerAuthorizer)
+
 	connectedRegistriesClient := containerregistry.NewConnectedRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&connectedRegistriesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -79,6 +88,8 @@ func NewContainersClient(o *common.ClientOptions) *Client {
 		ContainerRegistryAgentPoolsClient: &registryAgentPoolsClient,
 		KubernetesClustersClient:          &kubernetesClustersClient,
 		ContainerInstanceClient:           &containerInstanceClient,
+		FleetAutoUpgradeProfilesClient:    &fleetAutoUpgradeProfilesClient,
+		FleetUpdateStrategiesClient:       &fleetUpdateStrategiesClient,
 		MaintenanceConfigurationsClient:   &maintenanceConfigurationsClient,
 		RegistriesClient:                  &registriesClient,
 		WebhooksClient:                    &webhooksClient,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservationgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/managedclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -226,6 +227,16 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"current_orchestrator_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"node_image_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"os_disk_size_gb": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -313,6 +324,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: snapshots.ValidateSnapshotID,
+			},
+
 			"ultra_ssd_enabled": {
 				Type:     pluginsdk.TypeBool,
 				ForceNew: true,
@@ -535,6 +553,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.CapacityReservationGroupID = utils.String(capacityReservationGroupId)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &agentpools.CreationData{
+			SourceResourceId: utils.String(snapshotId),
+		}
+	}
+
 	maxCount := d.Get("max_count").(int)
 	minCount := d.Get("min_count").(int)
 
@@ -897,6 +921,9 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("orchestrator_version", props.CurrentOrchestratorVersion)
 		}
 
+		d.Set("current_orchestrator_version", props.CurrentOrchestratorVersion)
+		d.Set("node_image_version", props.NodeImageVersion)
+
 		osDiskSizeGB := 0
 		if props.OsDiskSizeGB != nil {
 			osDiskSizeGB = int(*props.OsDiskSizeGB)
@@ -937,6 +964,12 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("host_group_id", props.HostGroupID)
 		d.Set("capacity_reservation_group_id", props.CapacityReservationGroupID)
 
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceId != nil {
+			snapshotId = *props.CreationData.SourceResourceId
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		if err := d.Set("upgrade_settings", flattenAgentPoolUpgradeSettings(props.UpgradeSettings)); err != nil {
 			return fmt.Errorf("setting `upgrade_settings`: %+v", err)
 		}
@@ -30,6 +30,8 @@ func TestAccKubernetesClusterNodePool_autoScale(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+				check.That(data.ResourceName).Key("current_orchestrator_version").Exists(),
+				check.That(data.ResourceName).Key("node_image_version").Exists(),
 			),
 		},
 		data.ImportStep(),
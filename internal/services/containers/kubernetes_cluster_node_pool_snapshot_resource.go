@@ -0,0 +1,264 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/agentpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesClusterNodePoolSnapshotResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterNodePoolSnapshotResource{}
+
+type KubernetesClusterNodePoolSnapshotModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	SourceNodePoolId  string            `tfschema:"source_node_pool_id"`
+	Tags              map[string]string `tfschema:"tags"`
+
+	FipsEnabled       bool   `tfschema:"fips_enabled"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+	NodeImageVersion  string `tfschema:"node_image_version"`
+	OsSku             string `tfschema:"os_sku"`
+	OsType            string `tfschema:"os_type"`
+	VmSize            string `tfschema:"vm_size"`
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_node_pool_snapshot"
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) ModelObject() interface{} {
+	return &KubernetesClusterNodePoolSnapshotModel{}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"source_node_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NodePoolID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fips_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"kubernetes_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"node_image_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_sku": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vm_size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.Snapshots
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model KubernetesClusterNodePoolSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := snapshots.NewSnapshotID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			nodePoolId, err := agentpools.ParseAgentPoolID(model.SourceNodePoolId)
+			if err != nil {
+				return err
+			}
+
+			snapshotType := snapshots.SnapshotTypeNodePool
+			payload := snapshots.Snapshot{
+				Location: location.Normalize(model.Location),
+				Properties: &snapshots.SnapshotProperties{
+					CreationData: &snapshots.CreationData{
+						SourceResourceId: pointer.To(nodePoolId.ID()),
+					},
+					SnapshotType: &snapshotType,
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.Snapshots
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterNodePoolSnapshotModel{
+				Name:              id.SnapshotName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.CreationData != nil && props.CreationData.SourceResourceId != nil {
+						nodePoolId, err := agentpools.ParseAgentPoolIDInsensitively(*props.CreationData.SourceResourceId)
+						if err != nil {
+							return err
+						}
+						state.SourceNodePoolId = nodePoolId.ID()
+					}
+
+					state.FipsEnabled = pointer.From(props.EnableFIPS)
+					state.KubernetesVersion = pointer.From(props.KubernetesVersion)
+					state.NodeImageVersion = pointer.From(props.NodeImageVersion)
+					state.VmSize = pointer.From(props.VMSize)
+
+					if props.OsSku != nil {
+						state.OsSku = string(*props.OsSku)
+					}
+					if props.OsType != nil {
+						state.OsType = string(*props.OsType)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.Snapshots
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterNodePoolSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := snapshots.TagsObject{
+					Tags: pointer.To(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.Snapshots
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclustersnapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/managedclusters"
@@ -148,6 +149,18 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"node_os_channel_upgrade": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(managedclusters.NodeOSUpgradeChannelNodeImage),
+					string(managedclusters.NodeOSUpgradeChannelNone),
+					string(managedclusters.NodeOSUpgradeChannelSecurityPatch),
+					string(managedclusters.NodeOSUpgradeChannelUnmanaged),
+				}, false),
+			},
+
 			"auto_scaler_profile": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1107,6 +1120,13 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: managedclustersnapshots.ValidateManagedClusterSnapshotID,
+			},
+
 			"sku_tier": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1439,6 +1459,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if v := d.Get("node_os_channel_upgrade").(string); v != "" {
+		parameters.Properties.AutoUpgradeProfile.NodeOSUpgradeChannel = utils.ToPtr(managedclusters.NodeOSUpgradeChannel(v))
+	}
+
+	if v := d.Get("snapshot_id").(string); v != "" {
+		parameters.Properties.CreationData = &managedclusters.CreationData{
+			SourceResourceId: utils.String(v),
+		}
+	}
+
 	managedClusterIdentityRaw := d.Get("identity").([]interface{})
 	kubernetesClusterIdentityRaw := d.Get("kubelet_identity").([]interface{})
 	servicePrincipalProfileRaw := d.Get("service_principal").([]interface{})
@@ -1855,6 +1885,15 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.Model.Properties.AutoUpgradeProfile.UpgradeChannel = &channel
 	}
 
+	if d.HasChange("node_os_channel_upgrade") {
+		updateCluster = true
+		if existing.Model.Properties.AutoUpgradeProfile == nil {
+			existing.Model.Properties.AutoUpgradeProfile = &managedclusters.ManagedClusterAutoUpgradeProfile{}
+		}
+
+		existing.Model.Properties.AutoUpgradeProfile.NodeOSUpgradeChannel = utils.ToPtr(managedclusters.NodeOSUpgradeChannel(d.Get("node_os_channel_upgrade").(string)))
+	}
+
 	if d.HasChange("http_proxy_config") {
 		updateCluster = true
 		httpProxyConfigRaw := d.Get("http_proxy_config").([]interface{})
@@ -2104,6 +2143,18 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 		d.Set("automatic_channel_upgrade", upgradeChannel)
 
+		nodeOSUpgradeChannel := ""
+		if profile := props.AutoUpgradeProfile; profile != nil && profile.NodeOSUpgradeChannel != nil {
+			nodeOSUpgradeChannel = string(*profile.NodeOSUpgradeChannel)
+		}
+		d.Set("node_os_channel_upgrade", nodeOSUpgradeChannel)
+
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceId != nil {
+			snapshotId = *props.CreationData.SourceResourceId
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		enablePrivateCluster := false
 		enablePrivateClusterPublicFQDN := false
 		runCommandEnabled := true
@@ -0,0 +1,264 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclustersnapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-01-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesClusterSnapshotResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterSnapshotResource{}
+
+type KubernetesClusterSnapshotModel struct {
+	Name                      string            `tfschema:"name"`
+	ResourceGroupName         string            `tfschema:"resource_group_name"`
+	Location                  string            `tfschema:"location"`
+	SourceKubernetesClusterId string            `tfschema:"source_kubernetes_cluster_id"`
+	Tags                      map[string]string `tfschema:"tags"`
+
+	KubernetesVersion             string `tfschema:"kubernetes_version"`
+	NetworkPlugin                 string `tfschema:"network_plugin"`
+	NetworkPolicy                 string `tfschema:"network_policy"`
+	RoleBasedAccessControlEnabled bool   `tfschema:"role_based_access_control_enabled"`
+	SkuTier                       string `tfschema:"sku_tier"`
+}
+
+func (r KubernetesClusterSnapshotResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_snapshot"
+}
+
+func (r KubernetesClusterSnapshotResource) ModelObject() interface{} {
+	return &KubernetesClusterSnapshotModel{}
+}
+
+func (r KubernetesClusterSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedclustersnapshots.ValidateManagedClusterSnapshotID
+}
+
+func (r KubernetesClusterSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"source_kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r KubernetesClusterSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"network_plugin": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"network_policy": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"role_based_access_control_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"sku_tier": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.ManagedClusterSnapshots
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model KubernetesClusterSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := managedclustersnapshots.NewManagedClusterSnapshotID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			clusterId, err := managedclusters.ParseManagedClusterID(model.SourceKubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			snapshotType := managedclustersnapshots.SnapshotTypeManagedCluster
+			payload := managedclustersnapshots.ManagedClusterSnapshot{
+				Location: location.Normalize(model.Location),
+				Properties: &managedclustersnapshots.ManagedClusterSnapshotProperties{
+					CreationData: &managedclustersnapshots.CreationData{
+						SourceResourceId: pointer.To(clusterId.ID()),
+					},
+					SnapshotType: &snapshotType,
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.ManagedClusterSnapshots
+
+			id, err := managedclustersnapshots.ParseManagedClusterSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterSnapshotModel{
+				Name:              id.ManagedClusterSnapshotName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.CreationData != nil && props.CreationData.SourceResourceId != nil {
+						clusterId, err := managedclusters.ParseManagedClusterIDInsensitively(*props.CreationData.SourceResourceId)
+						if err != nil {
+							return err
+						}
+						state.SourceKubernetesClusterId = clusterId.ID()
+					}
+
+					if cluster := props.ManagedClusterPropertiesReadOnly; cluster != nil {
+						state.KubernetesVersion = pointer.From(cluster.KubernetesVersion)
+						state.RoleBasedAccessControlEnabled = pointer.From(cluster.EnableRbac)
+
+						if networkProfile := cluster.NetworkProfile; networkProfile != nil {
+							if networkProfile.NetworkPlugin != nil {
+								state.NetworkPlugin = string(*networkProfile.NetworkPlugin)
+							}
+							if networkProfile.NetworkPolicy != nil {
+								state.NetworkPolicy = string(*networkProfile.NetworkPolicy)
+							}
+						}
+
+						if sku := cluster.Sku; sku != nil && sku.Tier != nil {
+							state.SkuTier = string(*sku.Tier)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterSnapshotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.ManagedClusterSnapshots
+
+			id, err := managedclustersnapshots.ParseManagedClusterSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := managedclustersnapshots.TagsObject{
+					Tags: pointer.To(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.ManagedClusterSnapshots
+
+			id, err := managedclustersnapshots.ParseManagedClusterSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
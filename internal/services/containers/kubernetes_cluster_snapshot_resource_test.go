@@ -0,0 +1,158 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclustersnapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterSnapshotResource struct{}
+
+func TestAccKubernetesClusterSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_snapshot", "test")
+	r := KubernetesClusterSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kubernetes_version").Exists(),
+				check.That(data.ResourceName).Key("network_plugin").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_snapshot", "test")
+	r := KubernetesClusterSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesClusterSnapshot_restoreCluster(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_snapshot", "test")
+	r := KubernetesClusterSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restoreCluster(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_kubernetes_cluster.restored").Key("snapshot_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesClusterSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedclustersnapshots.ParseManagedClusterSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ContainerService.ManagedClusterSnapshots.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesClusterSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_snapshot" "test" {
+  name                         = "acctestsnap%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  source_kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesClusterSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_snapshot" "import" {
+  name                         = azurerm_kubernetes_cluster_snapshot.test.name
+  resource_group_name          = azurerm_kubernetes_cluster_snapshot.test.resource_group_name
+  location                     = azurerm_kubernetes_cluster_snapshot.test.location
+  source_kubernetes_cluster_id = azurerm_kubernetes_cluster_snapshot.test.source_kubernetes_cluster_id
+}
+`, r.basic(data))
+}
+
+func (r KubernetesClusterSnapshotResource) restoreCluster(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster" "restored" {
+  name                = "acctestaksrestored%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaksrestored%d"
+  snapshot_id         = azurerm_kubernetes_cluster_snapshot.test.id
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (KubernetesClusterSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,271 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesFleetAutoUpgradeProfileResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetAutoUpgradeProfileResource{}
+
+type KubernetesFleetAutoUpgradeProfileModel struct {
+	Name                     string `tfschema:"name"`
+	KubernetesFleetManagerId string `tfschema:"kubernetes_fleet_manager_id"`
+	Channel                  string `tfschema:"channel"`
+	Enabled                  bool   `tfschema:"enabled"`
+	NodeImageSelectionType   string `tfschema:"node_image_selection_type"`
+	UpdateStrategyId         string `tfschema:"update_strategy_id"`
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_auto_upgrade_profile"
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) ModelObject() interface{} {
+	return &KubernetesFleetAutoUpgradeProfileModel{}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FleetAutoUpgradeProfileID
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"channel": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForUpgradeChannel(), false),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"node_image_selection_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(azuresdkhacks.AutoUpgradeNodeImageSelectionTypeLatest),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForAutoUpgradeNodeImageSelectionType(), false),
+		},
+
+		"update_strategy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.FleetUpdateStrategyID,
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			var model KubernetesFleetAutoUpgradeProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFleetAutoUpgradeProfileID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.AutoUpgradeProfile{
+				Properties: &azuresdkhacks.AutoUpgradeProfileProperties{
+					Channel:  azuresdkhacks.UpgradeChannel(model.Channel),
+					Disabled: pointer.To(!model.Enabled),
+				},
+			}
+
+			if model.Channel != string(azuresdkhacks.UpgradeChannelNodeImage) {
+				payload.Properties.NodeImageSelection = &azuresdkhacks.AutoUpgradeNodeImageSelection{
+					Type: azuresdkhacks.AutoUpgradeNodeImageSelectionType(model.NodeImageSelectionType),
+				}
+			}
+
+			if model.UpdateStrategyId != "" {
+				payload.Properties.UpdateStrategyId = pointer.To(model.UpdateStrategyId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := parse.FleetAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetAutoUpgradeProfileModel{
+				Name:                     id.AutoUpgradeProfileName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroup, id.FleetName).ID(),
+				NodeImageSelectionType:   string(azuresdkhacks.AutoUpgradeNodeImageSelectionTypeLatest),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Channel = string(props.Channel)
+					state.Enabled = !pointer.From(props.Disabled)
+
+					if props.NodeImageSelection != nil {
+						state.NodeImageSelectionType = string(props.NodeImageSelection.Type)
+					}
+
+					if props.UpdateStrategyId != nil {
+						updateStrategyId, err := parse.FleetUpdateStrategyIDInsensitively(*props.UpdateStrategyId)
+						if err != nil {
+							return err
+						}
+						state.UpdateStrategyId = updateStrategyId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := parse.FleetAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetAutoUpgradeProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("channel") {
+				payload.Properties.Channel = azuresdkhacks.UpgradeChannel(model.Channel)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.Disabled = pointer.To(!model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChanges("channel", "node_image_selection_type") {
+				payload.Properties.NodeImageSelection = nil
+				if model.Channel != string(azuresdkhacks.UpgradeChannelNodeImage) {
+					payload.Properties.NodeImageSelection = &azuresdkhacks.AutoUpgradeNodeImageSelection{
+						Type: azuresdkhacks.AutoUpgradeNodeImageSelectionType(model.NodeImageSelectionType),
+					}
+				}
+			}
+
+			if metadata.ResourceData.HasChange("update_strategy_id") {
+				payload.Properties.UpdateStrategyId = nil
+				if model.UpdateStrategyId != "" {
+					payload.Properties.UpdateStrategyId = pointer.To(model.UpdateStrategyId)
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := parse.FleetAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,160 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetAutoUpgradeProfileResource struct{}
+
+func TestAccKubernetesFleetAutoUpgradeProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_auto_upgrade_profile", "test")
+	r := KubernetesFleetAutoUpgradeProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetAutoUpgradeProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_auto_upgrade_profile", "test")
+	r := KubernetesFleetAutoUpgradeProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetAutoUpgradeProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_auto_upgrade_profile", "test")
+	r := KubernetesFleetAutoUpgradeProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FleetAutoUpgradeProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.FleetAutoUpgradeProfilesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_auto_upgrade_profile" "test" {
+  name                        = "acctest-kfaup-%d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+  channel                     = "Stable"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_auto_upgrade_profile" "import" {
+  name                        = azurerm_kubernetes_fleet_auto_upgrade_profile.test.name
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_auto_upgrade_profile.test.kubernetes_fleet_manager_id
+  channel                     = azurerm_kubernetes_fleet_auto_upgrade_profile.test.channel
+}
+`, r.basic(data))
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_strategy" "test" {
+  name                        = "acctest-kfus-%[2]d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+
+  stage {
+    name = "example-stage-1"
+
+    group {
+      name = "example-group-1"
+    }
+  }
+}
+
+resource "azurerm_kubernetes_fleet_auto_upgrade_profile" "test" {
+  name                        = "acctest-kfaup-%[2]d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+  channel                     = "Rapid"
+  enabled                     = false
+  node_image_selection_type   = "Consistent"
+  update_strategy_id          = azurerm_kubernetes_fleet_update_strategy.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-kfaup-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_kubernetes_fleet_manager" "test" {
+  name                = "acctest-kfm-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,286 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesFleetUpdateStrategyResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+
+type KubernetesFleetUpdateStrategyModel struct {
+	Name                     string                                    `tfschema:"name"`
+	KubernetesFleetManagerId string                                    `tfschema:"kubernetes_fleet_manager_id"`
+	Stage                    []KubernetesFleetUpdateStrategyStageModel `tfschema:"stage"`
+}
+
+type KubernetesFleetUpdateStrategyStageModel struct {
+	Name                    string                                         `tfschema:"name"`
+	Group                   []KubernetesFleetUpdateStrategyStageGroupModel `tfschema:"group"`
+	AfterStageWaitInSeconds int64                                          `tfschema:"after_stage_wait_in_seconds"`
+}
+
+type KubernetesFleetUpdateStrategyStageGroupModel struct {
+	Name string `tfschema:"name"`
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_strategy"
+}
+
+func (r KubernetesFleetUpdateStrategyResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateStrategyModel{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FleetUpdateStrategyID
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fleets.ValidateFleetID,
+		},
+
+		"stage": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"group": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"after_stage_wait_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 3600),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			var model KubernetesFleetUpdateStrategyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := fleets.ParseFleetID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFleetUpdateStrategyID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.FleetUpdateStrategy{
+				Properties: &azuresdkhacks.FleetUpdateStrategyProperties{
+					Strategy: azuresdkhacks.UpdateRunStrategy{
+						Stages: expandKubernetesFleetUpdateStrategyStages(model.Stage),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := parse.FleetUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetUpdateStrategyModel{
+				Name:                     id.UpdateStrategyName,
+				KubernetesFleetManagerId: fleets.NewFleetID(id.SubscriptionId, id.ResourceGroup, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Stage = flattenKubernetesFleetUpdateStrategyStages(props.Strategy.Stages)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := parse.FleetUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetUpdateStrategyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("stage") {
+				payload.Properties.Strategy.Stages = expandKubernetesFleetUpdateStrategyStages(model.Stage)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetUpdateStrategiesClient
+
+			id, err := parse.FleetUpdateStrategyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateStrategyStages(input []KubernetesFleetUpdateStrategyStageModel) []azuresdkhacks.UpdateStage {
+	output := make([]azuresdkhacks.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]azuresdkhacks.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, azuresdkhacks.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output = append(output, azuresdkhacks.UpdateStage{
+			AfterStageWaitInSeconds: pointer.To(stage.AfterStageWaitInSeconds),
+			Groups:                  &groups,
+			Name:                    stage.Name,
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateStrategyStages(input []azuresdkhacks.UpdateStage) []KubernetesFleetUpdateStrategyStageModel {
+	output := make([]KubernetesFleetUpdateStrategyStageModel, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetUpdateStrategyStageGroupModel, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetUpdateStrategyStageGroupModel{
+					Name: group.Name,
+				})
+			}
+		}
+
+		output = append(output, KubernetesFleetUpdateStrategyStageModel{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: pointer.From(stage.AfterStageWaitInSeconds),
+		})
+	}
+
+	return output
+}
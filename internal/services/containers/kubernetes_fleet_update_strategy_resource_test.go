@@ -0,0 +1,179 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetUpdateStrategyResource struct{}
+
+func TestAccKubernetesFleetUpdateStrategy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_strategy", "test")
+	r := KubernetesFleetUpdateStrategyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetUpdateStrategy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_strategy", "test")
+	r := KubernetesFleetUpdateStrategyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetUpdateStrategy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_strategy", "test")
+	r := KubernetesFleetUpdateStrategyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesFleetUpdateStrategyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FleetUpdateStrategyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.FleetUpdateStrategiesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesFleetUpdateStrategyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_strategy" "test" {
+  name                        = "acctest-kfus-%d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+
+  stage {
+    name = "example-stage-1"
+
+    group {
+      name = "example-group-1"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetUpdateStrategyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_strategy" "import" {
+  name                        = azurerm_kubernetes_fleet_update_strategy.test.name
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_update_strategy.test.kubernetes_fleet_manager_id
+
+  stage {
+    name = "example-stage-1"
+
+    group {
+      name = "example-group-1"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r KubernetesFleetUpdateStrategyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_strategy" "test" {
+  name                        = "acctest-kfus-%d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+
+  stage {
+    name = "example-stage-1"
+
+    group {
+      name = "example-group-1"
+    }
+
+    group {
+      name = "example-group-2"
+    }
+
+    after_stage_wait_in_seconds = 60
+  }
+
+  stage {
+    name = "example-stage-2"
+
+    group {
+      name = "example-group-3"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetUpdateStrategyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-kfus-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_kubernetes_fleet_manager" "test" {
+  name                = "acctest-kfm-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2019-08-01/containerservices"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -49,6 +50,17 @@ func dataSourceKubernetesServiceVersions() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"version_selector": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.KubernetesVersionSelector,
+			},
+
+			"selected_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -76,6 +88,7 @@ func dataSourceKubernetesServiceVersionsRead(d *pluginsdk.ResourceData, meta int
 	}
 
 	var versions []string
+	parsedVersions := make([]*version.Version, 0)
 	versionPrefix := d.Get("version_prefix").(string)
 	includePreview := d.Get("include_preview").(bool)
 
@@ -114,15 +127,26 @@ func dataSourceKubernetesServiceVersionsRead(d *pluginsdk.ResourceData, meta int
 				continue
 			}
 
+			parsedVersions = append(parsedVersions, v)
+
 			if v.GreaterThan(lv) {
 				lv = v
 			}
 		}
 	}
 
+	selectedVersion := ""
+	if selector := d.Get("version_selector").(string); selector != "" {
+		selectedVersion, err = selectKubernetesVersion(parsedVersions, selector)
+		if err != nil {
+			return fmt.Errorf("selecting a Kubernetes Version in %q: %+v", id.LocationName, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	d.Set("versions", versions)
 	d.Set("latest_version", lv.Original())
+	d.Set("selected_version", selectedVersion)
 
 	return nil
 }
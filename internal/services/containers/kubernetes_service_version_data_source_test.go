@@ -67,6 +67,22 @@ func TestAccDataSourceAzureRMKubernetesServiceVersions_nopreview(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMKubernetesServiceVersions_versionSelector(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_service_versions", "test")
+	r := KubernetesServiceVersionDataSource{}
+	kvrx := regexp.MustCompile(k8sVersionRX)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.versionSelector(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("selected_version").Exists(),
+				acceptance.TestMatchResourceAttr(data.ResourceName, "selected_version", kvrx),
+			),
+		},
+	})
+}
+
 func (KubernetesServiceVersionDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -104,3 +120,17 @@ data "azurerm_kubernetes_service_versions" "test" {
 }
 `, data.Locations.Primary)
 }
+
+func (KubernetesServiceVersionDataSource) versionSelector(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_kubernetes_service_versions" "test" {
+  location         = "%s"
+  include_preview  = false
+  version_selector = "n-1"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,47 @@
+package containers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// selectKubernetesVersion returns the most recent patch version of the minor version identified by the selector, where
+// `latest` and `n` are the most recent minor version, `n-1` is the minor version prior to that and so on.
+func selectKubernetesVersion(versions []*version.Version, selector string) (string, error) {
+	offset := 0
+	if selector != "latest" && selector != "n" {
+		v, err := strconv.Atoi(strings.TrimPrefix(selector, "n-"))
+		if err != nil {
+			return "", fmt.Errorf("parsing the version selector %q: %+v", selector, err)
+		}
+		offset = v
+	}
+
+	// determine the most recent patch version available for each minor version
+	latestPatches := make(map[string]*version.Version)
+	for _, v := range versions {
+		segments := v.Segments()
+		minor := fmt.Sprintf("%d.%d", segments[0], segments[1])
+		if existing, ok := latestPatches[minor]; !ok || v.GreaterThan(existing) {
+			latestPatches[minor] = v
+		}
+	}
+
+	candidates := make([]*version.Version, 0, len(latestPatches))
+	for _, v := range latestPatches {
+		candidates = append(candidates, v)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GreaterThan(candidates[j])
+	})
+
+	if offset >= len(candidates) {
+		return "", fmt.Errorf("the version selector %q requires at least %d minor versions to be available but only %d were found", selector, offset+1, len(candidates))
+	}
+
+	return candidates[offset].Original(), nil
+}
@@ -0,0 +1,59 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+)
+
+func TestSelectKubernetesVersion(t *testing.T) {
+	available := make([]*version.Version, 0)
+	for _, v := range []string{"1.24.9", "1.25.5", "1.24.10", "1.26.0", "1.25.6", "1.26.3"} {
+		available = append(available, version.Must(version.NewVersion(v)))
+	}
+
+	cases := []struct {
+		Selector string
+		Expected string
+		Error    bool
+	}{
+		{
+			Selector: "latest",
+			Expected: "1.26.3",
+		},
+		{
+			Selector: "n",
+			Expected: "1.26.3",
+		},
+		{
+			Selector: "n-1",
+			Expected: "1.25.6",
+		},
+		{
+			Selector: "n-2",
+			Expected: "1.24.10",
+		},
+		{
+			Selector: "n-3",
+			Error:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Selector, func(t *testing.T) {
+			actual, err := selectKubernetesVersion(available, tc.Selector)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got %q", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if actual != tc.Expected {
+				t.Fatalf("expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FleetAutoUpgradeProfileId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	FleetName              string
+	AutoUpgradeProfileName string
+}
+
+func NewFleetAutoUpgradeProfileID(subscriptionId, resourceGroup, fleetName, autoUpgradeProfileName string) FleetAutoUpgradeProfileId {
+	return FleetAutoUpgradeProfileId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		FleetName:              fleetName,
+		AutoUpgradeProfileName: autoUpgradeProfileName,
+	}
+}
+
+func (id FleetAutoUpgradeProfileId) String() string {
+	segments := []string{
+		fmt.Sprintf("Auto Upgrade Profile Name %q", id.AutoUpgradeProfileName),
+		fmt.Sprintf("Fleet Name %q", id.FleetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Fleet Auto Upgrade Profile", segmentsStr)
+}
+
+func (id FleetAutoUpgradeProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s/autoUpgradeProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FleetName, id.AutoUpgradeProfileName)
+}
+
+// FleetAutoUpgradeProfileID parses a FleetAutoUpgradeProfile ID into an FleetAutoUpgradeProfileId struct
+func FleetAutoUpgradeProfileID(input string) (*FleetAutoUpgradeProfileId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FleetAutoUpgradeProfileId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FleetName, err = id.PopSegment("fleets"); err != nil {
+		return nil, err
+	}
+	if resourceId.AutoUpgradeProfileName, err = id.PopSegment("autoUpgradeProfiles"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FleetUpdateStrategyId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FleetName          string
+	UpdateStrategyName string
+}
+
+func NewFleetUpdateStrategyID(subscriptionId, resourceGroup, fleetName, updateStrategyName string) FleetUpdateStrategyId {
+	return FleetUpdateStrategyId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FleetName:          fleetName,
+		UpdateStrategyName: updateStrategyName,
+	}
+}
+
+func (id FleetUpdateStrategyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Update Strategy Name %q", id.UpdateStrategyName),
+		fmt.Sprintf("Fleet Name %q", id.FleetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Fleet Update Strategy", segmentsStr)
+}
+
+func (id FleetUpdateStrategyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s/updateStrategies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FleetName, id.UpdateStrategyName)
+}
+
+// FleetUpdateStrategyID parses a FleetUpdateStrategy ID into an FleetUpdateStrategyId struct
+func FleetUpdateStrategyID(input string) (*FleetUpdateStrategyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FleetUpdateStrategyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FleetName, err = id.PopSegment("fleets"); err != nil {
+		return nil, err
+	}
+	if resourceId.UpdateStrategyName, err = id.PopSegment("updateStrategies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// FleetUpdateStrategyIDInsensitively parses an FleetUpdateStrategy ID into an FleetUpdateStrategyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the FleetUpdateStrategyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func FleetUpdateStrategyIDInsensitively(input string) (*FleetUpdateStrategyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FleetUpdateStrategyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'fleets' segment
+	fleetsKey := "fleets"
+	for key := range id.Path {
+		if strings.EqualFold(key, fleetsKey) {
+			fleetsKey = key
+			break
+		}
+	}
+	if resourceId.FleetName, err = id.PopSegment(fleetsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'updateStrategies' segment
+	updateStrategiesKey := "updateStrategies"
+	for key := range id.Path {
+		if strings.EqualFold(key, updateStrategiesKey) {
+			updateStrategiesKey = key
+			break
+		}
+	}
+	if resourceId.UpdateStrategyName, err = id.PopSegment(updateStrategiesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
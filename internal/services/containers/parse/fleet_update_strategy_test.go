@@ -0,0 +1,264 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = FleetUpdateStrategyId{}
+
+func TestFleetUpdateStrategyIDFormatter(t *testing.T) {
+	actual := NewFleetUpdateStrategyID("12345678-1234-9876-4563-123456789012", "resGroup1", "fleet1", "updateStrategy1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/updateStrategy1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFleetUpdateStrategyID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FleetUpdateStrategyId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing FleetName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/",
+			Error: true,
+		},
+
+		{
+			// missing value for FleetName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/",
+			Error: true,
+		},
+
+		{
+			// missing UpdateStrategyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/",
+			Error: true,
+		},
+
+		{
+			// missing value for UpdateStrategyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/updateStrategy1",
+			Expected: &FleetUpdateStrategyId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				FleetName:          "fleet1",
+				UpdateStrategyName: "updateStrategy1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONTAINERSERVICE/FLEETS/FLEET1/UPDATESTRATEGIES/UPDATESTRATEGY1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FleetUpdateStrategyID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.FleetName != v.Expected.FleetName {
+			t.Fatalf("Expected %q but got %q for FleetName", v.Expected.FleetName, actual.FleetName)
+		}
+		if actual.UpdateStrategyName != v.Expected.UpdateStrategyName {
+			t.Fatalf("Expected %q but got %q for UpdateStrategyName", v.Expected.UpdateStrategyName, actual.UpdateStrategyName)
+		}
+	}
+}
+
+func TestFleetUpdateStrategyIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FleetUpdateStrategyId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing FleetName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/",
+			Error: true,
+		},
+
+		{
+			// missing value for FleetName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/",
+			Error: true,
+		},
+
+		{
+			// missing UpdateStrategyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/",
+			Error: true,
+		},
+
+		{
+			// missing value for UpdateStrategyName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/updateStrategy1",
+			Expected: &FleetUpdateStrategyId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				FleetName:          "fleet1",
+				UpdateStrategyName: "updateStrategy1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updatestrategies/updateStrategy1",
+			Expected: &FleetUpdateStrategyId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				FleetName:          "fleet1",
+				UpdateStrategyName: "updateStrategy1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/FLEETS/fleet1/UPDATESTRATEGIES/updateStrategy1",
+			Expected: &FleetUpdateStrategyId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				FleetName:          "fleet1",
+				UpdateStrategyName: "updateStrategy1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/FlEeTs/fleet1/UpDaTeStRaTeGiEs/updateStrategy1",
+			Expected: &FleetUpdateStrategyId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "resGroup1",
+				FleetName:          "fleet1",
+				UpdateStrategyName: "updateStrategy1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FleetUpdateStrategyIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.FleetName != v.Expected.FleetName {
+			t.Fatalf("Expected %q but got %q for FleetName", v.Expected.FleetName, actual.FleetName)
+		}
+		if actual.UpdateStrategyName != v.Expected.UpdateStrategyName {
+			t.Fatalf("Expected %q but got %q for UpdateStrategyName", v.Expected.UpdateStrategyName, actual.UpdateStrategyName)
+		}
+	}
+}
@@ -62,6 +62,10 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		ContainerConnectedRegistryResource{},
+		KubernetesClusterNodePoolSnapshotResource{},
+		KubernetesClusterSnapshotResource{},
+		KubernetesFleetAutoUpgradeProfileResource{},
+		KubernetesFleetUpdateStrategyResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
@@ -11,3 +11,5 @@ package containers
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Registry -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Webhook -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/webHooks/webhook1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ContainerConnectedRegistry -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/connectedRegistries/registry1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FleetUpdateStrategy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateStrategies/updateStrategy1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FleetAutoUpgradeProfile -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/autoUpgradeProfiles/autoUpgradeProfile1
@@ -37,3 +37,18 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func KubernetesVersionSelector(i interface{}, k string) (warnings []string, errors []error) {
+	selector, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	re := regexp.MustCompile(`^(latest|n(-[0-9]+)?)$`)
+	if !re.MatchString(selector) {
+		errors = append(errors, fmt.Errorf("%s must be either `latest`, `n` or `n-<number>` (for example `n-1`). Got %q.", k, selector))
+	}
+
+	return warnings, errors
+}
@@ -133,3 +133,53 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesVersionSelector(t *testing.T) {
+	cases := []struct {
+		Selector string
+		Errors   int
+	}{
+		{
+			Selector: "",
+			Errors:   1,
+		},
+		{
+			Selector: "latest",
+			Errors:   0,
+		},
+		{
+			Selector: "n",
+			Errors:   0,
+		},
+		{
+			Selector: "n-1",
+			Errors:   0,
+		},
+		{
+			Selector: "n-12",
+			Errors:   0,
+		},
+		{
+			Selector: "n+1",
+			Errors:   1,
+		},
+		{
+			Selector: "n-",
+			Errors:   1,
+		},
+		{
+			Selector: "1.25",
+			Errors:   1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Selector, func(t *testing.T) {
+			_, errors := KubernetesVersionSelector(tc.Selector, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected Selector to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
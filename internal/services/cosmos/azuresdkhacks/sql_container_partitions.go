@@ -0,0 +1,194 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the APIs used to retrieve and redistribute the throughput of the physical
+// partitions of a SQL Container (and to merge them) are only available in API Version 2023-03-15-preview and later,
+// which isn't available in the version of the Azure SDK we're using
+type SqlContainerPartitionsWorkaroundClient struct {
+	sdkClient *documentdb.SQLResourcesClient
+}
+
+func NewSqlContainerPartitionsWorkaroundClient(client *documentdb.SQLResourcesClient) SqlContainerPartitionsWorkaroundClient {
+	return SqlContainerPartitionsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+const sqlContainerPartitionsAPIVersion = "2023-03-15-preview"
+
+// AllPhysicalPartitionsId can be specified when retrieving the throughput distribution to return every physical partition
+const AllPhysicalPartitionsId = "-1"
+
+type ThroughputPolicyType string
+
+const (
+	ThroughputPolicyTypeCustom ThroughputPolicyType = "custom"
+	ThroughputPolicyTypeEqual  ThroughputPolicyType = "equal"
+	ThroughputPolicyTypeNone   ThroughputPolicyType = "none"
+)
+
+type PhysicalPartitionId struct {
+	Id string `json:"id"`
+}
+
+type PhysicalPartitionThroughputInfo struct {
+	Id         *string  `json:"id,omitempty"`
+	Throughput *float64 `json:"throughput,omitempty"`
+}
+
+type PhysicalPartitionThroughputInfoResult struct {
+	autorest.Response `json:"-"`
+	Properties        *PhysicalPartitionThroughputInfoResultProperties `json:"properties,omitempty"`
+}
+
+type PhysicalPartitionThroughputInfoResultProperties struct {
+	Resource *PhysicalPartitionThroughputInfoResultResource `json:"resource,omitempty"`
+}
+
+type PhysicalPartitionThroughputInfoResultResource struct {
+	PhysicalPartitionThroughputInfo *[]PhysicalPartitionThroughputInfo `json:"physicalPartitionThroughputInfo,omitempty"`
+}
+
+type RedistributeThroughputParameters struct {
+	Properties *RedistributeThroughputProperties `json:"properties,omitempty"`
+}
+
+type RedistributeThroughputProperties struct {
+	Resource *RedistributeThroughputPropertiesResource `json:"resource,omitempty"`
+}
+
+type RedistributeThroughputPropertiesResource struct {
+	ThroughputPolicy                      ThroughputPolicyType              `json:"throughputPolicy"`
+	TargetPhysicalPartitionThroughputInfo []PhysicalPartitionThroughputInfo `json:"targetPhysicalPartitionThroughputInfo"`
+	SourcePhysicalPartitionThroughputInfo []PhysicalPartitionThroughputInfo `json:"sourcePhysicalPartitionThroughputInfo"`
+}
+
+type RetrieveThroughputParameters struct {
+	Properties *RetrieveThroughputProperties `json:"properties,omitempty"`
+}
+
+type RetrieveThroughputProperties struct {
+	Resource *RetrieveThroughputPropertiesResource `json:"resource,omitempty"`
+}
+
+type RetrieveThroughputPropertiesResource struct {
+	PhysicalPartitionIds []PhysicalPartitionId `json:"physicalPartitionIds"`
+}
+
+type MergeParameters struct {
+	IsDryRun *bool `json:"isDryRun,omitempty"`
+}
+
+type PhysicalPartitionStorageInfoCollection struct {
+	autorest.Response                      `json:"-"`
+	PhysicalPartitionStorageInfoCollection *[]PhysicalPartitionStorageInfo `json:"physicalPartitionStorageInfoCollection,omitempty"`
+}
+
+type PhysicalPartitionStorageInfo struct {
+	Id          *string  `json:"id,omitempty"`
+	StorageInKB *float64 `json:"storageInKB,omitempty"`
+}
+
+// RedistributeThroughput moves throughput between the physical partitions of the specified SQL Container and waits for
+// the redistribution to complete, returning the resulting throughput of the target partitions.
+func (c SqlContainerPartitionsWorkaroundClient) RedistributeThroughput(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, parameters RedistributeThroughputParameters) (result PhysicalPartitionThroughputInfoResult, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, accountName, databaseName, containerName, "throughputSettings/default/redistributeThroughput", parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "SQLContainerRedistributeThroughput", nil, "Failure preparing request")
+		return
+	}
+
+	err = c.sendAndWait(ctx, req, "SQLContainerRedistributeThroughput", &result, &result.Response)
+	return
+}
+
+// RetrieveThroughputDistribution retrieves the throughput of the specified physical partitions of the SQL Container,
+// where AllPhysicalPartitionsId can be used to retrieve all of them.
+func (c SqlContainerPartitionsWorkaroundClient) RetrieveThroughputDistribution(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, parameters RetrieveThroughputParameters) (result PhysicalPartitionThroughputInfoResult, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, accountName, databaseName, containerName, "throughputSettings/default/retrieveThroughputDistribution", parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "SQLContainerRetrieveThroughputDistribution", nil, "Failure preparing request")
+		return
+	}
+
+	err = c.sendAndWait(ctx, req, "SQLContainerRetrieveThroughputDistribution", &result, &result.Response)
+	return
+}
+
+// Merge merges the physical partitions of the specified SQL Container - when `IsDryRun` is set the partitions aren't
+// merged, but the storage used by each of them is returned.
+func (c SqlContainerPartitionsWorkaroundClient) Merge(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, parameters MergeParameters) (result PhysicalPartitionStorageInfoCollection, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, accountName, databaseName, containerName, "partitionMerge", parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", "ListSQLContainerPartitionMerge", nil, "Failure preparing request")
+		return
+	}
+
+	err = c.sendAndWait(ctx, req, "ListSQLContainerPartitionMerge", &result, &result.Response)
+	return
+}
+
+// sendAndWait sends the request, waits for the long running operation to complete and then unmarshals the result
+func (c SqlContainerPartitionsWorkaroundClient) sendAndWait(ctx context.Context, req *http.Request, operation string, result interface{}, response *autorest.Response) (err error) {
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		*response = autorest.Response{Response: resp}
+		return autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", operation, resp, "Failure sending request")
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		*response = autorest.Response{Response: resp}
+		return autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", operation, resp, "Failure sending request")
+	}
+
+	if err = future.WaitForCompletionRef(ctx, c.sdkClient.Client); err != nil {
+		*response = autorest.Response{Response: future.Response()}
+		return autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", operation, future.Response(), "Failure waiting for completion")
+	}
+
+	resp, err = future.GetResult(c.sdkClient)
+	if err == nil && resp.StatusCode != http.StatusNoContent {
+		err = autorest.Respond(
+			resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+			autorest.ByUnmarshallingJSON(result),
+			autorest.ByClosing())
+	}
+	*response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "documentdb.SQLResourcesClient", operation, resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c SqlContainerPartitionsWorkaroundClient) preparer(ctx context.Context, resourceGroupName string, accountName string, databaseName string, containerName string, action string, parameters interface{}) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"containerName":     autorest.Encode("path", containerName),
+		"databaseName":      autorest.Encode("path", databaseName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": sqlContainerPartitionsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/sqlDatabases/{databaseName}/containers/{containerName}/"+action, pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
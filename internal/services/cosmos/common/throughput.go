@@ -1,8 +1,6 @@
 package common
 
 import (
-	"fmt"
-
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -53,14 +51,50 @@ func SetResourceDataThroughputFromResponse(throughputResponse documentdb.Through
 	d.Set("autoscale_settings", autoscaleSettings)
 }
 
-func CheckForChangeFromAutoscaleAndManualThroughput(d *pluginsdk.ResourceData) error {
-	if d.HasChange("throughput") && d.HasChange("autoscale_settings") {
-		return fmt.Errorf("switching between autoscale and manually provisioned throughput via Terraform is not supported at this time")
+// ThroughputMigration is a change between manually provisioned and autoscale throughput, which is made using the
+// migrate APIs rather than by updating the throughput settings
+type ThroughputMigration int
+
+const (
+	ThroughputMigrationNone ThroughputMigration = iota
+	ThroughputMigrationToAutoscale
+	ThroughputMigrationToManual
+)
+
+func GetThroughputMigration(d *pluginsdk.ResourceData) ThroughputMigration {
+	if !d.HasChange("autoscale_settings") {
+		return ThroughputMigrationNone
 	}
 
-	return nil
+	old, new := d.GetChange("autoscale_settings")
+	hadAutoscale := len(old.([]interface{})) > 0
+	hasAutoscale := len(new.([]interface{})) > 0
+
+	switch {
+	case !hadAutoscale && hasAutoscale:
+		return ThroughputMigrationToAutoscale
+	case hadAutoscale && !hasAutoscale:
+		return ThroughputMigrationToManual
+	}
+
+	return ThroughputMigrationNone
 }
 
 func HasThroughputChange(d *pluginsdk.ResourceData) bool {
 	return d.HasChanges("throughput", "autoscale_settings")
 }
+
+// HasThroughputChangeAfterMigration returns whether the throughput settings need to be updated once the migration has
+// been made, since the service calculates the throughput to use when migrating
+func HasThroughputChangeAfterMigration(d *pluginsdk.ResourceData, migration ThroughputMigration) bool {
+	switch migration {
+	case ThroughputMigrationToAutoscale:
+		// `max_throughput` is computed, so is only set here when it's been specified
+		return d.Get("autoscale_settings.0.max_throughput").(int) > 0
+	case ThroughputMigrationToManual:
+		// `throughput` is computed, so the value from the configuration is checked
+		return !d.GetRawConfig().GetAttr("throughput").IsNull()
+	}
+
+	return HasThroughputChange(d)
+}
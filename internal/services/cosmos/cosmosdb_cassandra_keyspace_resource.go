@@ -136,11 +136,6 @@ func resourceCosmosDbCassandraKeyspaceUpdate(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Cassandra Keyspace %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.CassandraKeyspaceCreateUpdateParameters{
 		CassandraKeyspaceCreateUpdateProperties: &documentdb.CassandraKeyspaceCreateUpdateProperties{
 			Resource: &documentdb.CassandraKeyspaceResource{
@@ -159,7 +154,27 @@ func resourceCosmosDbCassandraKeyspaceUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("waiting on create/update future for Cosmos Cassandra Keyspace %q (Account: %q): %+v", id.ResourceGroup, id.DatabaseAccountName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateCassandraKeyspaceToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Cassandra Keyspace %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Cassandra Keyspace %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateCassandraKeyspaceToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Cassandra Keyspace %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Cassandra Keyspace %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateCassandraKeyspaceThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
@@ -163,11 +163,6 @@ func resourceCosmosDbCassandraTableUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
-	}
-
 	table := documentdb.CassandraTableCreateUpdateParameters{
 		CassandraTableCreateUpdateProperties: &documentdb.CassandraTableCreateUpdateProperties{
 			Resource: &documentdb.CassandraTableResource{
@@ -192,7 +187,27 @@ func resourceCosmosDbCassandraTableUpdate(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateCassandraTableToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+		if err != nil {
+			return fmt.Errorf("migrating %s to autoscale throughput: %+v", *id, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of %s to autoscale throughput: %+v", *id, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateCassandraTableToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+		if err != nil {
+			return fmt.Errorf("migrating %s to manual throughput: %+v", *id, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of %s to manual throughput: %+v", *id, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateCassandraTableThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName, *throughputParameters)
 		if err != nil {
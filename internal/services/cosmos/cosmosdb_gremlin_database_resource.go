@@ -136,11 +136,6 @@ func resourceCosmosGremlinDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Gremlin Database %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.GremlinDatabaseCreateUpdateParameters{
 		GremlinDatabaseCreateUpdateProperties: &documentdb.GremlinDatabaseCreateUpdateProperties{
 			Resource: &documentdb.GremlinDatabaseResource{
@@ -159,7 +154,27 @@ func resourceCosmosGremlinDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("waiting on create/update future for Cosmos Gremlin Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateGremlinDatabaseToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Gremlin Database %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Gremlin Database %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateGremlinDatabaseToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Gremlin Database %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Gremlin Database %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateGremlinDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
@@ -264,11 +264,6 @@ func resourceCosmosDbGremlinGraphUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Gremlin Graph %q (Account: %q, Database: %q): %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
-	}
-
 	partitionkeypaths := d.Get("partition_key_path").(string)
 
 	db := documentdb.GremlinGraphCreateUpdateParameters{
@@ -311,7 +306,27 @@ func resourceCosmosDbGremlinGraphUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting on create/update future for Cosmos Gremlin Graph %q (Account: %q, Database: %q): %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateGremlinGraphToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Gremlin Graph %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Gremlin Graph %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateGremlinGraphToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Gremlin Graph %q (Account: %q, Database: %q) to manual throughput: %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Gremlin Graph %q (Account: %q, Database: %q) to manual throughput: %+v", id.GraphName, id.DatabaseAccountName, id.GremlinDatabaseName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateGremlinGraphThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName, *throughputParameters)
 		if err != nil {
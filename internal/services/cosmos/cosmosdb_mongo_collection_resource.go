@@ -229,11 +229,6 @@ func resourceCosmosDbMongoCollectionUpdate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Mongo Collection %q (Account: %q, Database: %q): %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
-	}
-
 	var ttl *int
 	if v, ok := d.GetOk("default_ttl_seconds"); ok {
 		ttl = utils.Int(v.(int))
@@ -273,7 +268,27 @@ func resourceCosmosDbMongoCollectionUpdate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("waiting on create/update future for Cosmos Mongo Collection %q (Account: %q, Database: %q): %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateMongoDBCollectionToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Mongo Collection %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Mongo Collection %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateMongoDBCollectionToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Mongo Collection %q (Account: %q, Database: %q) to manual throughput: %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Mongo Collection %q (Account: %q, Database: %q) to manual throughput: %+v", id.CollectionName, id.DatabaseAccountName, id.MongodbDatabaseName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateMongoDBCollectionThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName, *throughputParameters)
 		if err != nil {
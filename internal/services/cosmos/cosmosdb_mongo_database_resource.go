@@ -136,11 +136,6 @@ func resourceCosmosDbMongoDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Mongo Database %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.MongoDBDatabaseCreateUpdateParameters{
 		MongoDBDatabaseCreateUpdateProperties: &documentdb.MongoDBDatabaseCreateUpdateProperties{
 			Resource: &documentdb.MongoDBDatabaseResource{
@@ -159,7 +154,27 @@ func resourceCosmosDbMongoDatabaseUpdate(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("waiting on create/update future for Cosmos Mongo Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateMongoDBDatabaseToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Mongo Database %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Mongo Database %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateMongoDBDatabaseToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Mongo Database %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Mongo Database %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateMongoDBDatabaseThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
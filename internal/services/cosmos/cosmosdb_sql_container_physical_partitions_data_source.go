@@ -0,0 +1,149 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSqlContainerPhysicalPartitionsDataSource struct{}
+
+var _ sdk.DataSource = CosmosDbSqlContainerPhysicalPartitionsDataSource{}
+
+type CosmosDbSqlContainerPhysicalPartitionsDataSourceModel struct {
+	ContainerId    string                      `tfschema:"container_id"`
+	IncludeStorage bool                        `tfschema:"include_storage"`
+	Partitions     []CosmosDbPhysicalPartition `tfschema:"partitions"`
+}
+
+type CosmosDbPhysicalPartition struct {
+	Id          string  `tfschema:"id"`
+	Throughput  int     `tfschema:"throughput"`
+	StorageInKB float64 `tfschema:"storage_in_kb"`
+}
+
+func (r CosmosDbSqlContainerPhysicalPartitionsDataSource) ResourceType() string {
+	return "azurerm_cosmosdb_sql_container_physical_partitions"
+}
+
+func (r CosmosDbSqlContainerPhysicalPartitionsDataSource) ModelObject() interface{} {
+	return &CosmosDbSqlContainerPhysicalPartitionsDataSourceModel{}
+}
+
+func (r CosmosDbSqlContainerPhysicalPartitionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.SqlContainerID,
+		},
+
+		"include_storage": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerPhysicalPartitionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"partitions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"throughput": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"storage_in_kb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerPhysicalPartitionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSqlContainerPartitionsWorkaroundClient(metadata.Client.Cosmos.SqlClient)
+
+			var model CosmosDbSqlContainerPhysicalPartitionsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.SqlContainerID(model.ContainerId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RetrieveThroughputDistribution(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, azuresdkhacks.RetrieveThroughputParameters{
+				Properties: &azuresdkhacks.RetrieveThroughputProperties{
+					Resource: &azuresdkhacks.RetrieveThroughputPropertiesResource{
+						PhysicalPartitionIds: []azuresdkhacks.PhysicalPartitionId{
+							{
+								Id: azuresdkhacks.AllPhysicalPartitionsId,
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving the throughput distribution for %s: %+v", id, err)
+			}
+
+			// the storage used by each partition is only available from a dry run of a partition merge
+			storage := make(map[string]float64)
+			if model.IncludeStorage {
+				mergeResp, err := client.Merge(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, azuresdkhacks.MergeParameters{
+					IsDryRun: utils.Bool(true),
+				})
+				if err != nil {
+					return fmt.Errorf("retrieving the storage used by the partitions of %s: %+v", id, err)
+				}
+
+				if mergeResp.PhysicalPartitionStorageInfoCollection != nil {
+					for _, v := range *mergeResp.PhysicalPartitionStorageInfoCollection {
+						if v.Id != nil && v.StorageInKB != nil {
+							storage[*v.Id] = *v.StorageInKB
+						}
+					}
+				}
+			}
+
+			model.Partitions = make([]CosmosDbPhysicalPartition, 0)
+			for _, v := range flattenCosmosDbPhysicalPartitionThroughput(resp) {
+				model.Partitions = append(model.Partitions, CosmosDbPhysicalPartition{
+					Id:          v.Id,
+					Throughput:  v.Throughput,
+					StorageInKB: storage[v.Id],
+				})
+			}
+
+			metadata.ResourceData.SetId(id.ID())
+			return metadata.Encode(&model)
+		},
+	}
+}
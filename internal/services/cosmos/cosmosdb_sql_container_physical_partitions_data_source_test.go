@@ -0,0 +1,37 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CosmosDbSqlContainerPhysicalPartitionsDataSource struct{}
+
+func TestAccCosmosDbSqlContainerPhysicalPartitionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_sql_container_physical_partitions", "test")
+	r := CosmosDbSqlContainerPhysicalPartitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("partitions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("partitions.0.id").HasValue("0"),
+				check.That(data.ResourceName).Key("partitions.0.throughput").Exists(),
+			),
+		},
+	})
+}
+
+func (CosmosDbSqlContainerPhysicalPartitionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_sql_container_physical_partitions" "test" {
+  container_id = azurerm_cosmosdb_sql_container.test.id
+}
+`, CosmosDbSqlContainerThroughputRedistributionResource{}.template(data))
+}
@@ -235,11 +235,6 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
-	}
-
 	partitionkeypaths := d.Get("partition_key_path").(string)
 
 	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
@@ -292,7 +287,27 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting on create/update future for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateSQLContainerToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos SQL Container %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos SQL Container %q (Account: %q, Database: %q) to autoscale throughput: %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateSQLContainerToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos SQL Container %q (Account: %q, Database: %q) to manual throughput: %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos SQL Container %q (Account: %q, Database: %q) to manual throughput: %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, *throughputParameters)
 		if err != nil {
@@ -0,0 +1,325 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSqlContainerThroughputRedistributionModel struct {
+	ContainerId      string                                `tfschema:"container_id"`
+	TargetPartitions []CosmosDbPhysicalPartitionThroughput `tfschema:"target_partition"`
+	SourcePartitions []CosmosDbPhysicalPartitionThroughput `tfschema:"source_partition"`
+	Partitions       []CosmosDbPhysicalPartitionThroughput `tfschema:"partitions"`
+}
+
+type CosmosDbPhysicalPartitionThroughput struct {
+	Id         string `tfschema:"id"`
+	Throughput int    `tfschema:"throughput"`
+}
+
+type CosmosDbSqlContainerThroughputRedistributionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbSqlContainerThroughputRedistributionResource{}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) ResourceType() string {
+	return "azurerm_cosmosdb_sql_container_throughput_redistribution"
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) ModelObject() interface{} {
+	return &CosmosDbSqlContainerThroughputRedistributionModel{}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SqlContainerThroughputSettingID
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SqlContainerID,
+		},
+
+		"target_partition": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"throughput": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"source_partition": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"throughput": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"partitions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"throughput": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSqlContainerPartitionsWorkaroundClient(metadata.Client.Cosmos.SqlClient)
+
+			var model CosmosDbSqlContainerThroughputRedistributionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := parse.SqlContainerID(model.ContainerId)
+			if err != nil {
+				return err
+			}
+
+			// the throughput distribution always exists for a container with dedicated throughput, so there's no
+			// existing resource to check for here
+			id := parse.NewSqlContainerThroughputSettingID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.DatabaseAccountName, containerId.SqlDatabaseName, containerId.ContainerName, "default")
+
+			parameters := expandCosmosDbSqlContainerThroughputRedistribution(azuresdkhacks.ThroughputPolicyTypeCustom, model.TargetPartitions, model.SourcePartitions)
+			if _, err := client.RedistributeThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, parameters); err != nil {
+				return fmt.Errorf("redistributing the throughput for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSqlContainerPartitionsWorkaroundClient(metadata.Client.Cosmos.SqlClient)
+
+			id, err := parse.SqlContainerThroughputSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbSqlContainerThroughputRedistributionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("target_partition", "source_partition") {
+				parameters := expandCosmosDbSqlContainerThroughputRedistribution(azuresdkhacks.ThroughputPolicyTypeCustom, model.TargetPartitions, model.SourcePartitions)
+				if _, err := client.RedistributeThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, parameters); err != nil {
+					return fmt.Errorf("redistributing the throughput for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			sqlClient := metadata.Client.Cosmos.SqlClient
+			client := azuresdkhacks.NewSqlContainerPartitionsWorkaroundClient(sqlClient)
+
+			id, err := parse.SqlContainerThroughputSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerId := parse.NewSqlContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+			existing, err := sqlClient.GetSQLContainer(ctx, containerId.ResourceGroup, containerId.DatabaseAccountName, containerId.SqlDatabaseName, containerId.ContainerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+
+			var state CosmosDbSqlContainerThroughputRedistributionModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.RetrieveThroughputDistribution(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, azuresdkhacks.RetrieveThroughputParameters{
+				Properties: &azuresdkhacks.RetrieveThroughputProperties{
+					Resource: &azuresdkhacks.RetrieveThroughputPropertiesResource{
+						PhysicalPartitionIds: []azuresdkhacks.PhysicalPartitionId{
+							{
+								Id: azuresdkhacks.AllPhysicalPartitionsId,
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("retrieving the throughput distribution for %s: %+v", *id, err)
+			}
+
+			state.ContainerId = containerId.ID()
+			state.Partitions = flattenCosmosDbPhysicalPartitionThroughput(resp)
+
+			// the throughput of the target partitions is refreshed so that the redistribution is re-applied when it's
+			// been changed outside of Terraform, the source partitions only describe where the throughput is taken from
+			for i, target := range state.TargetPartitions {
+				for _, partition := range state.Partitions {
+					if partition.Id == target.Id {
+						state.TargetPartitions[i].Throughput = partition.Throughput
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSqlContainerPartitionsWorkaroundClient(metadata.Client.Cosmos.SqlClient)
+
+			id, err := parse.SqlContainerThroughputSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the throughput can't be removed from the partitions, so it's distributed evenly across them again
+			parameters := expandCosmosDbSqlContainerThroughputRedistribution(azuresdkhacks.ThroughputPolicyTypeEqual, nil, nil)
+			if _, err := client.RedistributeThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, parameters); err != nil {
+				return fmt.Errorf("resetting the throughput distribution for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbSqlContainerThroughputRedistribution(policy azuresdkhacks.ThroughputPolicyType, targets []CosmosDbPhysicalPartitionThroughput, sources []CosmosDbPhysicalPartitionThroughput) azuresdkhacks.RedistributeThroughputParameters {
+	return azuresdkhacks.RedistributeThroughputParameters{
+		Properties: &azuresdkhacks.RedistributeThroughputProperties{
+			Resource: &azuresdkhacks.RedistributeThroughputPropertiesResource{
+				ThroughputPolicy:                      policy,
+				TargetPhysicalPartitionThroughputInfo: expandCosmosDbPhysicalPartitionThroughput(targets),
+				SourcePhysicalPartitionThroughputInfo: expandCosmosDbPhysicalPartitionThroughput(sources),
+			},
+		},
+	}
+}
+
+func expandCosmosDbPhysicalPartitionThroughput(input []CosmosDbPhysicalPartitionThroughput) []azuresdkhacks.PhysicalPartitionThroughputInfo {
+	output := make([]azuresdkhacks.PhysicalPartitionThroughputInfo, 0)
+	for _, v := range input {
+		item := azuresdkhacks.PhysicalPartitionThroughputInfo{
+			Id: utils.String(v.Id),
+		}
+		// the throughput of a source partition can be omitted to take as much throughput from it as is needed
+		if v.Throughput > 0 {
+			item.Throughput = utils.Float(float64(v.Throughput))
+		}
+		output = append(output, item)
+	}
+	return output
+}
+
+func flattenCosmosDbPhysicalPartitionThroughput(input azuresdkhacks.PhysicalPartitionThroughputInfoResult) []CosmosDbPhysicalPartitionThroughput {
+	output := make([]CosmosDbPhysicalPartitionThroughput, 0)
+	if input.Properties == nil || input.Properties.Resource == nil || input.Properties.Resource.PhysicalPartitionThroughputInfo == nil {
+		return output
+	}
+
+	for _, v := range *input.Properties.Resource.PhysicalPartitionThroughputInfo {
+		if v.Id == nil {
+			continue
+		}
+
+		partition := CosmosDbPhysicalPartitionThroughput{
+			Id: *v.Id,
+		}
+		if v.Throughput != nil {
+			partition.Throughput = int(*v.Throughput)
+		}
+		output = append(output, partition)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return cosmosDbPhysicalPartitionIdLess(output[i].Id, output[j].Id)
+	})
+
+	return output
+}
+
+// cosmosDbPhysicalPartitionIdLess orders the IDs of physical partitions numerically, since they're numbers returned as
+// strings
+func cosmosDbPhysicalPartitionIdLess(first, second string) bool {
+	firstValue, firstErr := strconv.Atoi(first)
+	secondValue, secondErr := strconv.Atoi(second)
+	if firstErr != nil || secondErr != nil {
+		return first < second
+	}
+	return firstValue < secondValue
+}
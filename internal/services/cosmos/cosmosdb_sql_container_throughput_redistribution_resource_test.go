@@ -0,0 +1,108 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSqlContainerThroughputRedistributionResource struct{}
+
+func TestAccCosmosDbSqlContainerThroughputRedistribution_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container_throughput_redistribution", "test")
+	r := CosmosDbSqlContainerThroughputRedistributionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 7000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_partition.0.throughput").HasValue("7000"),
+				check.That(data.ResourceName).Key("partitions.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("target_partition", "source_partition"),
+	})
+}
+
+func TestAccCosmosDbSqlContainerThroughputRedistribution_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_container_throughput_redistribution", "test")
+	r := CosmosDbSqlContainerThroughputRedistributionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 7000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("target_partition", "source_partition"),
+		{
+			Config: r.basic(data, 8000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_partition.0.throughput").HasValue("8000"),
+			),
+		},
+		data.ImportStep("target_partition", "source_partition"),
+	})
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SqlContainerThroughputSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cosmos.SqlClient.GetSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) template(data acceptance.TestData) string {
+	// 12,000 RU/s is provisioned so that the container has two physical partitions
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+  throughput          = 12000
+}
+`, CosmosSqlDatabaseResource{}.basic(data), data.RandomInteger)
+}
+
+func (r CosmosDbSqlContainerThroughputRedistributionResource) basic(data acceptance.TestData, throughput int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_container_throughput_redistribution" "test" {
+  container_id = azurerm_cosmosdb_sql_container.test.id
+
+  target_partition {
+    id         = "0"
+    throughput = %d
+  }
+
+  source_partition {
+    id = "1"
+  }
+}
+`, r.template(data), throughput)
+}
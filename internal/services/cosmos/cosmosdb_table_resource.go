@@ -136,11 +136,6 @@ func resourceCosmosDbTableUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	err = common.CheckForChangeFromAutoscaleAndManualThroughput(d)
-	if err != nil {
-		return fmt.Errorf("updating Cosmos Table %q (Account: %q) - %+v", id.Name, id.DatabaseAccountName, err)
-	}
-
 	db := documentdb.TableCreateUpdateParameters{
 		TableCreateUpdateProperties: &documentdb.TableCreateUpdateProperties{
 			Resource: &documentdb.TableResource{
@@ -159,7 +154,27 @@ func resourceCosmosDbTableUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("waiting on create/update future for Cosmos Table %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
-	if common.HasThroughputChange(d) {
+	migration := common.GetThroughputMigration(d)
+	switch migration {
+	case common.ThroughputMigrationToAutoscale:
+		migrateFuture, err := client.MigrateTableToAutoscale(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Table %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Table %q (Account: %q) to autoscale throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	case common.ThroughputMigrationToManual:
+		migrateFuture, err := client.MigrateTableToManualThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+		if err != nil {
+			return fmt.Errorf("migrating Cosmos Table %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+		if err = migrateFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on migration of Cosmos Table %q (Account: %q) to manual throughput: %+v", id.Name, id.DatabaseAccountName, err)
+		}
+	}
+
+	if common.HasThroughputChangeAfterMigration(d, migration) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
 		throughputFuture, err := client.UpdateTableThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name, *throughputParameters)
 		if err != nil {
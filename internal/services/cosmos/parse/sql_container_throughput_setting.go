@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SqlContainerThroughputSettingId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	DatabaseAccountName   string
+	SqlDatabaseName       string
+	ContainerName         string
+	ThroughputSettingName string
+}
+
+func NewSqlContainerThroughputSettingID(subscriptionId, resourceGroup, databaseAccountName, sqlDatabaseName, containerName, throughputSettingName string) SqlContainerThroughputSettingId {
+	return SqlContainerThroughputSettingId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		DatabaseAccountName:   databaseAccountName,
+		SqlDatabaseName:       sqlDatabaseName,
+		ContainerName:         containerName,
+		ThroughputSettingName: throughputSettingName,
+	}
+}
+
+func (id SqlContainerThroughputSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Throughput Setting Name %q", id.ThroughputSettingName),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Sql Database Name %q", id.SqlDatabaseName),
+		fmt.Sprintf("Database Account Name %q", id.DatabaseAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Sql Container Throughput Setting", segmentsStr)
+}
+
+func (id SqlContainerThroughputSettingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/sqlDatabases/%s/containers/%s/throughputSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, id.ThroughputSettingName)
+}
+
+// SqlContainerThroughputSettingID parses a SqlContainerThroughputSetting ID into an SqlContainerThroughputSettingId struct
+func SqlContainerThroughputSettingID(input string) (*SqlContainerThroughputSettingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SqlContainerThroughputSettingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.ThroughputSettingName, err = id.PopSegment("throughputSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -17,11 +17,14 @@ func (r Registration) AssociatedGitHubLabel() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		CosmosDbSqlContainerPhysicalPartitionsDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		CosmosDbSqlContainerThroughputRedistributionResource{},
 		CosmosDbSqlDedicatedGatewayResource{},
 	}
 }
@@ -0,0 +1,30 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes `databricks/2024-05-01/workspaces`
+// the `enhancedSecurityCompliance` property of a Workspace (the Compliance Security Profile, Enhanced Security
+// Monitoring and Automatic Cluster Update settings) is only available from API Version `2024-05-01`, which isn't
+// included in the vendored SDK, so the Workspace is created/updated and retrieved using this client.
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/workspaces/%s", defaultApiVersion)
+}
+
+type WorkspacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspacesClientWithBaseURI(endpoint string) WorkspacesClient {
+	return WorkspacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,79 @@
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
+)
+
+type AutomaticClusterUpdateValue string
+
+const (
+	AutomaticClusterUpdateValueDisabled AutomaticClusterUpdateValue = "Disabled"
+	AutomaticClusterUpdateValueEnabled  AutomaticClusterUpdateValue = "Enabled"
+)
+
+type ComplianceSecurityProfileValue string
+
+const (
+	ComplianceSecurityProfileValueDisabled ComplianceSecurityProfileValue = "Disabled"
+	ComplianceSecurityProfileValueEnabled  ComplianceSecurityProfileValue = "Enabled"
+)
+
+type ComplianceStandard string
+
+const (
+	ComplianceStandardHIPAA  ComplianceStandard = "HIPAA"
+	ComplianceStandardNONE   ComplianceStandard = "NONE"
+	ComplianceStandardPCIDSS ComplianceStandard = "PCI_DSS"
+)
+
+func PossibleValuesForComplianceStandard() []string {
+	return []string{
+		string(ComplianceStandardHIPAA),
+		string(ComplianceStandardNONE),
+		string(ComplianceStandardPCIDSS),
+	}
+}
+
+type EnhancedSecurityMonitoringValue string
+
+const (
+	EnhancedSecurityMonitoringValueDisabled EnhancedSecurityMonitoringValue = "Disabled"
+	EnhancedSecurityMonitoringValueEnabled  EnhancedSecurityMonitoringValue = "Enabled"
+)
+
+type Workspace struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties WorkspaceProperties    `json:"properties"`
+	Sku        *workspaces.Sku        `json:"sku,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type WorkspaceProperties struct {
+	workspaces.WorkspaceProperties
+
+	EnhancedSecurityCompliance *EnhancedSecurityComplianceDefinition `json:"enhancedSecurityCompliance,omitempty"`
+}
+
+type EnhancedSecurityComplianceDefinition struct {
+	AutomaticClusterUpdate     *AutomaticClusterUpdateDefinition     `json:"automaticClusterUpdate,omitempty"`
+	ComplianceSecurityProfile  *ComplianceSecurityProfileDefinition  `json:"complianceSecurityProfile,omitempty"`
+	EnhancedSecurityMonitoring *EnhancedSecurityMonitoringDefinition `json:"enhancedSecurityMonitoring,omitempty"`
+}
+
+type AutomaticClusterUpdateDefinition struct {
+	Value *AutomaticClusterUpdateValue `json:"value,omitempty"`
+}
+
+type ComplianceSecurityProfileDefinition struct {
+	ComplianceStandards *[]ComplianceStandard           `json:"complianceStandards,omitempty"`
+	Value               *ComplianceSecurityProfileValue `json:"value,omitempty"`
+}
+
+type EnhancedSecurityMonitoringDefinition struct {
+	Value *EnhancedSecurityMonitoringValue `json:"value,omitempty"`
+}
@@ -4,11 +4,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-04-01-preview/accessconnector"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/azuresdkhacks"
 )
 
 type Client struct {
 	AccessConnectorClient *accessconnector.AccessConnectorClient
 	WorkspacesClient      *workspaces.WorkspacesClient
+
+	WorkspacesWorkaroundClient *azuresdkhacks.WorkspacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -16,9 +19,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&AccessConnectorClient.Client, o.ResourceManagerAuthorizer)
 	WorkspacesClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&WorkspacesClient.Client, o.ResourceManagerAuthorizer)
+	WorkspacesWorkaroundClient := azuresdkhacks.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspacesWorkaroundClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AccessConnectorClient: &AccessConnectorClient,
 		WorkspacesClient:      &WorkspacesClient,
+
+		WorkspacesWorkaroundClient: &WorkspacesWorkaroundClient,
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -120,6 +121,45 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				},
 			},
 
+			"enhanced_security_compliance": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"automatic_cluster_update_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_standards": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(azuresdkhacks.ComplianceStandardHIPAA),
+									string(azuresdkhacks.ComplianceStandardPCIDSS),
+								}, false),
+							},
+						},
+
+						"enhanced_security_monitoring_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"managed_disk_cmk_rotation_to_latest_version_enabled": {
 				Type:         pluginsdk.TypeBool,
 				Optional:     true,
@@ -344,6 +384,29 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled', 'managed_disk_cmk_key_vault_key_id' and 'managed_services_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
 			}
 
+			if v, ok := d.GetOk("enhanced_security_compliance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				if !strings.EqualFold("premium", newSku.(string)) {
+					return fmt.Errorf("'enhanced_security_compliance' is only available with a 'premium' workspace 'sku', got %q", newSku)
+				}
+
+				config := v.([]interface{})[0].(map[string]interface{})
+				complianceSecurityProfileEnabled := config["compliance_security_profile_enabled"].(bool)
+				if !complianceSecurityProfileEnabled && !config["automatic_cluster_update_enabled"].(bool) && !config["enhanced_security_monitoring_enabled"].(bool) {
+					return fmt.Errorf("at least one of 'automatic_cluster_update_enabled', 'compliance_security_profile_enabled' or 'enhanced_security_monitoring_enabled' must be set to 'true' when 'enhanced_security_compliance' is specified")
+				}
+				if complianceSecurityProfileEnabled && (!config["automatic_cluster_update_enabled"].(bool) || !config["enhanced_security_monitoring_enabled"].(bool)) {
+					return fmt.Errorf("'automatic_cluster_update_enabled' and 'enhanced_security_monitoring_enabled' must be set to 'true' when 'compliance_security_profile_enabled' is set to 'true'")
+				}
+				if !complianceSecurityProfileEnabled && config["compliance_security_profile_standards"].(*pluginsdk.Set).Len() > 0 {
+					return fmt.Errorf("'compliance_security_profile_standards' cannot be set when 'compliance_security_profile_enabled' is set to 'false'")
+				}
+			}
+
+			// the Compliance Security Profile can't be disabled once it has been enabled on a Workspace
+			if oldProfile, newProfile := d.GetChange("enhanced_security_compliance.0.compliance_security_profile_enabled"); oldProfile.(bool) && !newProfile.(bool) {
+				d.ForceNew("enhanced_security_compliance.0.compliance_security_profile_enabled")
+			}
+
 			return nil
 		}),
 	}
@@ -351,6 +414,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 
 func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	workaroundClient := meta.(*clients.Client).DataBricks.WorkspacesWorkaroundClient
 	lbClient := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -504,19 +568,34 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	// Including the Tags in the workspace parameters will update the tags on
 	// the workspace only
-	workspace := workspaces.Workspace{
+	workspace := azuresdkhacks.Workspace{
 		Sku: &workspaces.Sku{
 			Name: skuName,
 		},
 		Location: location,
-		Properties: workspaces.WorkspaceProperties{
-			PublicNetworkAccess:    &publicNetworkAccess,
-			ManagedResourceGroupId: managedResourceGroupID,
-			Parameters:             customParams,
+		Properties: azuresdkhacks.WorkspaceProperties{
+			WorkspaceProperties: workspaces.WorkspaceProperties{
+				PublicNetworkAccess:    &publicNetworkAccess,
+				ManagedResourceGroupId: managedResourceGroupID,
+				Parameters:             customParams,
+			},
+			EnhancedSecurityCompliance: expandWorkspaceEnhancedSecurityCompliance(d.Get("enhanced_security_compliance").([]interface{})),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	// removing the block disables the settings, which otherwise would be left as-is by the API
+	if workspace.Properties.EnhancedSecurityCompliance == nil && !d.IsNewResource() && d.HasChange("enhanced_security_compliance") {
+		workspace.Properties.EnhancedSecurityCompliance = expandWorkspaceEnhancedSecurityCompliance([]interface{}{
+			map[string]interface{}{
+				"automatic_cluster_update_enabled":      false,
+				"compliance_security_profile_enabled":   false,
+				"compliance_security_profile_standards": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+				"enhanced_security_monitoring_enabled":  false,
+			},
+		})
+	}
+
 	if requireNsgRules != "" {
 		requiredNsgRulesConst := workspaces.RequiredNsgRules(requireNsgRules)
 		workspace.Properties.RequiredNsgRules = &requiredNsgRulesConst
@@ -526,7 +605,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		workspace.Properties.Encryption = encrypt
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, workspace); err != nil {
+	if err := workaroundClient.CreateOrUpdateThenPoll(ctx, id, workspace); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -569,7 +648,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 }
 
 func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	client := meta.(*clients.Client).DataBricks.WorkspacesWorkaroundClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -657,6 +736,10 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("workspace_id", model.Properties.WorkspaceId)
 		}
 
+		if err := d.Set("enhanced_security_compliance", flattenWorkspaceEnhancedSecurityCompliance(model.Properties.EnhancedSecurityCompliance)); err != nil {
+			return fmt.Errorf("setting `enhanced_security_compliance`: %+v", err)
+		}
+
 		// customer managed key for managed services
 		encryptKeyName := ""
 		encryptKeyVersion := ""
@@ -724,6 +807,96 @@ func resourceDatabricksWorkspaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func expandWorkspaceEnhancedSecurityCompliance(input []interface{}) *azuresdkhacks.EnhancedSecurityComplianceDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	config := input[0].(map[string]interface{})
+
+	automaticClusterUpdate := azuresdkhacks.AutomaticClusterUpdateValueDisabled
+	if config["automatic_cluster_update_enabled"].(bool) {
+		automaticClusterUpdate = azuresdkhacks.AutomaticClusterUpdateValueEnabled
+	}
+
+	complianceSecurityProfile := azuresdkhacks.ComplianceSecurityProfileValueDisabled
+	if config["compliance_security_profile_enabled"].(bool) {
+		complianceSecurityProfile = azuresdkhacks.ComplianceSecurityProfileValueEnabled
+	}
+
+	complianceStandards := make([]azuresdkhacks.ComplianceStandard, 0)
+	for _, standard := range config["compliance_security_profile_standards"].(*pluginsdk.Set).List() {
+		complianceStandards = append(complianceStandards, azuresdkhacks.ComplianceStandard(standard.(string)))
+	}
+	if complianceSecurityProfile == azuresdkhacks.ComplianceSecurityProfileValueEnabled && len(complianceStandards) == 0 {
+		complianceStandards = append(complianceStandards, azuresdkhacks.ComplianceStandardNONE)
+	}
+
+	enhancedSecurityMonitoring := azuresdkhacks.EnhancedSecurityMonitoringValueDisabled
+	if config["enhanced_security_monitoring_enabled"].(bool) {
+		enhancedSecurityMonitoring = azuresdkhacks.EnhancedSecurityMonitoringValueEnabled
+	}
+
+	return &azuresdkhacks.EnhancedSecurityComplianceDefinition{
+		AutomaticClusterUpdate: &azuresdkhacks.AutomaticClusterUpdateDefinition{
+			Value: &automaticClusterUpdate,
+		},
+		ComplianceSecurityProfile: &azuresdkhacks.ComplianceSecurityProfileDefinition{
+			ComplianceStandards: &complianceStandards,
+			Value:               &complianceSecurityProfile,
+		},
+		EnhancedSecurityMonitoring: &azuresdkhacks.EnhancedSecurityMonitoringDefinition{
+			Value: &enhancedSecurityMonitoring,
+		},
+	}
+}
+
+func flattenWorkspaceEnhancedSecurityCompliance(input *azuresdkhacks.EnhancedSecurityComplianceDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	automaticClusterUpdateEnabled := false
+	if v := input.AutomaticClusterUpdate; v != nil && v.Value != nil {
+		automaticClusterUpdateEnabled = *v.Value == azuresdkhacks.AutomaticClusterUpdateValueEnabled
+	}
+
+	complianceSecurityProfileEnabled := false
+	complianceStandards := make([]interface{}, 0)
+	if v := input.ComplianceSecurityProfile; v != nil {
+		if v.Value != nil {
+			complianceSecurityProfileEnabled = *v.Value == azuresdkhacks.ComplianceSecurityProfileValueEnabled
+		}
+		if v.ComplianceStandards != nil {
+			for _, standard := range *v.ComplianceStandards {
+				// `NONE` is returned when the Compliance Security Profile is enabled without any standards
+				if standard != azuresdkhacks.ComplianceStandardNONE {
+					complianceStandards = append(complianceStandards, string(standard))
+				}
+			}
+		}
+	}
+
+	enhancedSecurityMonitoringEnabled := false
+	if v := input.EnhancedSecurityMonitoring; v != nil && v.Value != nil {
+		enhancedSecurityMonitoringEnabled = *v.Value == azuresdkhacks.EnhancedSecurityMonitoringValueEnabled
+	}
+
+	// the API returns these settings as disabled for every Workspace, so they're only set when something is enabled
+	if !automaticClusterUpdateEnabled && !complianceSecurityProfileEnabled && !enhancedSecurityMonitoringEnabled && len(complianceStandards) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"automatic_cluster_update_enabled":      automaticClusterUpdateEnabled,
+			"compliance_security_profile_enabled":   complianceSecurityProfileEnabled,
+			"compliance_security_profile_standards": complianceStandards,
+			"enhanced_security_monitoring_enabled":  enhancedSecurityMonitoringEnabled,
+		},
+	}
+}
+
 func flattenWorkspaceManagedIdentity(input *workspaces.ManagedIdentityConfiguration) []interface{} {
 	if input == nil {
 		return nil
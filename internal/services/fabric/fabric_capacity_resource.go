@@ -0,0 +1,284 @@
+package fabric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/sdk/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type FabricCapacityModel struct {
+	Name                  string            `tfschema:"name"`
+	ResourceGroupName     string            `tfschema:"resource_group_name"`
+	Location              string            `tfschema:"location"`
+	SkuName               string            `tfschema:"sku_name"`
+	AdministrationMembers []string          `tfschema:"administration_members"`
+	SuspendedEnabled      bool              `tfschema:"suspended_enabled"`
+	Tags                  map[string]string `tfschema:"tags"`
+}
+
+type FabricCapacityResource struct{}
+
+var _ sdk.ResourceWithUpdate = FabricCapacityResource{}
+
+func (r FabricCapacityResource) ResourceType() string {
+	return "azurerm_fabric_capacity"
+}
+
+func (r FabricCapacityResource) ModelObject() interface{} {
+	return &FabricCapacityModel{}
+}
+
+func (r FabricCapacityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fabriccapacities.ValidateCapacityID
+}
+
+func (r FabricCapacityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CapacityName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"F2",
+				"F4",
+				"F8",
+				"F16",
+				"F32",
+				"F64",
+				"F128",
+				"F256",
+				"F512",
+				"F1024",
+				"F2048",
+			}, false),
+		},
+
+		"administration_members": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"suspended_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r FabricCapacityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FabricCapacityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.CapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model FabricCapacityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fabriccapacities.NewCapacityID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fabriccapacities.FabricCapacity{
+				Location: location.Normalize(model.Location),
+				Properties: fabriccapacities.FabricCapacityProperties{
+					Administration: fabriccapacities.CapacityAdministration{
+						Members: model.AdministrationMembers,
+					},
+				},
+				Sku: fabriccapacities.RpSku{
+					Name: model.SkuName,
+					Tier: fabriccapacities.RpSkuTierFabric,
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			// a Capacity is always active once it's been created, so it has to be suspended afterwards
+			if model.SuspendedEnabled {
+				if err := client.SuspendThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("suspending %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FabricCapacityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.CapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := FabricCapacityModel{
+				Name:              id.CapacityName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.SkuName = model.Sku.Name
+				state.AdministrationMembers = model.Properties.Administration.Members
+				state.SuspendedEnabled = fabricCapacityIsSuspended(model.Properties.State)
+				state.Tags = pointer.From(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FabricCapacityResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.CapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model FabricCapacityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Capacity has to be active for it to be scaled, so it's resumed before it's updated and suspended afterwards
+			if metadata.ResourceData.HasChange("suspended_enabled") && !model.SuspendedEnabled {
+				if err := client.ResumeThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("resuming %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("administration_members", "sku_name", "tags") {
+				payload := fabriccapacities.FabricCapacityUpdate{}
+
+				if metadata.ResourceData.HasChange("administration_members") {
+					payload.Properties = &fabriccapacities.FabricCapacityUpdateProperties{
+						Administration: &fabriccapacities.CapacityAdministration{
+							Members: model.AdministrationMembers,
+						},
+					}
+				}
+
+				if metadata.ResourceData.HasChange("sku_name") {
+					payload.Sku = &fabriccapacities.RpSku{
+						Name: model.SkuName,
+						Tier: fabriccapacities.RpSkuTierFabric,
+					}
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					payload.Tags = pointer.To(model.Tags)
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("suspended_enabled") && model.SuspendedEnabled {
+				if err := client.SuspendThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("suspending %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FabricCapacityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.CapacitiesClient
+
+			id, err := fabriccapacities.ParseCapacityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func fabricCapacityIsSuspended(input *fabriccapacities.ResourceState) bool {
+	if input == nil {
+		return false
+	}
+
+	switch *input {
+	case fabriccapacities.ResourceStatePaused, fabriccapacities.ResourceStatePausing, fabriccapacities.ResourceStateSuspended, fabriccapacities.ResourceStateSuspending:
+		return true
+	}
+
+	return false
+}
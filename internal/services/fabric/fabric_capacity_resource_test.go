@@ -0,0 +1,198 @@
+package fabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fabric/sdk/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FabricCapacityResource struct{}
+
+func TestAccFabricCapacity_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFabricCapacity_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFabricCapacity_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFabricCapacity_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFabricCapacity_suspended(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suspended_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suspended_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("suspended_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FabricCapacityResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fabriccapacities.ParseCapacityID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Fabric.CapacitiesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (FabricCapacityResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fabric-%d"
+  location = "%s"
+}
+
+data "azurerm_client_config" "test" {}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FabricCapacityResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_fabric_capacity" "test" {
+  name                   = "acctestfc%[2]d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  sku_name               = "F2"
+  administration_members = [data.azurerm_client_config.test.object_id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r FabricCapacityResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_fabric_capacity" "import" {
+  name                   = azurerm_fabric_capacity.test.name
+  location               = azurerm_fabric_capacity.test.location
+  resource_group_name    = azurerm_fabric_capacity.test.resource_group_name
+  sku_name               = azurerm_fabric_capacity.test.sku_name
+  administration_members = azurerm_fabric_capacity.test.administration_members
+}
+`, r.basic(data))
+}
+
+func (r FabricCapacityResource) complete(data acceptance.TestData, suspended bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_fabric_capacity" "test" {
+  name                   = "acctestfc%[2]d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  sku_name               = "F4"
+  administration_members = [data.azurerm_client_config.test.object_id]
+  suspended_enabled      = %[3]t
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, suspended)
+}
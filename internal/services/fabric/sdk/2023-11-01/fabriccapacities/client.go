@@ -0,0 +1,18 @@
+package fabriccapacities
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FabricCapacitiesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFabricCapacitiesClientWithBaseURI(endpoint string) FabricCapacitiesClient {
+	return FabricCapacitiesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,126 @@
+package fabriccapacities
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":     ProvisioningStateCanceled,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ResourceState string
+
+const (
+	ResourceStateActive       ResourceState = "Active"
+	ResourceStateDeleting     ResourceState = "Deleting"
+	ResourceStateFailed       ResourceState = "Failed"
+	ResourceStatePaused       ResourceState = "Paused"
+	ResourceStatePausing      ResourceState = "Pausing"
+	ResourceStatePreparing    ResourceState = "Preparing"
+	ResourceStateProvisioning ResourceState = "Provisioning"
+	ResourceStateResuming     ResourceState = "Resuming"
+	ResourceStateScaling      ResourceState = "Scaling"
+	ResourceStateSuspended    ResourceState = "Suspended"
+	ResourceStateSuspending   ResourceState = "Suspending"
+)
+
+func PossibleValuesForResourceState() []string {
+	return []string{
+		string(ResourceStateActive),
+		string(ResourceStateDeleting),
+		string(ResourceStateFailed),
+		string(ResourceStatePaused),
+		string(ResourceStatePausing),
+		string(ResourceStatePreparing),
+		string(ResourceStateProvisioning),
+		string(ResourceStateResuming),
+		string(ResourceStateScaling),
+		string(ResourceStateSuspended),
+		string(ResourceStateSuspending),
+	}
+}
+
+func parseResourceState(input string) (*ResourceState, error) {
+	vals := map[string]ResourceState{
+		"active":       ResourceStateActive,
+		"deleting":     ResourceStateDeleting,
+		"failed":       ResourceStateFailed,
+		"paused":       ResourceStatePaused,
+		"pausing":      ResourceStatePausing,
+		"preparing":    ResourceStatePreparing,
+		"provisioning": ResourceStateProvisioning,
+		"resuming":     ResourceStateResuming,
+		"scaling":      ResourceStateScaling,
+		"suspended":    ResourceStateSuspended,
+		"suspending":   ResourceStateSuspending,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceState(input)
+	return &out, nil
+}
+
+type RpSkuTier string
+
+const (
+	RpSkuTierFabric RpSkuTier = "Fabric"
+)
+
+func PossibleValuesForRpSkuTier() []string {
+	return []string{
+		string(RpSkuTierFabric),
+	}
+}
+
+func parseRpSkuTier(input string) (*RpSkuTier, error) {
+	vals := map[string]RpSkuTier{
+		"fabric": RpSkuTierFabric,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RpSkuTier(input)
+	return &out, nil
+}
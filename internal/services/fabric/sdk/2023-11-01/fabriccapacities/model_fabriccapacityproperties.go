@@ -0,0 +1,10 @@
+package fabriccapacities
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FabricCapacityProperties struct {
+	Administration    CapacityAdministration `json:"administration"`
+	ProvisioningState *ProvisioningState     `json:"provisioningState,omitempty"`
+	State             *ResourceState         `json:"state,omitempty"`
+}
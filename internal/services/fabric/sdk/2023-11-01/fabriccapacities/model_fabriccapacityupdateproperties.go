@@ -0,0 +1,8 @@
+package fabriccapacities
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FabricCapacityUpdateProperties struct {
+	Administration *CapacityAdministration `json:"administration,omitempty"`
+}
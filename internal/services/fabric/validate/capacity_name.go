@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func CapacityName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z][a-z0-9]{2,62}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters in length, start with a lowercase letter and contain only lowercase letters or numbers", k))
+	}
+
+	return warnings, errors
+}
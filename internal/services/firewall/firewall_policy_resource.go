@@ -1,8 +1,13 @@
 package firewall
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -16,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -47,6 +53,16 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 		},
 
 		Schema: resourceFirewallPolicySchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the Firewall Policy accesses the Key Vault containing the TLS Inspection certificate using its Managed Identity
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if len(diff.Get("tls_certificate").([]interface{})) > 0 && len(diff.Get("identity").([]interface{})) == 0 {
+					return fmt.Errorf("an `identity` block must be specified when `tls_certificate` is specified, since the Firewall Policy uses this Managed Identity to retrieve the certificate from the Key Vault")
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -75,12 +91,17 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
+	intrusionDetection, err := expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `intrusion_detection`: %+v", err)
+	}
+
 	props := network.FirewallPolicy{
 		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
 			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
-			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			IntrusionDetection:   intrusionDetection,
 			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
 			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
 			ExplicitProxy:        expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
@@ -183,7 +204,7 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "dns": %+v`, err)
 		}
 
-		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection)); err != nil {
+		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection, d.Get("intrusion_detection.0.signature_overrides_document").(string))); err != nil {
 			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
 		}
 
@@ -298,9 +319,9 @@ func expandFirewallPolicyDNSSetting(input []interface{}) *network.DNSSettings {
 	return output
 }
 
-func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.FirewallPolicyIntrusionDetection {
+func expandFirewallPolicyIntrusionDetection(input []interface{}) (*network.FirewallPolicyIntrusionDetection, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	raw := input[0].(map[string]interface{})
@@ -314,6 +335,26 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 		})
 	}
 
+	if document := raw["signature_overrides_document"].(string); document != "" {
+		documentOverrides, err := parseFirewallPolicySignatureOverridesDocument(document)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `signature_overrides_document`: %+v", err)
+		}
+
+		for _, override := range signatureOverrides {
+			if _, ok := documentOverrides[*override.ID]; ok {
+				return nil, fmt.Errorf("the signature override %q is specified both in a `signature_overrides` block and in the `signature_overrides_document`", *override.ID)
+			}
+		}
+
+		for _, id := range sortedFirewallPolicySignatureOverrideIds(documentOverrides) {
+			signatureOverrides = append(signatureOverrides, network.FirewallPolicyIntrusionDetectionSignatureSpecification{
+				ID:   utils.String(id),
+				Mode: documentOverrides[id],
+			})
+		}
+	}
+
 	var trafficBypass []network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications
 
 	for _, v := range raw["traffic_bypass"].([]interface{}) {
@@ -342,7 +383,105 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 			PrivateRanges:         &privateRanges,
 			BypassTrafficSettings: &trafficBypass,
 		},
+	}, nil
+}
+
+// parseFirewallPolicySignatureOverridesDocument parses a document containing IDPS signature overrides in bulk, which
+// can either be a JSON array (`[{"id": "2024897", "state": "Deny"}]`), a JSON object (`{"2024897": "Deny"}`) or a
+// CSV document with the columns `id,state` - returning the state for each signature ID
+func parseFirewallPolicySignatureOverridesDocument(input string) (map[string]network.FirewallPolicyIntrusionDetectionStateType, error) {
+	type signatureOverride struct {
+		ID    string `json:"id"`
+		State string `json:"state"`
+	}
+
+	overrides := make([]signatureOverride, 0)
+	document := strings.TrimSpace(input)
+	switch {
+	case strings.HasPrefix(document, "["):
+		if err := json.Unmarshal([]byte(document), &overrides); err != nil {
+			return nil, fmt.Errorf("unmarshaling JSON array: %+v", err)
+		}
+
+	case strings.HasPrefix(document, "{"):
+		raw := make(map[string]string)
+		if err := json.Unmarshal([]byte(document), &raw); err != nil {
+			return nil, fmt.Errorf("unmarshaling JSON object: %+v", err)
+		}
+		for id, state := range raw {
+			overrides = append(overrides, signatureOverride{ID: id, State: state})
+		}
+
+	default:
+		reader := csv.NewReader(strings.NewReader(document))
+		reader.FieldsPerRecord = 2
+		reader.TrimLeadingSpace = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %+v", err)
+		}
+		for i, record := range records {
+			// the header row is optional
+			if i == 0 && strings.EqualFold(record[0], "id") {
+				continue
+			}
+			overrides = append(overrides, signatureOverride{ID: record[0], State: record[1]})
+		}
 	}
+
+	states := []network.FirewallPolicyIntrusionDetectionStateType{
+		network.FirewallPolicyIntrusionDetectionStateTypeOff,
+		network.FirewallPolicyIntrusionDetectionStateTypeAlert,
+		network.FirewallPolicyIntrusionDetectionStateTypeDeny,
+	}
+
+	output := make(map[string]network.FirewallPolicyIntrusionDetectionStateType)
+	for _, override := range overrides {
+		id := strings.TrimSpace(override.ID)
+		if id == "" {
+			return nil, fmt.Errorf("a signature override is missing an `id`")
+		}
+		if _, ok := output[id]; ok {
+			return nil, fmt.Errorf("the signature override %q is specified more than once", id)
+		}
+
+		var state network.FirewallPolicyIntrusionDetectionStateType
+		for _, v := range states {
+			if strings.EqualFold(strings.TrimSpace(override.State), string(v)) {
+				state = v
+			}
+		}
+		if state == "" {
+			return nil, fmt.Errorf("the state %q for the signature override %q is invalid, expected one of %q, %q or %q", override.State, id, states[0], states[1], states[2])
+		}
+
+		output[id] = state
+	}
+
+	return output, nil
+}
+
+func sortedFirewallPolicySignatureOverrideIds(input map[string]network.FirewallPolicyIntrusionDetectionStateType) []string {
+	ids := make([]string, 0, len(input))
+	for id := range input {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func validateFirewallPolicySignatureOverridesDocument(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parseFirewallPolicySignatureOverridesDocument(v); err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q: %+v", k, err))
+	}
+
+	return
 }
 
 func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
@@ -481,20 +620,30 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		}}
 }
 
-func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection, signatureOverridesDocument string) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
+	// the signature overrides specified within the document are returned alongside those specified as blocks, so
+	// these are excluded from the `signature_overrides` blocks to avoid a diff
+	documentOverrides := make(map[string]network.FirewallPolicyIntrusionDetectionStateType)
+	if signatureOverridesDocument != "" {
+		if v, err := parseFirewallPolicySignatureOverridesDocument(signatureOverridesDocument); err == nil {
+			documentOverrides = v
+		}
+	}
+
 	signatureOverrides := make([]interface{}, 0)
 	trafficBypass := make([]interface{}, 0)
 
 	if input.Configuration == nil {
 		return []interface{}{
 			map[string]interface{}{
-				"mode":                string(input.Mode),
-				"signature_overrides": signatureOverrides,
-				"traffic_bypass":      trafficBypass,
+				"mode":                         string(input.Mode),
+				"signature_overrides":          signatureOverrides,
+				"signature_overrides_document": signatureOverridesDocument,
+				"traffic_bypass":               trafficBypass,
 			},
 		}
 	}
@@ -505,6 +654,9 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 			if override.ID != nil {
 				id = *override.ID
 			}
+			if _, ok := documentOverrides[id]; ok {
+				continue
+			}
 			signatureOverrides = append(signatureOverrides, map[string]interface{}{
 				"id":    id,
 				"state": string(override.Mode),
@@ -568,10 +720,11 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 
 	return []interface{}{
 		map[string]interface{}{
-			"mode":                string(input.Mode),
-			"signature_overrides": signatureOverrides,
-			"traffic_bypass":      trafficBypass,
-			"private_ranges":      privateRanges,
+			"mode":                         string(input.Mode),
+			"signature_overrides":          signatureOverrides,
+			"signature_overrides_document": signatureOverridesDocument,
+			"traffic_bypass":               trafficBypass,
+			"private_ranges":               privateRanges,
 		},
 	}
 }
@@ -811,6 +964,11 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 							},
 						},
 					},
+					"signature_overrides_document": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFirewallPolicySignatureOverridesDocument,
+					},
 					"private_ranges": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -895,8 +1053,9 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"key_vault_secret_id": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 					},
 					"name": {
 						Type:     pluginsdk.TypeString,
@@ -131,6 +131,23 @@ func TestAccFirewallPolicy_updatePremium(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_signatureOverridesDocument(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.signatureOverridesDocument(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("intrusion_detection.0.signature_overrides.#").HasValue("1"),
+			),
+		},
+		// the document is only stored in the state, the overrides within it are imported as `signature_overrides` blocks
+		data.ImportStep("intrusion_detection.0.signature_overrides", "intrusion_detection.0.signature_overrides_document"),
+	})
+}
+
 func TestAccFirewallPolicy_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -337,6 +354,42 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) signatureOverridesDocument(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.templatePremium(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+  intrusion_detection {
+    mode = "Alert"
+    signature_overrides {
+      state = "Alert"
+      id    = "1"
+    }
+    signature_overrides_document = <<CSV
+id,state
+2024897,Deny
+2024898,Off
+CSV
+  }
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+  tls_certificate {
+    key_vault_secret_id = azurerm_key_vault_certificate.test.versionless_secret_id
+    name                = azurerm_key_vault_certificate.test.name
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) requiresImport(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.basic(data)
@@ -3,6 +3,7 @@ package firewall
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"time"
 
@@ -517,6 +518,11 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 	}
 
+	// the API returns the Rule Collections ordered by their priority, rather than the order in which they were sent
+	applicationRuleCollections = orderFirewallPolicyRuleCollections(applicationRuleCollections, d.Get("application_rule_collection").([]interface{}))
+	networkRuleCollections = orderFirewallPolicyRuleCollections(networkRuleCollections, d.Get("network_rule_collection").([]interface{}))
+	natRuleCollections = orderFirewallPolicyRuleCollections(natRuleCollections, d.Get("nat_rule_collection").([]interface{}))
+
 	if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
 		return fmt.Errorf("setting `application_rule_collection`: %+v", err)
 	}
@@ -794,6 +800,41 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 	return applicationRuleCollection, networkRuleCollection, natRuleCollection, nil
 }
 
+// orderFirewallPolicyRuleCollections orders the flattened Rule Collections so that those already present in the state
+// retain their position, with any others (e.g. when importing) ordered by their priority - to avoid re-ordering diffs
+func orderFirewallPolicyRuleCollections(input []interface{}, existing []interface{}) []interface{} {
+	positions := make(map[string]int)
+	for i, v := range existing {
+		if v == nil {
+			continue
+		}
+		positions[v.(map[string]interface{})["name"].(string)] = i
+	}
+
+	output := make([]interface{}, len(input))
+	copy(output, input)
+	sort.SliceStable(output, func(i, j int) bool {
+		a := output[i].(map[string]interface{})
+		b := output[j].(map[string]interface{})
+
+		aPosition, aExists := positions[a["name"].(string)]
+		bPosition, bExists := positions[b["name"].(string)]
+		if aExists && bExists {
+			return aPosition < bPosition
+		}
+		if aExists != bExists {
+			return aExists
+		}
+
+		if a["priority"].(int32) != b["priority"].(int32) {
+			return a["priority"].(int32) < b["priority"].(int32)
+		}
+		return a["name"].(string) < b["name"].(string)
+	})
+
+	return output
+}
+
 func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRule) ([]interface{}, error) {
 	if input == nil {
 		return []interface{}{}, nil
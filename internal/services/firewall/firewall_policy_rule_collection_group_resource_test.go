@@ -118,6 +118,23 @@ func TestAccFirewallPolicyRuleCollectionGroup_updatePremium(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_unorderedPriorities(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unorderedPriorities(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_rule_collection.0.name").HasValue("network_rule_collection1"),
+				check.That(data.ResourceName).Key("network_rule_collection.1.name").HasValue("network_rule_collection2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
 	r := FirewallPolicyRuleCollectionGroupResource{}
@@ -871,6 +888,52 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) unorderedPriorities(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 600
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+  network_rule_collection {
+    name     = "network_rule_collection2"
+    priority = 400
+    action   = "Allow"
+    rule {
+      name                  = "network_rule_collection2_rule1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.2"]
+      destination_addresses = ["192.168.1.2"]
+      destination_ports     = ["443"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
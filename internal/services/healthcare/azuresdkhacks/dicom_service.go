@@ -0,0 +1,125 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// TODO 4.0: check if this can be removed
+// the CORS configuration, event state and data partitions of a DICOM Service aren't available in the vendored SDK - so
+// the DICOM Service is sent/retrieved using a newer API version with these properties patched in.
+
+const dicomServiceAPIVersion = "2023-12-01"
+
+type DicomServiceCorsConfiguration struct {
+	AllowCredentials *bool     `json:"allowCredentials,omitempty"`
+	Headers          *[]string `json:"headers,omitempty"`
+	MaxAge           *int32    `json:"maxAge,omitempty"`
+	Methods          *[]string `json:"methods,omitempty"`
+	Origins          *[]string `json:"origins,omitempty"`
+}
+
+type DicomServiceAdditionalProperties struct {
+	CorsConfiguration    *DicomServiceCorsConfiguration `json:"corsConfiguration,omitempty"`
+	EnableDataPartitions *bool                          `json:"enableDataPartitions,omitempty"`
+
+	// EventState is read-only
+	EventState *string `json:"eventState,omitempty"`
+}
+
+// CreateOrUpdateDicomServiceWithAdditionalProperties creates or updates the DICOM Service, including the properties
+// which are missing from the vendored SDK
+func CreateOrUpdateDicomServiceWithAdditionalProperties(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string, parameters healthcareapis.DicomService, additional DicomServiceAdditionalProperties) (result healthcareapis.DicomServicesCreateOrUpdateFuture, err error) {
+	req, err := createOrUpdateDicomServiceWithAdditionalPropertiesPreparer(ctx, client, resourceGroupName, workspaceName, dicomServiceName, parameters, additional)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func createOrUpdateDicomServiceWithAdditionalPropertiesPreparer(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string, parameters healthcareapis.DicomService, additional DicomServiceAdditionalProperties) (*http.Request, error) {
+	additionalProperties := make(map[string]interface{})
+	if additional.CorsConfiguration != nil {
+		additionalProperties["corsConfiguration"] = additional.CorsConfiguration
+	}
+	if additional.EnableDataPartitions != nil {
+		additionalProperties["enableDataPartitions"] = additional.EnableDataPartitions
+	}
+	body, err := withAdditionalProperties(parameters, additionalProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": dicomServiceAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/dicomservices/{dicomServiceName}", dicomServicePathParameters(client, resourceGroupName, workspaceName, dicomServiceName)),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetDicomServiceAdditionalProperties retrieves the properties of the DICOM Service which are missing from the vendored SDK
+func GetDicomServiceAdditionalProperties(ctx context.Context, client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string) (*DicomServiceAdditionalProperties, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": dicomServiceAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/dicomservices/{dicomServiceName}", dicomServicePathParameters(client, resourceGroupName, workspaceName, dicomServiceName)),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Properties *DicomServiceAdditionalProperties `json:"properties,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.DicomServicesClient", "Get", resp, "Failure responding to request")
+	}
+
+	if result.Properties == nil {
+		return &DicomServiceAdditionalProperties{}, nil
+	}
+	return result.Properties, nil
+}
+
+func dicomServicePathParameters(client *healthcareapis.DicomServicesClient, resourceGroupName string, workspaceName string, dicomServiceName string) map[string]interface{} {
+	return map[string]interface{}{
+		"dicomServiceName":  autorest.Encode("path", dicomServiceName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+}
@@ -0,0 +1,113 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// TODO 4.0: check if this can be removed
+// the `importConfiguration` of a FHIR Service was introduced in API version `2022-06-01` which isn't available in the
+// vendored SDK - so the FHIR Service is sent/retrieved using the newer API version with the import configuration patched in.
+
+const fhirServiceImportAPIVersion = "2022-06-01"
+
+type FhirServiceImportConfiguration struct {
+	Enabled              *bool   `json:"enabled,omitempty"`
+	InitialImportMode    *bool   `json:"initialImportMode,omitempty"`
+	IntegrationDataStore *string `json:"integrationDataStore,omitempty"`
+}
+
+// CreateOrUpdateFhirServiceWithImportConfiguration creates or updates the FHIR Service, including the specified Import Configuration
+func CreateOrUpdateFhirServiceWithImportConfiguration(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string, parameters healthcareapis.FhirService, importConfiguration *FhirServiceImportConfiguration) (result healthcareapis.FhirServicesCreateOrUpdateFuture, err error) {
+	req, err := createOrUpdateFhirServiceWithImportConfigurationPreparer(ctx, client, resourceGroupName, workspaceName, fhirServiceName, parameters, importConfiguration)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func createOrUpdateFhirServiceWithImportConfigurationPreparer(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string, parameters healthcareapis.FhirService, importConfiguration *FhirServiceImportConfiguration) (*http.Request, error) {
+	additionalProperties := make(map[string]interface{})
+	if importConfiguration != nil {
+		additionalProperties["importConfiguration"] = importConfiguration
+	}
+	body, err := withAdditionalProperties(parameters, additionalProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": fhirServiceImportAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/fhirservices/{fhirServiceName}", fhirServicePathParameters(client, resourceGroupName, workspaceName, fhirServiceName)),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetFhirServiceImportConfiguration retrieves the Import Configuration of the FHIR Service, which is nil when unset
+func GetFhirServiceImportConfiguration(ctx context.Context, client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string) (*FhirServiceImportConfiguration, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": fhirServiceImportAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthcareApis/workspaces/{workspaceName}/fhirservices/{fhirServiceName}", fhirServicePathParameters(client, resourceGroupName, workspaceName, fhirServiceName)),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", resp, "Failure sending request")
+	}
+
+	var result struct {
+		Properties *struct {
+			ImportConfiguration *FhirServiceImportConfiguration `json:"importConfiguration,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "healthcareapis.FhirServicesClient", "Get", resp, "Failure responding to request")
+	}
+
+	if result.Properties == nil {
+		return nil, nil
+	}
+	return result.Properties.ImportConfiguration, nil
+}
+
+func fhirServicePathParameters(client *healthcareapis.FhirServicesClient, resourceGroupName string, workspaceName string, fhirServiceName string) map[string]interface{} {
+	return map[string]interface{}{
+		"fhirServiceName":   autorest.Encode("path", fhirServiceName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+}
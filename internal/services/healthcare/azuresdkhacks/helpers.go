@@ -0,0 +1,32 @@
+package azuresdkhacks
+
+import "encoding/json"
+
+// withAdditionalProperties serializes the SDK model and merges the specified fields into its `properties`, so that
+// properties which are missing from the vendored SDK can be sent to the API
+func withAdditionalProperties(input interface{}, additionalProperties map[string]interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil, err
+	}
+
+	if len(additionalProperties) == 0 {
+		return body, nil
+	}
+
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+	}
+	for k, v := range additionalProperties {
+		props[k] = v
+	}
+	body["properties"] = props
+
+	return body, nil
+}
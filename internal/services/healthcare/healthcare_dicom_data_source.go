@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/healthcareapis/mgmt/2021-11-01/healthcareapis" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -81,6 +83,57 @@ func dataSourceHealthcareDicomService() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_origins": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"allowed_headers": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"allowed_methods": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+
+						"max_age_in_seconds": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"credentials_allowed": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"data_partitions_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"event_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -119,7 +172,18 @@ func dataSourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta in
 		d.Set("authentication", flattenDicomAuthentication(props.AuthenticationConfiguration))
 		d.Set("private_endpoint", flattenDicomServicePrivateEndpoint(props.PrivateEndpointConnections))
 		d.Set("service_url", props.ServiceURL)
+		d.Set("public_network_access_enabled", props.PublicNetworkAccess == healthcareapis.PublicNetworkAccessEnabled)
+	}
+
+	additionalProperties, err := azuresdkhacks.GetDicomServiceAdditionalProperties(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving additional properties for %s: %+v", id, err)
+	}
+	if err := d.Set("cors", flattenDicomCorsConfiguration(additionalProperties.CorsConfiguration)); err != nil {
+		return fmt.Errorf("setting `cors`: %+v", err)
 	}
+	d.Set("data_partitions_enabled", utils.NormaliseNilableBool(additionalProperties.EnableDataPartitions))
+	d.Set("event_state", utils.NormalizeNilableString(additionalProperties.EventState))
 
 	identity, _ := flattenDicomManagedIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
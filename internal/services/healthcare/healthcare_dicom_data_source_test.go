@@ -18,7 +18,9 @@ func TestAccHealthCareDicomDataSource_basic(t *testing.T) {
 		{
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).Key("name").Exists()),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("service_url").Exists(),
+				check.That(data.ResourceName).Key("event_state").Exists()),
 		},
 	})
 }
@@ -13,11 +13,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -112,6 +114,77 @@ func resourceHealthcareApisDicomService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"cors": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allowed_origins": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_headers": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"allowed_methods": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MaxItems: 64,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"DELETE",
+									"GET",
+									"HEAD",
+									"MERGE",
+									"POST",
+									"OPTIONS",
+									"PUT",
+								}, false),
+							},
+						},
+
+						"max_age_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 2000000000),
+						},
+
+						"credentials_allowed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"data_partitions_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"event_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -162,7 +235,12 @@ func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta in
 		parameters.DicomServiceProperties.PublicNetworkAccess = healthcareapis.PublicNetworkAccessDisabled
 	}
 
-	future, err := client.CreateOrUpdate(ctx, dicomServiceId.ResourceGroup, dicomServiceId.WorkspaceName, dicomServiceId.Name, parameters)
+	additionalProperties := azuresdkhacks.DicomServiceAdditionalProperties{
+		CorsConfiguration:    expandDicomCorsConfiguration(d.Get("cors").([]interface{})),
+		EnableDataPartitions: utils.Bool(d.Get("data_partitions_enabled").(bool)),
+	}
+
+	future, err := azuresdkhacks.CreateOrUpdateDicomServiceWithAdditionalProperties(ctx, client, dicomServiceId.ResourceGroup, dicomServiceId.WorkspaceName, dicomServiceId.Name, parameters, additionalProperties)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", dicomServiceId, err)
 	}
@@ -213,6 +291,16 @@ func resourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	additionalProperties, err := azuresdkhacks.GetDicomServiceAdditionalProperties(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving additional properties for %s: %+v", *id, err)
+	}
+	if err := d.Set("cors", flattenDicomCorsConfiguration(additionalProperties.CorsConfiguration)); err != nil {
+		return fmt.Errorf("setting `cors`: %+v", err)
+	}
+	d.Set("data_partitions_enabled", utils.NormaliseNilableBool(additionalProperties.EnableDataPartitions))
+	d.Set("event_state", utils.NormalizeNilableString(additionalProperties.EventState))
+
 	identity, _ := flattenDicomManagedIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
 		return fmt.Errorf("setting `identity`: %+v", err)
@@ -257,7 +345,12 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, parameters)
+	additionalProperties := azuresdkhacks.DicomServiceAdditionalProperties{
+		CorsConfiguration:    expandDicomCorsConfiguration(d.Get("cors").([]interface{})),
+		EnableDataPartitions: utils.Bool(d.Get("data_partitions_enabled").(bool)),
+	}
+
+	future, err := azuresdkhacks.CreateOrUpdateDicomServiceWithAdditionalProperties(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name, parameters, additionalProperties)
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -379,10 +472,68 @@ func flattenDicomServicePrivateEndpoint(input *[]healthcareapis.PrivateEndpointC
 		if endpoint.ID != nil {
 			result["id"] = *endpoint.ID
 		}
+
+		results = append(results, result)
 	}
 	return results
 }
 
+func expandDicomCorsConfiguration(input []interface{}) *azuresdkhacks.DicomServiceCorsConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.DicomServiceCorsConfiguration{
+			Origins:          &[]string{},
+			Headers:          &[]string{},
+			Methods:          &[]string{},
+			AllowCredentials: utils.Bool(false),
+		}
+	}
+
+	block := input[0].(map[string]interface{})
+
+	allowCredentials := block["credentials_allowed"].(bool)
+	cors := &azuresdkhacks.DicomServiceCorsConfiguration{
+		Origins:          utils.ExpandStringSlice(block["allowed_origins"].(*pluginsdk.Set).List()),
+		Headers:          utils.ExpandStringSlice(block["allowed_headers"].(*pluginsdk.Set).List()),
+		Methods:          utils.ExpandStringSlice(block["allowed_methods"].(*pluginsdk.Set).List()),
+		AllowCredentials: &allowCredentials,
+	}
+
+	if v, ok := block["max_age_in_seconds"]; ok {
+		maxAgeInSeconds := int32(v.(int))
+		cors.MaxAge = &maxAgeInSeconds
+	}
+
+	return cors
+}
+
+func flattenDicomCorsConfiguration(input *azuresdkhacks.DicomServiceCorsConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if (input.Origins == nil || len(*input.Origins) == 0) &&
+		(input.Methods == nil || len(*input.Methods) == 0) &&
+		(input.Headers == nil || len(*input.Headers) == 0) &&
+		(input.AllowCredentials == nil || !*input.AllowCredentials) {
+		return []interface{}{}
+	}
+
+	var maxAge int
+	if input.MaxAge != nil {
+		maxAge = int(*input.MaxAge)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"credentials_allowed": utils.NormaliseNilableBool(input.AllowCredentials),
+			"allowed_headers":     utils.FlattenStringSlice(input.Headers),
+			"allowed_methods":     utils.FlattenStringSlice(input.Methods),
+			"allowed_origins":     utils.FlattenStringSlice(input.Origins),
+			"max_age_in_seconds":  maxAge,
+		},
+	}
+}
+
 func expandDicomManagedIdentity(input []interface{}) (*healthcareapis.ServiceManagedIdentityIdentity, error) {
 	expanded, err := identity.ExpandSystemAndUserAssignedMap(input)
 	if err != nil {
@@ -83,6 +83,49 @@ func TestAccHealthCareDicomResource_updateUserAssignedIdentity(t *testing.T) {
 	})
 }
 
+func TestAccHealthCareDicomResource_updateCors(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
+	r := HealthCareDicomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep(),
+		{
+			Config: r.cors(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r)),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cors.#").HasValue("0")),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHealthCareDicomResource_dataPartitions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
+	r := HealthCareDicomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataPartitions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_partitions_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("event_state").Exists()),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccHealthCareDicomResource_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_healthcare_dicom_service", "test")
 	r := HealthCareDicomResource{}
@@ -162,6 +205,43 @@ resource "azurerm_healthcare_dicom_service" "test" {
 `, r.template(data), data.RandomIntOfLength(10), data.Locations.Primary)
 }
 
+func (r HealthCareDicomResource) cors(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name         = "dicom%d"
+  workspace_id = azurerm_healthcare_workspace.test.id
+  location     = "%s"
+
+  cors {
+    allowed_origins     = ["https://acctest.com:123", "https://acctest1.com:3389"]
+    allowed_headers     = ["*"]
+    allowed_methods     = ["GET", "DELETE", "PUT"]
+    max_age_in_seconds  = 3600
+    credentials_allowed = true
+  }
+
+  depends_on = [azurerm_healthcare_workspace.test]
+}
+`, r.template(data), data.RandomIntOfLength(10), data.Locations.Primary)
+}
+
+func (r HealthCareDicomResource) dataPartitions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_healthcare_dicom_service" "test" {
+  name                    = "dicom%d"
+  workspace_id            = azurerm_healthcare_workspace.test.id
+  location                = "%s"
+  data_partitions_enabled = true
+
+  depends_on = [azurerm_healthcare_workspace.test]
+}
+`, r.template(data), data.RandomIntOfLength(10), data.Locations.Primary)
+}
+
 func (r HealthCareDicomResource) userAssignedIdentity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
@@ -215,6 +216,33 @@ func resourceHealthcareApisFhirService() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"import": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"integration_data_store_storage_account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"initial_import_mode_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -291,7 +319,8 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 	}
 	parameters.FhirServiceProperties.AcrConfiguration = &acrConfig
 
-	future, err := client.CreateOrUpdate(ctx, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
+	importConfiguration := expandFhirImportConfiguration(d.Get("import").([]interface{}))
+	future, err := azuresdkhacks.CreateOrUpdateFhirServiceWithImportConfiguration(ctx, client, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters, importConfiguration)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", fhirServiceId, err)
 	}
@@ -366,6 +395,14 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("public_network_access_enabled", props.PublicNetworkAccess == healthcareapis.PublicNetworkAccessEnabled)
 		}
 
+		importConfiguration, err := azuresdkhacks.GetFhirServiceImportConfiguration(ctx, client, id.ResourceGroup, id.WorkspaceName, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving import configuration for %s: %+v", *id, err)
+		}
+		if err := d.Set("import", flattenFhirImportConfiguration(importConfiguration)); err != nil {
+			return fmt.Errorf("setting `import`: %+v", err)
+		}
+
 		if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
 			return err
 		}
@@ -447,7 +484,17 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 	}
 	parameters.FhirServiceProperties.AcrConfiguration = &acrConfig
 
-	future, err := client.CreateOrUpdate(ctx, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters)
+	importConfiguration := expandFhirImportConfiguration(d.Get("import").([]interface{}))
+	if importConfiguration == nil && d.HasChange("import") {
+		// the import configuration is retained by the service when omitted, so it has to be explicitly disabled
+		importConfiguration = &azuresdkhacks.FhirServiceImportConfiguration{
+			Enabled:              utils.Bool(false),
+			InitialImportMode:    utils.Bool(false),
+			IntegrationDataStore: utils.String(""),
+		}
+	}
+
+	future, err := azuresdkhacks.CreateOrUpdateFhirServiceWithImportConfiguration(ctx, client, fhirServiceId.ResourceGroup, fhirServiceId.WorkspaceName, fhirServiceId.Name, parameters, importConfiguration)
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", fhirServiceId, err)
 	}
@@ -741,3 +788,31 @@ func fhirServiceCreateStateRefreshFunc(ctx context.Context, client *healthcareap
 		return resp, string(resp.ProvisioningState), nil
 	}
 }
+
+func expandFhirImportConfiguration(input []interface{}) *azuresdkhacks.FhirServiceImportConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &azuresdkhacks.FhirServiceImportConfiguration{
+		Enabled:              utils.Bool(v["enabled"].(bool)),
+		InitialImportMode:    utils.Bool(v["initial_import_mode_enabled"].(bool)),
+		IntegrationDataStore: utils.String(v["integration_data_store_storage_account_name"].(string)),
+	}
+}
+
+func flattenFhirImportConfiguration(input *azuresdkhacks.FhirServiceImportConfiguration) []interface{} {
+	// a disabled import configuration is returned without an integration data store
+	if input == nil || input.IntegrationDataStore == nil || *input.IntegrationDataStore == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"integration_data_store_storage_account_name": *input.IntegrationDataStore,
+			"enabled":                     utils.NormaliseNilableBool(input.Enabled),
+			"initial_import_mode_enabled": utils.NormaliseNilableBool(input.InitialImportMode),
+		},
+	}
+}
@@ -125,6 +125,43 @@ func TestAccHealthcareApiFhirService_updateCors(t *testing.T) {
 	})
 }
 
+func TestAccHealthcareApiFhirService_updateImportConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_fhir_service", "test")
+	r := HealthcareApiFhirServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updateIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.importConfiguration(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.importConfiguration(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updateIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("import.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccHealthcareApiFhirService_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_healthcare_fhir_service", "test")
 	r := HealthcareApiFhirServiceResource{}
@@ -416,6 +453,50 @@ resource "azurerm_healthcare_fhir_service" "test" {
 `, r.template(data), data.RandomInteger, data.Locations.Primary, data.Locations.Secondary, data.RandomInteger, data.RandomInteger)
 }
 
+func (r HealthcareApiFhirServiceResource) importConfiguration(data acceptance.TestData, initialImportMode bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acc%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_healthcare_fhir_service" "test" {
+  name                = "fhir%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_id        = azurerm_healthcare_workspace.test.id
+  kind                = "fhir-R4"
+
+  authentication {
+    authority = "https://login.microsoftonline.com/72f988bf-86f1-41af-91ab-2d7cd011db47"
+    audience  = "https://acctestfhir.fhir.azurehealthcareapis.com"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  configuration_export_storage_account_name = azurerm_storage_account.test.name
+
+  import {
+    integration_data_store_storage_account_name = azurerm_storage_account.test.name
+    initial_import_mode_enabled                 = %t
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_healthcare_fhir_service.test.identity[0].principal_id
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, initialImportMode)
+}
+
 func (HealthcareApiFhirServiceResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
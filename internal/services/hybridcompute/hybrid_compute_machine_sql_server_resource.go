@@ -0,0 +1,512 @@
+package hybridcompute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	arcSqlServerExtensionPublisher   = "Microsoft.AzureData"
+	arcSqlServerExtensionTypeWindows = "WindowsAgent.SqlServer"
+	arcSqlServerExtensionTypeLinux   = "LinuxAgent.SqlServer"
+
+	arcSqlServerLicenseTypeLicenseOnly = "LicenseOnly"
+	arcSqlServerLicenseTypePaid        = "Paid"
+	arcSqlServerLicenseTypePAYG        = "PAYG"
+)
+
+type HybridComputeMachineSqlServerModel struct {
+	MachineId                      string                                    `tfschema:"machine_id"`
+	LicenseType                    string                                    `tfschema:"license_type"`
+	ExtendedSecurityUpdatesEnabled bool                                      `tfschema:"extended_security_updates_enabled"`
+	ExcludedSqlInstances           []string                                  `tfschema:"excluded_sql_instances"`
+	Assessment                     []HybridComputeMachineSqlServerAssessment `tfschema:"assessment"`
+	AutomaticUpgradeEnabled        bool                                      `tfschema:"automatic_upgrade_enabled"`
+}
+
+type HybridComputeMachineSqlServerAssessment struct {
+	Enabled                 bool                                              `tfschema:"enabled"`
+	LogAnalyticsWorkspaceId string                                            `tfschema:"log_analytics_workspace_id"`
+	RunImmediately          bool                                              `tfschema:"run_immediately"`
+	Schedule                []HybridComputeMachineSqlServerAssessmentSchedule `tfschema:"schedule"`
+}
+
+type HybridComputeMachineSqlServerAssessmentSchedule struct {
+	DayOfWeek         string `tfschema:"day_of_week"`
+	MonthlyOccurrence int64  `tfschema:"monthly_occurrence"`
+	StartTime         string `tfschema:"start_time"`
+	WeeklyInterval    int64  `tfschema:"weekly_interval"`
+}
+
+// arcSqlServerExtensionSettings is the schema of the `settings` used by the Azure Arc SQL Server Extension
+type arcSqlServerExtensionSettings struct {
+	SqlManagement                 arcSqlServerManagementSettings  `json:"SqlManagement"`
+	LicenseType                   string                          `json:"LicenseType"`
+	EnableExtendedSecurityUpdates bool                            `json:"enableExtendedSecurityUpdates"`
+	ExcludedSqlInstances          []string                        `json:"ExcludedSqlInstances"`
+	AssessmentSettings            *arcSqlServerAssessmentSettings `json:"AssessmentSettings,omitempty"`
+}
+
+type arcSqlServerManagementSettings struct {
+	IsEnabled bool `json:"IsEnabled"`
+}
+
+type arcSqlServerAssessmentSettings struct {
+	Enable              bool                            `json:"Enable"`
+	RunImmediately      bool                            `json:"RunImmediately"`
+	Schedule            *arcSqlServerAssessmentSchedule `json:"schedule,omitempty"`
+	WorkspaceResourceId string                          `json:"WorkspaceResourceId,omitempty"`
+	WorkspaceLocation   string                          `json:"WorkspaceLocation,omitempty"`
+}
+
+type arcSqlServerAssessmentSchedule struct {
+	Enable            bool   `json:"Enable"`
+	DayOfWeek         string `json:"dayOfWeek"`
+	MonthlyOccurrence *int64 `json:"monthlyOccurrence"`
+	StartTime         string `json:"startTime"`
+	WeeklyInterval    *int64 `json:"WeeklyInterval"`
+}
+
+type HybridComputeMachineSqlServerResource struct{}
+
+var _ sdk.ResourceWithUpdate = HybridComputeMachineSqlServerResource{}
+
+func (r HybridComputeMachineSqlServerResource) ResourceType() string {
+	return "azurerm_hybrid_compute_machine_sql_server"
+}
+
+func (r HybridComputeMachineSqlServerResource) ModelObject() interface{} {
+	return &HybridComputeMachineSqlServerModel{}
+}
+
+func (r HybridComputeMachineSqlServerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machineextensions.ValidateExtensionID
+}
+
+func (r HybridComputeMachineSqlServerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"license_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				arcSqlServerLicenseTypeLicenseOnly,
+				arcSqlServerLicenseTypePaid,
+				arcSqlServerLicenseTypePAYG,
+			}, false),
+		},
+
+		"assessment": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"log_analytics_workspace_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: workspaces.ValidateWorkspaceID,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"run_immediately": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"schedule": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"day_of_week": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"Monday",
+										"Tuesday",
+										"Wednesday",
+										"Thursday",
+										"Friday",
+										"Saturday",
+										"Sunday",
+									}, false),
+								},
+
+								"start_time": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringMatch(
+										regexp.MustCompile("^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$"),
+										"`start_time` must match the format HH:mm",
+									),
+								},
+
+								"weekly_interval": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ExactlyOneOf: []string{"assessment.0.schedule.0.weekly_interval", "assessment.0.schedule.0.monthly_occurrence"},
+									ValidateFunc: validation.IntBetween(1, 6),
+								},
+
+								"monthly_occurrence": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ExactlyOneOf: []string{"assessment.0.schedule.0.weekly_interval", "assessment.0.schedule.0.monthly_occurrence"},
+									ValidateFunc: validation.IntBetween(1, 5),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"automatic_upgrade_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"excluded_sql_instances": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"extended_security_updates_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r HybridComputeMachineSqlServerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HybridComputeMachineSqlServerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+			machinesClient := metadata.Client.HybridCompute.MachinesClient
+
+			var model HybridComputeMachineSqlServerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(model.MachineId)
+			if err != nil {
+				return err
+			}
+
+			machine, err := machinesClient.Get(ctx, *machineId, machines.GetOperationOptions{})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			extensionType := arcSqlServerExtensionTypeWindows
+			if props := machine.Model.Properties; props != nil && props.OsType != nil && strings.EqualFold(*props.OsType, "linux") {
+				extensionType = arcSqlServerExtensionTypeLinux
+			}
+
+			id := machineextensions.NewExtensionID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, extensionType)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			settings, err := r.expandSettings(ctx, metadata, model)
+			if err != nil {
+				return err
+			}
+
+			parameters := machineextensions.MachineExtension{
+				Location: location.Normalize(machine.Model.Location),
+				Properties: &machineextensions.MachineExtensionProperties{
+					EnableAutomaticUpgrade: utils.Bool(model.AutomaticUpgradeEnabled),
+					Publisher:              utils.String(arcSqlServerExtensionPublisher),
+					Settings:               settings,
+					Type:                   utils.String(extensionType),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HybridComputeMachineSqlServerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := HybridComputeMachineSqlServerModel{
+				MachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.EnableAutomaticUpgrade != nil {
+						state.AutomaticUpgradeEnabled = *props.EnableAutomaticUpgrade
+					}
+
+					settings, err := parseArcSqlServerExtensionSettings(props.Settings)
+					if err != nil {
+						return fmt.Errorf("parsing the settings for %s: %+v", *id, err)
+					}
+
+					if settings != nil {
+						state.LicenseType = settings.LicenseType
+						state.ExtendedSecurityUpdatesEnabled = settings.EnableExtendedSecurityUpdates
+						state.ExcludedSqlInstances = settings.ExcludedSqlInstances
+
+						assessment, err := flattenArcSqlServerAssessmentSettings(settings.AssessmentSettings)
+						if err != nil {
+							return fmt.Errorf("flattening `assessment`: %+v", err)
+						}
+						state.Assessment = assessment
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r HybridComputeMachineSqlServerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model HybridComputeMachineSqlServerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the settings are replaced as a whole, so we always send these
+			settings, err := r.expandSettings(ctx, metadata, model)
+			if err != nil {
+				return err
+			}
+
+			parameters := machineextensions.MachineExtensionUpdate{
+				Properties: &machineextensions.MachineExtensionUpdateProperties{
+					Settings: settings,
+				},
+			}
+
+			if metadata.ResourceData.HasChange("automatic_upgrade_enabled") {
+				parameters.Properties.EnableAutomaticUpgrade = utils.Bool(model.AutomaticUpgradeEnabled)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HybridComputeMachineSqlServerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HybridComputeMachineSqlServerResource) expandSettings(ctx context.Context, metadata sdk.ResourceMetaData, model HybridComputeMachineSqlServerModel) (*interface{}, error) {
+	if model.ExtendedSecurityUpdatesEnabled && model.LicenseType == arcSqlServerLicenseTypeLicenseOnly {
+		return nil, fmt.Errorf("`extended_security_updates_enabled` can only be enabled when `license_type` is `%s` or `%s`", arcSqlServerLicenseTypePaid, arcSqlServerLicenseTypePAYG)
+	}
+
+	excludedSqlInstances := model.ExcludedSqlInstances
+	if excludedSqlInstances == nil {
+		excludedSqlInstances = make([]string, 0)
+	}
+
+	settings := arcSqlServerExtensionSettings{
+		SqlManagement: arcSqlServerManagementSettings{
+			IsEnabled: true,
+		},
+		LicenseType:                   model.LicenseType,
+		EnableExtendedSecurityUpdates: model.ExtendedSecurityUpdatesEnabled,
+		ExcludedSqlInstances:          excludedSqlInstances,
+	}
+
+	if len(model.Assessment) > 0 {
+		assessment := model.Assessment[0]
+
+		workspaceId, err := workspaces.ParseWorkspaceID(assessment.LogAnalyticsWorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		workspace, err := metadata.Client.LogAnalytics.WorkspaceClient.Get(ctx, *workspaceId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+		}
+		if workspace.Model == nil {
+			return nil, fmt.Errorf("retrieving %s: `model` was nil", *workspaceId)
+		}
+
+		settings.AssessmentSettings = &arcSqlServerAssessmentSettings{
+			Enable:              assessment.Enabled,
+			RunImmediately:      assessment.RunImmediately,
+			WorkspaceResourceId: workspaceId.ID(),
+			WorkspaceLocation:   location.Normalize(workspace.Model.Location),
+		}
+
+		if len(assessment.Schedule) > 0 {
+			schedule := assessment.Schedule[0]
+			settings.AssessmentSettings.Schedule = &arcSqlServerAssessmentSchedule{
+				Enable:    true,
+				DayOfWeek: schedule.DayOfWeek,
+				StartTime: schedule.StartTime,
+			}
+
+			if schedule.WeeklyInterval != 0 {
+				settings.AssessmentSettings.Schedule.WeeklyInterval = utils.Int64(schedule.WeeklyInterval)
+			}
+			if schedule.MonthlyOccurrence != 0 {
+				settings.AssessmentSettings.Schedule.MonthlyOccurrence = utils.Int64(schedule.MonthlyOccurrence)
+			}
+		}
+	}
+
+	var output interface{} = settings
+	return &output, nil
+}
+
+func parseArcSqlServerExtensionSettings(input *interface{}) (*arcSqlServerExtensionSettings, error) {
+	if input == nil || *input == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(*input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling: %+v", err)
+	}
+
+	var settings arcSqlServerExtensionSettings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return nil, fmt.Errorf("unmarshaling: %+v", err)
+	}
+
+	return &settings, nil
+}
+
+func flattenArcSqlServerAssessmentSettings(input *arcSqlServerAssessmentSettings) ([]HybridComputeMachineSqlServerAssessment, error) {
+	if input == nil || input.WorkspaceResourceId == "" {
+		return []HybridComputeMachineSqlServerAssessment{}, nil
+	}
+
+	workspaceId, err := workspaces.ParseWorkspaceIDInsensitively(input.WorkspaceResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]HybridComputeMachineSqlServerAssessmentSchedule, 0)
+	if v := input.Schedule; v != nil && v.Enable {
+		item := HybridComputeMachineSqlServerAssessmentSchedule{
+			DayOfWeek: v.DayOfWeek,
+			StartTime: v.StartTime,
+		}
+		if v.WeeklyInterval != nil {
+			item.WeeklyInterval = *v.WeeklyInterval
+		}
+		if v.MonthlyOccurrence != nil {
+			item.MonthlyOccurrence = *v.MonthlyOccurrence
+		}
+		schedule = append(schedule, item)
+	}
+
+	return []HybridComputeMachineSqlServerAssessment{
+		{
+			Enabled:                 input.Enable,
+			LogAnalyticsWorkspaceId: workspaceId.ID(),
+			RunImmediately:          input.RunImmediately,
+			Schedule:                schedule,
+		},
+	}, nil
+}
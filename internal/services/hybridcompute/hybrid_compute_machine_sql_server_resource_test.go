@@ -0,0 +1,166 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HybridComputeMachineSqlServerResource struct {
+	machineId string
+}
+
+// NOTE: these tests require an existing Azure Arc enabled Windows machine with SQL Server installed,
+// since provisioning one requires installing SQL Server within the Virtual Machine
+func newHybridComputeMachineSqlServerResource(t *testing.T) HybridComputeMachineSqlServerResource {
+	machineId := os.Getenv("ARM_TEST_ARC_SQL_SERVER_MACHINE_ID")
+	if machineId == "" {
+		t.Skip("Skipping as `ARM_TEST_ARC_SQL_SERVER_MACHINE_ID` is not specified")
+	}
+
+	return HybridComputeMachineSqlServerResource{
+		machineId: machineId,
+	}
+}
+
+func TestAccHybridComputeMachineSqlServer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hybrid_compute_machine_sql_server", "test")
+	r := newHybridComputeMachineSqlServerResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHybridComputeMachineSqlServer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hybrid_compute_machine_sql_server", "test")
+	r := newHybridComputeMachineSqlServerResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccHybridComputeMachineSqlServer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hybrid_compute_machine_sql_server", "test")
+	r := newHybridComputeMachineSqlServerResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r HybridComputeMachineSqlServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machineextensions.ParseExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachineExtensionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r HybridComputeMachineSqlServerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_hybrid_compute_machine_sql_server" "test" {
+  machine_id   = %q
+  license_type = "PAYG"
+}
+`, r.machineId)
+}
+
+func (r HybridComputeMachineSqlServerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_hybrid_compute_machine_sql_server" "import" {
+  machine_id   = azurerm_hybrid_compute_machine_sql_server.test.machine_id
+  license_type = azurerm_hybrid_compute_machine_sql_server.test.license_type
+}
+`, r.basic(data))
+}
+
+func (r HybridComputeMachineSqlServerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hcsql-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_hybrid_compute_machine_sql_server" "test" {
+  machine_id                        = %[3]q
+  license_type                      = "Paid"
+  extended_security_updates_enabled = true
+  excluded_sql_instances            = ["EXCLUDED"]
+
+  assessment {
+    log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+    schedule {
+      day_of_week        = "Sunday"
+      start_time         = "02:00"
+      monthly_occurrence = 2
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, r.machineId)
+}
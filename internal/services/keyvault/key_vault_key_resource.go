@@ -5,12 +5,16 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"log"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -202,6 +206,60 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				},
 			},
 
+			"release_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"any_of": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"authority": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+
+									"all_of": keyVaultKeyReleasePolicyConditionSchema(),
+
+									"any_of": keyVaultKeyReleasePolicyConditionSchema(),
+								},
+							},
+						},
+
+						"immutable_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "1.0.0",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"policy_hash": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"exportable": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"release_policy"},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -255,6 +313,44 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a Release Policy can't be removed from a Key, and can't be changed once it's been marked as immutable
+			pluginsdk.ForceNewIfChange("release_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldPolicies := old.([]interface{})
+				if len(oldPolicies) == 0 || oldPolicies[0] == nil {
+					return false
+				}
+				newPolicies := new.([]interface{})
+				if len(newPolicies) == 0 || newPolicies[0] == nil {
+					return true
+				}
+
+				return oldPolicies[0].(map[string]interface{})["immutable_enabled"].(bool)
+			}),
+		),
+	}
+}
+
+func keyVaultKeyReleasePolicyConditionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"claim": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"equals": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
 	}
 }
 
@@ -328,6 +424,18 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
+	if d.Get("exportable").(bool) {
+		parameters.KeyAttributes.Exportable = utils.Bool(true)
+	}
+
+	if v, ok := d.GetOk("release_policy"); ok {
+		releasePolicy, err := expandKeyVaultKeyReleasePolicy(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `release_policy`: %+v", err)
+		}
+		parameters.ReleasePolicy = releasePolicy
+	}
+
 	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
 		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeys && utils.ResponseWasConflict(resp.Response) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
@@ -434,6 +542,14 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
+	if d.HasChange("release_policy") {
+		releasePolicy, err := expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `release_policy`: %+v", err)
+		}
+		parameters.ReleasePolicy = releasePolicy
+	}
+
 	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
 		return err
 	}
@@ -530,6 +646,20 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if v := attributes.Expires; v != nil {
 			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
 		}
+
+		exportable := false
+		if v := attributes.Exportable; v != nil {
+			exportable = *v
+		}
+		d.Set("exportable", exportable)
+	}
+
+	releasePolicy, err := flattenKeyVaultKeyReleasePolicy(resp.ReleasePolicy)
+	if err != nil {
+		return fmt.Errorf("flattening `release_policy`: %+v", err)
+	}
+	if err := d.Set("release_policy", releasePolicy); err != nil {
+		return fmt.Errorf("setting `release_policy`: %+v", err)
 	}
 
 	// Computed
@@ -802,6 +932,162 @@ func flattenKeyVaultKeyRotationPolicy(input keyvault.KeyRotationPolicy) []interf
 	return []interface{}{policy}
 }
 
+// keyVaultKeyReleasePolicy is the Secure Key Release policy document, the fields are ordered so that the encoded
+// policy is canonical - meaning the same policy always results in the same document (and policy hash)
+type keyVaultKeyReleasePolicy struct {
+	AnyOf   []keyVaultKeyReleasePolicyAuthority `json:"anyOf"`
+	Version string                              `json:"version"`
+}
+
+type keyVaultKeyReleasePolicyAuthority struct {
+	AllOf     []keyVaultKeyReleasePolicyCondition `json:"allOf,omitempty"`
+	AnyOf     []keyVaultKeyReleasePolicyCondition `json:"anyOf,omitempty"`
+	Authority string                              `json:"authority"`
+}
+
+type keyVaultKeyReleasePolicyCondition struct {
+	Claim  string      `json:"claim"`
+	Equals interface{} `json:"equals"`
+}
+
+func expandKeyVaultKeyReleasePolicy(input []interface{}) (*keyvault.KeyReleasePolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	data, err := expandKeyVaultKeyReleasePolicyData(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyvault.KeyReleasePolicy{
+		ContentType:   utils.String("application/json; charset=utf-8"),
+		Immutable:     utils.Bool(raw["immutable_enabled"].(bool)),
+		EncodedPolicy: utils.String(base64.RawURLEncoding.EncodeToString(data)),
+	}, nil
+}
+
+func expandKeyVaultKeyReleasePolicyData(input map[string]interface{}) ([]byte, error) {
+	policy := keyVaultKeyReleasePolicy{
+		AnyOf:   make([]keyVaultKeyReleasePolicyAuthority, 0),
+		Version: input["version"].(string),
+	}
+
+	for _, v := range input["any_of"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		raw := v.(map[string]interface{})
+
+		authority := keyVaultKeyReleasePolicyAuthority{
+			AllOf:     expandKeyVaultKeyReleasePolicyConditions(raw["all_of"].([]interface{})),
+			AnyOf:     expandKeyVaultKeyReleasePolicyConditions(raw["any_of"].([]interface{})),
+			Authority: raw["authority"].(string),
+		}
+		if len(authority.AllOf) == 0 && len(authority.AnyOf) == 0 {
+			return nil, fmt.Errorf("at least one `all_of` or `any_of` condition must be specified for the authority %q", authority.Authority)
+		}
+
+		policy.AnyOf = append(policy.AnyOf, authority)
+	}
+
+	return json.Marshal(policy)
+}
+
+func expandKeyVaultKeyReleasePolicyConditions(input []interface{}) []keyVaultKeyReleasePolicyCondition {
+	output := make([]keyVaultKeyReleasePolicyCondition, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		raw := v.(map[string]interface{})
+
+		// boolean claims (e.g. `x-ms-sevsnpvm-is-debuggable`) have to be compared against a boolean value
+		var equals interface{} = raw["equals"].(string)
+		if b, err := strconv.ParseBool(raw["equals"].(string)); err == nil {
+			equals = b
+		}
+
+		output = append(output, keyVaultKeyReleasePolicyCondition{
+			Claim:  raw["claim"].(string),
+			Equals: equals,
+		})
+	}
+
+	return output
+}
+
+func flattenKeyVaultKeyReleasePolicy(input *keyvault.KeyReleasePolicy) ([]interface{}, error) {
+	if input == nil || input.EncodedPolicy == nil || *input.EncodedPolicy == "" {
+		return []interface{}{}, nil
+	}
+
+	// the API returns the policy base64url encoded, however the padding may or may not be present
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*input.EncodedPolicy, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decoding the encoded policy: %+v", err)
+	}
+
+	var policy keyVaultKeyReleasePolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("unmarshaling the policy: %+v", err)
+	}
+
+	authorities := make([]interface{}, 0)
+	for _, authority := range policy.AnyOf {
+		authorities = append(authorities, map[string]interface{}{
+			"authority": authority.Authority,
+			"all_of":    flattenKeyVaultKeyReleasePolicyConditions(authority.AllOf),
+			"any_of":    flattenKeyVaultKeyReleasePolicyConditions(authority.AnyOf),
+		})
+	}
+
+	// the policy returned from the API differs in whitespace/ordering from the one which was sent, so the hash is
+	// computed from the canonical form of the policy to remain stable across reads
+	canonical, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the canonical policy: %+v", err)
+	}
+	hash := sha256.Sum256(canonical)
+
+	immutable := false
+	if input.Immutable != nil {
+		immutable = *input.Immutable
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"any_of":            authorities,
+			"immutable_enabled": immutable,
+			"version":           policy.Version,
+			"policy_hash":       hex.EncodeToString(hash[:]),
+		},
+	}, nil
+}
+
+func flattenKeyVaultKeyReleasePolicyConditions(input []keyVaultKeyReleasePolicyCondition) []interface{} {
+	output := make([]interface{}, 0)
+	for _, condition := range input {
+		equals := ""
+		switch v := condition.Equals.(type) {
+		case string:
+			equals = v
+		case bool:
+			equals = strconv.FormatBool(v)
+		case float64:
+			equals = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+
+		output = append(output, map[string]interface{}{
+			"claim":  condition.Claim,
+			"equals": equals,
+		})
+	}
+
+	return output
+}
+
 // Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
 func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(pubKey)
@@ -113,6 +113,31 @@ func TestAccKeyVaultKey_basicRSAHSM(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_releasePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.releasePolicy(data, "azure-compliant-cvm"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exportable").HasValue("true"),
+				check.That(data.ResourceName).Key("release_policy.0.policy_hash").Exists(),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.releasePolicy(data, "azure-compliant-uvm"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("release_policy.0.policy_hash").Exists(),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+	})
+}
+
 func TestAccKeyVaultKey_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -838,6 +863,49 @@ func (r KeyVaultKeyResource) templatePremium(data acceptance.TestData) string {
 	return r.template(data, "premium")
 }
 
+func (r KeyVaultKeyResource) releasePolicy(data acceptance.TestData, complianceStatus string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA-HSM"
+  key_size     = 2048
+  exportable   = true
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  release_policy {
+    any_of {
+      authority = "https://sharedeus.eus.attest.azure.net"
+
+      all_of {
+        claim  = "x-ms-isolation-tee.x-ms-compliance-status"
+        equals = "%s"
+      }
+
+      all_of {
+        claim  = "x-ms-isolation-tee.x-ms-attestation-type"
+        equals = "sevsnpvm"
+      }
+    }
+  }
+}
+`, r.templatePremium(data), data.RandomString, complianceStatus)
+}
+
 func (KeyVaultKeyResource) template(data acceptance.TestData, sku string) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {}
@@ -0,0 +1,29 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes a Kusto API Version with Language Extension images
+// selecting the image of a Language Extension (`languageExtensionImageName`) was introduced in API Version `2023-05-02`,
+// however the vendored SDK only includes `2022-02-01` so the Language Extension endpoints of a Cluster are defined here.
+
+const defaultApiVersion = "2023-05-02"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/clusters/%s", defaultApiVersion)
+}
+
+type ClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClustersClientWithBaseURI(endpoint string) ClustersClient {
+	return ClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,28 @@
+package azuresdkhacks
+
+import "github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2022-02-01/clusters"
+
+type LanguageExtensionImageName string
+
+const (
+	LanguageExtensionImageNamePythonThreeSixFive  LanguageExtensionImageName = "Python3_6_5"
+	LanguageExtensionImageNamePythonThreeTenEight LanguageExtensionImageName = "Python3_10_8"
+	LanguageExtensionImageNameR                   LanguageExtensionImageName = "R"
+)
+
+func PossibleValuesForLanguageExtensionImageName() []string {
+	return []string{
+		string(LanguageExtensionImageNamePythonThreeSixFive),
+		string(LanguageExtensionImageNamePythonThreeTenEight),
+		string(LanguageExtensionImageNameR),
+	}
+}
+
+type LanguageExtension struct {
+	LanguageExtensionImageName *LanguageExtensionImageName     `json:"languageExtensionImageName,omitempty"`
+	LanguageExtensionName      *clusters.LanguageExtensionName `json:"languageExtensionName,omitempty"`
+}
+
+type LanguageExtensionsList struct {
+	Value *[]LanguageExtension `json:"value,omitempty"`
+}
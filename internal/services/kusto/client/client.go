@@ -10,11 +10,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2022-02-01/managedprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2022-02-01/scripts" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/azuresdkhacks"
 )
 
 type Client struct {
 	AttachedDatabaseConfigurationsClient *attacheddatabaseconfigurations.AttachedDatabaseConfigurationsClient
 	ClustersClient                       *clusters.ClustersClient
+	ClusterLanguageExtensionsClient      *azuresdkhacks.ClustersClient
 	ClusterManagedPrivateEndpointClient  *managedprivateendpoints.ManagedPrivateEndpointsClient
 	ClusterPrincipalAssignmentsClient    *clusterprincipalassignments.ClusterPrincipalAssignmentsClient
 	DatabasesClient                      *databases.DatabasesClient
@@ -27,6 +29,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ClustersClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&ClustersClient.Client, o.ResourceManagerAuthorizer)
 
+	ClusterLanguageExtensionsClient := azuresdkhacks.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ClusterLanguageExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
 	ClusterManagedPrivateEndpointClient := managedprivateendpoints.NewManagedPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&ClusterManagedPrivateEndpointClient.Client, o.ResourceManagerAuthorizer)
 
@@ -50,6 +55,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		AttachedDatabaseConfigurationsClient: &AttachedDatabaseConfigurationsClient,
 		ClustersClient:                       &ClustersClient,
+		ClusterLanguageExtensionsClient:      &ClusterLanguageExtensionsClient,
 		ClusterManagedPrivateEndpointClient:  &ClusterManagedPrivateEndpointClient,
 		ClusterPrincipalAssignmentsClient:    &ClusterPrincipalAssignmentsClient,
 		DatabasesClient:                      &DatabasesClient,
@@ -1,11 +1,13 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -166,12 +169,30 @@ func resourceKustoCluster() *pluginsdk.Resource {
 				},
 			},
 
-			"language_extensions": {
+			"language_extension": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				Elem: &pluginsdk.Schema{
-					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForLanguageExtensionName(), false),
+				Computed: !features.FourPointOhBeta(),
+				ConflictsWith: func() []string {
+					if !features.FourPointOhBeta() {
+						return []string{"language_extensions"}
+					}
+					return []string{}
+				}(),
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForLanguageExtensionName(), false),
+						},
+
+						"image": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForLanguageExtensionImageName(), false),
+						},
+					},
 				},
 			},
 
@@ -253,6 +274,20 @@ func resourceKustoCluster() *pluginsdk.Resource {
 		s.Schema["engine"].Default = string(clusters.EngineTypeVTwo)
 	}
 
+	if !features.FourPointOhBeta() {
+		s.Schema["language_extensions"] = &pluginsdk.Schema{
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"language_extension"},
+			Deprecated:    "`language_extensions` has been superseded by `language_extension` and will be removed in 4.0",
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(clusters.PossibleValuesForLanguageExtensionName(), false),
+			},
+		}
+	}
+
 	return s
 }
 
@@ -308,6 +343,21 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	// when only the bounds of the optimized autoscale have changed these can be patched in place, which avoids
+	// sending the full cluster definition (and restarting the cluster)
+	if !d.IsNewResource() && optimizedAutoScale != nil && !d.HasChangesExcept("optimized_auto_scale") {
+		update := clusters.ClusterUpdate{
+			Properties: &clusters.ClusterProperties{
+				OptimizedAutoscale: optimizedAutoScale,
+			},
+		}
+		if err := client.UpdateThenPoll(ctx, id, update, clusters.DefaultUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("updating `optimized_auto_scale` for %s: %+v", id, err)
+		}
+
+		return resourceKustoClusterRead(d, meta)
+	}
+
 	engine := clusters.EngineType(d.Get("engine").(string))
 
 	publicNetworkAccess := clusters.PublicNetworkAccessEnabled
@@ -376,42 +426,16 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(id.ID())
 
-	if v, ok := d.GetOk("language_extensions"); ok {
-		languageExtensions := expandKustoClusterLanguageExtensions(v.([]interface{}))
-
-		currentLanguageExtensions, err := client.ListLanguageExtensions(ctx, id)
-		if err != nil {
-			return fmt.Errorf("retrieving the language extensions on %s: %+v", id, err)
-		}
-
-		languageExtensionsToAdd := diffLanguageExtensions(*languageExtensions.Value, *currentLanguageExtensions.Model.Value)
-		if len(languageExtensionsToAdd) > 0 {
-			languageExtensionsListToAdd := clusters.LanguageExtensionsList{
-				Value: &languageExtensionsToAdd,
-			}
-
-			resp, err := client.AddLanguageExtensions(ctx, id, languageExtensionsListToAdd)
-			if err != nil {
-				return fmt.Errorf("adding language extensions to %s: %+v", id, err)
-			}
-			if err = resp.Poller.PollUntilDone(); err != nil {
-				return fmt.Errorf("waiting for the addition of language extensions on %s: %+v", id, err)
-			}
-		}
-
-		languageExtensionsToRemove := diffLanguageExtensions(*currentLanguageExtensions.Model.Value, *languageExtensions.Value)
-		if len(languageExtensionsToRemove) > 0 {
-			languageExtensionsListToRemove := clusters.LanguageExtensionsList{
-				Value: &languageExtensionsToRemove,
-			}
+	var languageExtensions *[]azuresdkhacks.LanguageExtension
+	if d.HasChange("language_extension") {
+		languageExtensions = expandKustoClusterLanguageExtension(d.Get("language_extension").([]interface{}))
+	} else if !features.FourPointOhBeta() && d.HasChange("language_extensions") {
+		languageExtensions = expandKustoClusterLanguageExtensions(d.Get("language_extensions").([]interface{}))
+	}
 
-			removeLanguageExtensionsFuture, err := client.RemoveLanguageExtensions(ctx, id, languageExtensionsListToRemove)
-			if err != nil {
-				return fmt.Errorf("removing language extensions from %s: %+v", id, err)
-			}
-			if err = removeLanguageExtensionsFuture.Poller.PollUntilDone(); err != nil {
-				return fmt.Errorf("waiting for the removal of language extensions from %s: %+v", id, err)
-			}
+	if languageExtensions != nil {
+		if err := updateKustoClusterLanguageExtensions(ctx, client, meta.(*clients.Client).Kusto.ClusterLanguageExtensionsClient, id, *languageExtensions); err != nil {
+			return err
 		}
 	}
 
@@ -477,7 +501,6 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("streaming_ingestion_enabled", props.EnableStreamingIngest)
 			d.Set("purge_enabled", props.EnablePurge)
 			d.Set("virtual_network_configuration", flattenKustoClusterVNET(props.VirtualNetworkConfiguration))
-			d.Set("language_extensions", flattenKustoClusterLanguageExtensions(props.LanguageExtensions))
 			d.Set("uri", props.Uri)
 			d.Set("data_ingestion_uri", props.DataIngestionUri)
 			d.Set("engine", props.EngineType)
@@ -490,6 +513,20 @@ func resourceKustoClusterRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the image of a Language Extension isn't available in the vendored SDK, so these are retrieved using the workaround client
+	languageExtensions, err := meta.(*clients.Client).Kusto.ClusterLanguageExtensionsClient.ListLanguageExtensions(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving the language extensions on %s: %+v", *id, err)
+	}
+
+	if err := d.Set("language_extension", flattenKustoClusterLanguageExtension(languageExtensions.Model)); err != nil {
+		return fmt.Errorf("setting `language_extension`: %+v", err)
+	}
+
+	if !features.FourPointOhBeta() {
+		d.Set("language_extensions", flattenKustoClusterLanguageExtensions(languageExtensions.Model))
+	}
+
 	return nil
 }
 
@@ -595,23 +632,28 @@ func expandKustoClusterVNET(input []interface{}) *clusters.VirtualNetworkConfigu
 	}
 }
 
-func expandKustoClusterLanguageExtensions(input []interface{}) *clusters.LanguageExtensionsList {
-	if len(input) == 0 {
-		return nil
+func expandKustoClusterLanguageExtension(input []interface{}) *[]azuresdkhacks.LanguageExtension {
+	extensions := make([]azuresdkhacks.LanguageExtension, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		extensions = append(extensions, azuresdkhacks.LanguageExtension{
+			LanguageExtensionName:      pointer.To(clusters.LanguageExtensionName(v["name"].(string))),
+			LanguageExtensionImageName: pointer.To(azuresdkhacks.LanguageExtensionImageName(v["image"].(string))),
+		})
 	}
 
-	extensions := make([]clusters.LanguageExtension, 0)
+	return &extensions
+}
+
+func expandKustoClusterLanguageExtensions(input []interface{}) *[]azuresdkhacks.LanguageExtension {
+	extensions := make([]azuresdkhacks.LanguageExtension, 0)
 	for _, language := range input {
-		name := clusters.LanguageExtensionName(language.(string))
-		v := clusters.LanguageExtension{
-			LanguageExtensionName: &name,
-		}
-		extensions = append(extensions, v)
+		extensions = append(extensions, azuresdkhacks.LanguageExtension{
+			LanguageExtensionName: pointer.To(clusters.LanguageExtensionName(language.(string))),
+		})
 	}
 
-	return &clusters.LanguageExtensionsList{
-		Value: &extensions,
-	}
+	return &extensions
 }
 
 func flattenKustoClusterSku(sku *clusters.AzureSku) []interface{} {
@@ -644,37 +686,114 @@ func flattenKustoClusterVNET(vnet *clusters.VirtualNetworkConfiguration) []inter
 	return []interface{}{output}
 }
 
-func flattenKustoClusterLanguageExtensions(extensions *clusters.LanguageExtensionsList) []interface{} {
-	if extensions == nil {
-		return []interface{}{}
+func flattenKustoClusterLanguageExtension(extensions *azuresdkhacks.LanguageExtensionsList) []interface{} {
+	output := make([]interface{}, 0)
+	if extensions == nil || extensions.Value == nil {
+		return output
 	}
 
+	for _, v := range *extensions.Value {
+		name := ""
+		if v.LanguageExtensionName != nil {
+			name = string(*v.LanguageExtensionName)
+		}
+
+		image := ""
+		if v.LanguageExtensionImageName != nil {
+			image = string(*v.LanguageExtensionImageName)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"image": image,
+		})
+	}
+
+	return output
+}
+
+func flattenKustoClusterLanguageExtensions(extensions *azuresdkhacks.LanguageExtensionsList) []interface{} {
 	output := make([]interface{}, 0)
-	if extensions.Value != nil {
-		for _, v := range *extensions.Value {
-			output = append(output, v.LanguageExtensionName)
+	if extensions == nil || extensions.Value == nil {
+		return output
+	}
+
+	for _, v := range *extensions.Value {
+		if v.LanguageExtensionName != nil {
+			output = append(output, string(*v.LanguageExtensionName))
 		}
 	}
 
 	return output
 }
 
-func diffLanguageExtensions(a, b []clusters.LanguageExtension) []clusters.LanguageExtension {
-	target := make(map[string]bool)
-	for _, x := range b {
-		if x.LanguageExtensionName != nil {
-			target[string(*x.LanguageExtensionName)] = true
+// updateKustoClusterLanguageExtensions removes the Language Extensions which are no longer required (or whose image
+// has changed, since the image of an existing Language Extension can't be updated) and then adds the missing ones.
+// Where no image is specified the image of an existing Language Extension is kept.
+func updateKustoClusterLanguageExtensions(ctx context.Context, clustersClient *clusters.ClustersClient, languageExtensionsClient *azuresdkhacks.ClustersClient, id clusters.ClusterId, desired []azuresdkhacks.LanguageExtension) error {
+	current, err := languageExtensionsClient.ListLanguageExtensions(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving the language extensions on %s: %+v", id, err)
+	}
+
+	existing := make([]azuresdkhacks.LanguageExtension, 0)
+	if current.Model != nil && current.Model.Value != nil {
+		existing = *current.Model.Value
+	}
+
+	toRemove := make([]clusters.LanguageExtension, 0)
+	for _, e := range existing {
+		if !languageExtensionsContain(desired, e) {
+			toRemove = append(toRemove, clusters.LanguageExtension{
+				LanguageExtensionName: e.LanguageExtensionName,
+			})
 		}
 	}
 
-	diff := make([]clusters.LanguageExtension, 0)
-	for _, x := range a {
-		if x.LanguageExtensionName != nil {
-			if _, ok := target[string(*x.LanguageExtensionName)]; !ok {
-				diff = append(diff, x)
-			}
+	if len(toRemove) > 0 {
+		resp, err := clustersClient.RemoveLanguageExtensions(ctx, id, clusters.LanguageExtensionsList{Value: &toRemove})
+		if err != nil {
+			return fmt.Errorf("removing language extensions from %s: %+v", id, err)
+		}
+		if err = resp.Poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("waiting for the removal of language extensions from %s: %+v", id, err)
+		}
+	}
+
+	toAdd := make([]azuresdkhacks.LanguageExtension, 0)
+	for _, e := range desired {
+		if !languageExtensionsContain(existing, e) {
+			toAdd = append(toAdd, e)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := languageExtensionsClient.AddLanguageExtensionsThenPoll(ctx, id, azuresdkhacks.LanguageExtensionsList{Value: &toAdd}); err != nil {
+			return fmt.Errorf("adding language extensions to %s: %+v", id, err)
 		}
 	}
 
-	return diff
+	return nil
+}
+
+// languageExtensionsContain returns whether the list contains a Language Extension with the same name and, where both
+// specify one, the same image.
+func languageExtensionsContain(list []azuresdkhacks.LanguageExtension, target azuresdkhacks.LanguageExtension) bool {
+	if target.LanguageExtensionName == nil {
+		return false
+	}
+
+	for _, v := range list {
+		if v.LanguageExtensionName == nil || *v.LanguageExtensionName != *target.LanguageExtensionName {
+			continue
+		}
+
+		if v.LanguageExtensionImageName != nil && target.LanguageExtensionImageName != nil && *v.LanguageExtensionImageName != *target.LanguageExtensionImageName {
+			return false
+		}
+
+		return true
+	}
+
+	return false
 }
@@ -268,6 +268,30 @@ func TestAccKustoCluster_languageExtensions(t *testing.T) {
 	})
 }
 
+func TestAccKustoCluster_languageExtension(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cluster", "test")
+	r := KustoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.languageExtension(data, "Python3_6_5"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("language_extension.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.languageExtension(data, "Python3_10_8"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("language_extension.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKustoCluster_optimizedAutoScale(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kusto_cluster", "test")
 	r := KustoClusterResource{}
@@ -786,6 +810,40 @@ resource "azurerm_kusto_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (KustoClusterResource) languageExtension(data acceptance.TestData, pythonImage string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+
+  language_extension {
+    name  = "PYTHON"
+    image = "%s"
+  }
+
+  language_extension {
+    name  = "R"
+    image = "R"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, pythonImage)
+}
+
 func (KustoClusterResource) optimizedAutoScale(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
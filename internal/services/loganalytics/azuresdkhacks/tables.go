@@ -0,0 +1,123 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+)
+
+// NOTE: this workaround client exists since the `tables` package of API Version 2022-10-01 of
+// `Microsoft.OperationalInsights` isn't included in the version of the Azure SDK we're using - only `workspaces` is
+const tablesAPIVersion = "2022-10-01"
+
+type TablePlan string
+
+const (
+	TablePlanAnalytics TablePlan = "Analytics"
+	TablePlanBasic     TablePlan = "Basic"
+)
+
+type TablesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTablesClientWithBaseURI(endpoint string) TablesClient {
+	return TablesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/tables/%s", tablesAPIVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type Table struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *TableProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
+
+type TableProperties struct {
+	Plan                          *TablePlan `json:"plan,omitempty"`
+	RetentionInDays               *int64     `json:"retentionInDays,omitempty"`
+	RetentionInDaysAsDefault      *bool      `json:"retentionInDaysAsDefault,omitempty"`
+	TotalRetentionInDays          *int64     `json:"totalRetentionInDays,omitempty"`
+	TotalRetentionInDaysAsDefault *bool      `json:"totalRetentionInDaysAsDefault,omitempty"`
+}
+
+type TablesListResult struct {
+	Value *[]Table `json:"value,omitempty"`
+}
+
+// ListByWorkspace returns every Table within the Workspace in a single request
+func (c TablesClient) ListByWorkspace(ctx context.Context, id workspaces.WorkspaceId) (result []Table, err error) {
+	req, err := c.preparer(ctx, fmt.Sprintf("%s/tables", id.ID()), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "ListByWorkspace", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "ListByWorkspace", resp, "Failure sending request")
+		return
+	}
+
+	var model TablesListResult
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "ListByWorkspace", resp, "Failure responding to request")
+		return
+	}
+
+	result = make([]Table, 0)
+	if model.Value != nil {
+		result = *model.Value
+	}
+
+	return
+}
+
+// UpdateThenPoll patches the Table within the Workspace then polls until the update has completed
+func (c TablesClient) UpdateThenPoll(ctx context.Context, id workspaces.WorkspaceId, tableName string, input Table) error {
+	req, err := c.preparer(ctx, fmt.Sprintf("%s/tables/%s", id.ID(), tableName), autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "tables.TablesClient", "Update", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "tables.TablesClient", "Update", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after Update: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Update: %+v", err)
+	}
+
+	return nil
+}
+
+func (c TablesClient) preparer(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": tablesAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,169 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+)
+
+// NOTE: this workaround client exists since Workspace Replication (and the Failover/Failback operations) were
+// introduced in API Version 2025-02-01 of `Microsoft.OperationalInsights`, whereas the version of the Azure SDK
+// we're using only includes 2022-10-01 for Workspaces.
+// TODO 4.0: remove this once `azurerm_log_analytics_workspace` is switched to a newer API version
+const workspaceReplicationAPIVersion = "2025-02-01"
+
+type WorkspaceFailoverState string
+
+const (
+	WorkspaceFailoverStateActivating   WorkspaceFailoverState = "Activating"
+	WorkspaceFailoverStateActive       WorkspaceFailoverState = "Active"
+	WorkspaceFailoverStateDeactivating WorkspaceFailoverState = "Deactivating"
+	WorkspaceFailoverStateFailed       WorkspaceFailoverState = "Failed"
+	WorkspaceFailoverStateInactive     WorkspaceFailoverState = "Inactive"
+)
+
+type WorkspaceReplicationClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceReplicationClientWithBaseURI(endpoint string) WorkspaceReplicationClient {
+	return WorkspaceReplicationClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/workspaces/%s", workspaceReplicationAPIVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type WorkspaceReplication struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+type WorkspaceFailover struct {
+	State *WorkspaceFailoverState `json:"state,omitempty"`
+}
+
+type WorkspaceReplicationStatus struct {
+	Location   string `json:"location"`
+	Properties *struct {
+		Failover    *WorkspaceFailover    `json:"failover,omitempty"`
+		Replication *WorkspaceReplication `json:"replication,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+type WorkspaceReplicationGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *WorkspaceReplicationStatus
+}
+
+// Get retrieves the Replication and Failover status of the Workspace
+func (c WorkspaceReplicationClient) Get(ctx context.Context, id workspaces.WorkspaceId) (result WorkspaceReplicationGetOperationResponse, err error) {
+	result.HttpResponse, err = c.get(ctx, id, &result.Model)
+	return
+}
+
+// SetReplicationThenPoll updates the Replication of the Workspace, leaving all other properties of the Workspace as-is,
+// then polls until the Workspace has been provisioned
+func (c WorkspaceReplicationClient) SetReplicationThenPoll(ctx context.Context, id workspaces.WorkspaceId, input WorkspaceReplication) error {
+	// the whole Workspace is retrieved so that it can be sent back with only the Replication changed
+	var payload map[string]interface{}
+	if _, err := c.get(ctx, id, &payload); err != nil {
+		return err
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	properties["replication"] = input
+
+	// otherwise the service returns an error that the resource definition is invalid
+	delete(payload, "systemData")
+
+	req, err := c.preparer(ctx, id.ID(), autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "SetReplication", nil, "Failure preparing request")
+	}
+
+	return c.sendThenPoll(ctx, req, "SetReplication")
+}
+
+// FailoverThenPoll activates the Failover of the Workspace to the Replication location, then polls until it's completed
+func (c WorkspaceReplicationClient) FailoverThenPoll(ctx context.Context, id workspaces.WorkspaceId, replicationLocation string) error {
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/locations/%s/workspaces/%s/failover", id.SubscriptionId, id.ResourceGroupName, replicationLocation, id.WorkspaceName)
+	req, err := c.preparer(ctx, path, autorest.AsPost())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "Failover", nil, "Failure preparing request")
+	}
+
+	return c.sendThenPoll(ctx, req, "Failover")
+}
+
+// FailbackThenPoll deactivates the Failover of the Workspace, returning it to the primary location, then polls until
+// it's completed
+func (c WorkspaceReplicationClient) FailbackThenPoll(ctx context.Context, id workspaces.WorkspaceId) error {
+	req, err := c.preparer(ctx, fmt.Sprintf("%s/failback", id.ID()), autorest.AsPost())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "Failback", nil, "Failure preparing request")
+	}
+
+	return c.sendThenPoll(ctx, req, "Failback")
+}
+
+func (c WorkspaceReplicationClient) get(ctx context.Context, id workspaces.WorkspaceId, model interface{}) (*http.Response, error) {
+	req, err := c.preparer(ctx, id.ID(), autorest.AsGet())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(model),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", "Get", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+func (c WorkspaceReplicationClient) sendThenPoll(ctx context.Context, req *http.Request, operation string) error {
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "workspaces.WorkspaceReplicationClient", operation, resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after %s: %+v", operation, err)
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after %s: %+v", operation, err)
+	}
+
+	return nil
+}
+
+func (c WorkspaceReplicationClient) preparer(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": workspaceReplicationAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -14,6 +14,7 @@ import (
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 )
 
 type Client struct {
@@ -26,9 +27,11 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	TablesClient               *azuresdkhacks.TablesClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	WorkspaceClient            *featureWorkspaces.WorkspacesClient // 2022-10-01 API version does not contain sharedkeys related API, so we keep two versions SDK of this API
+	WorkspaceReplicationClient *azuresdkhacks.WorkspaceReplicationClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -47,6 +50,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	featureWorkspaceClient := featureWorkspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&featureWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	WorkspaceReplicationClient := azuresdkhacks.NewWorkspaceReplicationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspaceReplicationClient.Client, o.ResourceManagerAuthorizer)
+
 	SavedSearchesClient := savedsearches.NewSavedSearchesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&SavedSearchesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -56,6 +62,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	StorageInsightsClient := storageinsights.NewStorageInsightsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&StorageInsightsClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := azuresdkhacks.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	LinkedServicesClient := linkedservices.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&LinkedServicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -79,7 +88,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		SavedSearchesClient:        &SavedSearchesClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		SharedKeyWorkspacesClient:  &WorkspacesClient,
 		WorkspaceClient:            &featureWorkspaceClient,
+		WorkspaceReplicationClient: &WorkspaceReplicationClient,
 	}
 }
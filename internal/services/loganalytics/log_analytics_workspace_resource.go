@@ -8,13 +8,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	sharedKeyWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -158,6 +161,40 @@ func resourceLogAnalyticsWorkspace() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"replication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"failover_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"active_location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -334,6 +371,13 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta i
 
 	d.SetId(id.ID())
 
+	if d.HasChange("replication") {
+		replicationClient := meta.(*clients.Client).LogAnalytics.WorkspaceReplicationClient
+		if err := updateLogAnalyticsWorkspaceReplication(ctx, replicationClient, id, d.Get("replication").([]interface{})); err != nil {
+			return fmt.Errorf("updating the replication of %s: %+v", id, err)
+		}
+	}
+
 	return resourceLogAnalyticsWorkspaceRead(d, meta)
 }
 
@@ -461,6 +505,26 @@ func resourceLogAnalyticsWorkspaceRead(d *pluginsdk.ResourceData, meta interface
 			return err
 		}
 	}
+
+	configuredReplication := d.Get("replication").([]interface{})
+	replicationResp, err := meta.(*clients.Client).LogAnalytics.WorkspaceReplicationClient.Get(ctx, *id)
+	if err != nil {
+		// the API Version exposing replication may not be available in every cloud, so this is only fatal when it's used
+		if len(configuredReplication) > 0 {
+			return fmt.Errorf("retrieving the replication of %s: %+v", *id, err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve the replication of %s: %+v", *id, err)
+	}
+
+	replication, activeLocation := flattenLogAnalyticsWorkspaceReplication(replicationResp.Model, configuredReplication)
+	if activeLocation == "" && resp.Model != nil {
+		activeLocation = azure.NormalizeLocation(resp.Model.Location)
+	}
+	if err := d.Set("replication", replication); err != nil {
+		return fmt.Errorf("setting `replication`: %+v", err)
+	}
+	d.Set("active_location", activeLocation)
+
 	return nil
 }
 
@@ -479,6 +543,14 @@ func resourceLogAnalyticsWorkspaceDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	// the Workspace has to be failed back and replication disabled prior to deletion
+	if replication := d.Get("replication").([]interface{}); len(replication) > 0 {
+		replicationClient := meta.(*clients.Client).LogAnalytics.WorkspaceReplicationClient
+		if err := updateLogAnalyticsWorkspaceReplication(ctx, replicationClient, *id, nil); err != nil {
+			return fmt.Errorf("disabling the replication of %s: %+v", *id, err)
+		}
+	}
+
 	PermanentlyDeleteOnDestroy := meta.(*clients.Client).Features.LogAnalyticsWorkspace.PermanentlyDeleteOnDestroy
 	err = client.DeleteThenPoll(ctx, sharedKeyId, sharedKeyWorkspaces.DeleteOperationOptions{Force: utils.Bool(PermanentlyDeleteOnDestroy)})
 	if err != nil {
@@ -496,3 +568,118 @@ func dailyQuotaGbDiffSuppressFunc(_, _, _ string, d *pluginsdk.ResourceData) boo
 
 	return false
 }
+
+// updateLogAnalyticsWorkspaceReplication reconciles the replication and failover of the Workspace with the `replication`
+// block, failing back prior to changing the replication and failing over once the replication is in place
+func updateLogAnalyticsWorkspaceReplication(ctx context.Context, client *azuresdkhacks.WorkspaceReplicationClient, id workspaces.WorkspaceId, input []interface{}) error {
+	enabled := false
+	replicationLocation := ""
+	failoverEnabled := false
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		enabled = raw["enabled"].(bool)
+		replicationLocation = location.Normalize(raw["location"].(string))
+		failoverEnabled = enabled && raw["failover_enabled"].(bool)
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving: %+v", err)
+	}
+
+	currentEnabled := false
+	currentLocation := ""
+	failoverState := azuresdkhacks.WorkspaceFailoverStateInactive
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if replication := model.Properties.Replication; replication != nil {
+			currentEnabled = pointer.From(replication.Enabled)
+			currentLocation = location.Normalize(pointer.From(replication.Location))
+		}
+		if failover := model.Properties.Failover; failover != nil && failover.State != nil {
+			failoverState = *failover.State
+		}
+	}
+
+	failoverActive := failoverState == azuresdkhacks.WorkspaceFailoverStateActive || failoverState == azuresdkhacks.WorkspaceFailoverStateActivating
+	replicationChanged := currentEnabled != enabled || (enabled && currentLocation != replicationLocation)
+
+	if failoverActive && (!failoverEnabled || replicationChanged) {
+		if err := client.FailbackThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("failing back: %+v", err)
+		}
+		failoverActive = false
+	}
+
+	if replicationChanged {
+		// the replication location can't be changed in-place, so replication is disabled first
+		if currentEnabled && enabled {
+			disable := azuresdkhacks.WorkspaceReplication{
+				Enabled:  pointer.To(false),
+				Location: pointer.To(currentLocation),
+			}
+			if err := client.SetReplicationThenPoll(ctx, id, disable); err != nil {
+				return fmt.Errorf("disabling replication to %q: %+v", currentLocation, err)
+			}
+		}
+
+		replication := azuresdkhacks.WorkspaceReplication{
+			Enabled: pointer.To(enabled),
+		}
+		if enabled {
+			replication.Location = pointer.To(replicationLocation)
+		} else if currentLocation != "" {
+			replication.Location = pointer.To(currentLocation)
+		}
+		if err := client.SetReplicationThenPoll(ctx, id, replication); err != nil {
+			return fmt.Errorf("setting replication: %+v", err)
+		}
+	}
+
+	if failoverEnabled && !failoverActive {
+		if err := client.FailoverThenPoll(ctx, id, replicationLocation); err != nil {
+			return fmt.Errorf("failing over to %q: %+v", replicationLocation, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenLogAnalyticsWorkspaceReplication(input *azuresdkhacks.WorkspaceReplicationStatus, configured []interface{}) ([]interface{}, string) {
+	if input == nil {
+		return []interface{}{}, ""
+	}
+
+	activeLocation := location.Normalize(input.Location)
+	if input.Properties == nil || input.Properties.Replication == nil {
+		return []interface{}{}, activeLocation
+	}
+
+	replication := input.Properties.Replication
+	enabled := pointer.From(replication.Enabled)
+	replicationLocation := location.Normalize(pointer.From(replication.Location))
+
+	failoverEnabled := false
+	if failover := input.Properties.Failover; failover != nil && failover.State != nil {
+		switch *failover.State {
+		case azuresdkhacks.WorkspaceFailoverStateActive, azuresdkhacks.WorkspaceFailoverStateActivating:
+			failoverEnabled = true
+		}
+		if *failover.State == azuresdkhacks.WorkspaceFailoverStateActive && replicationLocation != "" {
+			activeLocation = replicationLocation
+		}
+	}
+
+	// a Workspace which has never been replicated is returned with replication disabled, which is only exposed when
+	// the `replication` block is configured to avoid a diff for Workspaces not using replication
+	if !enabled && len(configured) == 0 {
+		return []interface{}{}, activeLocation
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":          enabled,
+			"location":         replicationLocation,
+			"failover_enabled": failoverEnabled,
+		},
+	}, activeLocation
+}
@@ -0,0 +1,425 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// a retention of -1 resets the retention of a table to the default of the workspace
+const logAnalyticsWorkspaceTableDefaultRetention = int64(-1)
+
+type LogAnalyticsWorkspaceTablesModel struct {
+	WorkspaceId string                       `tfschema:"workspace_id"`
+	Table       []LogAnalyticsWorkspaceTable `tfschema:"table"`
+}
+
+type LogAnalyticsWorkspaceTable struct {
+	Name                 string `tfschema:"name"`
+	Plan                 string `tfschema:"plan"`
+	RetentionInDays      int64  `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64  `tfschema:"total_retention_in_days"`
+}
+
+type LogAnalyticsWorkspaceTablesResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTablesResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTablesResource{}
+)
+
+func (r LogAnalyticsWorkspaceTablesResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_tables"
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTablesModel{}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspaces.ValidateWorkspaceID
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"table": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"plan": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(azuresdkhacks.TablePlanAnalytics),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(azuresdkhacks.TablePlanAnalytics),
+							string(azuresdkhacks.TablePlanBasic),
+						}, false),
+					},
+
+					"retention_in_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(4, 730),
+					},
+
+					"total_retention_in_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(4, 2556),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			workspace, err := metadata.Client.LogAnalytics.WorkspaceClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(workspace.HttpResponse) {
+					return fmt.Errorf("%s was not found", *id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if err := applyLogAnalyticsWorkspaceTables(ctx, metadata.Client.LogAnalytics.TablesClient, *id, model.Table, nil); err != nil {
+				return fmt.Errorf("configuring the tables of %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			workspace, err := metadata.Client.LogAnalytics.WorkspaceClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(workspace.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			tables, err := client.ListByWorkspace(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the tables of %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTablesModel{
+				WorkspaceId: id.ID(),
+				Table:       flattenLogAnalyticsWorkspaceTables(tables, model.Table),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// tables which are no longer managed are reset to the defaults of the workspace
+			desired := make(map[string]struct{})
+			for _, v := range model.Table {
+				desired[strings.ToLower(v.Name)] = struct{}{}
+			}
+
+			removed := make([]string, 0)
+			oldTables, _ := metadata.ResourceData.GetChange("table")
+			for _, raw := range oldTables.(*pluginsdk.Set).List() {
+				name := raw.(map[string]interface{})["name"].(string)
+				if _, ok := desired[strings.ToLower(name)]; !ok {
+					removed = append(removed, name)
+				}
+			}
+
+			if err := applyLogAnalyticsWorkspaceTables(ctx, metadata.Client.LogAnalytics.TablesClient, *id, model.Table, removed); err != nil {
+				return fmt.Errorf("configuring the tables of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			removed := make([]string, 0)
+			for _, v := range model.Table {
+				removed = append(removed, v.Name)
+			}
+
+			if err := applyLogAnalyticsWorkspaceTables(ctx, metadata.Client.LogAnalytics.TablesClient, *id, nil, removed); err != nil {
+				return fmt.Errorf("resetting the tables of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			names := make(map[string]struct{})
+			for _, v := range model.Table {
+				if v.Name == "" {
+					// the name isn't known until apply
+					continue
+				}
+
+				if _, ok := names[strings.ToLower(v.Name)]; ok {
+					return fmt.Errorf("the table %q is specified more than once", v.Name)
+				}
+				names[strings.ToLower(v.Name)] = struct{}{}
+
+				if v.Plan == string(azuresdkhacks.TablePlanBasic) && v.RetentionInDays != 0 {
+					return fmt.Errorf("`retention_in_days` cannot be specified for the table %q since the interactive retention of tables using the `Basic` plan is fixed at 8 days", v.Name)
+				}
+
+				if v.RetentionInDays != 0 && v.TotalRetentionInDays != 0 && v.TotalRetentionInDays < v.RetentionInDays {
+					return fmt.Errorf("`total_retention_in_days` must be greater than or equal to `retention_in_days` for the table %q", v.Name)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// applyLogAnalyticsWorkspaceTables retrieves every table of the workspace in a single request and only updates the
+// tables whose configuration differs, so that managing a large number of tables doesn't lead to throttling
+func applyLogAnalyticsWorkspaceTables(ctx context.Context, client *azuresdkhacks.TablesClient, id workspaces.WorkspaceId, desired []LogAnalyticsWorkspaceTable, removed []string) error {
+	tables, err := client.ListByWorkspace(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing tables: %+v", err)
+	}
+
+	existing := make(map[string]azuresdkhacks.Table)
+	for _, v := range tables {
+		existing[strings.ToLower(pointer.From(v.Name))] = v
+	}
+
+	for _, name := range removed {
+		if _, ok := existing[strings.ToLower(name)]; !ok {
+			// nothing to reset if the table has since been removed
+			continue
+		}
+		desired = append(desired, LogAnalyticsWorkspaceTable{
+			Name: name,
+			Plan: string(azuresdkhacks.TablePlanAnalytics),
+		})
+	}
+
+	for _, v := range desired {
+		table, ok := existing[strings.ToLower(v.Name)]
+		if !ok {
+			return fmt.Errorf("the table %q was not found", v.Name)
+		}
+
+		input := expandLogAnalyticsWorkspaceTable(v)
+		if logAnalyticsWorkspaceTableMatches(table, input) {
+			continue
+		}
+
+		if err := client.UpdateThenPoll(ctx, id, pointer.From(table.Name), azuresdkhacks.Table{Properties: &input}); err != nil {
+			return fmt.Errorf("updating the table %q: %+v", v.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandLogAnalyticsWorkspaceTable(input LogAnalyticsWorkspaceTable) azuresdkhacks.TableProperties {
+	plan := azuresdkhacks.TablePlan(input.Plan)
+	if plan == "" {
+		plan = azuresdkhacks.TablePlanAnalytics
+	}
+
+	properties := azuresdkhacks.TableProperties{
+		Plan:                 pointer.To(plan),
+		TotalRetentionInDays: pointer.To(logAnalyticsWorkspaceTableDefaultRetention),
+	}
+
+	// the interactive retention of tables using the `Basic` plan is fixed and can't be set
+	if plan != azuresdkhacks.TablePlanBasic {
+		properties.RetentionInDays = pointer.To(logAnalyticsWorkspaceTableDefaultRetention)
+		if input.RetentionInDays != 0 {
+			properties.RetentionInDays = pointer.To(input.RetentionInDays)
+		}
+	}
+
+	if input.TotalRetentionInDays != 0 {
+		properties.TotalRetentionInDays = pointer.To(input.TotalRetentionInDays)
+	}
+
+	return properties
+}
+
+func logAnalyticsWorkspaceTableMatches(existing azuresdkhacks.Table, desired azuresdkhacks.TableProperties) bool {
+	props := existing.Properties
+	if props == nil {
+		return false
+	}
+
+	if !strings.EqualFold(string(pointer.From(props.Plan)), string(pointer.From(desired.Plan))) {
+		return false
+	}
+
+	retention := logAnalyticsWorkspaceTableRetention(props.RetentionInDays, props.RetentionInDaysAsDefault)
+	if desired.RetentionInDays != nil && retention != *desired.RetentionInDays {
+		return false
+	}
+
+	totalRetention := logAnalyticsWorkspaceTableRetention(props.TotalRetentionInDays, props.TotalRetentionInDaysAsDefault)
+	return totalRetention == pointer.From(desired.TotalRetentionInDays)
+}
+
+func logAnalyticsWorkspaceTableRetention(retention *int64, asDefault *bool) int64 {
+	if retention == nil || pointer.From(asDefault) {
+		return logAnalyticsWorkspaceTableDefaultRetention
+	}
+	return *retention
+}
+
+// flattenLogAnalyticsWorkspaceTables returns the tables which are configured, or when importing (where no tables are
+// configured) the tables which don't use the defaults of the workspace
+func flattenLogAnalyticsWorkspaceTables(input []azuresdkhacks.Table, configured []LogAnalyticsWorkspaceTable) []LogAnalyticsWorkspaceTable {
+	names := make(map[string]struct{})
+	for _, v := range configured {
+		names[strings.ToLower(v.Name)] = struct{}{}
+	}
+
+	result := make([]LogAnalyticsWorkspaceTable, 0)
+	for _, v := range input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		plan := pointer.From(props.Plan)
+		retention := logAnalyticsWorkspaceTableRetention(props.RetentionInDays, props.RetentionInDaysAsDefault)
+		totalRetention := logAnalyticsWorkspaceTableRetention(props.TotalRetentionInDays, props.TotalRetentionInDaysAsDefault)
+
+		if len(configured) > 0 {
+			if _, ok := names[strings.ToLower(pointer.From(v.Name))]; !ok {
+				continue
+			}
+		} else if plan != azuresdkhacks.TablePlanBasic && retention == logAnalyticsWorkspaceTableDefaultRetention && totalRetention == logAnalyticsWorkspaceTableDefaultRetention {
+			continue
+		}
+
+		table := LogAnalyticsWorkspaceTable{
+			Name: pointer.From(v.Name),
+			Plan: string(plan),
+		}
+
+		if plan != azuresdkhacks.TablePlanBasic && retention != logAnalyticsWorkspaceTableDefaultRetention {
+			table.RetentionInDays = retention
+		}
+
+		if totalRetention != logAnalyticsWorkspaceTableDefaultRetention {
+			table.TotalRetentionInDays = totalRetention
+		}
+
+		result = append(result, table)
+	}
+
+	return result
+}
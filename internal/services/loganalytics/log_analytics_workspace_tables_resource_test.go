@@ -0,0 +1,145 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceTablesResource struct{}
+
+func (r LogAnalyticsWorkspaceTablesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaces.ParseWorkspaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := client.LogAnalytics.TablesClient.ListByWorkspace(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("listing the tables of %s: %+v", *id, err)
+	}
+	return utils.Bool(len(tables) > 0), nil
+}
+
+func TestAccLogAnalyticsWorkspaceTables_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_tables", "test")
+	r := LogAnalyticsWorkspaceTablesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTables_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_tables", "test")
+	r := LogAnalyticsWorkspaceTablesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_tables" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  table {
+    name              = "AppEvents"
+    retention_in_days = 7
+  }
+
+  table {
+    name                    = "AppTraces"
+    retention_in_days       = 30
+    total_retention_in_days = 365
+  }
+}
+`, r.template(data))
+}
+
+func (r LogAnalyticsWorkspaceTablesResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_tables" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  table {
+    name              = "AppEvents"
+    retention_in_days = 14
+  }
+
+  table {
+    name                    = "AppTraces"
+    plan                    = "Basic"
+    total_retention_in_days = 90
+  }
+
+  table {
+    name                    = "AppRequests"
+    retention_in_days       = 60
+    total_retention_in_days = 730
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,150 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
+)
+
+// NOTE: this workaround client exists since transformations (`transformKql`) on the data flows of a Data Collection
+// Rule were introduced in API Version 2022-06-01, whereas the version of the Azure SDK we're using is 2021-04-01.
+// The 2022-06-01 API is a superset of 2021-04-01, so the SDK model is sent as-is with the transformations added.
+// TODO 4.0: remove this once `azurerm_monitor_data_collection_rule` is switched to a newer API version
+const dataCollectionRuleTransformationsAPIVersion = "2022-06-01"
+
+type DataCollectionRuleTransformationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataCollectionRuleTransformationsClientWithBaseURI(endpoint string) DataCollectionRuleTransformationsClient {
+	return DataCollectionRuleTransformationsClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/datacollectionrules/%s", dataCollectionRuleTransformationsAPIVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type dataCollectionRuleTransformations struct {
+	Properties *struct {
+		DataFlows *[]struct {
+			TransformKql *string `json:"transformKql,omitempty"`
+		} `json:"dataFlows,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// GetTransformations returns the `transformKql` of each data flow within the Data Collection Rule, in order,
+// where an empty string means the data flow has no transformation
+func (c DataCollectionRuleTransformationsClient) GetTransformations(ctx context.Context, id datacollectionrules.DataCollectionRuleId) ([]string, error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "GetTransformations", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "GetTransformations", resp, "Failure sending request")
+	}
+
+	var model dataCollectionRuleTransformations
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&model),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "GetTransformations", resp, "Failure responding to request")
+	}
+
+	result := make([]string, 0)
+	if model.Properties != nil && model.Properties.DataFlows != nil {
+		for _, v := range *model.Properties.DataFlows {
+			transformation := ""
+			if v.TransformKql != nil {
+				transformation = *v.TransformKql
+			}
+			result = append(result, transformation)
+		}
+	}
+
+	return result, nil
+}
+
+// CreateWithTransformations creates or updates the Data Collection Rule, setting the `transformKql` of each data flow
+// to the transformation at the same index, where an empty string means the data flow has no transformation
+func (c DataCollectionRuleTransformationsClient) CreateWithTransformations(ctx context.Context, id datacollectionrules.DataCollectionRuleId, input datacollectionrules.DataCollectionRuleResource, transformations []string) error {
+	payload, err := withDataCollectionRuleTransformations(input, transformations)
+	if err != nil {
+		return fmt.Errorf("building the payload for %s: %+v", id, err)
+	}
+
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "CreateWithTransformations", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "CreateWithTransformations", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRuleTransformationsClient", "CreateWithTransformations", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func withDataCollectionRuleTransformations(input datacollectionrules.DataCollectionRuleResource, transformations []string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("`properties` was nil")
+	}
+
+	dataFlows, _ := properties["dataFlows"].([]interface{})
+	if len(dataFlows) != len(transformations) {
+		return nil, fmt.Errorf("expected %d transformations for the data flows but got %d", len(dataFlows), len(transformations))
+	}
+
+	for i, v := range dataFlows {
+		dataFlow, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected data flow at index %d", i)
+		}
+		if transformations[i] != "" {
+			dataFlow["transformKql"] = transformations[i]
+		}
+	}
+
+	return payload, nil
+}
+
+func (c DataCollectionRuleTransformationsClient) preparer(ctx context.Context, id datacollectionrules.DataCollectionRuleId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": dataCollectionRuleTransformationsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,146 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+)
+
+// NOTE: this workaround client exists since Azure Monitor Workspaces (`Microsoft.Monitor/accounts`) were introduced in
+// API Version 2023-04-03, which isn't included in the version of the Azure SDK we're using
+const monitorWorkspacesAPIVersion = "2023-04-03"
+
+type MonitorWorkspacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMonitorWorkspacesClientWithBaseURI(endpoint string) MonitorWorkspacesClient {
+	return MonitorWorkspacesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/azuremonitorworkspaces/%s", monitorWorkspacesAPIVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+type MonitorWorkspace struct {
+	Id         *string                     `json:"id,omitempty"`
+	Location   string                      `json:"location"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *MonitorWorkspaceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string          `json:"tags,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
+
+type MonitorWorkspaceProperties struct {
+	AccountId           *string                  `json:"accountId,omitempty"`
+	Metrics             *MonitorWorkspaceMetrics `json:"metrics,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess     `json:"publicNetworkAccess,omitempty"`
+}
+
+type MonitorWorkspaceMetrics struct {
+	InternalId              *string `json:"internalId,omitempty"`
+	PrometheusQueryEndpoint *string `json:"prometheusQueryEndpoint,omitempty"`
+}
+
+type MonitorWorkspaceGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *MonitorWorkspace
+}
+
+// Get ...
+func (c MonitorWorkspacesClient) Get(ctx context.Context, id parse.MonitorWorkspaceId) (result MonitorWorkspaceGetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Create ...
+func (c MonitorWorkspacesClient) Create(ctx context.Context, id parse.MonitorWorkspaceId, input MonitorWorkspace) error {
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Create", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Create", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Create", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c MonitorWorkspacesClient) DeleteThenPoll(ctx context.Context, id parse.MonitorWorkspaceId) error {
+	req, err := c.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azuremonitorworkspaces.MonitorWorkspacesClient", "Delete", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c MonitorWorkspacesClient) preparer(ctx context.Context, id parse.MonitorWorkspaceId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": monitorWorkspacesAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,146 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the Access Mode Settings of an Azure Monitor Private Link Scope are
+// part of API Version 2021-07-01-preview, but are missing from the models in the version of the Azure SDK we're using
+type PrivateLinkScopesWorkaroundClient struct {
+	sdkClient *insights.PrivateLinkScopesClient
+}
+
+func NewPrivateLinkScopesWorkaroundClient(client *insights.PrivateLinkScopesClient) PrivateLinkScopesWorkaroundClient {
+	return PrivateLinkScopesWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+const privateLinkScopesAPIVersion = "2021-07-01-preview"
+
+type AccessMode string
+
+const (
+	AccessModeOpen        AccessMode = "Open"
+	AccessModePrivateOnly AccessMode = "PrivateOnly"
+)
+
+func PossibleValuesForAccessMode() []string {
+	return []string{
+		string(AccessModeOpen),
+		string(AccessModePrivateOnly),
+	}
+}
+
+type PrivateLinkScope struct {
+	autorest.Response `json:"-"`
+	Location          *string                     `json:"location,omitempty"`
+	Properties        *PrivateLinkScopeProperties `json:"properties,omitempty"`
+	Tags              map[string]*string          `json:"tags"`
+}
+
+type PrivateLinkScopeProperties struct {
+	AccessModeSettings *AccessModeSettings `json:"accessModeSettings,omitempty"`
+}
+
+type AccessModeSettings struct {
+	IngestionAccessMode AccessMode `json:"ingestionAccessMode"`
+	QueryAccessMode     AccessMode `json:"queryAccessMode"`
+}
+
+func (c PrivateLinkScopesWorkaroundClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, scopeName string, parameters PrivateLinkScope) (result PrivateLinkScope, err error) {
+	req, err := c.createOrUpdatePreparer(ctx, resourceGroupName, scopeName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.responder(resp, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c PrivateLinkScopesWorkaroundClient) Get(ctx context.Context, resourceGroupName string, scopeName string) (result PrivateLinkScope, err error) {
+	req, err := c.getPreparer(ctx, resourceGroupName, scopeName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.responder(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.PrivateLinkScopesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c PrivateLinkScopesWorkaroundClient) createOrUpdatePreparer(ctx context.Context, resourceGroupName string, scopeName string, parameters PrivateLinkScope) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"scopeName":         autorest.Encode("path", scopeName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": privateLinkScopesAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Insights/privateLinkScopes/{scopeName}", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c PrivateLinkScopesWorkaroundClient) getPreparer(ctx context.Context, resourceGroupName string, scopeName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"scopeName":         autorest.Encode("path", scopeName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": privateLinkScopesAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Insights/privateLinkScopes/{scopeName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c PrivateLinkScopesWorkaroundClient) responder(resp *http.Response, codes ...int) (result PrivateLinkScope, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(codes...),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,158 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+)
+
+// NOTE: this workaround client exists since Prometheus Rule Groups were introduced in API Version 2023-03-01 of
+// `Microsoft.AlertsManagement`, which isn't included in the version of the Azure SDK we're using
+const prometheusRuleGroupsAPIVersion = "2023-03-01"
+
+type PrometheusRuleGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrometheusRuleGroupsClientWithBaseURI(endpoint string) PrometheusRuleGroupsClient {
+	return PrometheusRuleGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/prometheusrulegroups/%s", prometheusRuleGroupsAPIVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type PrometheusRuleGroup struct {
+	Id         *string                       `json:"id,omitempty"`
+	Location   string                        `json:"location"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties PrometheusRuleGroupProperties `json:"properties"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type PrometheusRuleGroupProperties struct {
+	ClusterName *string          `json:"clusterName,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Enabled     *bool            `json:"enabled,omitempty"`
+	Interval    *string          `json:"interval,omitempty"`
+	Rules       []PrometheusRule `json:"rules"`
+	Scopes      []string         `json:"scopes"`
+}
+
+type PrometheusRule struct {
+	Actions              *[]PrometheusRuleGroupAction        `json:"actions,omitempty"`
+	Alert                *string                             `json:"alert,omitempty"`
+	Annotations          *map[string]string                  `json:"annotations,omitempty"`
+	Enabled              *bool                               `json:"enabled,omitempty"`
+	Expression           string                              `json:"expression"`
+	For                  *string                             `json:"for,omitempty"`
+	Labels               *map[string]string                  `json:"labels,omitempty"`
+	Record               *string                             `json:"record,omitempty"`
+	ResolveConfiguration *PrometheusRuleResolveConfiguration `json:"resolveConfiguration,omitempty"`
+	Severity             *int64                              `json:"severity,omitempty"`
+}
+
+type PrometheusRuleGroupAction struct {
+	ActionGroupId    *string            `json:"actionGroupId,omitempty"`
+	ActionProperties *map[string]string `json:"actionProperties,omitempty"`
+}
+
+type PrometheusRuleResolveConfiguration struct {
+	AutoResolved  *bool   `json:"autoResolved,omitempty"`
+	TimeToResolve *string `json:"timeToResolve,omitempty"`
+}
+
+type PrometheusRuleGroupGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *PrometheusRuleGroup
+}
+
+// Get ...
+func (c PrometheusRuleGroupsClient) Get(ctx context.Context, id parse.PrometheusRuleGroupId) (result PrometheusRuleGroupGetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdate ...
+func (c PrometheusRuleGroupsClient) CreateOrUpdate(ctx context.Context, id parse.PrometheusRuleGroupId, input PrometheusRuleGroup) error {
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// Delete ...
+func (c PrometheusRuleGroupsClient) Delete(ctx context.Context, id parse.PrometheusRuleGroupId) error {
+	req, err := c.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "prometheusrulegroups.PrometheusRuleGroupsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (c PrometheusRuleGroupsClient) preparer(ctx context.Context, id parse.PrometheusRuleGroupId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": prometheusRuleGroupsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -14,6 +14,7 @@ import (
 	diagnosticCategoryClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
 )
 
 type Client struct {
@@ -26,24 +27,27 @@ type Client struct {
 	// alerts management
 	ActionRulesClient             *alertsmanagement.ActionRulesClient
 	AlertProcessingRulesClient    *alertprocessingrules.AlertProcessingRulesClient
+	PrometheusRuleGroupsClient    *azuresdkhacks.PrometheusRuleGroupsClient
 	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
-	ActionGroupsClient                   *newActionGroupClient.ActionGroupsClient
-	ActivityLogsClient                   *classic.ActivityLogsClient
-	ActivityLogAlertsClient              *insights.ActivityLogAlertsClient
-	AlertRulesClient                     *classic.AlertRulesClient
-	DataCollectionEndpointsClient        *datacollectionendpoints.DataCollectionEndpointsClient
-	DataCollectionRuleAssociationsClient *datacollectionruleassociations.DataCollectionRuleAssociationsClient
-	DataCollectionRulesClient            *datacollectionrules.DataCollectionRulesClient
-	DiagnosticSettingsClient             *diagnosticSettingClient.DiagnosticSettingsClient
-	DiagnosticSettingsCategoryClient     *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
-	LogProfilesClient                    *classic.LogProfilesClient
-	MetricAlertsClient                   *classic.MetricAlertsClient
-	PrivateLinkScopesClient              *classic.PrivateLinkScopesClient
-	PrivateLinkScopedResourcesClient     *classic.PrivateLinkScopedResourcesClient
-	ScheduledQueryRulesClient            *classic.ScheduledQueryRulesClient
-	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
+	ActionGroupsClient                      *newActionGroupClient.ActionGroupsClient
+	ActivityLogsClient                      *classic.ActivityLogsClient
+	ActivityLogAlertsClient                 *insights.ActivityLogAlertsClient
+	AlertRulesClient                        *classic.AlertRulesClient
+	DataCollectionEndpointsClient           *datacollectionendpoints.DataCollectionEndpointsClient
+	DataCollectionRuleAssociationsClient    *datacollectionruleassociations.DataCollectionRuleAssociationsClient
+	DataCollectionRulesClient               *datacollectionrules.DataCollectionRulesClient
+	DataCollectionRuleTransformationsClient *azuresdkhacks.DataCollectionRuleTransformationsClient
+	DiagnosticSettingsClient                *diagnosticSettingClient.DiagnosticSettingsClient
+	DiagnosticSettingsCategoryClient        *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
+	LogProfilesClient                       *classic.LogProfilesClient
+	MetricAlertsClient                      *classic.MetricAlertsClient
+	MonitorWorkspacesClient                 *azuresdkhacks.MonitorWorkspacesClient
+	PrivateLinkScopesClient                 *classic.PrivateLinkScopesClient
+	PrivateLinkScopedResourcesClient        *classic.PrivateLinkScopedResourcesClient
+	ScheduledQueryRulesClient               *classic.ScheduledQueryRulesClient
+	ScheduledQueryRulesV2Client             *scheduledqueryrules.ScheduledQueryRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -59,6 +63,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertProcessingRulesClient := alertprocessingrules.NewAlertProcessingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&AlertProcessingRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	PrometheusRuleGroupsClient := azuresdkhacks.NewPrometheusRuleGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PrometheusRuleGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	SmartDetectorAlertRulesClient := alertsmanagement.NewSmartDetectorAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SmartDetectorAlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -83,6 +90,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	DataCollectionRulesClient := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	DataCollectionRuleTransformationsClient := azuresdkhacks.NewDataCollectionRuleTransformationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&DataCollectionRuleTransformationsClient.Client, o.ResourceManagerAuthorizer)
+
 	DiagnosticSettingsClient := diagnosticSettingClient.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -95,6 +105,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	MetricAlertsClient := classic.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&MetricAlertsClient.Client, o.ResourceManagerAuthorizer)
 
+	MonitorWorkspacesClient := azuresdkhacks.NewMonitorWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&MonitorWorkspacesClient.Client, o.ResourceManagerAuthorizer)
+
 	PrivateLinkScopesClient := classic.NewPrivateLinkScopesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PrivateLinkScopesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -108,25 +121,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ScheduledQueryRulesV2Client.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AADDiagnosticSettingsClient:          &AADDiagnosticSettingsClient,
-		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
-		ActionRulesClient:                    &ActionRulesClient,
-		SmartDetectorAlertRulesClient:        &SmartDetectorAlertRulesClient,
-		ActionGroupsClient:                   &ActionGroupsClient,
-		ActivityLogsClient:                   &activityLogsClient,
-		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
-		AlertRulesClient:                     &AlertRulesClient,
-		AlertProcessingRulesClient:           &AlertProcessingRulesClient,
-		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
-		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
-		DataCollectionRulesClient:            &DataCollectionRulesClient,
-		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
-		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
-		LogProfilesClient:                    &LogProfilesClient,
-		MetricAlertsClient:                   &MetricAlertsClient,
-		PrivateLinkScopesClient:              &PrivateLinkScopesClient,
-		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
-		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
-		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
+		AADDiagnosticSettingsClient:             &AADDiagnosticSettingsClient,
+		AutoscaleSettingsClient:                 &AutoscaleSettingsClient,
+		ActionRulesClient:                       &ActionRulesClient,
+		SmartDetectorAlertRulesClient:           &SmartDetectorAlertRulesClient,
+		ActionGroupsClient:                      &ActionGroupsClient,
+		ActivityLogsClient:                      &activityLogsClient,
+		ActivityLogAlertsClient:                 &ActivityLogAlertsClient,
+		AlertRulesClient:                        &AlertRulesClient,
+		AlertProcessingRulesClient:              &AlertProcessingRulesClient,
+		DataCollectionEndpointsClient:           &DataCollectionEndpointsClient,
+		DataCollectionRuleAssociationsClient:    &DataCollectionRuleAssociationsClient,
+		DataCollectionRulesClient:               &DataCollectionRulesClient,
+		DataCollectionRuleTransformationsClient: &DataCollectionRuleTransformationsClient,
+		DiagnosticSettingsClient:                &DiagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient:        &DiagnosticSettingsCategoryClient,
+		LogProfilesClient:                       &LogProfilesClient,
+		MetricAlertsClient:                      &MetricAlertsClient,
+		MonitorWorkspacesClient:                 &MonitorWorkspacesClient,
+		PrivateLinkScopesClient:                 &PrivateLinkScopesClient,
+		PrivateLinkScopedResourcesClient:        &PrivateLinkScopedResourcesClient,
+		PrometheusRuleGroupsClient:              &PrometheusRuleGroupsClient,
+		ScheduledQueryRulesClient:               &ScheduledQueryRulesClient,
+		ScheduledQueryRulesV2Client:             &ScheduledQueryRulesV2Client,
 	}
 }
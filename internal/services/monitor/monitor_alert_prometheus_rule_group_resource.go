@@ -0,0 +1,526 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AlertPrometheusRuleGroupModel struct {
+	Name              string                         `tfschema:"name"`
+	ResourceGroupName string                         `tfschema:"resource_group_name"`
+	Location          string                         `tfschema:"location"`
+	Scopes            []string                       `tfschema:"scopes"`
+	ClusterName       string                         `tfschema:"cluster_name"`
+	Description       string                         `tfschema:"description"`
+	RuleGroupEnabled  bool                           `tfschema:"rule_group_enabled"`
+	Interval          string                         `tfschema:"interval"`
+	Rule              []AlertPrometheusRuleGroupRule `tfschema:"rule"`
+	RulesYaml         string                         `tfschema:"rules_yaml"`
+	Tags              map[string]string              `tfschema:"tags"`
+}
+
+type AlertPrometheusRuleGroupRule struct {
+	Record          string                                    `tfschema:"record"`
+	Alert           string                                    `tfschema:"alert"`
+	Expression      string                                    `tfschema:"expression"`
+	Enabled         bool                                      `tfschema:"enabled"`
+	For             string                                    `tfschema:"for"`
+	Severity        int64                                     `tfschema:"severity"`
+	Labels          map[string]string                         `tfschema:"labels"`
+	Annotations     map[string]string                         `tfschema:"annotations"`
+	Action          []AlertPrometheusRuleGroupAction          `tfschema:"action"`
+	AlertResolution []AlertPrometheusRuleGroupAlertResolution `tfschema:"alert_resolution"`
+}
+
+type AlertPrometheusRuleGroupAction struct {
+	ActionGroupId    string            `tfschema:"action_group_id"`
+	ActionProperties map[string]string `tfschema:"action_properties"`
+}
+
+type AlertPrometheusRuleGroupAlertResolution struct {
+	AutoResolved  bool   `tfschema:"auto_resolved"`
+	TimeToResolve string `tfschema:"time_to_resolve"`
+}
+
+type AlertPrometheusRuleGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+
+func (r AlertPrometheusRuleGroupResource) ResourceType() string {
+	return "azurerm_monitor_alert_prometheus_rule_group"
+}
+
+func (r AlertPrometheusRuleGroupResource) ModelObject() interface{} {
+	return &AlertPrometheusRuleGroupModel{}
+}
+
+func (r AlertPrometheusRuleGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrometheusRuleGroupID
+}
+
+func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"cluster_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rule_group_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		// when `rules_yaml` is used the interval may be sourced from the rule file instead
+		"interval": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: helpersValidate.ISO8601Duration,
+		},
+
+		"rule": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"rules_yaml"},
+			AtLeastOneOf:  []string{"rule", "rules_yaml"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"record": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"alert": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"expression": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"for": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: helpersValidate.ISO8601Duration,
+					},
+
+					"severity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 4),
+					},
+
+					"labels": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"annotations": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"action_group_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: azure.ValidateResourceID,
+								},
+
+								"action_properties": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+
+					"alert_resolution": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"auto_resolved": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+
+								"time_to_resolve": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: helpersValidate.ISO8601Duration,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"rules_yaml": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"rule"},
+			AtLeastOneOf:  []string{"rule", "rules_yaml"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AlertPrometheusRuleGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertPrometheusRuleGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewPrometheusRuleGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandAlertPrometheusRuleGroupProperties(model)
+			if err != nil {
+				return err
+			}
+
+			input := azuresdkhacks.PrometheusRuleGroup{
+				Location:   location.Normalize(model.Location),
+				Properties: *properties,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AlertPrometheusRuleGroupModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				// the rule file isn't returned by the API, the rules it defines are exposed through `rule` instead
+				RulesYaml: metadata.ResourceData.Get("rules_yaml").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				state.Scopes = props.Scopes
+				state.ClusterName = pointer.From(props.ClusterName)
+				state.Description = pointer.From(props.Description)
+				state.RuleGroupEnabled = pointer.From(props.Enabled)
+				state.Interval = pointer.From(props.Interval)
+				state.Rule = flattenAlertPrometheusRuleGroupRules(props.Rules)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertPrometheusRuleGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			existing := resp.Model
+
+			properties, err := expandAlertPrometheusRuleGroupProperties(model)
+			if err != nil {
+				return err
+			}
+			existing.Properties = *properties
+
+			if metadata.ResourceData.HasChange("tags") {
+				existing.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdate(ctx, *id, *existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAlertPrometheusRuleGroupProperties(model AlertPrometheusRuleGroupModel) (*azuresdkhacks.PrometheusRuleGroupProperties, error) {
+	properties := azuresdkhacks.PrometheusRuleGroupProperties{
+		Enabled: pointer.To(model.RuleGroupEnabled),
+		Scopes:  model.Scopes,
+	}
+
+	if model.ClusterName != "" {
+		properties.ClusterName = pointer.To(model.ClusterName)
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	if model.RulesYaml != "" {
+		rules, interval, err := expandPrometheusRuleGroupRulesFromYaml(model.RulesYaml)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `rules_yaml`: %+v", err)
+		}
+		properties.Rules = rules
+
+		if model.Interval == "" && interval != "" {
+			model.Interval = interval
+		}
+	} else {
+		properties.Rules = expandAlertPrometheusRuleGroupRules(model.Rule)
+	}
+
+	if model.Interval != "" {
+		properties.Interval = pointer.To(model.Interval)
+	}
+
+	return &properties, nil
+}
+
+func expandAlertPrometheusRuleGroupRules(input []AlertPrometheusRuleGroupRule) []azuresdkhacks.PrometheusRule {
+	rules := make([]azuresdkhacks.PrometheusRule, 0)
+	for _, v := range input {
+		rule := azuresdkhacks.PrometheusRule{
+			Enabled:    pointer.To(v.Enabled),
+			Expression: v.Expression,
+		}
+
+		if v.Record != "" {
+			rule.Record = pointer.To(v.Record)
+		}
+
+		if v.Alert != "" {
+			rule.Alert = pointer.To(v.Alert)
+			rule.Severity = pointer.To(v.Severity)
+		}
+
+		if v.For != "" {
+			rule.For = pointer.To(v.For)
+		}
+
+		if len(v.Labels) > 0 {
+			rule.Labels = pointer.To(v.Labels)
+		}
+
+		if len(v.Annotations) > 0 {
+			rule.Annotations = pointer.To(v.Annotations)
+		}
+
+		if len(v.Action) > 0 {
+			actions := make([]azuresdkhacks.PrometheusRuleGroupAction, 0)
+			for _, action := range v.Action {
+				actions = append(actions, azuresdkhacks.PrometheusRuleGroupAction{
+					ActionGroupId:    pointer.To(action.ActionGroupId),
+					ActionProperties: pointer.To(action.ActionProperties),
+				})
+			}
+			rule.Actions = &actions
+		}
+
+		if len(v.AlertResolution) > 0 {
+			resolution := v.AlertResolution[0]
+			rule.ResolveConfiguration = &azuresdkhacks.PrometheusRuleResolveConfiguration{
+				AutoResolved: pointer.To(resolution.AutoResolved),
+			}
+			if resolution.TimeToResolve != "" {
+				rule.ResolveConfiguration.TimeToResolve = pointer.To(resolution.TimeToResolve)
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenAlertPrometheusRuleGroupRules(input []azuresdkhacks.PrometheusRule) []AlertPrometheusRuleGroupRule {
+	rules := make([]AlertPrometheusRuleGroupRule, 0)
+	for _, v := range input {
+		rule := AlertPrometheusRuleGroupRule{
+			Record:      pointer.From(v.Record),
+			Alert:       pointer.From(v.Alert),
+			Expression:  v.Expression,
+			Enabled:     v.Enabled == nil || *v.Enabled,
+			For:         pointer.From(v.For),
+			Severity:    pointer.From(v.Severity),
+			Labels:      pointer.From(v.Labels),
+			Annotations: pointer.From(v.Annotations),
+		}
+
+		if v.Actions != nil {
+			actions := make([]AlertPrometheusRuleGroupAction, 0)
+			for _, action := range *v.Actions {
+				actions = append(actions, AlertPrometheusRuleGroupAction{
+					ActionGroupId:    pointer.From(action.ActionGroupId),
+					ActionProperties: pointer.From(action.ActionProperties),
+				})
+			}
+			rule.Action = actions
+		}
+
+		if resolution := v.ResolveConfiguration; resolution != nil {
+			rule.AlertResolution = []AlertPrometheusRuleGroupAlertResolution{
+				{
+					AutoResolved:  pointer.From(resolution.AutoResolved),
+					TimeToResolve: pointer.From(resolution.TimeToResolve),
+				},
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
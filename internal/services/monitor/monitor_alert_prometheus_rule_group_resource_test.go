@@ -0,0 +1,282 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorAlertPrometheusRuleGroupResource struct{}
+
+func (r MonitorAlertPrometheusRuleGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrometheusRuleGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.PrometheusRuleGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_rulesYaml(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rulesYaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("interval").HasValue("PT1M"),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+				check.That(data.ResourceName).Key("rule.0.record").HasValue("job:node_cpu_seconds:rate5m"),
+				check.That(data.ResourceName).Key("rule.1.alert").HasValue("HighCPU"),
+				check.That(data.ResourceName).Key("rule.1.for").HasValue("PT10M"),
+			),
+		},
+		data.ImportStep("rules_yaml"),
+	})
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-amprg-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-amw-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rule {
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type))"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "import" {
+  name                = azurerm_monitor_alert_prometheus_rule_group.test.name
+  resource_group_name = azurerm_monitor_alert_prometheus_rule_group.test.resource_group_name
+  location            = azurerm_monitor_alert_prometheus_rule_group.test.location
+  scopes              = azurerm_monitor_alert_prometheus_rule_group.test.scopes
+
+  rule {
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type))"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = "example-cluster"
+  description         = "Acceptance Test Prometheus Rule Group"
+  rule_group_enabled  = false
+  interval            = "PT1M"
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rule {
+    enabled    = false
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type))"
+
+    labels = {
+      team = "prod"
+    }
+  }
+
+  rule {
+    alert      = "Billing_Processing_Very_Slow"
+    enabled    = true
+    expression = "job_type:billing_jobs_duration_seconds:99p5m > 30"
+    for        = "PT5M"
+    severity   = 2
+
+    action {
+      action_group_id = azurerm_monitor_action_group.test.id
+
+      action_properties = {
+        key = "value"
+      }
+    }
+
+    alert_resolution {
+      auto_resolved   = true
+      time_to_resolve = "PT10M"
+    }
+
+    annotations = {
+      annotationName = "annotationValue"
+    }
+
+    labels = {
+      team = "prod"
+    }
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) rulesYaml(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rules_yaml = <<YAML
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: example
+spec:
+  groups:
+    - name: example
+      interval: 1m
+      rules:
+        - record: job:node_cpu_seconds:rate5m
+          expr: sum by (job) (rate(node_cpu_seconds_total{mode!="idle"}[5m]))
+        - alert: HighCPU
+          expr: job:node_cpu_seconds:rate5m > 0.9
+          for: 10m
+          labels:
+            severity: warning
+          annotations:
+            summary: High CPU usage
+YAML
+}
+`, r.template(data), data.RandomInteger)
+}
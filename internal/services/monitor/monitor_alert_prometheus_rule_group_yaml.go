@@ -0,0 +1,151 @@
+package monitor
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"gopkg.in/yaml.v3"
+)
+
+// prometheusRuleFile models both a standard Prometheus rule file and a prometheus-operator `PrometheusRule`, where
+// the groups are nested within `spec`
+type prometheusRuleFile struct {
+	Groups []prometheusRuleFileGroup `yaml:"groups"`
+	Spec   *struct {
+		Groups []prometheusRuleFileGroup `yaml:"groups"`
+	} `yaml:"spec"`
+}
+
+type prometheusRuleFileGroup struct {
+	Name     string                   `yaml:"name"`
+	Interval string                   `yaml:"interval"`
+	Rules    []prometheusRuleFileRule `yaml:"rules"`
+}
+
+type prometheusRuleFileRule struct {
+	Alert       string            `yaml:"alert"`
+	Record      string            `yaml:"record"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+// expandPrometheusRuleGroupRulesFromYaml converts a Prometheus rule file containing a single rule group into the rules
+// of an Azure Prometheus Rule Group, returning the rules and the (ISO8601) evaluation interval of the group if set
+func expandPrometheusRuleGroupRulesFromYaml(input string) ([]azuresdkhacks.PrometheusRule, string, error) {
+	var file prometheusRuleFile
+	if err := yaml.Unmarshal([]byte(input), &file); err != nil {
+		return nil, "", fmt.Errorf("parsing the Prometheus rule file: %+v", err)
+	}
+
+	groups := file.Groups
+	if len(groups) == 0 && file.Spec != nil {
+		groups = file.Spec.Groups
+	}
+	if len(groups) != 1 {
+		return nil, "", fmt.Errorf("the Prometheus rule file must contain exactly one rule group but got %d", len(groups))
+	}
+
+	group := groups[0]
+
+	interval := ""
+	if group.Interval != "" {
+		v, err := prometheusDurationToISO8601(group.Interval)
+		if err != nil {
+			return nil, "", fmt.Errorf("parsing the `interval` of rule group %q: %+v", group.Name, err)
+		}
+		interval = v
+	}
+
+	rules := make([]azuresdkhacks.PrometheusRule, 0)
+	for i, r := range group.Rules {
+		if (r.Alert == "") == (r.Record == "") {
+			return nil, "", fmt.Errorf("rule %d of rule group %q must specify exactly one of `alert` or `record`", i, group.Name)
+		}
+		if strings.TrimSpace(r.Expr) == "" {
+			return nil, "", fmt.Errorf("rule %d of rule group %q must specify an `expr`", i, group.Name)
+		}
+
+		rule := azuresdkhacks.PrometheusRule{
+			Expression: strings.TrimSpace(r.Expr),
+		}
+
+		if r.Record != "" {
+			rule.Record = pointer.To(r.Record)
+		} else {
+			rule.Alert = pointer.To(r.Alert)
+		}
+
+		if r.For != "" {
+			v, err := prometheusDurationToISO8601(r.For)
+			if err != nil {
+				return nil, "", fmt.Errorf("parsing the `for` of rule %d of rule group %q: %+v", i, group.Name, err)
+			}
+			rule.For = &v
+		}
+
+		if len(r.Labels) > 0 {
+			labels := r.Labels
+			rule.Labels = &labels
+		}
+
+		if len(r.Annotations) > 0 {
+			annotations := r.Annotations
+			rule.Annotations = &annotations
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, interval, nil
+}
+
+var prometheusDurationRegex = regexp.MustCompile(`^(?:(\d+)y)?(?:(\d+)w)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?$`)
+
+// prometheusDurationToISO8601 converts a Prometheus duration (e.g. `1h30m`) into an ISO8601 duration (e.g. `PT1H30M`)
+func prometheusDurationToISO8601(input string) (string, error) {
+	matches := prometheusDurationRegex.FindStringSubmatch(input)
+	if input == "" || matches == nil {
+		return "", fmt.Errorf("%q is not a supported Prometheus duration", input)
+	}
+
+	values := make([]int, len(matches)-1)
+	for i, v := range matches[1:] {
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return "", fmt.Errorf("parsing %q: %+v", input, err)
+		}
+		values[i] = n
+	}
+
+	years, weeks, days, hours, minutes, seconds := values[0], values[1], values[2], values[3], values[4], values[5]
+	days += years*365 + weeks*7
+
+	result := "P"
+	if days > 0 {
+		result += fmt.Sprintf("%dD", days)
+	}
+
+	if hours > 0 || minutes > 0 || seconds > 0 || days == 0 {
+		result += "T"
+		if hours > 0 {
+			result += fmt.Sprintf("%dH", hours)
+		}
+		if minutes > 0 {
+			result += fmt.Sprintf("%dM", minutes)
+		}
+		if seconds > 0 || (hours == 0 && minutes == 0) {
+			result += fmt.Sprintf("%dS", seconds)
+		}
+	}
+
+	return result, nil
+}
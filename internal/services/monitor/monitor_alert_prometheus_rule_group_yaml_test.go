@@ -0,0 +1,176 @@
+package monitor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestPrometheusDurationToISO8601(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{Input: "", Error: true},
+		{Input: "5", Error: true},
+		{Input: "500ms", Error: true},
+		{Input: "5m1h", Error: true},
+		{Input: "0s", Expected: "PT0S"},
+		{Input: "30s", Expected: "PT30S"},
+		{Input: "5m", Expected: "PT5M"},
+		{Input: "1h30m", Expected: "PT1H30M"},
+		{Input: "1d", Expected: "P1D"},
+		{Input: "1w2d12h", Expected: "P9DT12H"},
+		{Input: "1y", Expected: "P365D"},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := prometheusDurationToISO8601(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+			t.Fatalf("expected no error for %q but got: %+v", tc.Input, err)
+		}
+		if tc.Error {
+			t.Fatalf("expected an error for %q but got %q", tc.Input, actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q for %q but got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestExpandPrometheusRuleGroupRulesFromYaml(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Input            string
+		ExpectedRules    []azuresdkhacks.PrometheusRule
+		ExpectedInterval string
+		Error            bool
+	}{
+		{
+			Name:  "invalid yaml",
+			Input: "groups: [",
+			Error: true,
+		},
+		{
+			Name: "multiple groups",
+			Input: `
+groups:
+  - name: one
+    rules: []
+  - name: two
+    rules: []
+`,
+			Error: true,
+		},
+		{
+			Name: "alert and record",
+			Input: `
+groups:
+  - name: example
+    rules:
+      - alert: Example
+        record: example:rate5m
+        expr: up == 0
+`,
+			Error: true,
+		},
+		{
+			Name: "missing expression",
+			Input: `
+groups:
+  - name: example
+    rules:
+      - alert: Example
+`,
+			Error: true,
+		},
+		{
+			Name: "rule file",
+			Input: `
+groups:
+  - name: example
+    interval: 1m
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighErrorRate
+        expr: |
+          job:http_errors:rate5m > 0.5
+        for: 10m
+        labels:
+          severity: page
+        annotations:
+          summary: High error rate
+`,
+			ExpectedInterval: "PT1M",
+			ExpectedRules: []azuresdkhacks.PrometheusRule{
+				{
+					Record:     utils.String("job:http_requests:rate5m"),
+					Expression: "sum by (job) (rate(http_requests_total[5m]))",
+				},
+				{
+					Alert:       utils.String("HighErrorRate"),
+					Expression:  "job:http_errors:rate5m > 0.5",
+					For:         utils.String("PT10M"),
+					Labels:      &map[string]string{"severity": "page"},
+					Annotations: &map[string]string{"summary": "High error rate"},
+				},
+			},
+		},
+		{
+			Name: "prometheus-operator rule",
+			Input: `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: example
+spec:
+  groups:
+    - name: example
+      rules:
+        - alert: InstanceDown
+          expr: up == 0
+          for: 5m
+`,
+			ExpectedRules: []azuresdkhacks.PrometheusRule{
+				{
+					Alert:      utils.String("InstanceDown"),
+					Expression: "up == 0",
+					For:        utils.String("PT5M"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		rules, interval, err := expandPrometheusRuleGroupRulesFromYaml(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+			t.Fatalf("expected no error for %q but got: %+v", tc.Name, err)
+		}
+		if tc.Error {
+			t.Fatalf("expected an error for %q", tc.Name)
+		}
+
+		if interval != tc.ExpectedInterval {
+			t.Fatalf("expected interval %q for %q but got %q", tc.ExpectedInterval, tc.Name, interval)
+		}
+
+		if !reflect.DeepEqual(rules, tc.ExpectedRules) {
+			t.Fatalf("expected rules %+v for %q but got %+v", tc.ExpectedRules, tc.Name, rules)
+		}
+	}
+}
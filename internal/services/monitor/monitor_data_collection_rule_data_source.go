@@ -64,6 +64,10 @@ func (d DataCollectionRuleDataSource) Attributes() map[string]*pluginsdk.Schema
 							Type: pluginsdk.TypeString,
 						},
 					},
+					"transform_kql": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -290,6 +294,12 @@ func (d DataCollectionRuleDataSource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			transformations, err := metadata.Client.Monitor.DataCollectionRuleTransformationsClient.GetTransformations(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving the data flow transformations of %s: %+v", id, err)
+			}
+			flattenDataCollectionRuleDataFlowTransformations(dataFlows, transformations)
+
 			metadata.SetID(id)
 
 			return metadata.Encode(&DataCollectionRule{
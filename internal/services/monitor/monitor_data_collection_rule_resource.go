@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -34,6 +35,7 @@ type DataCollectionRule struct {
 type DataFlow struct {
 	Destinations []string `tfschema:"destinations"`
 	Streams      []string `tfschema:"streams"`
+	TransformKql string   `tfschema:"transform_kql"`
 }
 
 type DataSource struct {
@@ -124,6 +126,11 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
+					"transform_kql": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.DataCollectionRuleTransformKql,
+					},
 				},
 			},
 		},
@@ -409,8 +416,15 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 				Tags: tags.Expand(state.Tags),
 			}
 
-			if _, err := client.Create(ctx, id, input); err != nil {
-				return fmt.Errorf("creating %s: %+v", id, err)
+			if transformations := expandDataCollectionRuleDataFlowTransformations(state.DataFlows); transformations != nil {
+				transformationsClient := metadata.Client.Monitor.DataCollectionRuleTransformationsClient
+				if err := transformationsClient.CreateWithTransformations(ctx, id, input, transformations); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+			} else {
+				if _, err := client.Create(ctx, id, input); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
 			}
 
 			metadata.SetID(id)
@@ -457,6 +471,12 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			transformations, err := metadata.Client.Monitor.DataCollectionRuleTransformationsClient.GetTransformations(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving the data flow transformations of %s: %+v", *id, err)
+			}
+			flattenDataCollectionRuleDataFlowTransformations(dataFlows, transformations)
+
 			return metadata.Encode(&DataCollectionRule{
 				Name:              id.DataCollectionRuleName,
 				ResourceGroupName: id.ResourceGroupName,
@@ -531,8 +551,17 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 			// otherwise Service will return an error: "The resource definition is invalid."
 			existing.SystemData = nil
 
-			if _, err := client.Create(ctx, *id, *existing); err != nil {
-				return fmt.Errorf("updating %s: %+v", *id, err)
+			// the transformations aren't part of the model retrieved above, so they're always sent from the config,
+			// otherwise updating any other property would remove them
+			if transformations := expandDataCollectionRuleDataFlowTransformations(state.DataFlows); transformations != nil {
+				transformationsClient := metadata.Client.Monitor.DataCollectionRuleTransformationsClient
+				if err := transformationsClient.CreateWithTransformations(ctx, *id, *existing, transformations); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			} else {
+				if _, err := client.Create(ctx, *id, *existing); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
 			}
 			return nil
 		},
@@ -588,6 +617,24 @@ func expandDataCollectionRuleDataFlows(input []DataFlow) *[]datacollectionrules.
 	return &result
 }
 
+// expandDataCollectionRuleDataFlowTransformations returns the transformation of each data flow, or nil when none
+// of the data flows have a transformation
+func expandDataCollectionRuleDataFlowTransformations(input []DataFlow) []string {
+	result := make([]string, 0)
+	found := false
+	for _, v := range input {
+		result = append(result, v.TransformKql)
+		if v.TransformKql != "" {
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return result
+}
+
 func expandDataCollectionRuleDataFlowStreams(input []string) *[]datacollectionrules.KnownDataFlowStreams {
 	if len(input) == 0 {
 		return nil
@@ -841,6 +888,14 @@ func flattenDataCollectionRuleDataFlows(input *[]datacollectionrules.DataFlow) [
 	return result
 }
 
+func flattenDataCollectionRuleDataFlowTransformations(dataFlows []DataFlow, transformations []string) {
+	for i := range dataFlows {
+		if i < len(transformations) {
+			dataFlows[i].TransformKql = transformations[i]
+		}
+	}
+}
+
 func flattenDataCollectionRuleDataFlowStreams(input *[]datacollectionrules.KnownDataFlowStreams) []string {
 	if input == nil {
 		return make([]string, 0)
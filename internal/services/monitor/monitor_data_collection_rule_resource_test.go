@@ -113,6 +113,37 @@ func TestAccMonitorDataCollectionRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDataCollectionRule_transformKql(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.transformKql(data, `transform_kql = "source | where SeverityLevel == 'err'"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_flow.0.transform_kql").HasValue("source | where SeverityLevel == 'err'"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.transformKql(data, `transform_kql = "source | project TimeGenerated, Computer, SyslogMessage"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.transformKql(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_flow.0.transform_kql").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorDataCollectionRuleResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -351,6 +382,46 @@ resource "azurerm_monitor_data_collection_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorDataCollectionRuleResource) transformKql(data acceptance.TestData, transformKql string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctestmdcr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams      = ["Microsoft-Syslog"]
+    destinations = ["test-destination-log"]
+    %[3]s
+  }
+
+  data_sources {
+    syslog {
+      facility_names = ["*"]
+      log_levels     = ["*"]
+      name           = "test-datasource-syslog"
+      streams        = ["Microsoft-Syslog"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, transformKql)
+}
+
 func (r MonitorDataCollectionRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
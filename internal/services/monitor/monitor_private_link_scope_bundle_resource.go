@@ -0,0 +1,390 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	applicationinsightsvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorPrivateLinkScopeBundleModel struct {
+	Name                string                 `tfschema:"name"`
+	ResourceGroupName   string                 `tfschema:"resource_group_name"`
+	IngestionAccessMode string                 `tfschema:"ingestion_access_mode"`
+	QueryAccessMode     string                 `tfschema:"query_access_mode"`
+	LinkedResourceIds   []string               `tfschema:"linked_resource_ids"`
+	Tags                map[string]interface{} `tfschema:"tags"`
+}
+
+type MonitorPrivateLinkScopeBundleResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorPrivateLinkScopeBundleResource{}
+
+func (r MonitorPrivateLinkScopeBundleResource) ResourceType() string {
+	return "azurerm_monitor_private_link_scope_bundle"
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) ModelObject() interface{} {
+	return &MonitorPrivateLinkScopeBundleModel{}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateLinkScopeID
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateLinkScopeName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"ingestion_access_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(azuresdkhacks.AccessModeOpen),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForAccessMode(), false),
+		},
+
+		"query_access_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(azuresdkhacks.AccessModeOpen),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForAccessMode(), false),
+		},
+
+		"linked_resource_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					applicationinsightsvalidate.ComponentID,
+					workspaces.ValidateWorkspaceID,
+					datacollectionendpoints.ValidateDataCollectionEndpointID,
+				),
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MonitorPrivateLinkScopeBundleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := azuresdkhacks.NewPrivateLinkScopesWorkaroundClient(metadata.Client.Monitor.PrivateLinkScopesClient)
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewPrivateLinkScopeID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := azuresdkhacks.PrivateLinkScope{
+				Location: utils.String("Global"),
+				Properties: &azuresdkhacks.PrivateLinkScopeProperties{
+					AccessModeSettings: &azuresdkhacks.AccessModeSettings{
+						IngestionAccessMode: azuresdkhacks.AccessMode(model.IngestionAccessMode),
+						QueryAccessMode:     azuresdkhacks.AccessMode(model.QueryAccessMode),
+					},
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the Scope has to exist before any resources can be associated with it - so these are added once it's been
+			// created, and the ID is set first so that any partially associated resources are tracked in the state
+			metadata.SetID(id)
+
+			for _, linkedResourceId := range model.LinkedResourceIds {
+				if err := addMonitorPrivateLinkScopeBundleLinkedResource(ctx, scopedResourcesClient, id, linkedResourceId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateLinkScopesWorkaroundClient(metadata.Client.Monitor.PrivateLinkScopesClient)
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config MonitorPrivateLinkScopeBundleModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := MonitorPrivateLinkScopeBundleModel{
+				Name:                id.Name,
+				ResourceGroupName:   id.ResourceGroup,
+				IngestionAccessMode: string(azuresdkhacks.AccessModeOpen),
+				QueryAccessMode:     string(azuresdkhacks.AccessModeOpen),
+				Tags:                tags.Flatten(resp.Tags),
+			}
+
+			if props := resp.Properties; props != nil && props.AccessModeSettings != nil {
+				state.IngestionAccessMode = string(props.AccessModeSettings.IngestionAccessMode)
+				state.QueryAccessMode = string(props.AccessModeSettings.QueryAccessMode)
+			}
+
+			scopedResources, err := listMonitorPrivateLinkScopeBundleLinkedResources(ctx, scopedResourcesClient, *id)
+			if err != nil {
+				return err
+			}
+
+			linkedResourceIds := make([]string, 0)
+			for linkedResourceId := range scopedResources {
+				// the API may return the Linked Resource ID using a different casing, so use the value from the config where possible
+				for _, v := range config.LinkedResourceIds {
+					if strings.EqualFold(v, linkedResourceId) {
+						linkedResourceId = v
+						break
+					}
+				}
+				linkedResourceIds = append(linkedResourceIds, linkedResourceId)
+			}
+			state.LinkedResourceIds = linkedResourceIds
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateLinkScopesWorkaroundClient(metadata.Client.Monitor.PrivateLinkScopesClient)
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorPrivateLinkScopeBundleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("ingestion_access_mode", "query_access_mode", "tags") {
+				existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				parameters := azuresdkhacks.PrivateLinkScope{
+					Location: existing.Location,
+					Properties: &azuresdkhacks.PrivateLinkScopeProperties{
+						AccessModeSettings: &azuresdkhacks.AccessModeSettings{
+							IngestionAccessMode: azuresdkhacks.AccessMode(model.IngestionAccessMode),
+							QueryAccessMode:     azuresdkhacks.AccessMode(model.QueryAccessMode),
+						},
+					},
+					Tags: tags.Expand(model.Tags),
+				}
+				if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("linked_resource_ids") {
+				if err := reconcileMonitorPrivateLinkScopeLinkedResources(ctx, scopedResourcesClient, *id, model.LinkedResourceIds); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrivateLinkScopesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the associated resources are removed along with the Scope
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// monitorPrivateLinkScopeBundleScopedResourceName returns the name of the Scoped Resource used to associate the
+// specified resource with the Private Link Scope - which is derived from the Linked Resource ID so that it's stable
+func monitorPrivateLinkScopeBundleScopedResourceName(linkedResourceId string) string {
+	segments := strings.Split(strings.TrimSuffix(linkedResourceId, "/"), "/")
+	name := segments[len(segments)-1]
+	return fmt.Sprintf("%s-%08x", name, crc32.ChecksumIEEE([]byte(strings.ToLower(linkedResourceId))))
+}
+
+// listMonitorPrivateLinkScopeBundleLinkedResources returns a map of the Linked Resource ID to the name of the Scoped
+// Resource for each resource associated with the specified Private Link Scope
+func listMonitorPrivateLinkScopeBundleLinkedResources(ctx context.Context, client *insights.PrivateLinkScopedResourcesClient, id parse.PrivateLinkScopeId) (map[string]string, error) {
+	result := make(map[string]string)
+
+	iterator, err := client.ListByPrivateLinkScopeComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Scoped Resources for %s: %+v", id, err)
+	}
+
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.Name != nil && item.ScopedResourceProperties != nil && item.ScopedResourceProperties.LinkedResourceID != nil {
+			result[*item.ScopedResourceProperties.LinkedResourceID] = *item.Name
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing the Scoped Resources for %s: %+v", id, err)
+		}
+	}
+
+	return result, nil
+}
+
+// reconcileMonitorPrivateLinkScopeLinkedResources ensures that only the specified resources are associated with the
+// Private Link Scope, removing any other associations
+func reconcileMonitorPrivateLinkScopeLinkedResources(ctx context.Context, client *insights.PrivateLinkScopedResourcesClient, id parse.PrivateLinkScopeId, linkedResourceIds []string) error {
+	scopedResources, err := listMonitorPrivateLinkScopeBundleLinkedResources(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]bool)
+	for _, v := range linkedResourceIds {
+		desired[strings.ToLower(v)] = true
+	}
+
+	// remove the resources which are no longer required first, since the number of associations is limited
+	for linkedResourceId, scopedResourceName := range scopedResources {
+		if desired[strings.ToLower(linkedResourceId)] {
+			continue
+		}
+
+		if err := removeMonitorPrivateLinkScopeBundleLinkedResource(ctx, client, id, scopedResourceName); err != nil {
+			return err
+		}
+	}
+
+	existing := make(map[string]bool)
+	for linkedResourceId := range scopedResources {
+		existing[strings.ToLower(linkedResourceId)] = true
+	}
+	for _, linkedResourceId := range linkedResourceIds {
+		if existing[strings.ToLower(linkedResourceId)] {
+			continue
+		}
+
+		if err := addMonitorPrivateLinkScopeBundleLinkedResource(ctx, client, id, linkedResourceId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addMonitorPrivateLinkScopeBundleLinkedResource(ctx context.Context, client *insights.PrivateLinkScopedResourcesClient, id parse.PrivateLinkScopeId, linkedResourceId string) error {
+	scopedResourceId := parse.NewPrivateLinkScopedServiceID(id.SubscriptionId, id.ResourceGroup, id.Name, monitorPrivateLinkScopeBundleScopedResourceName(linkedResourceId))
+
+	parameters := insights.ScopedResource{
+		ScopedResourceProperties: &insights.ScopedResourceProperties{
+			LinkedResourceID: utils.String(linkedResourceId),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, scopedResourceId.ResourceGroup, scopedResourceId.PrivateLinkScopeName, scopedResourceId.ScopedResourceName, parameters)
+	if err != nil {
+		return fmt.Errorf("associating %q with %s: %+v", linkedResourceId, id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %q to be associated with %s: %+v", linkedResourceId, id, err)
+	}
+
+	return nil
+}
+
+func removeMonitorPrivateLinkScopeBundleLinkedResource(ctx context.Context, client *insights.PrivateLinkScopedResourcesClient, id parse.PrivateLinkScopeId, scopedResourceName string) error {
+	scopedResourceId := parse.NewPrivateLinkScopedServiceID(id.SubscriptionId, id.ResourceGroup, id.Name, scopedResourceName)
+
+	future, err := client.Delete(ctx, scopedResourceId.ResourceGroup, scopedResourceId.PrivateLinkScopeName, scopedResourceId.ScopedResourceName)
+	if err != nil {
+		return fmt.Errorf("removing %s: %+v", scopedResourceId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the removal of %s: %+v", scopedResourceId, err)
+	}
+
+	return nil
+}
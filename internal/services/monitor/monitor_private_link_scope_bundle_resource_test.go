@@ -0,0 +1,175 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorPrivateLinkScopeBundleResource struct{}
+
+func TestAccMonitorPrivateLinkScopeBundle_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scope_bundle", "test")
+	r := MonitorPrivateLinkScopeBundleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ingestion_access_mode").HasValue("Open"),
+				check.That(data.ResourceName).Key("query_access_mode").HasValue("Open"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPrivateLinkScopeBundle_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scope_bundle", "test")
+	r := MonitorPrivateLinkScopeBundleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorPrivateLinkScopeBundle_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scope_bundle", "test")
+	r := MonitorPrivateLinkScopeBundleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_resource_ids.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_resource_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateLinkScopeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.PrivateLinkScopesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.AzureMonitorPrivateLinkScopeProperties != nil), nil
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-plsb-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctest-appinsights-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_data_collection_endpoint" "test" {
+  name                          = "acctest-dce-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  public_network_access_enabled = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scope_bundle" "test" {
+  name                = "acctest-plsb-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scope_bundle" "import" {
+  name                = azurerm_monitor_private_link_scope_bundle.test.name
+  resource_group_name = azurerm_monitor_private_link_scope_bundle.test.resource_group_name
+}
+`, r.basic(data))
+}
+
+func (r MonitorPrivateLinkScopeBundleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scope_bundle" "test" {
+  name                  = "acctest-plsb-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  ingestion_access_mode = "PrivateOnly"
+  query_access_mode     = "PrivateOnly"
+
+  linked_resource_ids = [
+    azurerm_application_insights.test.id,
+    azurerm_log_analytics_workspace.test.id,
+    azurerm_monitor_data_collection_endpoint.test.id,
+  ]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	applicationinsightsvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
@@ -91,6 +92,9 @@ func resourceMonitorPrivateLinkScopedServiceCreateUpdate(d *pluginsdk.ResourceDa
 		}
 	}
 
+	locks.ByName(id.PrivateLinkScopeName, monitorPrivateLinkScopeResourceName)
+	defer locks.UnlockByName(id.PrivateLinkScopeName, monitorPrivateLinkScopeResourceName)
+
 	parameters := insights.ScopedResource{
 		ScopedResourceProperties: &insights.ScopedResourceProperties{
 			LinkedResourceID: utils.String(d.Get("linked_resource_id").(string)),
@@ -151,6 +155,9 @@ func resourceMonitorPrivateLinkScopedServiceDelete(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	locks.ByName(id.PrivateLinkScopeName, monitorPrivateLinkScopeResourceName)
+	defer locks.UnlockByName(id.PrivateLinkScopeName, monitorPrivateLinkScopeResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.PrivateLinkScopeName, id.ScopedResourceName)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
@@ -0,0 +1,235 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	applicationinsightsvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// monitorPrivateLinkScopeResourceName is used to lock the Private Link Scope whilst resources are associated with it,
+// since the API rejects concurrent changes to the Scoped Resources of a Scope
+const monitorPrivateLinkScopeResourceName = "azurerm_monitor_private_link_scope"
+
+type MonitorPrivateLinkScopedServicesModel struct {
+	ScopeId           string   `tfschema:"scope_id"`
+	LinkedResourceIds []string `tfschema:"linked_resource_ids"`
+}
+
+type MonitorPrivateLinkScopedServicesResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorPrivateLinkScopedServicesResource{}
+
+func (r MonitorPrivateLinkScopedServicesResource) ResourceType() string {
+	return "azurerm_monitor_private_link_scoped_services"
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) ModelObject() interface{} {
+	return &MonitorPrivateLinkScopedServicesModel{}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateLinkScopeID
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateLinkScopeID,
+		},
+
+		"linked_resource_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					applicationinsightsvalidate.ComponentID,
+					workspaces.ValidateWorkspaceID,
+					datacollectionendpoints.ValidateDataCollectionEndpointID,
+				),
+			},
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MonitorPrivateLinkScopedServicesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.PrivateLinkScopesClient
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(model.ScopeId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.Name, monitorPrivateLinkScopeResourceName)
+			defer locks.UnlockByName(id.Name, monitorPrivateLinkScopeResourceName)
+
+			if _, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// this resource manages the Scoped Resources of the Scope exclusively, so any existing associations need importing
+			scopedResources, err := listMonitorPrivateLinkScopeBundleLinkedResources(ctx, scopedResourcesClient, *id)
+			if err != nil {
+				return err
+			}
+			if len(scopedResources) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the ID is set first so that any partially associated resources are tracked in the state
+			metadata.SetID(id)
+
+			for _, linkedResourceId := range model.LinkedResourceIds {
+				if err := addMonitorPrivateLinkScopeBundleLinkedResource(ctx, scopedResourcesClient, *id, linkedResourceId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrivateLinkScopesClient
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config MonitorPrivateLinkScopedServicesModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scopedResources, err := listMonitorPrivateLinkScopeBundleLinkedResources(ctx, scopedResourcesClient, *id)
+			if err != nil {
+				return err
+			}
+			if len(scopedResources) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			linkedResourceIds := make([]string, 0)
+			for linkedResourceId := range scopedResources {
+				// the API may return the Linked Resource ID using a different casing, so use the value from the config where possible
+				for _, v := range config.LinkedResourceIds {
+					if strings.EqualFold(v, linkedResourceId) {
+						linkedResourceId = v
+						break
+					}
+				}
+				linkedResourceIds = append(linkedResourceIds, linkedResourceId)
+			}
+
+			state := MonitorPrivateLinkScopedServicesModel{
+				ScopeId:           id.ID(),
+				LinkedResourceIds: linkedResourceIds,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorPrivateLinkScopedServicesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.Name, monitorPrivateLinkScopeResourceName)
+			defer locks.UnlockByName(id.Name, monitorPrivateLinkScopeResourceName)
+
+			if metadata.ResourceData.HasChange("linked_resource_ids") {
+				if err := reconcileMonitorPrivateLinkScopeLinkedResources(ctx, scopedResourcesClient, *id, model.LinkedResourceIds); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			scopedResourcesClient := metadata.Client.Monitor.PrivateLinkScopedResourcesClient
+
+			id, err := parse.PrivateLinkScopeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.Name, monitorPrivateLinkScopeResourceName)
+			defer locks.UnlockByName(id.Name, monitorPrivateLinkScopeResourceName)
+
+			scopedResources, err := listMonitorPrivateLinkScopeBundleLinkedResources(ctx, scopedResourcesClient, *id)
+			if err != nil {
+				return err
+			}
+
+			for _, scopedResourceName := range scopedResources {
+				if err := removeMonitorPrivateLinkScopeBundleLinkedResource(ctx, scopedResourcesClient, *id, scopedResourceName); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorPrivateLinkScopedServicesResource struct{}
+
+func TestAccMonitorPrivateLinkScopedServices_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scoped_services", "test")
+	r := MonitorPrivateLinkScopedServicesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPrivateLinkScopedServices_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scoped_services", "test")
+	r := MonitorPrivateLinkScopedServicesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorPrivateLinkScopedServices_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scoped_services", "test")
+	r := MonitorPrivateLinkScopedServicesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_resource_ids.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateLinkScopeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := client.Monitor.PrivateLinkScopedResourcesClient.ListByPrivateLinkScopeComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing the Scoped Resources for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(iterator.NotDone()), nil
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scope" "test" {
+  name                = "acctest-ampls-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, MonitorPrivateLinkScopeBundleResource{}.template(data), data.RandomInteger)
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scoped_services" "test" {
+  scope_id = azurerm_monitor_private_link_scope.test.id
+
+  linked_resource_ids = [
+    azurerm_log_analytics_workspace.test.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scoped_services" "import" {
+  scope_id            = azurerm_monitor_private_link_scoped_services.test.scope_id
+  linked_resource_ids = azurerm_monitor_private_link_scoped_services.test.linked_resource_ids
+}
+`, r.basic(data))
+}
+
+func (r MonitorPrivateLinkScopedServicesResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_private_link_scoped_services" "test" {
+  scope_id = azurerm_monitor_private_link_scope.test.id
+
+  linked_resource_ids = [
+    azurerm_application_insights.test.id,
+    azurerm_log_analytics_workspace.test.id,
+    azurerm_monitor_data_collection_endpoint.test.id,
+  ]
+}
+`, r.template(data))
+}
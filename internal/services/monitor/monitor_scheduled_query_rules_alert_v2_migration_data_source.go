@@ -0,0 +1,430 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ScheduledQueryRulesAlertV2MigrationDataSourceModel struct {
+	ScheduledQueryRulesAlertId string                                    `tfschema:"scheduled_query_rules_alert_id"`
+	Name                       string                                    `tfschema:"name"`
+	ResourceGroupName          string                                    `tfschema:"resource_group_name"`
+	Location                   string                                    `tfschema:"location"`
+	Actions                    []ScheduledQueryRulesAlertV2ActionsModel  `tfschema:"action"`
+	AutoMitigate               bool                                      `tfschema:"auto_mitigation_enabled"`
+	Criteria                   []ScheduledQueryRulesAlertV2CriteriaModel `tfschema:"criteria"`
+	Description                string                                    `tfschema:"description"`
+	DisplayName                string                                    `tfschema:"display_name"`
+	Enabled                    bool                                      `tfschema:"enabled"`
+	EvaluationFrequency        string                                    `tfschema:"evaluation_frequency"`
+	MuteActionsDuration        string                                    `tfschema:"mute_actions_after_alert_duration"`
+	Scopes                     []string                                  `tfschema:"scopes"`
+	Severity                   int64                                     `tfschema:"severity"`
+	Tags                       map[string]string                         `tfschema:"tags"`
+	WindowSize                 string                                    `tfschema:"window_duration"`
+}
+
+type ScheduledQueryRulesAlertV2MigrationDataSource struct{}
+
+var _ sdk.DataSource = ScheduledQueryRulesAlertV2MigrationDataSource{}
+
+func (d ScheduledQueryRulesAlertV2MigrationDataSource) ResourceType() string {
+	return "azurerm_monitor_scheduled_query_rules_alert_v2_migration"
+}
+
+func (d ScheduledQueryRulesAlertV2MigrationDataSource) ModelObject() interface{} {
+	return &ScheduledQueryRulesAlertV2MigrationDataSourceModel{}
+}
+
+func (d ScheduledQueryRulesAlertV2MigrationDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scheduled_query_rules_alert_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ScheduledQueryRulesID,
+		},
+	}
+}
+
+func (d ScheduledQueryRulesAlertV2MigrationDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"location": commonschema.LocationComputed(),
+
+		"action": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"action_groups": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"custom_properties": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"auto_mitigation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"criteria": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"query": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"operator": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"time_aggregation_method": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"threshold": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"dimension": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"operator": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"values": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+
+					"failing_periods": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"minimum_failing_periods_to_trigger_alert": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"number_of_evaluation_periods": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"metric_measure_column": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_id_column": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"evaluation_frequency": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mute_actions_after_alert_duration": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"severity": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"window_duration": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d ScheduledQueryRulesAlertV2MigrationDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ScheduledQueryRulesClient
+
+			var state ScheduledQueryRulesAlertV2MigrationDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.ScheduledQueryRulesID(state.ScheduledQueryRulesAlertId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ScheduledQueryRuleName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("%s was not found", *id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model, err := convertScheduledQueryRulesAlertToV2(resp)
+			if err != nil {
+				return fmt.Errorf("converting %s: %+v", *id, err)
+			}
+			model.ScheduledQueryRulesAlertId = state.ScheduledQueryRulesAlertId
+			model.Name = id.ScheduledQueryRuleName
+			model.ResourceGroupName = id.ResourceGroup
+
+			metadata.SetID(id)
+			return metadata.Encode(model)
+		},
+	}
+}
+
+// convertScheduledQueryRulesAlertToV2 maps a legacy (v1) Log Search Alert Rule onto the equivalent arguments of an
+// `azurerm_monitor_scheduled_query_rules_alert_v2` resource.
+func convertScheduledQueryRulesAlertToV2(input insights.LogSearchRuleResource) (*ScheduledQueryRulesAlertV2MigrationDataSourceModel, error) {
+	output := ScheduledQueryRulesAlertV2MigrationDataSourceModel{
+		Location: location.NormalizeNilable(input.Location),
+		Tags:     make(map[string]string),
+	}
+	for k, v := range input.Tags {
+		if v != nil {
+			output.Tags[k] = *v
+		}
+	}
+
+	props := input.LogSearchRule
+	if props == nil {
+		return nil, fmt.Errorf("`properties` was nil")
+	}
+
+	// only alerting rules can be represented in the v2 API, log to metric rules are superseded by Data Collection Rules
+	action, ok := props.Action.(insights.AlertingAction)
+	if !ok {
+		return nil, fmt.Errorf("only rules using an `AlertingAction` can be migrated, got %T", props.Action)
+	}
+
+	output.AutoMitigate = utils.NormaliseNilableBool(props.AutoMitigate)
+	output.Description = utils.NormalizeNilableString(props.Description)
+	output.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+	output.Enabled = props.Enabled == insights.EnabledTrue
+
+	if schedule := props.Schedule; schedule != nil {
+		if schedule.FrequencyInMinutes != nil {
+			output.EvaluationFrequency = fmt.Sprintf("PT%dM", *schedule.FrequencyInMinutes)
+		}
+		if schedule.TimeWindowInMinutes != nil {
+			output.WindowSize = fmt.Sprintf("PT%dM", *schedule.TimeWindowInMinutes)
+		}
+	}
+
+	if action.ThrottlingInMin != nil && *action.ThrottlingInMin > 0 {
+		output.MuteActionsDuration = fmt.Sprintf("PT%dM", *action.ThrottlingInMin)
+	}
+
+	if action.Severity != "" {
+		severity, err := strconv.ParseInt(string(action.Severity), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `severity` %q: %+v", action.Severity, err)
+		}
+		output.Severity = severity
+	}
+
+	if azns := action.AznsAction; azns != nil {
+		actions := ScheduledQueryRulesAlertV2ActionsModel{
+			ActionGroups:     make([]string, 0),
+			CustomProperties: make(map[string]string),
+		}
+		if azns.ActionGroup != nil {
+			actions.ActionGroups = *azns.ActionGroup
+		}
+		// the v2 API has no dedicated fields for these, they're surfaced to the action groups as custom properties instead
+		if azns.EmailSubject != nil && *azns.EmailSubject != "" {
+			actions.CustomProperties["email_subject"] = *azns.EmailSubject
+		}
+		if azns.CustomWebhookPayload != nil && *azns.CustomWebhookPayload != "" {
+			actions.CustomProperties["custom_webhook_payload"] = *azns.CustomWebhookPayload
+		}
+		output.Actions = []ScheduledQueryRulesAlertV2ActionsModel{actions}
+	}
+
+	criteria := ScheduledQueryRulesAlertV2CriteriaModel{
+		TimeAggregation: scheduledqueryrules.TimeAggregationCount,
+	}
+	if source := props.Source; source != nil {
+		criteria.Query = utils.NormalizeNilableString(source.Query)
+		if source.DataSourceID != nil {
+			output.Scopes = []string{*source.DataSourceID}
+		}
+	}
+
+	if trigger := action.Trigger; trigger != nil {
+		criteria.Operator = scheduledqueryrules.ConditionOperator(trigger.ThresholdOperator)
+		criteria.Threshold = utils.NormalizeNilableFloat(trigger.Threshold)
+
+		if metricTrigger := trigger.MetricTrigger; metricTrigger != nil {
+			// metric measurement rules evaluate the `AggregatedValue` column per row
+			criteria.TimeAggregation = scheduledqueryrules.TimeAggregationAverage
+			criteria.MetricMeasureColumn = "AggregatedValue"
+			criteria.Dimensions = convertScheduledQueryRulesAlertMetricColumnToV2Dimensions(metricTrigger.MetricColumn)
+			criteria.FailingPeriods = convertScheduledQueryRulesAlertMetricTriggerToV2FailingPeriods(*metricTrigger)
+		}
+	}
+	output.Criteria = []ScheduledQueryRulesAlertV2CriteriaModel{criteria}
+
+	return &output, nil
+}
+
+// convertScheduledQueryRulesAlertMetricColumnToV2Dimensions splits the (comma separated) v1 `metric_column` into a
+// v2 dimension per column, each of which includes all values so that an alert is raised per combination.
+func convertScheduledQueryRulesAlertMetricColumnToV2Dimensions(input *string) []ScheduledQueryRulesAlertV2DimensionModel {
+	output := make([]ScheduledQueryRulesAlertV2DimensionModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, column := range strings.Split(*input, ",") {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			continue
+		}
+		output = append(output, ScheduledQueryRulesAlertV2DimensionModel{
+			Name:     column,
+			Operator: scheduledqueryrules.DimensionOperatorInclude,
+			Values:   []string{"*"},
+		})
+	}
+
+	return output
+}
+
+// convertScheduledQueryRulesAlertMetricTriggerToV2FailingPeriods maps the v1 breach count onto v2 failing periods,
+// which are limited to between 1 and 6 evaluation periods.
+func convertScheduledQueryRulesAlertMetricTriggerToV2FailingPeriods(input insights.LogMetricTrigger) []ScheduledQueryRulesAlertV2FailingPeriodsModel {
+	breaches := int64(1)
+	if input.Threshold != nil {
+		breaches = int64(*input.Threshold)
+		// v1 compares the number of breaches against the threshold, v2 requires the minimum number of failing periods
+		if input.ThresholdOperator == insights.ConditionalOperatorGreaterThan {
+			breaches++
+		}
+	}
+	breaches = clampScheduledQueryRulesAlertV2FailingPeriods(breaches)
+
+	evaluationPeriods := breaches
+	if input.MetricTriggerType == insights.MetricTriggerTypeTotal {
+		evaluationPeriods = 6
+	}
+
+	return []ScheduledQueryRulesAlertV2FailingPeriodsModel{
+		{
+			MinFailingPeriodsToAlert:  breaches,
+			NumberOfEvaluationPeriods: evaluationPeriods,
+		},
+	}
+}
+
+func clampScheduledQueryRulesAlertV2FailingPeriods(input int64) int64 {
+	if input < 1 {
+		return 1
+	}
+	if input > 6 {
+		return 6
+	}
+	return input
+}
@@ -0,0 +1,79 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorScheduledQueryRulesAlertV2MigrationDataSource struct{}
+
+func TestAccMonitorScheduledQueryRulesAlertV2MigrationDataSource_numberOfResults(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_scheduled_query_rules_alert_v2_migration", "test")
+	d := MonitorScheduledQueryRulesAlertV2MigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.numberOfResults(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("scopes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("evaluation_frequency").HasValue("PT60M"),
+				check.That(data.ResourceName).Key("window_duration").HasValue("PT60M"),
+				check.That(data.ResourceName).Key("criteria.0.time_aggregation_method").HasValue("Count"),
+				check.That(data.ResourceName).Key("criteria.0.operator").HasValue("GreaterThan"),
+				check.That(data.ResourceName).Key("criteria.0.threshold").HasValue("5000"),
+				check.That(data.ResourceName).Key("criteria.0.dimension.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2MigrationDataSource_metricMeasurement(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_scheduled_query_rules_alert_v2_migration", "test")
+	d := MonitorScheduledQueryRulesAlertV2MigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.metricMeasurement(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("severity").HasValue("3"),
+				check.That(data.ResourceName).Key("mute_actions_after_alert_duration").HasValue("PT5M"),
+				check.That(data.ResourceName).Key("action.0.action_groups.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action.0.custom_properties.email_subject").HasValue("Custom alert email subject"),
+				check.That(data.ResourceName).Key("criteria.0.time_aggregation_method").HasValue("Average"),
+				check.That(data.ResourceName).Key("criteria.0.metric_measure_column").HasValue("AggregatedValue"),
+				check.That(data.ResourceName).Key("criteria.0.dimension.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.dimension.0.name").HasValue("TimeGenerated"),
+				check.That(data.ResourceName).Key("criteria.0.dimension.0.operator").HasValue("Include"),
+				check.That(data.ResourceName).Key("criteria.0.failing_periods.0.minimum_failing_periods_to_trigger_alert").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.failing_periods.0.number_of_evaluation_periods").HasValue("6"),
+				check.That(data.ResourceName).Key("tags.Env").HasValue("test"),
+			),
+		},
+	})
+}
+
+func (d MonitorScheduledQueryRulesAlertV2MigrationDataSource) numberOfResults(data acceptance.TestData) string {
+	ts := time.Now().Format(time.RFC3339)
+
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_scheduled_query_rules_alert_v2_migration" "test" {
+  scheduled_query_rules_alert_id = azurerm_monitor_scheduled_query_rules_alert.test.id
+}
+`, MonitorScheduledQueryRulesResource{}.AlertingActionConfigBasic(data, ts))
+}
+
+func (d MonitorScheduledQueryRulesAlertV2MigrationDataSource) metricMeasurement(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_scheduled_query_rules_alert_v2_migration" "test" {
+  scheduled_query_rules_alert_id = azurerm_monitor_scheduled_query_rules_alert.test.id
+}
+`, MonitorScheduledQueryRulesResource{}.AlertingActionConfigComplete(data))
+}
@@ -3,8 +3,10 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type ScheduledQueryRulesAlertV2Model struct {
@@ -70,7 +73,10 @@ type ScheduledQueryRulesAlertV2FailingPeriodsModel struct {
 
 type ScheduledQueryRulesAlertV2Resource struct{}
 
-var _ sdk.ResourceWithUpdate = ScheduledQueryRulesAlertV2Resource{}
+var (
+	_ sdk.ResourceWithUpdate        = ScheduledQueryRulesAlertV2Resource{}
+	_ sdk.ResourceWithCustomizeDiff = ScheduledQueryRulesAlertV2Resource{}
+)
 
 func (r ScheduledQueryRulesAlertV2Resource) ResourceType() string {
 	return "azurerm_monitor_scheduled_query_rules_alert_v2"
@@ -402,6 +408,45 @@ func (r ScheduledQueryRulesAlertV2Resource) Attributes() map[string]*pluginsdk.S
 	}
 }
 
+func (r ScheduledQueryRulesAlertV2Resource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff == nil || !metadata.ResourceDiff.HasChange("scopes") || !metadata.ResourceDiff.NewValueKnown("scopes") {
+				return nil
+			}
+
+			var supportedLocations map[string]struct{}
+			for _, raw := range metadata.ResourceDiff.Get("scopes").([]interface{}) {
+				scope, ok := raw.(string)
+				if !ok || scope == "" {
+					continue
+				}
+
+				scopeLocation, err := validateScheduledQueryRulesAlertV2Scope(ctx, metadata.Client.Resource.ResourcesClient, scope)
+				if err != nil {
+					return err
+				}
+				if scopeLocation == nil || strings.EqualFold(*scopeLocation, "global") {
+					continue
+				}
+
+				if supportedLocations == nil {
+					supportedLocations, err = scheduledQueryRulesSupportedLocations(ctx, metadata.Client.Resource.ResourceProvidersClient)
+					if err != nil {
+						return err
+					}
+				}
+				if _, ok := supportedLocations[location.Normalize(*scopeLocation)]; !ok {
+					return fmt.Errorf("the resource %q in `scopes` is located in %q where Scheduled Query Rules are not supported", scope, *scopeLocation)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ScheduledQueryRulesAlertV2Resource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -902,3 +947,58 @@ func flattenScheduledQueryRulesAlertV2FailingPeriodsModel(input *scheduledqueryr
 
 	return append(outputList, output)
 }
+
+// validateScheduledQueryRulesAlertV2Scope checks that a top-level resource referenced in `scopes` exists and returns its
+// location. Subscriptions, Resource Groups and nested resources aren't looked up, in which case no location is returned.
+func validateScheduledQueryRulesAlertV2Scope(ctx context.Context, client *resources.Client, scope string) (*string, error) {
+	segments := strings.Split(strings.Trim(scope, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") || !strings.EqualFold(segments[4], "providers") {
+		return nil, nil
+	}
+
+	subscriptionId, resourceGroup := segments[1], segments[3]
+	resourceType := fmt.Sprintf("%s/%s", segments[5], segments[6])
+	name := segments[7]
+
+	// the scope may live in a different subscription to the one the provider is configured for
+	resourcesClient := *client
+	resourcesClient.SubscriptionID = subscriptionId
+
+	filter := fmt.Sprintf("resourceType eq '%s' and name eq '%s'", resourceType, name)
+	result, err := resourcesClient.ListByResourceGroup(ctx, resourceGroup, filter, "", utils.Int32(5))
+	if err != nil {
+		if utils.ResponseWasNotFound(result.Response().Response) {
+			return nil, fmt.Errorf("the resource %q in `scopes` was not found", scope)
+		}
+		return nil, fmt.Errorf("looking up the resource %q in `scopes`: %+v", scope, err)
+	}
+
+	for _, v := range result.Values() {
+		if v.ID != nil && strings.EqualFold(*v.ID, scope) {
+			return v.Location, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the resource %q in `scopes` was not found", scope)
+}
+
+func scheduledQueryRulesSupportedLocations(ctx context.Context, client *resources.ProvidersClient) (map[string]struct{}, error) {
+	provider, err := client.Get(ctx, "Microsoft.Insights", "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Resource Provider %q: %+v", "Microsoft.Insights", err)
+	}
+
+	locations := make(map[string]struct{})
+	if provider.ResourceTypes != nil {
+		for _, resourceType := range *provider.ResourceTypes {
+			if resourceType.ResourceType == nil || !strings.EqualFold(*resourceType.ResourceType, "scheduledQueryRules") || resourceType.Locations == nil {
+				continue
+			}
+			for _, v := range *resourceType.Locations {
+				locations[location.Normalize(v)] = struct{}{}
+			}
+		}
+	}
+
+	return locations, nil
+}
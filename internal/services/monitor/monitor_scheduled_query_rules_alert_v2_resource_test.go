@@ -3,6 +3,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -86,6 +87,17 @@ func TestAccMonitorScheduledQueryRulesAlertV2_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorScheduledQueryRulesAlertV2_scopeNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.scopeNotFound(data),
+			ExpectError: regexp.MustCompile("in `scopes` was not found"),
+		},
+	})
+}
+
 func (r MonitorScheduledQueryRulesAlertV2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(state.ID)
 	if err != nil {
@@ -155,6 +167,32 @@ resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
 `, template, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r MonitorScheduledQueryRulesAlertV2Resource) scopeNotFound(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                 = "acctest-isqr-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = "%s"
+  evaluation_frequency = "PT5M"
+  window_duration      = "PT5M"
+  scopes               = ["${azurerm_resource_group.test.id}/providers/Microsoft.Insights/components/acctestappinsights-missing-%d"]
+  severity             = 3
+  criteria {
+    query                   = <<-QUERY
+      requests
+	    | summarize CountByCountry=count() by client_CountryOrRegion
+	  QUERY
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equal"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r MonitorScheduledQueryRulesAlertV2Resource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
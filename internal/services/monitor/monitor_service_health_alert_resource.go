@@ -0,0 +1,458 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2020-10-01/insights" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorServiceHealthAlertModel struct {
+	Name              string                            `tfschema:"name"`
+	ResourceGroupName string                            `tfschema:"resource_group_name"`
+	Events            []string                          `tfschema:"events"`
+	Locations         []string                          `tfschema:"locations"`
+	Services          []string                          `tfschema:"services"`
+	Action            []MonitorServiceHealthAlertAction `tfschema:"action"`
+	Description       string                            `tfschema:"description"`
+	Enabled           bool                              `tfschema:"enabled"`
+	Tags              map[string]interface{}            `tfschema:"tags"`
+	Scopes            []string                          `tfschema:"scopes"`
+}
+
+type MonitorServiceHealthAlertAction struct {
+	ActionGroupId     string            `tfschema:"action_group_id"`
+	WebhookProperties map[string]string `tfschema:"webhook_properties"`
+}
+
+// serviceHealthEventTypes are the types of Service Health event which can be alerted on, these are the possible values
+// of the `incidentType` property of a Service Health event in the Activity Log
+var serviceHealthEventTypes = []string{
+	"ActionRequired",
+	"Incident",
+	"Informational",
+	"Maintenance",
+	"Security",
+}
+
+type MonitorServiceHealthAlertResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorServiceHealthAlertResource{}
+
+func (r MonitorServiceHealthAlertResource) ResourceType() string {
+	return "azurerm_monitor_service_health_alert"
+}
+
+func (r MonitorServiceHealthAlertResource) ModelObject() interface{} {
+	return &MonitorServiceHealthAlertModel{}
+}
+
+func (r MonitorServiceHealthAlertResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ActivityLogAlertID
+}
+
+func (r MonitorServiceHealthAlertResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"events": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(serviceHealthEventTypes, false),
+			},
+		},
+
+		"locations": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"services": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"action_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ActionGroupID,
+					},
+
+					"webhook_properties": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r MonitorServiceHealthAlertResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MonitorServiceHealthAlertResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActivityLogAlertsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MonitorServiceHealthAlertModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewActivityLogAlertID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := insights.ActivityLogAlertResource{
+				// Activity Log Alerts are global, Service Health events are then filtered using the `locations`
+				Location: utils.String("Global"),
+				AlertRuleProperties: &insights.AlertRuleProperties{
+					// Service Health events are raised at the Subscription scope
+					Scopes:      &[]string{commonids.NewSubscriptionID(subscriptionId).ID()},
+					Condition:   expandMonitorServiceHealthAlertCondition(model.Events, model.Locations, model.Services),
+					Actions:     expandMonitorServiceHealthAlertActions(model.Action),
+					Description: utils.String(model.Description),
+					Enabled:     utils.Bool(model.Enabled),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MonitorServiceHealthAlertResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActivityLogAlertsClient
+
+			id, err := parse.ActivityLogAlertID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MonitorServiceHealthAlertModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				Events:            make([]string, 0),
+				Locations:         make([]string, 0),
+				Services:          make([]string, 0),
+				Action:            make([]MonitorServiceHealthAlertAction, 0),
+				Scopes:            make([]string, 0),
+				Tags:              tags.Flatten(resp.Tags),
+			}
+
+			if props := resp.AlertRuleProperties; props != nil {
+				if !isMonitorServiceHealthAlertCondition(props.Condition) {
+					return fmt.Errorf("%s is not a Service Health alert - use the `azurerm_monitor_activity_log_alert` resource to manage this Activity Log Alert", *id)
+				}
+
+				state.Events, state.Locations, state.Services = flattenMonitorServiceHealthAlertCondition(props.Condition)
+				state.Action = flattenMonitorServiceHealthAlertActions(props.Actions)
+				state.Enabled = utils.NormaliseNilableBool(props.Enabled)
+				if props.Description != nil {
+					state.Description = *props.Description
+				}
+				if props.Scopes != nil {
+					state.Scopes = *props.Scopes
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MonitorServiceHealthAlertResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActivityLogAlertsClient
+
+			id, err := parse.ActivityLogAlertID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorServiceHealthAlertModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.AlertRuleProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			parameters := existing
+			if metadata.ResourceData.HasChanges("events", "locations", "services") {
+				parameters.AlertRuleProperties.Condition = expandMonitorServiceHealthAlertCondition(model.Events, model.Locations, model.Services)
+			}
+			if metadata.ResourceData.HasChange("action") {
+				parameters.AlertRuleProperties.Actions = expandMonitorServiceHealthAlertActions(model.Action)
+			}
+			if metadata.ResourceData.HasChange("description") {
+				parameters.AlertRuleProperties.Description = utils.String(model.Description)
+			}
+			if metadata.ResourceData.HasChange("enabled") {
+				parameters.AlertRuleProperties.Enabled = utils.Bool(model.Enabled)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = tags.Expand(model.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorServiceHealthAlertResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActivityLogAlertsClient
+
+			id, err := parse.ActivityLogAlertID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandMonitorServiceHealthAlertCondition builds the same conditions as the `service_health` block of the
+// `azurerm_monitor_activity_log_alert` resource, so that alerts can be moved between the two resources
+func expandMonitorServiceHealthAlertCondition(events []string, locations []string, services []string) *insights.AlertRuleAllOfCondition {
+	conditions := []insights.AlertRuleAnyOfOrLeafCondition{
+		{
+			Field:  utils.String("category"),
+			Equals: utils.String("ServiceHealth"),
+		},
+	}
+
+	if len(locations) > 0 {
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			Field:       utils.String("properties.impactedServices[*].ImpactedRegions[*].RegionName"),
+			ContainsAny: &locations,
+		})
+	}
+
+	if len(events) > 0 {
+		eventConditions := make([]insights.AlertRuleLeafCondition, 0)
+		for _, event := range events {
+			eventConditions = append(eventConditions, insights.AlertRuleLeafCondition{
+				Field:  utils.String("properties.incidentType"),
+				Equals: utils.String(event),
+			})
+		}
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			AnyOf: &eventConditions,
+		})
+	}
+
+	if len(services) > 0 {
+		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
+			Field:       utils.String("properties.impactedServices[*].ServiceName"),
+			ContainsAny: &services,
+		})
+	}
+
+	return &insights.AlertRuleAllOfCondition{
+		AllOf: &conditions,
+	}
+}
+
+func isMonitorServiceHealthAlertCondition(input *insights.AlertRuleAllOfCondition) bool {
+	if input == nil || input.AllOf == nil {
+		return false
+	}
+
+	for _, condition := range *input.AllOf {
+		if condition.Field != nil && strings.EqualFold(*condition.Field, "category") && condition.Equals != nil {
+			return strings.EqualFold(*condition.Equals, "ServiceHealth")
+		}
+	}
+
+	return false
+}
+
+func flattenMonitorServiceHealthAlertCondition(input *insights.AlertRuleAllOfCondition) (events []string, locations []string, services []string) {
+	events = make([]string, 0)
+	locations = make([]string, 0)
+	services = make([]string, 0)
+	if input == nil || input.AllOf == nil {
+		return
+	}
+
+	for _, condition := range *input.AllOf {
+		if condition.Field != nil && condition.ContainsAny != nil {
+			switch strings.ToLower(*condition.Field) {
+			case "properties.impactedservices[*].impactedregions[*].regionname":
+				locations = append(locations, *condition.ContainsAny...)
+			case "properties.impactedservices[*].servicename":
+				services = append(services, *condition.ContainsAny...)
+			}
+		}
+
+		if condition.Field == nil && condition.AnyOf != nil {
+			for _, anyOf := range *condition.AnyOf {
+				if anyOf.Field != nil && strings.EqualFold(*anyOf.Field, "properties.incidentType") && anyOf.Equals != nil {
+					events = append(events, *anyOf.Equals)
+				}
+			}
+		}
+	}
+
+	sort.Strings(events)
+	return
+}
+
+func expandMonitorServiceHealthAlertActions(input []MonitorServiceHealthAlertAction) *insights.ActionList {
+	actionGroups := make([]insights.ActionGroup, 0)
+	for _, item := range input {
+		webhookProperties := make(map[string]*string)
+		for k, v := range item.WebhookProperties {
+			webhookProperties[k] = utils.String(v)
+		}
+
+		actionGroups = append(actionGroups, insights.ActionGroup{
+			ActionGroupID:     utils.String(item.ActionGroupId),
+			WebhookProperties: webhookProperties,
+		})
+	}
+
+	return &insights.ActionList{
+		ActionGroups: &actionGroups,
+	}
+}
+
+func flattenMonitorServiceHealthAlertActions(input *insights.ActionList) []MonitorServiceHealthAlertAction {
+	output := make([]MonitorServiceHealthAlertAction, 0)
+	if input == nil || input.ActionGroups == nil {
+		return output
+	}
+
+	for _, item := range *input.ActionGroups {
+		actionGroupId := ""
+		if item.ActionGroupID != nil {
+			// the API may return the Action Group ID using a different casing
+			if parsed, err := parse.ActionGroupIDInsensitively(*item.ActionGroupID); err == nil {
+				actionGroupId = parsed.ID()
+			} else {
+				actionGroupId = *item.ActionGroupID
+			}
+		}
+
+		webhookProperties := make(map[string]string)
+		for k, v := range item.WebhookProperties {
+			if v != nil {
+				webhookProperties[k] = *v
+			}
+		}
+
+		output = append(output, MonitorServiceHealthAlertAction{
+			ActionGroupId:     actionGroupId,
+			WebhookProperties: webhookProperties,
+		})
+	}
+
+	return output
+}
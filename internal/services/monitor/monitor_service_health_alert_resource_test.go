@@ -0,0 +1,175 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorServiceHealthAlertResource struct{}
+
+func TestAccMonitorServiceHealthAlert_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_service_health_alert", "test")
+	r := MonitorServiceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorServiceHealthAlert_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_service_health_alert", "test")
+	r := MonitorServiceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorServiceHealthAlert_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_service_health_alert", "test")
+	r := MonitorServiceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorServiceHealthAlert_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_service_health_alert", "test")
+	r := MonitorServiceHealthAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorServiceHealthAlertResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ActivityLogAlertID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActivityLogAlertsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MonitorServiceHealthAlertResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_service_health_alert" "test" {
+  name                = "acctestServiceHealthAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorServiceHealthAlertResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_service_health_alert" "import" {
+  name                = azurerm_monitor_service_health_alert.test.name
+  resource_group_name = azurerm_monitor_service_health_alert.test.resource_group_name
+}
+`, r.basic(data))
+}
+
+func (r MonitorServiceHealthAlertResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_service_health_alert" "test" {
+  name                = "acctestServiceHealthAlert-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  events              = ["Incident", "Maintenance"]
+  locations           = ["West Europe", "East US"]
+  services            = ["Virtual Machines", "Storage"]
+  description         = "Service Health alert for acceptance tests"
+  enabled             = false
+
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+
+    webhook_properties = {
+      from = "terraform"
+    }
+  }
+
+  tags = {
+    env = "test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,339 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MonitorServiceHealthEventsDataSource struct{}
+
+var _ sdk.DataSource = MonitorServiceHealthEventsDataSource{}
+
+type MonitorServiceHealthEventsDataSourceModel struct {
+	LookbackDays    int                  `tfschema:"lookback_days"`
+	EventTypes      []string             `tfschema:"event_types"`
+	Locations       []string             `tfschema:"locations"`
+	Services        []string             `tfschema:"services"`
+	IncludeResolved bool                 `tfschema:"include_resolved"`
+	Events          []ServiceHealthEvent `tfschema:"events"`
+}
+
+type ServiceHealthEvent struct {
+	TrackingId           string   `tfschema:"tracking_id"`
+	EventType            string   `tfschema:"event_type"`
+	Title                string   `tfschema:"title"`
+	Stage                string   `tfschema:"stage"`
+	Level                string   `tfschema:"level"`
+	Locations            []string `tfschema:"locations"`
+	Services             []string `tfschema:"services"`
+	ImpactStartTime      string   `tfschema:"impact_start_time"`
+	ImpactMitigationTime string   `tfschema:"impact_mitigation_time"`
+	LastUpdateTime       string   `tfschema:"last_update_time"`
+}
+
+// serviceHealthEventFilter contains the requirements a Service Health event must meet to be returned, where an empty
+// list means that the requirement isn't checked
+type serviceHealthEventFilter struct {
+	EventTypes      []string
+	Locations       []string
+	Services        []string
+	IncludeResolved bool
+}
+
+// serviceHealthImpactedService is the format of each item within the `impactedServices` property of a Service Health
+// event, which is returned as a JSON encoded string
+type serviceHealthImpactedService struct {
+	ServiceName     string `json:"ServiceName"`
+	ImpactedRegions []struct {
+		RegionName string `json:"RegionName"`
+	} `json:"ImpactedRegions"`
+}
+
+func (r MonitorServiceHealthEventsDataSource) ResourceType() string {
+	return "azurerm_monitor_service_health_events"
+}
+
+func (r MonitorServiceHealthEventsDataSource) ModelObject() interface{} {
+	return &MonitorServiceHealthEventsDataSourceModel{}
+}
+
+func (r MonitorServiceHealthEventsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"lookback_days": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  7,
+			// the Activity Log is retained for 90 days
+			ValidateFunc: validation.IntBetween(1, 90),
+		},
+
+		"event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(serviceHealthEventTypes, false),
+			},
+		},
+
+		"locations": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"services": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"include_resolved": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MonitorServiceHealthEventsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"events": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tracking_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"event_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"title": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"stage": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"level": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"locations": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"services": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"impact_start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"impact_mitigation_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_update_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MonitorServiceHealthEventsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.ActivityLogsClient
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var model MonitorServiceHealthEventsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Activity Log can't be filtered by category, so only the fields which are needed are retrieved and the
+			// Service Health events are then filtered out below
+			startTime := time.Now().UTC().AddDate(0, 0, -model.LookbackDays)
+			filter := fmt.Sprintf("eventTimestamp ge '%s'", startTime.Format(time.RFC3339))
+			iterator, err := client.ListComplete(ctx, filter, "category,eventTimestamp,level,properties")
+			if err != nil {
+				return fmt.Errorf("listing the Activity Log for %s: %+v", subscriptionId, err)
+			}
+
+			events := make([]insights.EventData, 0)
+			for iterator.NotDone() {
+				events = append(events, iterator.Value())
+				if err := iterator.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing the Activity Log for %s: %+v", subscriptionId, err)
+				}
+			}
+
+			model.Events = flattenServiceHealthEvents(events, serviceHealthEventFilter{
+				EventTypes:      model.EventTypes,
+				Locations:       model.Locations,
+				Services:        model.Services,
+				IncludeResolved: model.IncludeResolved,
+			})
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/serviceHealthEvents", subscriptionId.ID()))
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// flattenServiceHealthEvents returns the latest update for each Service Health event within the Activity Log which
+// meets the requirements of the filter, ordered by the time the event was last updated (most recent first)
+func flattenServiceHealthEvents(input []insights.EventData, filter serviceHealthEventFilter) []ServiceHealthEvent {
+	latest := make(map[string]insights.EventData)
+	for _, item := range input {
+		if item.Category == nil || item.Category.Value == nil || !strings.EqualFold(*item.Category.Value, "ServiceHealth") {
+			continue
+		}
+
+		trackingId := serviceHealthEventProperty(item, "trackingId")
+		if trackingId == "" || item.EventTimestamp == nil {
+			continue
+		}
+
+		// each update to a Service Health event is a separate entry in the Activity Log
+		if existing, ok := latest[trackingId]; ok && existing.EventTimestamp.After(item.EventTimestamp.Time) {
+			continue
+		}
+		latest[trackingId] = item
+	}
+
+	output := make([]ServiceHealthEvent, 0)
+	for trackingId, item := range latest {
+		event := ServiceHealthEvent{
+			TrackingId:           trackingId,
+			EventType:            serviceHealthEventProperty(item, "incidentType"),
+			Title:                serviceHealthEventProperty(item, "title"),
+			Stage:                serviceHealthEventProperty(item, "stage"),
+			Level:                string(item.Level),
+			Locations:            make([]string, 0),
+			Services:             make([]string, 0),
+			ImpactStartTime:      serviceHealthEventProperty(item, "impactStartTime"),
+			ImpactMitigationTime: serviceHealthEventProperty(item, "impactMitigationTime"),
+			LastUpdateTime:       item.EventTimestamp.Format(time.RFC3339),
+		}
+
+		locations := make(map[string]struct{})
+		services := make(map[string]struct{})
+		var impactedServices []serviceHealthImpactedService
+		if err := json.Unmarshal([]byte(serviceHealthEventProperty(item, "impactedServices")), &impactedServices); err == nil {
+			for _, impactedService := range impactedServices {
+				if impactedService.ServiceName != "" {
+					services[impactedService.ServiceName] = struct{}{}
+				}
+				for _, region := range impactedService.ImpactedRegions {
+					if region.RegionName != "" {
+						locations[region.RegionName] = struct{}{}
+					}
+				}
+			}
+		}
+		for v := range locations {
+			event.Locations = append(event.Locations, v)
+		}
+		for v := range services {
+			event.Services = append(event.Services, v)
+		}
+		sort.Strings(event.Locations)
+		sort.Strings(event.Services)
+
+		if !filter.matches(event) {
+			continue
+		}
+
+		output = append(output, event)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].LastUpdateTime != output[j].LastUpdateTime {
+			return output[i].LastUpdateTime > output[j].LastUpdateTime
+		}
+		return output[i].TrackingId < output[j].TrackingId
+	})
+
+	return output
+}
+
+func (f serviceHealthEventFilter) matches(event ServiceHealthEvent) bool {
+	if !f.IncludeResolved {
+		switch strings.ToLower(event.Stage) {
+		case "canceled", "complete", "rca", "resolved":
+			return false
+		}
+	}
+
+	if len(f.EventTypes) > 0 && !serviceHealthContainsAny(f.EventTypes, []string{event.EventType}) {
+		return false
+	}
+	if len(f.Locations) > 0 && !serviceHealthContainsAny(f.Locations, event.Locations) {
+		return false
+	}
+	if len(f.Services) > 0 && !serviceHealthContainsAny(f.Services, event.Services) {
+		return false
+	}
+
+	return true
+}
+
+func serviceHealthContainsAny(wanted []string, values []string) bool {
+	for _, w := range wanted {
+		for _, v := range values {
+			if strings.EqualFold(w, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func serviceHealthEventProperty(input insights.EventData, key string) string {
+	if v, ok := input.Properties[key]; ok && v != nil {
+		return *v
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorServiceHealthEventsDataSource struct{}
+
+func TestAccMonitorServiceHealthEventsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_service_health_events", "test")
+	d := MonitorServiceHealthEventsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("events.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccMonitorServiceHealthEventsDataSource_plannedMaintenance(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_service_health_events", "test")
+	d := MonitorServiceHealthEventsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.plannedMaintenance(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("events.#").Exists(),
+			),
+		},
+	})
+}
+
+func (MonitorServiceHealthEventsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_monitor_service_health_events" "test" {}
+`
+}
+
+func (MonitorServiceHealthEventsDataSource) plannedMaintenance() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_monitor_service_health_events" "test" {
+  lookback_days = 30
+  event_types   = ["Maintenance"]
+  locations     = ["West Europe", "North Europe"]
+}
+`
+}
@@ -0,0 +1,149 @@
+package monitor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenServiceHealthEvents(t *testing.T) {
+	event := func(category string, timestamp string, properties map[string]string) insights.EventData {
+		parsed, _ := time.Parse(time.RFC3339, timestamp)
+		props := make(map[string]*string)
+		for k, v := range properties {
+			props[k] = utils.String(v)
+		}
+		return insights.EventData{
+			Category:       &insights.LocalizableString{Value: utils.String(category)},
+			EventTimestamp: &date.Time{Time: parsed},
+			Level:          insights.EventLevelInformational,
+			Properties:     props,
+		}
+	}
+
+	input := []insights.EventData{
+		event("Administrative", "2023-03-01T10:00:00Z", map[string]string{
+			"trackingId": "ignored",
+		}),
+		event("ServiceHealth", "2023-03-01T10:00:00Z", map[string]string{
+			"trackingId":       "AAAA-111",
+			"incidentType":     "Maintenance",
+			"title":            "Planned maintenance (first update)",
+			"stage":            "Planned",
+			"impactedServices": `[{"ServiceName":"Virtual Machines","ImpactedRegions":[{"RegionName":"West Europe"}]}]`,
+		}),
+		event("ServiceHealth", "2023-03-02T10:00:00Z", map[string]string{
+			"trackingId":       "AAAA-111",
+			"incidentType":     "Maintenance",
+			"title":            "Planned maintenance",
+			"stage":            "Planned",
+			"impactStartTime":  "2023-03-10T00:00:00Z",
+			"impactedServices": `[{"ServiceName":"Virtual Machines","ImpactedRegions":[{"RegionName":"West Europe"},{"RegionName":"North Europe"}]}]`,
+		}),
+		event("ServiceHealth", "2023-03-03T10:00:00Z", map[string]string{
+			"trackingId":       "BBBB-222",
+			"incidentType":     "Incident",
+			"title":            "Storage outage",
+			"stage":            "Active",
+			"impactedServices": `[{"ServiceName":"Storage","ImpactedRegions":[{"RegionName":"East US"}]}]`,
+		}),
+		event("ServiceHealth", "2023-03-04T10:00:00Z", map[string]string{
+			"trackingId":   "CCCC-333",
+			"incidentType": "Incident",
+			"title":        "Resolved outage",
+			"stage":        "Resolved",
+		}),
+	}
+
+	maintenance := ServiceHealthEvent{
+		TrackingId:      "AAAA-111",
+		EventType:       "Maintenance",
+		Title:           "Planned maintenance",
+		Stage:           "Planned",
+		Level:           "Informational",
+		Locations:       []string{"North Europe", "West Europe"},
+		Services:        []string{"Virtual Machines"},
+		ImpactStartTime: "2023-03-10T00:00:00Z",
+		LastUpdateTime:  "2023-03-02T10:00:00Z",
+	}
+	incident := ServiceHealthEvent{
+		TrackingId:     "BBBB-222",
+		EventType:      "Incident",
+		Title:          "Storage outage",
+		Stage:          "Active",
+		Level:          "Informational",
+		Locations:      []string{"East US"},
+		Services:       []string{"Storage"},
+		LastUpdateTime: "2023-03-03T10:00:00Z",
+	}
+	resolved := ServiceHealthEvent{
+		TrackingId:     "CCCC-333",
+		EventType:      "Incident",
+		Title:          "Resolved outage",
+		Stage:          "Resolved",
+		Level:          "Informational",
+		Locations:      []string{},
+		Services:       []string{},
+		LastUpdateTime: "2023-03-04T10:00:00Z",
+	}
+
+	testData := []struct {
+		Name     string
+		Filter   serviceHealthEventFilter
+		Expected []ServiceHealthEvent
+	}{
+		{
+			Name:     "no filter",
+			Filter:   serviceHealthEventFilter{},
+			Expected: []ServiceHealthEvent{incident, maintenance},
+		},
+		{
+			Name: "include resolved",
+			Filter: serviceHealthEventFilter{
+				IncludeResolved: true,
+			},
+			Expected: []ServiceHealthEvent{resolved, incident, maintenance},
+		},
+		{
+			Name: "event type",
+			Filter: serviceHealthEventFilter{
+				EventTypes: []string{"Maintenance"},
+			},
+			Expected: []ServiceHealthEvent{maintenance},
+		},
+		{
+			Name: "location",
+			Filter: serviceHealthEventFilter{
+				Locations: []string{"north europe"},
+			},
+			Expected: []ServiceHealthEvent{maintenance},
+		},
+		{
+			Name: "service",
+			Filter: serviceHealthEventFilter{
+				Services: []string{"Storage", "SQL Database"},
+			},
+			Expected: []ServiceHealthEvent{incident},
+		},
+		{
+			Name: "no matches",
+			Filter: serviceHealthEventFilter{
+				EventTypes: []string{"Security"},
+			},
+			Expected: []ServiceHealthEvent{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := flattenServiceHealthEvents(input, v.Filter)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
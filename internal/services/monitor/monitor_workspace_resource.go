@@ -0,0 +1,230 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MonitorWorkspaceModel struct {
+	Name                       string            `tfschema:"name"`
+	ResourceGroupName          string            `tfschema:"resource_group_name"`
+	Location                   string            `tfschema:"location"`
+	PublicNetworkAccessEnabled bool              `tfschema:"public_network_access_enabled"`
+	QueryEndpoint              string            `tfschema:"query_endpoint"`
+	Tags                       map[string]string `tfschema:"tags"`
+}
+
+type MonitorWorkspaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorWorkspaceResource{}
+
+func (r MonitorWorkspaceResource) ResourceType() string {
+	return "azurerm_monitor_workspace"
+}
+
+func (r MonitorWorkspaceResource) ModelObject() interface{} {
+	return &MonitorWorkspaceModel{}
+}
+
+func (r MonitorWorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MonitorWorkspaceID
+}
+
+func (r MonitorWorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MonitorWorkspaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MonitorWorkspaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MonitorWorkspaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.MonitorWorkspacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewMonitorWorkspaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := azuresdkhacks.MonitorWorkspace{
+				Location: location.Normalize(model.Location),
+				Properties: &azuresdkhacks.MonitorWorkspaceProperties{
+					PublicNetworkAccess: expandMonitorWorkspacePublicNetworkAccess(model.PublicNetworkAccessEnabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.Create(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MonitorWorkspaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.MonitorWorkspacesClient
+
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MonitorWorkspaceModel{
+				Name:              id.AccountName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == azuresdkhacks.PublicNetworkAccessEnabled
+
+					if props.Metrics != nil {
+						state.QueryEndpoint = pointer.From(props.Metrics.PrometheusQueryEndpoint)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MonitorWorkspaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.MonitorWorkspacesClient
+
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorWorkspaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			existing := resp.Model
+			if existing.Properties == nil {
+				existing.Properties = &azuresdkhacks.MonitorWorkspaceProperties{}
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				existing.Properties.PublicNetworkAccess = expandMonitorWorkspacePublicNetworkAccess(model.PublicNetworkAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				existing.Tags = &model.Tags
+			}
+
+			if err := client.Create(ctx, *id, *existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorWorkspaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.MonitorWorkspacesClient
+
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMonitorWorkspacePublicNetworkAccess(input bool) *azuresdkhacks.PublicNetworkAccess {
+	if input {
+		return pointer.To(azuresdkhacks.PublicNetworkAccessEnabled)
+	}
+	return pointer.To(azuresdkhacks.PublicNetworkAccessDisabled)
+}
@@ -20,6 +20,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		DataCollectionEndpointDataSource{},
 		DataCollectionRuleDataSource{},
+		MonitorServiceHealthEventsDataSource{},
+		ScheduledQueryRulesAlertV2MigrationDataSource{},
 	}
 }
 
@@ -27,9 +29,14 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AlertProcessingRuleActionGroupResource{},
 		AlertProcessingRuleSuppressionResource{},
+		AlertPrometheusRuleGroupResource{},
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
+		MonitorPrivateLinkScopeBundleResource{},
+		MonitorPrivateLinkScopedServicesResource{},
+		MonitorServiceHealthAlertResource{},
+		MonitorWorkspaceResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 	}
 }
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DataCollectionRuleTransformKql performs the checks which can be done without the API - the incoming data of a
+// transformation is exposed as the virtual table `source`, so a transformation not reading from it is never valid
+func DataCollectionRuleTransformKql(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return nil, append(errors, fmt.Errorf("%s must not be empty", k))
+	}
+
+	if !regexp.MustCompile(`\bsource\b`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must read the incoming data from the `source` table, e.g. `source | extend TimeGenerated = now()`", k))
+	}
+
+	return
+}
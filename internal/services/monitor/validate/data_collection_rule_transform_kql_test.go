@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestDataCollectionRuleTransformKql(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// whitespace
+			input:    "  \n",
+			expected: false,
+		},
+		{
+			// doesn't read from source
+			input:    "Syslog | where SeverityLevel == 'err'",
+			expected: false,
+		},
+		{
+			// source as part of another identifier
+			input:    "sourceTable | extend TimeGenerated = now()",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "source",
+			expected: true,
+		},
+		{
+			// pipeline
+			input:    "source | where SeverityLevel == 'err' | project TimeGenerated, Computer, SyslogMessage",
+			expected: true,
+		},
+		{
+			// let statement ahead of source
+			input:    "let threshold = 10;\nsource | where Value > threshold",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionRuleTransformKql(v.input, "transform_kql")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this can be removed once the SQL Databases are migrated to `hashicorp/go-azure-sdk`
+// the free limit serverless offer (`useFreeLimit` and `freeLimitExhaustionBehavior`) is only available from API Version
+// `2023-08-01-preview`, however the vendored SDK only includes `v5.0` (`2021-02-01-preview`) - so these properties
+// are retrieved and updated using this client, the remainder of the Database continues to use the vendored SDK.
+
+const defaultApiVersion = "2023-08-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/databases/%s", defaultApiVersion)
+}
+
+type DatabasesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDatabasesClientWithBaseURI(endpoint string) DatabasesClient {
+	return DatabasesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,170 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+)
+
+// TODO 4.0: check if this can be removed once the SQL Managed Instances are migrated to `hashicorp/go-azure-sdk`
+// the `startStopSchedules` endpoint of a Managed Instance was introduced in API Version `2022-11-01-preview`, which
+// isn't included in the vendored SDK, so the client for it is defined here.
+
+const managedInstanceStartStopSchedulesApiVersion = "2022-11-01-preview"
+
+type ManagedInstanceStartStopSchedulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedInstanceStartStopSchedulesClientWithBaseURI(endpoint string) ManagedInstanceStartStopSchedulesClient {
+	return ManagedInstanceStartStopSchedulesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/startstopmanagedinstanceschedules/%s", managedInstanceStartStopSchedulesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type DayOfWeek string
+
+const (
+	DayOfWeekFriday    DayOfWeek = "Friday"
+	DayOfWeekMonday    DayOfWeek = "Monday"
+	DayOfWeekSaturday  DayOfWeek = "Saturday"
+	DayOfWeekSunday    DayOfWeek = "Sunday"
+	DayOfWeekThursday  DayOfWeek = "Thursday"
+	DayOfWeekTuesday   DayOfWeek = "Tuesday"
+	DayOfWeekWednesday DayOfWeek = "Wednesday"
+)
+
+func PossibleValuesForDayOfWeek() []string {
+	return []string{
+		string(DayOfWeekFriday),
+		string(DayOfWeekMonday),
+		string(DayOfWeekSaturday),
+		string(DayOfWeekSunday),
+		string(DayOfWeekThursday),
+		string(DayOfWeekTuesday),
+		string(DayOfWeekWednesday),
+	}
+}
+
+type StartStopManagedInstanceSchedule struct {
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *StartStopManagedInstanceScheduleProperties `json:"properties,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
+
+type StartStopManagedInstanceScheduleProperties struct {
+	Description       *string        `json:"description,omitempty"`
+	NextExecutionTime *string        `json:"nextExecutionTime,omitempty"`
+	NextRunAction     *string        `json:"nextRunAction,omitempty"`
+	ScheduleList      []ScheduleItem `json:"scheduleList"`
+	TimeZoneId        *string        `json:"timeZoneId,omitempty"`
+}
+
+type ScheduleItem struct {
+	StartDay  DayOfWeek `json:"startDay"`
+	StartTime string    `json:"startTime"`
+	StopDay   DayOfWeek `json:"stopDay"`
+	StopTime  string    `json:"stopTime"`
+}
+
+type ManagedInstanceStartStopSchedulesGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *StartStopManagedInstanceSchedule
+}
+
+// Get ...
+func (c ManagedInstanceStartStopSchedulesClient) Get(ctx context.Context, id parse.ManagedInstanceStartStopScheduleId) (result ManagedInstanceStartStopSchedulesGetOperationResponse, err error) {
+	req, err := c.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdate ...
+func (c ManagedInstanceStartStopSchedulesClient) CreateOrUpdate(ctx context.Context, id parse.ManagedInstanceStartStopScheduleId, input StartStopManagedInstanceSchedule) error {
+	// these are read-only and are rejected by the API when sent back
+	input.Id = nil
+	input.Name = nil
+	input.Type = nil
+
+	req, err := c.preparer(ctx, id, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// Delete ...
+func (c ManagedInstanceStartStopSchedulesClient) Delete(ctx context.Context, id parse.ManagedInstanceStartStopScheduleId) error {
+	req, err := c.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "startstopmanagedinstanceschedules.ManagedInstanceStartStopSchedulesClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (c ManagedInstanceStartStopSchedulesClient) preparer(ctx context.Context, id parse.ManagedInstanceStartStopScheduleId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": managedInstanceStartStopSchedulesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
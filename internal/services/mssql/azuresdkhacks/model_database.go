@@ -0,0 +1,25 @@
+package azuresdkhacks
+
+type FreeLimitExhaustionBehavior string
+
+const (
+	FreeLimitExhaustionBehaviorAutoPause     FreeLimitExhaustionBehavior = "AutoPause"
+	FreeLimitExhaustionBehaviorBillOverUsage FreeLimitExhaustionBehavior = "BillOverUsage"
+)
+
+func PossibleValuesForFreeLimitExhaustionBehavior() []string {
+	return []string{
+		string(FreeLimitExhaustionBehaviorAutoPause),
+		string(FreeLimitExhaustionBehaviorBillOverUsage),
+	}
+}
+
+// Database only contains the properties which aren't available in the vendored SDK
+type Database struct {
+	Properties *DatabaseProperties `json:"properties,omitempty"`
+}
+
+type DatabaseProperties struct {
+	FreeLimitExhaustionBehavior *FreeLimitExhaustionBehavior `json:"freeLimitExhaustionBehavior,omitempty"`
+	UseFreeLimit                *bool                        `json:"useFreeLimit,omitempty"`
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/azuresdkhacks"
 )
 
 type Client struct {
@@ -12,6 +13,7 @@ type Client struct {
 	DatabaseSecurityAlertPoliciesClient                *sql.DatabaseSecurityAlertPoliciesClient
 	DatabaseVulnerabilityAssessmentRuleBaselinesClient *sql.DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	DatabasesClient                                    *sql.DatabasesClient
+	DatabasesWorkaroundClient                          *azuresdkhacks.DatabasesClient
 	ElasticPoolsClient                                 *sql.ElasticPoolsClient
 	EncryptionProtectorClient                          *sql.EncryptionProtectorsClient
 	FailoverGroupsClient                               *sql.FailoverGroupsClient
@@ -25,6 +27,7 @@ type Client struct {
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
 	ManagedInstanceVulnerabilityAssessmentsClient      *sql.ManagedInstanceVulnerabilityAssessmentsClient
 	ManagedInstanceServerSecurityAlertPoliciesClient   *sql.ManagedServerSecurityAlertPoliciesClient
+	ManagedInstanceStartStopSchedulesClient            *azuresdkhacks.ManagedInstanceStartStopSchedulesClient
 	OutboundFirewallRulesClient                        *sql.OutboundFirewallRulesClient
 	ManagedInstanceAdministratorsClient                *sql.ManagedInstanceAdministratorsClient
 	ManagedInstanceAzureADOnlyAuthenticationsClient    *sql.ManagedInstanceAzureADOnlyAuthenticationsClient
@@ -63,6 +66,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	databasesClient := sql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databasesClient.Client, o.ResourceManagerAuthorizer)
 
+	databasesWorkaroundClient := azuresdkhacks.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&databasesWorkaroundClient.Client, o.ResourceManagerAuthorizer)
+
 	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&elasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -114,6 +120,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	managedInstanceServerSecurityAlertPoliciesClient := sql.NewManagedServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstanceServerSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedInstanceStartStopSchedulesClient := azuresdkhacks.NewManagedInstanceStartStopSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedInstanceStartStopSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	outboundFirewallRulesClient := sql.NewOutboundFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&outboundFirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -168,6 +177,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabaseSecurityAlertPoliciesClient:                &databaseSecurityAlertPoliciesClient,
 		DatabaseVulnerabilityAssessmentRuleBaselinesClient: &databaseVulnerabilityAssessmentRuleBaselinesClient,
 		DatabasesClient:                                  &databasesClient,
+		DatabasesWorkaroundClient:                        &databasesWorkaroundClient,
 		ElasticPoolsClient:                               &elasticPoolsClient,
 		EncryptionProtectorClient:                        &encryptionProtectorClient,
 		FailoverGroupsClient:                             &failoverGroupsClient,
@@ -183,6 +193,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagedInstanceEncryptionProtectorClient:         &managedInstanceEncryptionProtectorsClient,
 		ManagedInstanceKeysClient:                        &managedInstanceKeysClient,
 		ManagedInstanceServerSecurityAlertPoliciesClient: &managedInstanceServerSecurityAlertPoliciesClient,
+		ManagedInstanceStartStopSchedulesClient:          &managedInstanceStartStopSchedulesClient,
 		ManagedInstanceVulnerabilityAssessmentsClient:    &managedInstanceVulnerabilityAssessmentsClient,
 		ManagedInstancesClient:                           &managedInstancesClient,
 		OutboundFirewallRulesClient:                      &outboundFirewallRulesClient,
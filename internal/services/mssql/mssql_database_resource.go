@@ -16,6 +16,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
@@ -125,6 +126,14 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("serverless databases do not support license type")
 	}
 
+	useFreeLimit := d.Get("use_free_limit").(bool)
+	if useFreeLimit && !strings.HasPrefix(d.Get("sku_name").(string), "GP_S_") {
+		return fmt.Errorf("`use_free_limit` can only be enabled for serverless (`GP_S_*`) databases")
+	}
+	if v := d.Get("free_limit_exhaustion_behavior").(string); v != "" && !useFreeLimit && d.HasChange("free_limit_exhaustion_behavior") {
+		return fmt.Errorf("`free_limit_exhaustion_behavior` can only be specified when `use_free_limit` is enabled")
+	}
+
 	name := d.Get("name").(string)
 
 	serverId, err := parse.ServerID(d.Get("server_id").(string))
@@ -358,6 +367,23 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for %s to become ready: %+v", id, err)
 	}
 
+	// the free limit offer isn't available in the vendored API version, so is applied once the database is online
+	if d.HasChanges("use_free_limit", "free_limit_exhaustion_behavior") {
+		freeLimit := azuresdkhacks.Database{
+			Properties: &azuresdkhacks.DatabaseProperties{
+				UseFreeLimit: utils.Bool(useFreeLimit),
+			},
+		}
+		if v := d.Get("free_limit_exhaustion_behavior").(string); useFreeLimit && v != "" {
+			behavior := azuresdkhacks.FreeLimitExhaustionBehavior(v)
+			freeLimit.Properties.FreeLimitExhaustionBehavior = &behavior
+		}
+
+		if err := meta.(*clients.Client).MSSQL.DatabasesWorkaroundClient.UpdateThenPoll(ctx, id, freeLimit); err != nil {
+			return fmt.Errorf("updating the free limit for %s: %+v", id, err)
+		}
+	}
+
 	// Cannot set transparent data encryption for secondary databases
 	if createMode != string(sql.CreateModeOnlineSecondary) && createMode != string(sql.CreateModeSecondary) {
 		statusProperty := sql.TransparentDataEncryptionStatusDisabled
@@ -547,6 +573,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			skuName = *props.CurrentServiceObjectiveName
 		}
 		d.Set("sku_name", skuName)
+		d.Set("current_service_objective", props.CurrentServiceObjectiveName)
 		d.Set("storage_account_type", string(props.CurrentBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
 		if props.IsLedgerOn != nil {
@@ -566,6 +593,23 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("ledger_enabled", ledgerEnabled)
 	}
 
+	freeLimit, err := meta.(*clients.Client).MSSQL.DatabasesWorkaroundClient.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving the free limit for %s: %+v", id, err)
+	}
+	useFreeLimit := false
+	freeLimitExhaustionBehavior := ""
+	if model := freeLimit.Model; model != nil && model.Properties != nil {
+		if model.Properties.UseFreeLimit != nil {
+			useFreeLimit = *model.Properties.UseFreeLimit
+		}
+		if model.Properties.FreeLimitExhaustionBehavior != nil {
+			freeLimitExhaustionBehavior = string(*model.Properties.FreeLimitExhaustionBehavior)
+		}
+	}
+	d.Set("use_free_limit", useFreeLimit)
+	d.Set("free_limit_exhaustion_behavior", freeLimitExhaustionBehavior)
+
 	securityAlertPolicy, err := securityAlertPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err == nil {
 		if err := d.Set("threat_detection_policy", flattenMsSqlServerSecurityAlertPolicy(d, securityAlertPolicy)); err != nil {
@@ -1082,6 +1126,18 @@ func resourceMsSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc:  validation.StringInSlice(resourceMsSqlDatabaseMaintenanceNames(), false),
 		},
 
+		"use_free_limit": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"free_limit_exhaustion_behavior": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForFreeLimitExhaustionBehavior(), false),
+		},
+
 		"ledger_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -1095,6 +1151,11 @@ func resourceMsSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"current_service_objective": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
 		"tags": tags.Schema(),
 	}
 }
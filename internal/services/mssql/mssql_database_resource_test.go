@@ -138,6 +138,31 @@ func TestAccMsSqlDatabase_GP(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_GP_ServerlessFreeLimit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.gpServerlessFreeLimit(data, "AutoPause"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("use_free_limit").HasValue("true"),
+				check.That(data.ResourceName).Key("free_limit_exhaustion_behavior").HasValue("AutoPause"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.gpServerlessFreeLimit(data, "BillOverUsage"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("free_limit_exhaustion_behavior").HasValue("BillOverUsage"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlDatabase_GP_Serverless(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
 	r := MsSqlDatabaseResource{}
@@ -150,6 +175,7 @@ func TestAccMsSqlDatabase_GP_Serverless(t *testing.T) {
 				check.That(data.ResourceName).Key("auto_pause_delay_in_minutes").HasValue("70"),
 				check.That(data.ResourceName).Key("min_capacity").HasValue("0.75"),
 				check.That(data.ResourceName).Key("sku_name").HasValue("GP_S_Gen5_2"),
+				check.That(data.ResourceName).Key("current_service_objective").HasValue("GP_S_Gen5_2"),
 			),
 		},
 		data.ImportStep(),
@@ -960,6 +986,22 @@ resource "azurerm_mssql_database" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MsSqlDatabaseResource) gpServerlessFreeLimit(data acceptance.TestData, exhaustionBehavior string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name                           = "acctest-db-%[2]d"
+  server_id                      = azurerm_mssql_server.test.id
+  auto_pause_delay_in_minutes    = 60
+  min_capacity                   = 0.5
+  sku_name                       = "GP_S_Gen5_2"
+  use_free_limit                 = true
+  free_limit_exhaustion_behavior = "%[3]s"
+}
+`, r.template(data), data.RandomInteger, exhaustionBehavior)
+}
+
 func (r MsSqlDatabaseResource) hs(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,190 @@
+package mssql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMsSqlDatabases() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMsSqlDatabasesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ServerID,
+			},
+
+			"name_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"elastic_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ElasticPoolID,
+			},
+
+			"databases": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"elastic_pool_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"max_size_gb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"sku_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMsSqlDatabasesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.ServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	databases := make([]sql.Database, 0)
+	if v := d.Get("elastic_pool_id").(string); v != "" {
+		elasticPoolId, err := parse.ElasticPoolID(v)
+		if err != nil {
+			return err
+		}
+		if elasticPoolId.SubscriptionId != serverId.SubscriptionId || elasticPoolId.ResourceGroup != serverId.ResourceGroup || elasticPoolId.ServerName != serverId.Name {
+			return fmt.Errorf("the `elastic_pool_id` %q must be within the %s", v, *serverId)
+		}
+
+		iterator, err := client.ListByElasticPoolComplete(ctx, elasticPoolId.ResourceGroup, elasticPoolId.ServerName, elasticPoolId.Name)
+		if err != nil {
+			return fmt.Errorf("listing the Databases within %s: %+v", *elasticPoolId, err)
+		}
+		for iterator.NotDone() {
+			databases = append(databases, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing the Databases within %s: %+v", *elasticPoolId, err)
+			}
+		}
+	} else {
+		iterator, err := client.ListByServerComplete(ctx, serverId.ResourceGroup, serverId.Name, "")
+		if err != nil {
+			return fmt.Errorf("listing the Databases within %s: %+v", *serverId, err)
+		}
+		for iterator.NotDone() {
+			databases = append(databases, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing the Databases within %s: %+v", *serverId, err)
+			}
+		}
+	}
+
+	results := make([]interface{}, 0)
+	for _, database := range databases {
+		if database.Name == nil {
+			continue
+		}
+		name := *database.Name
+
+		// the `master` database is a system database which can't be managed, so it's excluded
+		if strings.EqualFold(name, "master") {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"id":              parse.NewDatabaseID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, name).ID(),
+			"name":            name,
+			"elastic_pool_id": "",
+			"max_size_gb":     0,
+			"sku_name":        "",
+			"status":          "",
+			"tags":            tags.Flatten(database.Tags),
+		}
+
+		if props := database.DatabaseProperties; props != nil {
+			if props.ElasticPoolID != nil {
+				elasticPoolId, err := parse.ElasticPoolID(*props.ElasticPoolID)
+				if err != nil {
+					return err
+				}
+				result["elastic_pool_id"] = elasticPoolId.ID()
+			}
+			if props.MaxSizeBytes != nil {
+				result["max_size_gb"] = int((*props.MaxSizeBytes) / int64(1073741824))
+			}
+			if props.CurrentServiceObjectiveName != nil {
+				result["sku_name"] = *props.CurrentServiceObjectiveName
+			}
+			result["status"] = string(props.Status)
+		}
+
+		results = append(results, result)
+	}
+
+	d.SetId(serverId.ID())
+	d.Set("server_id", serverId.ID())
+
+	if err := d.Set("databases", results); err != nil {
+		return fmt.Errorf("setting `databases`: %+v", err)
+	}
+
+	return nil
+}
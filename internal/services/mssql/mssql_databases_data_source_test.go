@@ -0,0 +1,97 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlDatabasesDataSource struct{}
+
+func TestAccDataSourceMsSqlDatabases_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_databases", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlDatabasesDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("databases.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMsSqlDatabases_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_databases", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlDatabasesDataSource{}.nameRegex(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("databases.#").HasValue("1"),
+				check.That(data.ResourceName).Key("databases.0.name").HasValue(fmt.Sprintf("acctest-db-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("databases.0.sku_name").HasValue("ElasticPool"),
+				check.That(data.ResourceName).Key("databases.0.status").HasValue("Online"),
+				check.That(data.ResourceName).Key("databases.0.elastic_pool_id").Exists(),
+			),
+		},
+		{
+			Config: MsSqlDatabasesDataSource{}.elasticPool(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("databases.#").HasValue("1"),
+				check.That(data.ResourceName).Key("databases.0.name").HasValue(fmt.Sprintf("acctest-db-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (MsSqlDatabasesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "other" {
+  name      = "acctest-other-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+}
+`, MsSqlDatabaseResource{}.elasticPool(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabasesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_databases" "test" {
+  server_id = azurerm_mssql_server.test.id
+
+  depends_on = [azurerm_mssql_database.test, azurerm_mssql_database.other]
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabasesDataSource) nameRegex(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_databases" "test" {
+  server_id  = azurerm_mssql_server.test.id
+  name_regex = "^acctest-db-"
+
+  depends_on = [azurerm_mssql_database.test, azurerm_mssql_database.other]
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabasesDataSource) elasticPool(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_databases" "test" {
+  server_id       = azurerm_mssql_server.test.id
+  elastic_pool_id = azurerm_mssql_elasticpool.test.id
+
+  depends_on = [azurerm_mssql_database.test, azurerm_mssql_database.other]
+}
+`, r.template(data))
+}
@@ -0,0 +1,307 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceStartStopScheduleModel struct {
+	ManagedInstanceId string                                      `tfschema:"managed_instance_id"`
+	Description       string                                      `tfschema:"description"`
+	Schedule          []MsSqlManagedInstanceStartStopScheduleItem `tfschema:"schedule"`
+	TimezoneId        string                                      `tfschema:"timezone_id"`
+	NextExecutionTime string                                      `tfschema:"next_execution_time"`
+	NextRunAction     string                                      `tfschema:"next_run_action"`
+}
+
+type MsSqlManagedInstanceStartStopScheduleItem struct {
+	StartDay  string `tfschema:"start_day"`
+	StartTime string `tfschema:"start_time"`
+	StopDay   string `tfschema:"stop_day"`
+	StopTime  string `tfschema:"stop_time"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceStartStopScheduleResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceStartStopScheduleResource{}
+
+type MsSqlManagedInstanceStartStopScheduleResource struct{}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_start_stop_schedule"
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceStartStopScheduleModel{}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceStartStopScheduleID
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	timeValidation := validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "the time must be in the format `HH:mm`")
+
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForDayOfWeek(), false),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: timeValidation,
+					},
+
+					"stop_day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForDayOfWeek(), false),
+					},
+
+					"stop_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: timeValidation,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timezone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_execution_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_run_action": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return fmt.Errorf("parsing `managed_instance_id`: %v", err)
+			}
+
+			// the API only supports a single schedule per Managed Instance, which is always named `default`
+			id := parse.NewManagedInstanceStartStopScheduleID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "default")
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := azuresdkhacks.StartStopManagedInstanceSchedule{
+				Properties: &azuresdkhacks.StartStopManagedInstanceScheduleProperties{
+					ScheduleList: expandManagedInstanceStartStopScheduleItems(model.Schedule),
+					TimeZoneId:   utils.String(model.TimezoneId),
+				},
+			}
+
+			if model.Description != "" {
+				parameters.Properties.Description = utils.String(model.Description)
+			}
+
+			if err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			parameters := *existing.Model
+
+			if metadata.ResourceData.HasChange("schedule") {
+				parameters.Properties.ScheduleList = expandManagedInstanceStartStopScheduleItems(model.Schedule)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				parameters.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("timezone_id") {
+				parameters.Properties.TimeZoneId = utils.String(model.TimezoneId)
+			}
+
+			// these are computed by the service and are rejected when sent back
+			parameters.Properties.NextExecutionTime = nil
+			parameters.Properties.NextRunAction = nil
+
+			if err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlManagedInstanceStartStopScheduleModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.NextExecutionTime = utils.NormalizeNilableString(props.NextExecutionTime)
+					state.NextRunAction = utils.NormalizeNilableString(props.NextRunAction)
+					state.Schedule = flattenManagedInstanceStartStopScheduleItems(props.ScheduleList)
+					state.TimezoneId = utils.NormalizeNilableString(props.TimeZoneId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedInstanceStartStopScheduleItems(input []MsSqlManagedInstanceStartStopScheduleItem) []azuresdkhacks.ScheduleItem {
+	output := make([]azuresdkhacks.ScheduleItem, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.ScheduleItem{
+			StartDay:  azuresdkhacks.DayOfWeek(v.StartDay),
+			StartTime: v.StartTime,
+			StopDay:   azuresdkhacks.DayOfWeek(v.StopDay),
+			StopTime:  v.StopTime,
+		})
+	}
+	return output
+}
+
+func flattenManagedInstanceStartStopScheduleItems(input []azuresdkhacks.ScheduleItem) []MsSqlManagedInstanceStartStopScheduleItem {
+	output := make([]MsSqlManagedInstanceStartStopScheduleItem, 0)
+	for _, v := range input {
+		output = append(output, MsSqlManagedInstanceStartStopScheduleItem{
+			StartDay:  string(v.StartDay),
+			StartTime: v.StartTime,
+			StopDay:   string(v.StopDay),
+			StopTime:  v.StopTime,
+		})
+	}
+	return output
+}
@@ -391,6 +391,44 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				},
 			},
 
+			"sql_virtual_machine_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: sqlvirtualmachines.ValidateSqlVirtualMachineGroupID,
+				RequiredWith: []string{"wsfc_domain_credential"},
+			},
+
+			"wsfc_domain_credential": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"sql_virtual_machine_group_id"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cluster_bootstrap_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"cluster_operator_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sql_service_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"storage_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -528,6 +566,14 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if sqlVirtualMachineGroupId := d.Get("sql_virtual_machine_group_id").(string); sqlVirtualMachineGroupId != "" {
+		parameters.Properties.SqlVirtualMachineGroupResourceId = utils.String(sqlVirtualMachineGroupId)
+		parameters.Properties.WsfcDomainCredentials = expandSqlVirtualMachineWsfcDomainCredentials(d.Get("wsfc_domain_credential").([]interface{}))
+	} else if !d.IsNewResource() && d.HasChange("sql_virtual_machine_group_id") {
+		// removing the SQL Virtual Machine from the SQL Virtual Machine Group requires an empty ID to be sent
+		parameters.Properties.SqlVirtualMachineGroupResourceId = utils.String("")
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -615,10 +661,22 @@ func resourceMsSqlVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 				return fmt.Errorf("setting `auto_patching`: %+v", err)
 			}
 
-			if err := d.Set("assessment", flattenSqlVirtualMachineAssessmentSettings(props.AssessmentSettings)); err != nil {
+			if err := d.Set("assessment", flattenSqlVirtualMachineAssessmentSettings(props.AssessmentSettings, d)); err != nil {
 				return fmt.Errorf("setting `assessment`: %+v", err)
 			}
 
+			sqlVirtualMachineGroupId := ""
+			if props.SqlVirtualMachineGroupResourceId != nil && *props.SqlVirtualMachineGroupResourceId != "" {
+				groupId, err := sqlvirtualmachines.ParseSqlVirtualMachineGroupIDInsensitively(*props.SqlVirtualMachineGroupResourceId)
+				if err != nil {
+					return err
+				}
+				sqlVirtualMachineGroupId = groupId.ID()
+			}
+			d.Set("sql_virtual_machine_group_id", sqlVirtualMachineGroupId)
+
+			// NOTE: `wsfc_domain_credential` isn't returned by the API, so is intentionally not set here
+
 			if err := d.Set("key_vault_credential", flattenSqlVirtualMachineKeyVaultCredential(props.KeyVaultCredentialSettings, d)); err != nil {
 				return fmt.Errorf("setting `key_vault_credential`: %+v", err)
 			}
@@ -1002,7 +1060,7 @@ func expandSqlVirtualMachineAssessmentSettings(input []interface{}) *sqlvirtualm
 	assessmentSetting := input[0].(map[string]interface{})
 
 	return &sqlvirtualmachines.AssessmentSettings{
-		Enable:         utils.Bool(true),
+		Enable:         utils.Bool(assessmentSetting["enabled"].(bool)),
 		RunImmediately: utils.Bool(assessmentSetting["run_immediately"].(bool)),
 		Schedule:       expandSqlVirtualMachineAssessmentSettingsSchedule(assessmentSetting["schedule"].([]interface{})),
 	}
@@ -1034,8 +1092,14 @@ func expandSqlVirtualMachineAssessmentSettingsSchedule(input []interface{}) *sql
 	return schedule
 }
 
-func flattenSqlVirtualMachineAssessmentSettings(assessmentSettings *sqlvirtualmachines.AssessmentSettings) []interface{} {
-	if assessmentSettings == nil || assessmentSettings.Enable == nil || !*assessmentSettings.Enable {
+func flattenSqlVirtualMachineAssessmentSettings(assessmentSettings *sqlvirtualmachines.AssessmentSettings, d *pluginsdk.ResourceData) []interface{} {
+	if assessmentSettings == nil {
+		return []interface{}{}
+	}
+
+	// the API returns the Assessment Settings as disabled when these haven't been configured, as such we only
+	// want to flatten a disabled assessment when one's been explicitly defined in the config
+	if (assessmentSettings.Enable == nil || !*assessmentSettings.Enable) && len(d.Get("assessment").([]interface{})) == 0 {
 		return []interface{}{}
 	}
 
@@ -1090,6 +1154,19 @@ func flattenSqlVirtualMachineAssessmentSettings(assessmentSettings *sqlvirtualma
 	}
 }
 
+func expandSqlVirtualMachineWsfcDomainCredentials(input []interface{}) *sqlvirtualmachines.WsfcDomainCredentials {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	wsfcDomainCredentials := input[0].(map[string]interface{})
+
+	return &sqlvirtualmachines.WsfcDomainCredentials{
+		ClusterBootstrapAccountPassword: utils.String(wsfcDomainCredentials["cluster_bootstrap_account_password"].(string)),
+		ClusterOperatorAccountPassword:  utils.String(wsfcDomainCredentials["cluster_operator_account_password"].(string)),
+		SqlServiceAccountPassword:       utils.String(wsfcDomainCredentials["sql_service_account_password"].(string)),
+	}
+}
+
 func expandSqlVirtualMachineKeyVaultCredential(input []interface{}) *sqlvirtualmachines.KeyVaultCredentialSettings {
 	if len(input) == 0 {
 		return nil
@@ -308,6 +308,12 @@ func TestAccMsSqlVirtualMachine_assessmentSettings(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.assessmentSettingsDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
 	})
 }
 
@@ -1068,3 +1074,24 @@ resource "azurerm_mssql_virtual_machine" "test" {
 }
 `, r.template(data))
 }
+
+func (r MsSqlVirtualMachineResource) assessmentSettingsDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+
+  assessment {
+    enabled = false
+
+    schedule {
+      day_of_week        = "Tuesday"
+      monthly_occurrence = 3
+      start_time         = "01:02"
+    }
+  }
+}
+`, r.template(data))
+}
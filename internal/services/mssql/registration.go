@@ -32,6 +32,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_mssql_database":    dataSourceMsSqlDatabase(),
+		"azurerm_mssql_databases":   dataSourceMsSqlDatabases(),
 		"azurerm_mssql_elasticpool": dataSourceMsSqlElasticpool(),
 		"azurerm_mssql_server":      dataSourceMsSqlServer(),
 	}
@@ -77,6 +78,7 @@ func (r Registration) Resources() []sdk.Resource {
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
 		MsSqlManagedInstanceResource{},
+		MsSqlManagedInstanceStartStopScheduleResource{},
 		ServerDNSAliasResource{},
 	}
 }
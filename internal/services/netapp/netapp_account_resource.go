@@ -99,6 +99,48 @@ func resourceNetAppAccount() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 						},
+						"site_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"administrators": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+						"backup_operators": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+						"aes_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"ldap_signing_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"ldap_over_tls_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"server_root_ca_certificate": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+						},
 					},
 				},
 			},
@@ -127,10 +169,15 @@ func resourceNetAppAccountCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	activeDirectories, err := expandNetAppActiveDirectories(d.Get("active_directory").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `active_directory`: %+v", err)
+	}
+
 	accountParameters := netappaccounts.NetAppAccount{
 		Location: azure.NormalizeLocation(d.Get("location").(string)),
 		Properties: &netappaccounts.AccountProperties{
-			ActiveDirectories: expandNetAppActiveDirectories(d.Get("active_directory").([]interface{})),
+			ActiveDirectories: activeDirectories,
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -166,7 +213,10 @@ func resourceNetAppAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 	if d.HasChange("active_directory") {
 		shouldUpdate = true
 		activeDirectoriesRaw := d.Get("active_directory").([]interface{})
-		activeDirectories := expandNetAppActiveDirectories(activeDirectoriesRaw)
+		activeDirectories, err := expandNetAppActiveDirectories(activeDirectoriesRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `active_directory`: %+v", err)
+		}
 		update.Properties.ActiveDirectories = activeDirectories
 	}
 
@@ -239,24 +289,46 @@ func resourceNetAppAccountDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
-func expandNetAppActiveDirectories(input []interface{}) *[]netappaccounts.ActiveDirectory {
+func expandNetAppActiveDirectories(input []interface{}) (*[]netappaccounts.ActiveDirectory, error) {
 	results := make([]netappaccounts.ActiveDirectory, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		dns := strings.Join(*utils.ExpandStringSlice(v["dns_servers"].([]interface{})), ",")
 
+		ldapOverTLSEnabled := v["ldap_over_tls_enabled"].(bool)
+		serverRootCACertificate := v["server_root_ca_certificate"].(string)
+		if ldapOverTLSEnabled && serverRootCACertificate == "" {
+			return nil, fmt.Errorf("`server_root_ca_certificate` must be specified when `ldap_over_tls_enabled` is true")
+		}
+		if !ldapOverTLSEnabled && serverRootCACertificate != "" {
+			return nil, fmt.Errorf("`server_root_ca_certificate` can only be specified when `ldap_over_tls_enabled` is true")
+		}
+
 		result := netappaccounts.ActiveDirectory{
+			Administrators:     utils.ExpandStringSlice(v["administrators"].([]interface{})),
+			AesEncryption:      utils.Bool(v["aes_encryption_enabled"].(bool)),
+			BackupOperators:    utils.ExpandStringSlice(v["backup_operators"].([]interface{})),
 			Dns:                utils.String(dns),
 			Domain:             utils.String(v["domain"].(string)),
+			LdapOverTLS:        utils.Bool(ldapOverTLSEnabled),
+			LdapSigning:        utils.Bool(v["ldap_signing_enabled"].(bool)),
 			OrganizationalUnit: utils.String(v["organizational_unit"].(string)),
 			Password:           utils.String(v["password"].(string)),
 			SmbServerName:      utils.String(v["smb_server_name"].(string)),
 			Username:           utils.String(v["username"].(string)),
 		}
 
+		if siteName := v["site_name"].(string); siteName != "" {
+			result.Site = utils.String(siteName)
+		}
+
+		if serverRootCACertificate != "" {
+			result.ServerRootCACertificate = utils.String(serverRootCACertificate)
+		}
+
 		results = append(results, result)
 	}
-	return &results
+	return &results, nil
 }
 
 func waitForAccountCreateOrUpdate(ctx context.Context, client *netappaccounts.NetAppAccountsClient, id netappaccounts.NetAppAccountId) error {
@@ -195,6 +195,12 @@ resource "azurerm_netapp_account" "test" {
     dns_servers         = ["1.2.3.4"]
     domain              = "westcentralus.com"
     organizational_unit = "OU=FirstLevel"
+    site_name           = "Default-First-Site-Name"
+    administrators      = ["aduser"]
+    backup_operators    = ["aduser"]
+
+    aes_encryption_enabled = true
+    ldap_signing_enabled   = true
   }
 
   tags = {
@@ -0,0 +1,191 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func resourceApplicationGatewayBackendAddressPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceApplicationGatewayBackendAddressPoolRead,
+		Update: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceApplicationGatewayBackendAddressPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayBackendAddressPoolSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(applicationGatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(applicationGatewayId.Name, applicationGatewayResourceName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+	props := applicationGateway.ApplicationGatewayPropertiesFormat
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewaySubResourceSchema(applicationGatewayBackendAddressPoolSchema()))
+	pool := utils.ToPtr(expandApplicationGatewayBackendAddressPool(config))
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	if props.BackendAddressPools != nil {
+		pools = *props.BackendAddressPools
+	}
+
+	exists := false
+	for i, v := range pools {
+		if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", id.ID())
+			}
+
+			pools[i] = *pool
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		pools = append(pools, *pool)
+	}
+
+	props.BackendAddressPools = &pools
+
+	if err := updateApplicationGatewayIfMatch(ctx, client, *applicationGatewayId, applicationGateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var pool *network.ApplicationGatewayBackendAddressPool
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, v := range *props.BackendAddressPools {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				pool = &v
+				break
+			}
+		}
+	}
+	if pool == nil {
+		log.Printf("[INFO] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	return setApplicationGatewaySubResource(d, applicationGatewaySubResourceSchema(applicationGatewayBackendAddressPoolSchema()), flattenApplicationGatewayBackendAddressPool(*pool))
+}
+
+func resourceApplicationGatewayBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(applicationGatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(applicationGatewayId.Name, applicationGatewayResourceName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	props := applicationGateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.BackendAddressPools == nil {
+		return nil
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	for _, v := range *props.BackendAddressPools {
+		if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+			continue
+		}
+
+		pools = append(pools, v)
+	}
+	if len(pools) == len(*props.BackendAddressPools) {
+		return nil
+	}
+	props.BackendAddressPools = &pools
+
+	if err := updateApplicationGatewayIfMatch(ctx, client, applicationGatewayId, applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HttpListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayHTTPListenerSchema()),
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHttpListenerID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroup, applicationGatewayId.Name, d.Get("name").(string))
+
+	locks.ByName(applicationGatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(applicationGatewayId.Name, applicationGatewayResourceName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *applicationGatewayId, err)
+	}
+	if applicationGateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *applicationGatewayId)
+	}
+	props := applicationGateway.ApplicationGatewayPropertiesFormat
+
+	config := expandApplicationGatewaySubResource(d, applicationGatewaySubResourceSchema(applicationGatewayHTTPListenerSchema()))
+	listener, err := expandApplicationGatewayHTTPListener(config, applicationGatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	if props.HTTPListeners != nil {
+		listeners = *props.HTTPListeners
+	}
+
+	exists := false
+	for i, v := range listeners {
+		if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+			}
+
+			listeners[i] = *listener
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		listeners = append(listeners, *listener)
+	}
+
+	props.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayIfMatch(ctx, client, *applicationGatewayId, applicationGateway); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing %s from state", applicationGatewayId, id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var listener *network.ApplicationGatewayHTTPListener
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, v := range *props.HTTPListeners {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				listener = &v
+				break
+			}
+		}
+	}
+	if listener == nil {
+		log.Printf("[INFO] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	flattened, err := flattenApplicationGatewayHTTPListener(*listener)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	return setApplicationGatewaySubResource(d, applicationGatewaySubResourceSchema(applicationGatewayHTTPListenerSchema()), flattened)
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(applicationGatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(applicationGatewayId.Name, applicationGatewayResourceName)
+
+	applicationGateway, err := client.Get(ctx, applicationGatewayId.ResourceGroup, applicationGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(applicationGateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	props := applicationGateway.ApplicationGatewayPropertiesFormat
+	if props == nil || props.HTTPListeners == nil {
+		return nil
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	for _, v := range *props.HTTPListeners {
+		if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+			continue
+		}
+
+		listeners = append(listeners, v)
+	}
+	if len(listeners) == len(*props.HTTPListeners) {
+		return nil
+	}
+	props.HTTPListeners = &listeners
+
+	if err := updateApplicationGatewayIfMatch(ctx, client, applicationGatewayId, applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayHTTPListenerResource struct{}
+
+func TestAccApplicationGatewayHTTPListener_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayHTTPListenerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HttpListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, v := range *props.HTTPListeners {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayHTTPListenerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "first.example.com"
+}
+`, ApplicationGatewayResource{}.ignoreChanges(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayHTTPListenerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_names                     = ["first.example.com", "second.example.com"]
+
+  custom_error_configuration {
+    status_code           = "HttpStatus403"
+    custom_error_page_url = "http://azure.com/error403_listener.html"
+  }
+}
+`, ApplicationGatewayResource{}.ignoreChanges(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayHTTPListenerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "import" {
+  name                           = azurerm_application_gateway_http_listener.test.name
+  application_gateway_id         = azurerm_application_gateway_http_listener.test.application_gateway_id
+  frontend_ip_configuration_name = azurerm_application_gateway_http_listener.test.frontend_ip_configuration_name
+  frontend_port_name             = azurerm_application_gateway_http_listener.test.frontend_port_name
+  protocol                       = azurerm_application_gateway_http_listener.test.protocol
+  host_name                      = azurerm_application_gateway_http_listener.test.host_name
+}
+`, r.basic(data))
+}
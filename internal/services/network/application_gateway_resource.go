@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -107,6 +108,375 @@ func sslProfileSchema(computed bool) *pluginsdk.Schema {
 	}
 }
 
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, false),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ssl_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayRewriteRuleSetSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"rule_sequence": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 1000),
+					},
+
+					"condition": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"variable": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"pattern": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"ignore_case": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+								"negate": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"request_header_configuration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"header_name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"header_value": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					"response_header_configuration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"header_name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"header_value": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					"url": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"path": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"query_string": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+
+								"components": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Computed: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"path_only",
+										"query_string_only",
+									}, false),
+								},
+
+								"reroute": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 func resourceApplicationGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApplicationGatewayCreate,
@@ -140,40 +510,36 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 
 			"identity": commonschema.UserAssignedIdentityOptional(),
 
+			"ignore_backend_address_pool_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ignore_http_listener_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ignore_request_routing_rule_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ignore_rewrite_rule_set_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// lintignore:S016,S023
 			"backend_address_pool": {
 				Type:     pluginsdk.TypeSet,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 				Set: applicationGatewayBackendAddressPool,
 			},
@@ -436,117 +802,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, false),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_profile_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-										}, false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
-						},
-
-						"ssl_profile_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 				Set: applicationGatewayHttpListnerHash,
 			},
@@ -636,94 +892,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
-							}, false),
-						},
-
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 20000),
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
 				},
 			},
 
@@ -1015,133 +1184,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-
-									"rule_sequence": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntBetween(1, 1000),
-									},
-
-									"condition": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"variable": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-												"pattern": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-												"ignore_case": {
-													Type:     pluginsdk.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"negate": {
-													Type:     pluginsdk.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-											},
-										},
-									},
-
-									"request_header_configuration": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"header_name": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-												"header_value": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-
-									"response_header_configuration": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"header_name": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-												"header_value": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-
-									"url": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"path": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-												},
-												"query_string": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-												},
-
-												"components": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													Computed: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"path_only",
-														"query_string_only",
-													}, false),
-												},
-
-												"reroute": {
-													Type:     pluginsdk.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRewriteRuleSetSchema(),
 				},
 			},
 
@@ -1757,6 +1800,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	applicationGateway, err := client.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1782,7 +1828,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		applicationGateway.ApplicationGatewayPropertiesFormat.TrustedRootCertificates = trustedRootCertificates
 	}
 
-	if d.HasChange("request_routing_rule") {
+	// when the `ignore_*_changes` fields are enabled these collections are managed by their own resources
+	// (e.g. `azurerm_application_gateway_request_routing_rule`) so the existing items are left as-is
+	if d.HasChange("request_routing_rule") && !d.Get("ignore_request_routing_rule_changes").(bool) {
 		requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
 		if err != nil {
 			return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
@@ -1840,7 +1888,7 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		applicationGateway.ApplicationGatewayPropertiesFormat.GlobalConfiguration = globalConfiguration
 	}
 
-	if d.HasChange("http_listener") {
+	if d.HasChange("http_listener") && !d.Get("ignore_http_listener_changes").(bool) {
 		httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
 		if err != nil {
 			return fmt.Errorf("fail to expand `http_listener`: %+v", err)
@@ -1849,7 +1897,7 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		applicationGateway.ApplicationGatewayPropertiesFormat.HTTPListeners = httpListeners
 	}
 
-	if d.HasChange("rewrite_rule_set") {
+	if d.HasChange("rewrite_rule_set") && !d.Get("ignore_rewrite_rule_set_changes").(bool) {
 		rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d)
 		if err != nil {
 			return fmt.Errorf("expanding `rewrite_rule_set`: %v", err)
@@ -1870,7 +1918,7 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		applicationGateway.ApplicationGatewayPropertiesFormat.CustomErrorConfigurations = expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{}))
 	}
 
-	if d.HasChange("backend_address_pool") {
+	if d.HasChange("backend_address_pool") && !d.Get("ignore_backend_address_pool_changes").(bool) {
 		applicationGateway.ApplicationGatewayPropertiesFormat.BackendAddressPools = expandApplicationGatewayBackendAddressPools(d)
 	}
 
@@ -1985,15 +2033,17 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 			return fmt.Errorf("waiting for %s to stop: %+v", id, err)
 		}
-	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, applicationGateway)
-	if err != nil {
-		return fmt.Errorf("updating %s: %+v", id, err)
+		// stopping the Application Gateway changes the ETag
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		applicationGateway.Etag = existing.Etag
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	if err := updateApplicationGatewayIfMatch(ctx, client, *id, applicationGateway); err != nil {
+		return err
 	}
 
 	if stopApplicationGateway {
@@ -2055,8 +2105,12 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 		}
 
-		if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
-			return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
+		// when the `ignore_*_changes` fields are enabled these collections are managed by their own resources,
+		// so the items configured on this resource are kept in the state as-is
+		if !d.Get("ignore_backend_address_pool_changes").(bool) {
+			if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+				return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
+			}
 		}
 
 		backendHttpSettings, err := flattenApplicationGatewayBackendHTTPSettings(props.BackendHTTPSettingsCollection)
@@ -2075,12 +2129,14 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("fips_enabled", props.EnableFips)
 		d.Set("force_firewall_policy_association", props.ForceFirewallPolicyAssociation)
 
-		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
-		if err != nil {
-			return fmt.Errorf("flattening `http_listener`: %+v", err)
-		}
-		if setErr := d.Set("http_listener", httpListeners); setErr != nil {
-			return fmt.Errorf("setting `http_listener`: %+v", setErr)
+		if !d.Get("ignore_http_listener_changes").(bool) {
+			httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
+			if err != nil {
+				return fmt.Errorf("flattening `http_listener`: %+v", err)
+			}
+			if setErr := d.Set("http_listener", httpListeners); setErr != nil {
+				return fmt.Errorf("setting `http_listener`: %+v", setErr)
+			}
 		}
 
 		if setErr := d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(props.FrontendPorts)); setErr != nil {
@@ -2115,12 +2171,14 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `probe`: %+v", setErr)
 		}
 
-		requestRoutingRules, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)
-		if err != nil {
-			return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
-		}
-		if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
-			return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
+		if !d.Get("ignore_request_routing_rule_changes").(bool) {
+			requestRoutingRules, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)
+			if err != nil {
+				return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
+			}
+			if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
+				return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
+			}
 		}
 
 		redirectConfigurations, err := flattenApplicationGatewayRedirectConfigurations(props.RedirectConfigurations)
@@ -2131,9 +2189,11 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `redirect_configuration`: %+v", setErr)
 		}
 
-		rewriteRuleSets := flattenApplicationGatewayRewriteRuleSets(props.RewriteRuleSets)
-		if setErr := d.Set("rewrite_rule_set", rewriteRuleSets); setErr != nil {
-			return fmt.Errorf("setting `rewrite_rule_set`: %+v", setErr)
+		if !d.Get("ignore_rewrite_rule_set_changes").(bool) {
+			rewriteRuleSets := flattenApplicationGatewayRewriteRuleSets(props.RewriteRuleSets)
+			if setErr := d.Set("rewrite_rule_set", rewriteRuleSets); setErr != nil {
+				return fmt.Errorf("setting `rewrite_rule_set`: %+v", setErr)
+			}
 		}
 
 		if setErr := d.Set("sku", flattenApplicationGatewaySku(props.Sku)); setErr != nil {
@@ -2194,6 +2254,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -2391,40 +2454,43 @@ func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]n
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-		backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
-
-		if fqdnsConfig, ok := v["fqdns"]; ok {
-			fqdns := fqdnsConfig.(*schema.Set).List()
-			for _, ip := range fqdns {
-				backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-					Fqdn: utils.String(ip.(string)),
-				})
-			}
-		}
+		results = append(results, expandApplicationGatewayBackendAddressPool(raw.(map[string]interface{})))
+	}
 
-		if ipAddressesConfig, ok := v["ip_addresses"]; ok {
-			ipAddresses := ipAddressesConfig.(*schema.Set).List()
+	return &results
+}
 
-			for _, ip := range ipAddresses {
-				backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-					IPAddress: utils.String(ip.(string)),
-				})
-			}
+func expandApplicationGatewayBackendAddressPool(v map[string]interface{}) network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+
+	if fqdnsConfig, ok := v["fqdns"]; ok {
+		fqdns := fqdnsConfig.(*schema.Set).List()
+		for _, ip := range fqdns {
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				Fqdn: utils.String(ip.(string)),
+			})
 		}
+	}
 
-		name := v["name"].(string)
-		output := network.ApplicationGatewayBackendAddressPool{
-			Name: utils.String(name),
-			ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
-				BackendAddresses: &backendAddresses,
-			},
+	if ipAddressesConfig, ok := v["ip_addresses"]; ok {
+		ipAddresses := ipAddressesConfig.(*schema.Set).List()
+
+		for _, ip := range ipAddresses {
+			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+				IPAddress: utils.String(ip.(string)),
+			})
 		}
+	}
 
-		results = append(results, output)
+	name := v["name"].(string)
+	output := network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
 	}
 
-	return &results
+	return output
 }
 
 func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
@@ -2434,38 +2500,42 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		ipAddressList := make([]interface{}, 0)
-		fqdnList := make([]interface{}, 0)
-
-		if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
-			if props.BackendAddresses != nil {
-				for _, address := range *props.BackendAddresses {
-					if address.IPAddress != nil {
-						ipAddressList = append(ipAddressList, *address.IPAddress)
-					} else if address.Fqdn != nil {
-						fqdnList = append(fqdnList, *address.Fqdn)
-					}
+		results = append(results, flattenApplicationGatewayBackendAddressPool(config))
+	}
+
+	return results
+}
+
+func flattenApplicationGatewayBackendAddressPool(config network.ApplicationGatewayBackendAddressPool) map[string]interface{} {
+	ipAddressList := make([]interface{}, 0)
+	fqdnList := make([]interface{}, 0)
+
+	if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
+		if props.BackendAddresses != nil {
+			for _, address := range *props.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				} else if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
 				}
 			}
 		}
+	}
 
-		output := map[string]interface{}{
-			"fqdns":        fqdnList,
-			"ip_addresses": ipAddressList,
-		}
-
-		if config.ID != nil {
-			output["id"] = *config.ID
-		}
+	output := map[string]interface{}{
+		"fqdns":        fqdnList,
+		"ip_addresses": ipAddressList,
+	}
 
-		if config.Name != nil {
-			output["name"] = *config.Name
-		}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-		results = append(results, output)
+	if config.Name != nil {
+		output["name"] = *config.Name
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
@@ -2771,75 +2841,82 @@ func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
-		sslProfileName := v["ssl_profile_name"].(string)
+		listener, err := expandApplicationGatewayHTTPListener(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
 
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+		results = append(results, *listener)
+	}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+	return &results, nil
+}
 
-		listener := network.ApplicationGatewayHTTPListener{
-			Name: utils.String(name),
-			ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &network.SubResource{
-					ID: utils.String(frontendIPConfigID),
-				},
-				FrontendPort: &network.SubResource{
-					ID: utils.String(frontendPortID),
-				},
-				Protocol:                    network.ApplicationGatewayProtocol(protocol),
-				RequireServerNameIndication: utils.Bool(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayHTTPListener, error) {
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+	sslProfileName := v["ssl_profile_name"].(string)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
 			},
-		}
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(protocol),
+			RequireServerNameIndication: utils.Bool(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
-		}
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
 
-		if host != "" {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
-		}
+	if host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+	}
 
-		if len(hosts) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
-		}
+	if len(hosts) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+	}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
-				ID: utils.String(certID),
-			}
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
 		}
+	}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
-				ID: utils.String(firewallPolicyID),
-			}
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
 		}
+	}
 
-		if sslProfileName != "" && len(sslProfileName) > 0 {
-			sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
-				ID: utils.String(sslProfileID),
-			}
+	if sslProfileName != "" && len(sslProfileName) > 0 {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
+			ID: utils.String(sslProfileID),
 		}
-
-		results = append(results, listener)
 	}
 
-	return &results, nil
+	return &listener, nil
 }
 
 func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) ([]interface{}, error) {
@@ -2848,89 +2925,98 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 		return results, nil
 	}
 
-	for _, v := range *input {
-		output := map[string]interface{}{}
+	for _, v := range *input {
+		output, err := flattenApplicationGatewayHTTPListener(v)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
 
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
+func flattenApplicationGatewayHTTPListener(v network.ApplicationGatewayHTTPListener) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-		if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
-			if port := props.FrontendPort; port != nil {
-				if port.ID != nil {
-					portId, err := parse.FrontendPortIDInsensitively(*port.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_port_name"] = portId.Name
-					output["frontend_port_id"] = portId.ID()
-				}
-			}
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if feConfig := props.FrontendIPConfiguration; feConfig != nil {
-				if feConfig.ID != nil {
-					feConfigId, err := parse.FrontendIPConfigurationIDInsensitively(*feConfig.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_ip_configuration_name"] = feConfigId.Name
-					output["frontend_ip_configuration_id"] = feConfigId.ID()
+	if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		if port := props.FrontendPort; port != nil {
+			if port.ID != nil {
+				portId, err := parse.FrontendPortIDInsensitively(*port.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["frontend_port_name"] = portId.Name
+				output["frontend_port_id"] = portId.ID()
 			}
+		}
 
-			if hostname := props.HostName; hostname != nil {
-				output["host_name"] = *hostname
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil {
+			if feConfig.ID != nil {
+				feConfigId, err := parse.FrontendIPConfigurationIDInsensitively(*feConfig.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["frontend_ip_configuration_name"] = feConfigId.Name
+				output["frontend_ip_configuration_id"] = feConfigId.ID()
 			}
+		}
 
-			if hostnames := props.HostNames; hostnames != nil {
-				output["host_names"] = utils.FlattenStringSlice(hostnames)
-			}
+		if hostname := props.HostName; hostname != nil {
+			output["host_name"] = *hostname
+		}
 
-			output["protocol"] = string(props.Protocol)
+		if hostnames := props.HostNames; hostnames != nil {
+			output["host_names"] = utils.FlattenStringSlice(hostnames)
+		}
 
-			if cert := props.SslCertificate; cert != nil {
-				if cert.ID != nil {
-					certId, err := parse.SslCertificateIDInsensitively(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		output["protocol"] = string(props.Protocol)
 
-					output["ssl_certificate_name"] = certId.Name
-					output["ssl_certificate_id"] = certId.ID()
+		if cert := props.SslCertificate; cert != nil {
+			if cert.ID != nil {
+				certId, err := parse.SslCertificateIDInsensitively(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			if sni := props.RequireServerNameIndication; sni != nil {
-				output["require_sni"] = *sni
+				output["ssl_certificate_name"] = certId.Name
+				output["ssl_certificate_id"] = certId.ID()
 			}
+		}
 
-			if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
-				output["firewall_policy_id"] = *fwp.ID
-			}
+		if sni := props.RequireServerNameIndication; sni != nil {
+			output["require_sni"] = *sni
+		}
 
-			if sslp := props.SslProfile; sslp != nil {
-				if sslp.ID != nil {
-					sslProfileId, err := parse.SslProfileIDInsensitively(*sslp.ID)
-					if err != nil {
-						return nil, err
-					}
+		if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
+			output["firewall_policy_id"] = *fwp.ID
+		}
 
-					output["ssl_profile_name"] = sslProfileId.Name
-					output["ssl_profile_id"] = sslProfileId.ID()
+		if sslp := props.SslProfile; sslp != nil {
+			if sslp.ID != nil {
+				sslProfileId, err := parse.SslProfileIDInsensitively(*sslp.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
+				output["ssl_profile_name"] = sslProfileId.Name
+				output["ssl_profile_id"] = sslProfileId.ID()
+			}
 		}
 
-		results = append(results, output)
+		output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayIPConfigurations(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayIPConfiguration, bool) {
@@ -3439,90 +3525,110 @@ func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.Applica
 func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
 	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
-	priorityset := false
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-		priority := int32(v["priority"].(int))
-
-		rule := network.ApplicationGatewayRequestRoutingRule{
-			Name: utils.String(name),
-			ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
-				HTTPListener: &network.SubResource{
-					ID: utils.String(httpListenerID),
-				},
-			},
+		rule, err := expandApplicationGatewayRequestRoutingRule(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
 		}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
-		}
+		results = append(results, *rule)
+	}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+	if err := validateApplicationGatewayRequestRoutingRulePriorities(results); err != nil {
+		return nil, err
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-				ID: utils.String(backendAddressPoolID),
-			}
-		}
+	return &results, nil
+}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-				ID: utils.String(backendHTTPSettingsID),
-			}
+func validateApplicationGatewayRequestRoutingRulePriorities(input []network.ApplicationGatewayRequestRoutingRule) error {
+	priorityset := false
+	for _, rule := range input {
+		if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil && props.Priority != nil {
+			priorityset = true
 		}
+	}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-				ID: utils.String(redirectConfigID),
+	if priorityset {
+		for _, rule := range input {
+			if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props == nil || props.Priority == nil {
+				return fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
 			}
 		}
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
-				ID: utils.String(urlPathMapID),
-			}
+	return nil
+}
+
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+	priority := int32(v["priority"].(int))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
+
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
+
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-				ID: utils.String(rewriteRuleSetID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
 		}
+	}
 
-		if priority != 0 {
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
-			priorityset = true
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(redirectConfigID),
 		}
+	}
 
-		results = append(results, rule)
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
 	}
 
-	if priorityset {
-		for _, rule := range results {
-			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
-				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(rewriteRuleSetID),
 		}
 	}
 
-	return &results, nil
+	if priority != 0 {
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
+	}
+
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -3532,95 +3638,111 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
-			output := map[string]interface{}{
-				"rule_type": string(props.RuleType),
-			}
+		if config.ApplicationGatewayRequestRoutingRulePropertiesFormat == nil {
+			continue
+		}
 
-			if config.ID != nil {
-				output["id"] = *config.ID
-			}
+		output, err := flattenApplicationGatewayRequestRoutingRule(config)
+		if err != nil {
+			return nil, err
+		}
 
-			if config.Name != nil {
-				output["name"] = *config.Name
-			}
+		results = append(results, output)
+	}
 
-			if config.Priority != nil {
-				output["priority"] = *config.Priority
-			}
+	return results, nil
+}
 
-			if pool := props.BackendAddressPool; pool != nil {
-				if pool.ID != nil {
-					poolId, err := parse.BackendAddressPoolIDInsensitively(*pool.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["backend_address_pool_name"] = poolId.Name
-					output["backend_address_pool_id"] = poolId.ID()
-				}
-			}
+func flattenApplicationGatewayRequestRoutingRule(config network.ApplicationGatewayRequestRoutingRule) (map[string]interface{}, error) {
+	props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat
+	if props == nil {
+		return map[string]interface{}{}, nil
+	}
 
-			if settings := props.BackendHTTPSettings; settings != nil {
-				if settings.ID != nil {
-					settingsId, err := parse.BackendHttpSettingsCollectionIDInsensitively(*settings.ID)
-					if err != nil {
-						return nil, err
-					}
+	output := map[string]interface{}{
+		"rule_type": string(props.RuleType),
+	}
 
-					output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
-					output["backend_http_settings_id"] = *settings.ID
-				}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
+
+	if config.Name != nil {
+		output["name"] = *config.Name
+	}
+
+	if config.Priority != nil {
+		output["priority"] = *config.Priority
+	}
+
+	if pool := props.BackendAddressPool; pool != nil {
+		if pool.ID != nil {
+			poolId, err := parse.BackendAddressPoolIDInsensitively(*pool.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["backend_address_pool_name"] = poolId.Name
+			output["backend_address_pool_id"] = poolId.ID()
+		}
+	}
 
-			if listener := props.HTTPListener; listener != nil {
-				if listener.ID != nil {
-					listenerId, err := parse.HttpListenerIDInsensitively(*listener.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["http_listener_id"] = listenerId.ID()
-					output["http_listener_name"] = listenerId.Name
-				}
+	if settings := props.BackendHTTPSettings; settings != nil {
+		if settings.ID != nil {
+			settingsId, err := parse.BackendHttpSettingsCollectionIDInsensitively(*settings.ID)
+			if err != nil {
+				return nil, err
 			}
 
-			if pathMap := props.URLPathMap; pathMap != nil {
-				if pathMap.ID != nil {
-					pathMapId, err := parse.UrlPathMapIDInsensitively(*pathMap.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["url_path_map_name"] = pathMapId.Name
-					output["url_path_map_id"] = pathMapId.ID()
-				}
+			output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
+			output["backend_http_settings_id"] = *settings.ID
+		}
+	}
+
+	if listener := props.HTTPListener; listener != nil {
+		if listener.ID != nil {
+			listenerId, err := parse.HttpListenerIDInsensitively(*listener.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["http_listener_id"] = listenerId.ID()
+			output["http_listener_name"] = listenerId.Name
+		}
+	}
 
-			if redirect := props.RedirectConfiguration; redirect != nil {
-				if redirect.ID != nil {
-					redirectId, err := parse.RedirectConfigurationsIDInsensitively(*redirect.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
-					output["redirect_configuration_id"] = redirectId.ID()
-				}
+	if pathMap := props.URLPathMap; pathMap != nil {
+		if pathMap.ID != nil {
+			pathMapId, err := parse.UrlPathMapIDInsensitively(*pathMap.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["url_path_map_name"] = pathMapId.Name
+			output["url_path_map_id"] = pathMapId.ID()
+		}
+	}
 
-			if rewrite := props.RewriteRuleSet; rewrite != nil {
-				if rewrite.ID != nil {
-					rewriteId, err := parse.RewriteRuleSetIDInsensitively(*rewrite.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["rewrite_rule_set_name"] = rewriteId.Name
-					output["rewrite_rule_set_id"] = rewriteId.ID()
-				}
+	if redirect := props.RedirectConfiguration; redirect != nil {
+		if redirect.ID != nil {
+			redirectId, err := parse.RedirectConfigurationsIDInsensitively(*redirect.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
+			output["redirect_configuration_id"] = redirectId.ID()
+		}
+	}
 
-			results = append(results, output)
+	if rewrite := props.RewriteRuleSet; rewrite != nil {
+		if rewrite.ID != nil {
+			rewriteId, err := parse.RewriteRuleSetIDInsensitively(*rewrite.ID)
+			if err != nil {
+				return nil, err
+			}
+			output["rewrite_rule_set_name"] = rewriteId.Name
+			output["rewrite_rule_set_id"] = rewriteId.ID()
 		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
@@ -3628,96 +3750,104 @@ func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]netw
 	ruleSets := make([]network.ApplicationGatewayRewriteRuleSet, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-		rules := make([]network.ApplicationGatewayRewriteRule, 0)
+		ruleSet, err := expandApplicationGatewayRewriteRuleSet(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
+		ruleSets = append(ruleSets, *ruleSet)
+	}
 
-		for _, ruleConfig := range v["rewrite_rule"].([]interface{}) {
-			r := ruleConfig.(map[string]interface{})
-			conditions := make([]network.ApplicationGatewayRewriteRuleCondition, 0)
-			requestConfigurations := make([]network.ApplicationGatewayHeaderConfiguration, 0)
-			responseConfigurations := make([]network.ApplicationGatewayHeaderConfiguration, 0)
-			urlConfiguration := network.ApplicationGatewayURLConfiguration{}
+	return &ruleSets, nil
+}
 
-			rule := network.ApplicationGatewayRewriteRule{
-				Name:         utils.String(r["name"].(string)),
-				RuleSequence: utils.Int32(int32(r["rule_sequence"].(int))),
-			}
+func expandApplicationGatewayRewriteRuleSet(v map[string]interface{}) (*network.ApplicationGatewayRewriteRuleSet, error) {
+	rules := make([]network.ApplicationGatewayRewriteRule, 0)
 
-			for _, rawCondition := range r["condition"].([]interface{}) {
-				c := rawCondition.(map[string]interface{})
-				condition := network.ApplicationGatewayRewriteRuleCondition{
-					Variable:   utils.String(c["variable"].(string)),
-					Pattern:    utils.String(c["pattern"].(string)),
-					IgnoreCase: utils.Bool(c["ignore_case"].(bool)),
-					Negate:     utils.Bool(c["negate"].(bool)),
-				}
-				conditions = append(conditions, condition)
-			}
-			rule.Conditions = &conditions
+	name := v["name"].(string)
 
-			for _, rawConfig := range r["request_header_configuration"].([]interface{}) {
-				c := rawConfig.(map[string]interface{})
-				config := network.ApplicationGatewayHeaderConfiguration{
-					HeaderName:  utils.String(c["header_name"].(string)),
-					HeaderValue: utils.String(c["header_value"].(string)),
-				}
-				requestConfigurations = append(requestConfigurations, config)
-			}
+	for _, ruleConfig := range v["rewrite_rule"].([]interface{}) {
+		r := ruleConfig.(map[string]interface{})
+		conditions := make([]network.ApplicationGatewayRewriteRuleCondition, 0)
+		requestConfigurations := make([]network.ApplicationGatewayHeaderConfiguration, 0)
+		responseConfigurations := make([]network.ApplicationGatewayHeaderConfiguration, 0)
+		urlConfiguration := network.ApplicationGatewayURLConfiguration{}
 
-			for _, rawConfig := range r["response_header_configuration"].([]interface{}) {
-				c := rawConfig.(map[string]interface{})
-				config := network.ApplicationGatewayHeaderConfiguration{
-					HeaderName:  utils.String(c["header_name"].(string)),
-					HeaderValue: utils.String(c["header_value"].(string)),
-				}
-				responseConfigurations = append(responseConfigurations, config)
+		rule := network.ApplicationGatewayRewriteRule{
+			Name:         utils.String(r["name"].(string)),
+			RuleSequence: utils.Int32(int32(r["rule_sequence"].(int))),
+		}
+
+		for _, rawCondition := range r["condition"].([]interface{}) {
+			c := rawCondition.(map[string]interface{})
+			condition := network.ApplicationGatewayRewriteRuleCondition{
+				Variable:   utils.String(c["variable"].(string)),
+				Pattern:    utils.String(c["pattern"].(string)),
+				IgnoreCase: utils.Bool(c["ignore_case"].(bool)),
+				Negate:     utils.Bool(c["negate"].(bool)),
 			}
+			conditions = append(conditions, condition)
+		}
+		rule.Conditions = &conditions
 
-			for _, rawConfig := range r["url"].([]interface{}) {
-				c := rawConfig.(map[string]interface{})
-				if c["path"] == nil && c["query_string"] == nil {
-					return nil, fmt.Errorf("At least one of `path` or `query_string` must be set")
-				}
-				components := ""
-				if c["components"] != nil {
-					components = c["components"].(string)
-				}
-				if c["path"] != nil && components != "query_string_only" {
-					urlConfiguration.ModifiedPath = utils.String(c["path"].(string))
-				}
-				if c["query_string"] != nil && components != "path_only" {
-					urlConfiguration.ModifiedQueryString = utils.String(c["query_string"].(string))
-				}
-				if c["reroute"] != nil {
-					urlConfiguration.Reroute = utils.Bool(c["reroute"].(bool))
-				}
+		for _, rawConfig := range r["request_header_configuration"].([]interface{}) {
+			c := rawConfig.(map[string]interface{})
+			config := network.ApplicationGatewayHeaderConfiguration{
+				HeaderName:  utils.String(c["header_name"].(string)),
+				HeaderValue: utils.String(c["header_value"].(string)),
 			}
+			requestConfigurations = append(requestConfigurations, config)
+		}
 
-			rule.ActionSet = &network.ApplicationGatewayRewriteRuleActionSet{
-				RequestHeaderConfigurations:  &requestConfigurations,
-				ResponseHeaderConfigurations: &responseConfigurations,
+		for _, rawConfig := range r["response_header_configuration"].([]interface{}) {
+			c := rawConfig.(map[string]interface{})
+			config := network.ApplicationGatewayHeaderConfiguration{
+				HeaderName:  utils.String(c["header_name"].(string)),
+				HeaderValue: utils.String(c["header_value"].(string)),
 			}
+			responseConfigurations = append(responseConfigurations, config)
+		}
 
-			if len(r["url"].([]interface{})) > 0 {
-				rule.ActionSet.URLConfiguration = &urlConfiguration
+		for _, rawConfig := range r["url"].([]interface{}) {
+			c := rawConfig.(map[string]interface{})
+			if c["path"] == nil && c["query_string"] == nil {
+				return nil, fmt.Errorf("At least one of `path` or `query_string` must be set")
 			}
+			components := ""
+			if c["components"] != nil {
+				components = c["components"].(string)
+			}
+			if c["path"] != nil && components != "query_string_only" {
+				urlConfiguration.ModifiedPath = utils.String(c["path"].(string))
+			}
+			if c["query_string"] != nil && components != "path_only" {
+				urlConfiguration.ModifiedQueryString = utils.String(c["query_string"].(string))
+			}
+			if c["reroute"] != nil {
+				urlConfiguration.Reroute = utils.Bool(c["reroute"].(bool))
+			}
+		}
 
-			rules = append(rules, rule)
+		rule.ActionSet = &network.ApplicationGatewayRewriteRuleActionSet{
+			RequestHeaderConfigurations:  &requestConfigurations,
+			ResponseHeaderConfigurations: &responseConfigurations,
 		}
 
-		ruleSet := network.ApplicationGatewayRewriteRuleSet{
-			Name: utils.String(name),
-			ApplicationGatewayRewriteRuleSetPropertiesFormat: &network.ApplicationGatewayRewriteRuleSetPropertiesFormat{
-				RewriteRules: &rules,
-			},
+		if len(r["url"].([]interface{})) > 0 {
+			rule.ActionSet.URLConfiguration = &urlConfiguration
 		}
 
-		ruleSets = append(ruleSets, ruleSet)
+		rules = append(rules, rule)
 	}
 
-	return &ruleSets, nil
+	ruleSet := network.ApplicationGatewayRewriteRuleSet{
+		Name: utils.String(name),
+		ApplicationGatewayRewriteRuleSetPropertiesFormat: &network.ApplicationGatewayRewriteRuleSetPropertiesFormat{
+			RewriteRules: &rules,
+		},
+	}
+
+	return &ruleSet, nil
 }
 
 func flattenApplicationGatewayRewriteRuleSets(input *[]network.ApplicationGatewayRewriteRuleSet) []interface{} {
@@ -3727,142 +3857,154 @@ func flattenApplicationGatewayRewriteRuleSets(input *[]network.ApplicationGatewa
 	}
 
 	for _, config := range *input {
-		if props := config.ApplicationGatewayRewriteRuleSetPropertiesFormat; props != nil {
-			output := map[string]interface{}{}
+		if config.ApplicationGatewayRewriteRuleSetPropertiesFormat == nil {
+			continue
+		}
 
-			if config.ID != nil {
-				output["id"] = *config.ID
-			}
+		results = append(results, flattenApplicationGatewayRewriteRuleSet(config))
+	}
 
-			if config.Name != nil {
-				output["name"] = *config.Name
-			}
+	return results
+}
 
-			if rulesConfig := props.RewriteRules; rulesConfig != nil {
-				rules := make([]interface{}, 0)
-				for _, rule := range *rulesConfig {
-					ruleOutput := map[string]interface{}{}
+func flattenApplicationGatewayRewriteRuleSet(config network.ApplicationGatewayRewriteRuleSet) map[string]interface{} {
+	props := config.ApplicationGatewayRewriteRuleSetPropertiesFormat
+	if props == nil {
+		return map[string]interface{}{}
+	}
 
-					if rule.Name != nil {
-						ruleOutput["name"] = *rule.Name
-					}
+	output := map[string]interface{}{}
 
-					if rule.RuleSequence != nil {
-						ruleOutput["rule_sequence"] = *rule.RuleSequence
-					}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-					conditions := make([]interface{}, 0)
-					if rule.Conditions != nil {
-						for _, config := range *rule.Conditions {
-							condition := map[string]interface{}{}
+	if config.Name != nil {
+		output["name"] = *config.Name
+	}
 
-							if config.Variable != nil {
-								condition["variable"] = *config.Variable
-							}
+	if rulesConfig := props.RewriteRules; rulesConfig != nil {
+		rules := make([]interface{}, 0)
+		for _, rule := range *rulesConfig {
+			ruleOutput := map[string]interface{}{}
 
-							if config.Pattern != nil {
-								condition["pattern"] = *config.Pattern
-							}
+			if rule.Name != nil {
+				ruleOutput["name"] = *rule.Name
+			}
 
-							if config.IgnoreCase != nil {
-								condition["ignore_case"] = *config.IgnoreCase
-							}
+			if rule.RuleSequence != nil {
+				ruleOutput["rule_sequence"] = *rule.RuleSequence
+			}
 
-							if config.Negate != nil {
-								condition["negate"] = *config.Negate
-							}
+			conditions := make([]interface{}, 0)
+			if rule.Conditions != nil {
+				for _, config := range *rule.Conditions {
+					condition := map[string]interface{}{}
 
-							conditions = append(conditions, condition)
-						}
+					if config.Variable != nil {
+						condition["variable"] = *config.Variable
+					}
+
+					if config.Pattern != nil {
+						condition["pattern"] = *config.Pattern
 					}
-					ruleOutput["condition"] = conditions
 
-					requestConfigs := make([]interface{}, 0)
-					responseConfigs := make([]interface{}, 0)
-					urlConfigs := make([]interface{}, 0)
+					if config.IgnoreCase != nil {
+						condition["ignore_case"] = *config.IgnoreCase
+					}
+
+					if config.Negate != nil {
+						condition["negate"] = *config.Negate
+					}
 
-					if rule.ActionSet != nil {
-						actionSet := *rule.ActionSet
+					conditions = append(conditions, condition)
+				}
+			}
+			ruleOutput["condition"] = conditions
 
-						if actionSet.RequestHeaderConfigurations != nil {
-							for _, config := range *actionSet.RequestHeaderConfigurations {
-								requestConfig := map[string]interface{}{}
+			requestConfigs := make([]interface{}, 0)
+			responseConfigs := make([]interface{}, 0)
+			urlConfigs := make([]interface{}, 0)
 
-								if config.HeaderName != nil {
-									requestConfig["header_name"] = *config.HeaderName
-								}
+			if rule.ActionSet != nil {
+				actionSet := *rule.ActionSet
 
-								if config.HeaderValue != nil {
-									requestConfig["header_value"] = *config.HeaderValue
-								}
+				if actionSet.RequestHeaderConfigurations != nil {
+					for _, config := range *actionSet.RequestHeaderConfigurations {
+						requestConfig := map[string]interface{}{}
 
-								requestConfigs = append(requestConfigs, requestConfig)
-							}
+						if config.HeaderName != nil {
+							requestConfig["header_name"] = *config.HeaderName
 						}
 
-						if actionSet.ResponseHeaderConfigurations != nil {
-							for _, config := range *actionSet.ResponseHeaderConfigurations {
-								responseConfig := map[string]interface{}{}
+						if config.HeaderValue != nil {
+							requestConfig["header_value"] = *config.HeaderValue
+						}
 
-								if config.HeaderName != nil {
-									responseConfig["header_name"] = *config.HeaderName
-								}
+						requestConfigs = append(requestConfigs, requestConfig)
+					}
+				}
 
-								if config.HeaderValue != nil {
-									responseConfig["header_value"] = *config.HeaderValue
-								}
+				if actionSet.ResponseHeaderConfigurations != nil {
+					for _, config := range *actionSet.ResponseHeaderConfigurations {
+						responseConfig := map[string]interface{}{}
 
-								responseConfigs = append(responseConfigs, responseConfig)
-							}
+						if config.HeaderName != nil {
+							responseConfig["header_name"] = *config.HeaderName
 						}
 
-						if actionSet.URLConfiguration != nil {
-							config := *actionSet.URLConfiguration
-							components := ""
-							path := ""
-							if config.ModifiedPath != nil {
-								path = *config.ModifiedPath
-							}
+						if config.HeaderValue != nil {
+							responseConfig["header_value"] = *config.HeaderValue
+						}
 
-							queryString := ""
-							if config.ModifiedQueryString != nil {
-								queryString = *config.ModifiedQueryString
-							}
+						responseConfigs = append(responseConfigs, responseConfig)
+					}
+				}
 
-							if path != queryString {
-								if path != "" && queryString == "" {
-									components = "path_only"
-								} else if queryString != "" && path == "" {
-									components = "query_string_only"
-								}
-							}
+				if actionSet.URLConfiguration != nil {
+					config := *actionSet.URLConfiguration
+					components := ""
+					path := ""
+					if config.ModifiedPath != nil {
+						path = *config.ModifiedPath
+					}
 
-							reroute := false
-							if config.Reroute != nil {
-								reroute = *config.Reroute
-							}
+					queryString := ""
+					if config.ModifiedQueryString != nil {
+						queryString = *config.ModifiedQueryString
+					}
 
-							urlConfigs = append(urlConfigs, map[string]interface{}{
-								"components":   components,
-								"query_string": queryString,
-								"path":         path,
-								"reroute":      reroute,
-							})
+					if path != queryString {
+						if path != "" && queryString == "" {
+							components = "path_only"
+						} else if queryString != "" && path == "" {
+							components = "query_string_only"
 						}
 					}
-					ruleOutput["request_header_configuration"] = requestConfigs
-					ruleOutput["response_header_configuration"] = responseConfigs
-					ruleOutput["url"] = urlConfigs
 
-					rules = append(rules, ruleOutput)
+					reroute := false
+					if config.Reroute != nil {
+						reroute = *config.Reroute
+					}
+
+					urlConfigs = append(urlConfigs, map[string]interface{}{
+						"components":   components,
+						"query_string": queryString,
+						"path":         path,
+						"reroute":      reroute,
+					})
 				}
-				output["rewrite_rule"] = rules
 			}
-			results = append(results, output)
+			ruleOutput["request_header_configuration"] = requestConfigs
+			ruleOutput["response_header_configuration"] = responseConfigs
+			ruleOutput["url"] = urlConfigs
+
+			rules = append(rules, ruleOutput)
 		}
+		output["rewrite_rule"] = rules
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayRedirectConfigurations(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRedirectConfiguration, error) {
@@ -0,0 +1,181 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRewriteRuleSetResource struct{}
+
+func TestAccApplicationGatewayRewriteRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayRewriteRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RewriteRuleSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RewriteRuleSets != nil {
+		for _, v := range *props.RewriteRuleSets {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  name                   = "acctest-rwset-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+
+  rewrite_rule {
+    name          = "rewrite-1"
+    rule_sequence = 1
+
+    request_header_configuration {
+      header_name  = "X-custom"
+      header_value = "customvalue"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.ignoreChanges(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  name                   = "acctest-rwset-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+
+  rewrite_rule {
+    name          = "rewrite-1"
+    rule_sequence = 1
+
+    condition {
+      variable = "var_http_status"
+      pattern  = "502"
+    }
+
+    request_header_configuration {
+      header_name  = "X-custom"
+      header_value = "customvalue"
+    }
+  }
+
+  rewrite_rule {
+    name          = "rewrite-2"
+    rule_sequence = 2
+
+    response_header_configuration {
+      header_name  = "X-response"
+      header_value = "responsevalue"
+    }
+
+    url {
+      path         = "/rewritten"
+      query_string = "a=b"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.ignoreChanges(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "import" {
+  name                   = azurerm_application_gateway_rewrite_rule_set.test.name
+  application_gateway_id = azurerm_application_gateway_rewrite_rule_set.test.application_gateway_id
+
+  rewrite_rule {
+    name          = "rewrite-1"
+    rule_sequence = 1
+
+    request_header_configuration {
+      header_name  = "X-custom"
+      header_value = "customvalue"
+    }
+  }
+}
+`, r.basic(data))
+}
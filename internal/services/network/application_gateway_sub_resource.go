@@ -0,0 +1,107 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+// applicationGatewaySubResourceSchema returns the schema for a resource which manages a single item within one of
+// the collections of an Application Gateway, based on the schema of the matching block in `azurerm_application_gateway`
+func applicationGatewaySubResourceSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationGatewayID,
+		},
+	}
+
+	for k, v := range input {
+		// `id` is reserved at the top-level of a resource, and is the ID of the item itself
+		if k == "id" {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	output["name"].ForceNew = true
+	output["name"].ValidateFunc = validation.StringIsNotEmpty
+
+	return output
+}
+
+// expandApplicationGatewaySubResource returns the configuration of the item in the same shape as a block within
+// `azurerm_application_gateway`, so that the expand functions of the Application Gateway can be reused
+func expandApplicationGatewaySubResource(d *pluginsdk.ResourceData, input map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range input {
+		if k == "application_gateway_id" {
+			continue
+		}
+
+		output[k] = d.Get(k)
+	}
+
+	return output
+}
+
+func setApplicationGatewaySubResource(d *pluginsdk.ResourceData, input map[string]*pluginsdk.Schema, flattened map[string]interface{}) error {
+	for k := range input {
+		if k == "application_gateway_id" {
+			continue
+		}
+
+		if err := d.Set(k, flattened[k]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGatewayIfMatch updates the Application Gateway, sending the ETag which was returned when it was
+// retrieved as an If-Match header - meaning the update is rejected when the Application Gateway has been modified
+// in the meantime (for example by another resource managing one of the Application Gateway's collections)
+func updateApplicationGatewayIfMatch(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, applicationGateway network.ApplicationGateway) error {
+	etag := ""
+	if applicationGateway.Etag != nil {
+		etag = *applicationGateway.Etag
+	}
+
+	req, err := client.CreateOrUpdatePreparer(ctx, id.ResourceGroup, id.Name, applicationGateway)
+	if err != nil {
+		return fmt.Errorf("preparing request to update %s: %+v", id, err)
+	}
+
+	if etag != "" {
+		req, err = autorest.Prepare(req, autorest.WithHeader("If-Match", etag))
+		if err != nil {
+			return fmt.Errorf("preparing request to update %s: %+v", id, err)
+		}
+	}
+
+	future, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusPreconditionFailed {
+			return fmt.Errorf("updating %s: the Application Gateway was modified since it was retrieved (the ETag no longer matches %s) - please retry the operation", id, etag)
+		}
+
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
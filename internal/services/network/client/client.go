@@ -73,6 +73,8 @@ type Client struct {
 	PrivateLinkServiceClient                 *network.PrivateLinkServicesClient
 	ServiceAssociationLinkClient             *network.ServiceAssociationLinksClient
 	ResourceNavigationLinkClient             *network.ResourceNavigationLinksClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -345,5 +347,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		PrivateLinkServiceClient:                 &PrivateLinkServiceClient,
 		ServiceAssociationLinkClient:             &ServiceAssociationLinkClient,
 		ResourceNavigationLinkClient:             &ResourceNavigationLinkClient,
+
+		options: o,
 	}
 }
+
+func (c Client) VnetPeeringsClientForSubscription(subscriptionID string) *network.VirtualNetworkPeeringsClient {
+	vnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&vnetPeeringsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &vnetPeeringsClient
+}
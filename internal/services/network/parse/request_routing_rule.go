@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RequestRoutingRuleId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	Name                   string
+}
+
+func NewRequestRoutingRuleID(subscriptionId, resourceGroup, applicationGatewayName, name string) RequestRoutingRuleId {
+	return RequestRoutingRuleId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		Name:                   name,
+	}
+}
+
+func (id RequestRoutingRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Application Gateway Name %q", id.ApplicationGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Request Routing Rule", segmentsStr)
+}
+
+func (id RequestRoutingRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/requestRoutingRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.Name)
+}
+
+// RequestRoutingRuleID parses a RequestRoutingRule ID into an RequestRoutingRuleId struct
+func RequestRoutingRuleID(input string) (*RequestRoutingRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RequestRoutingRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("requestRoutingRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// RequestRoutingRuleIDInsensitively parses an RequestRoutingRule ID into an RequestRoutingRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the RequestRoutingRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func RequestRoutingRuleIDInsensitively(input string) (*RequestRoutingRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RequestRoutingRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'applicationGateways' segment
+	applicationGatewaysKey := "applicationGateways"
+	for key := range id.Path {
+		if strings.EqualFold(key, applicationGatewaysKey) {
+			applicationGatewaysKey = key
+			break
+		}
+	}
+	if resourceId.ApplicationGatewayName, err = id.PopSegment(applicationGatewaysKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'requestRoutingRules' segment
+	requestRoutingRulesKey := "requestRoutingRules"
+	for key := range id.Path {
+		if strings.EqualFold(key, requestRoutingRulesKey) {
+			requestRoutingRulesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(requestRoutingRulesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
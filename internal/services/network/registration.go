@@ -75,6 +75,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
 		"azurerm_virtual_hub_route_table":                   dataSourceVirtualHubRouteTable(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_bgp_routes":        dataSourceVirtualNetworkGatewayBgpRoutes(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
 		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
@@ -88,6 +89,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_application_gateway":                      resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool": resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_http_listener":        resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_request_routing_rule": resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_rewrite_rule_set":     resourceApplicationGatewayRewriteRuleSet(),
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
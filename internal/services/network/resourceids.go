@@ -30,6 +30,7 @@ package network
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackendAddressPool -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/beap1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=HttpListener -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/httpListeners/listener1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AuthenticationCertificate -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/authenticationCertificates/authcert1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RequestRoutingRule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/requestRoutingRules/requestRoutingRule1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RewriteRuleSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/rewriteRuleSets/rewriteRuleSet1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Probe -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/probes/probe1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SslCertificate -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/sslCertificates/sslcert1 -rewrite=true
@@ -5,6 +5,15 @@ import (
 )
 
 var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice([]string{
+	"APPLICATION-ATTACK-LFI",
+	"APPLICATION-ATTACK-NodeJS",
+	"APPLICATION-ATTACK-PHP",
+	"APPLICATION-ATTACK-RCE",
+	"APPLICATION-ATTACK-RFI",
+	"APPLICATION-ATTACK-SESSION-FIXATION",
+	"APPLICATION-ATTACK-SESSION-JAVA",
+	"APPLICATION-ATTACK-SQLI",
+	"APPLICATION-ATTACK-XSS",
 	"BadBots",
 	"crs_20_protocol_violations",
 	"crs_21_protocol_anomalies",
@@ -19,6 +28,13 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"General",
 	"GoodBots",
 	"Known-CVEs",
+	"METHOD-ENFORCEMENT",
+	"MS-ThreatIntel-AppSec",
+	"MS-ThreatIntel-CVEs",
+	"MS-ThreatIntel-SQLI",
+	"MS-ThreatIntel-WebShells",
+	"PROTOCOL-ATTACK",
+	"PROTOCOL-ENFORCEMENT",
 	"REQUEST-911-METHOD-ENFORCEMENT",
 	"REQUEST-913-SCANNER-DETECTION",
 	"REQUEST-920-PROTOCOL-ENFORCEMENT",
@@ -37,6 +53,7 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 var ValidateWebApplicationFirewallPolicyRuleSetVersion = validation.StringInSlice([]string{
 	"0.1",
 	"1.0",
+	"2.1",
 	"2.2.9",
 	"3.0",
 	"3.1",
@@ -46,12 +63,22 @@ var ValidateWebApplicationFirewallPolicyRuleSetVersion = validation.StringInSlic
 var ValidateWebApplicationFirewallPolicyRuleSetType = validation.StringInSlice([]string{
 	"OWASP",
 	"Microsoft_BotManagerRuleSet",
+	"Microsoft_DefaultRuleSet",
 }, false)
 
 var ValidateWebApplicationFirewallPolicyExclusionRuleSetVersion = validation.StringInSlice([]string{
+	"2.1",
 	"3.2",
 }, false)
 
 var ValidateWebApplicationFirewallPolicyExclusionRuleSetType = validation.StringInSlice([]string{
+	"Microsoft_DefaultRuleSet",
 	"OWASP",
 }, false)
+
+// WebApplicationFirewallPolicyExclusionRuleSetVersions are the versions of each Managed Rule Set which support
+// exclusions scoped to specific rule groups/rules
+var WebApplicationFirewallPolicyExclusionRuleSetVersions = map[string]string{
+	"Microsoft_DefaultRuleSet": "2.1",
+	"OWASP":                    "3.2",
+}
@@ -148,6 +148,21 @@ func resourceVirtualHubConnectionSchema() map[string]*pluginsdk.Schema {
 						},
 						AtLeastOneOf: []string{"routing.0.associated_route_table_id", "routing.0.propagated_route_table", "routing.0.static_vnet_route"},
 					},
+
+					"static_vnet_local_route_override_criteria": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(network.VnetLocalRouteOverrideCriteriaContains),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.VnetLocalRouteOverrideCriteriaContains),
+							string(network.VnetLocalRouteOverrideCriteriaEqual),
+						}, false),
+					},
+
+					"static_vnet_propagate_static_routes_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -312,6 +327,17 @@ func expandVirtualHubConnectionRouting(input []interface{}) *network.RoutingConf
 		result.VnetRoutes = expandVirtualHubConnectionVnetStaticRoute(vnetStaticRoute)
 	}
 
+	if overrideCriteria := v["static_vnet_local_route_override_criteria"].(string); overrideCriteria != "" {
+		if result.VnetRoutes == nil {
+			result.VnetRoutes = &network.VnetRoute{
+				StaticRoutes: &[]network.StaticRoute{},
+			}
+		}
+		result.VnetRoutes.StaticRoutesConfig = &network.StaticRoutesConfig{
+			VnetLocalRouteOverrideCriteria: network.VnetLocalRouteOverrideCriteria(overrideCriteria),
+		}
+	}
+
 	if propagatedRouteTable := v["propagated_route_table"].([]interface{}); len(propagatedRouteTable) != 0 {
 		result.PropagatedRouteTables = expandVirtualHubConnectionPropagatedRouteTable(propagatedRouteTable)
 	}
@@ -397,11 +423,24 @@ func flattenVirtualHubConnectionRouting(input *network.RoutingConfiguration) []i
 		associatedRouteTableId = *input.AssociatedRouteTable.ID
 	}
 
+	overrideCriteria := string(network.VnetLocalRouteOverrideCriteriaContains)
+	propagateStaticRoutes := false
+	if input.VnetRoutes != nil && input.VnetRoutes.StaticRoutesConfig != nil {
+		if v := input.VnetRoutes.StaticRoutesConfig.VnetLocalRouteOverrideCriteria; v != "" {
+			overrideCriteria = string(v)
+		}
+		if v := input.VnetRoutes.StaticRoutesConfig.PropagateStaticRoutes; v != nil {
+			propagateStaticRoutes = *v
+		}
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"associated_route_table_id": associatedRouteTableId,
-			"propagated_route_table":    flattenVirtualHubConnectionPropagatedRouteTable(input.PropagatedRouteTables),
-			"static_vnet_route":         flattenVirtualHubConnectionVnetStaticRoute(input.VnetRoutes),
+			"associated_route_table_id":                   associatedRouteTableId,
+			"propagated_route_table":                      flattenVirtualHubConnectionPropagatedRouteTable(input.PropagatedRouteTables),
+			"static_vnet_route":                           flattenVirtualHubConnectionVnetStaticRoute(input.VnetRoutes),
+			"static_vnet_local_route_override_criteria":   overrideCriteria,
+			"static_vnet_propagate_static_routes_enabled": propagateStaticRoutes,
 		},
 	}
 }
@@ -254,6 +254,31 @@ func TestAccVirtualHubConnection_updateRoutingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHubConnection_staticVnetLocalRouteOverrideCriteria(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_connection", "test")
+	r := VirtualHubConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withRoutingConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing.0.static_vnet_local_route_override_criteria").HasValue("Contains"),
+				check.That(data.ResourceName).Key("routing.0.static_vnet_propagate_static_routes_enabled").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.staticVnetLocalRouteOverrideCriteria(data, "Equal"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing.0.static_vnet_local_route_override_criteria").HasValue("Equal"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualHubConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.HubVirtualNetworkConnectionID(state.ID)
 	if err != nil {
@@ -550,6 +575,32 @@ resource "azurerm_virtual_hub_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r VirtualHubConnectionResource) staticVnetLocalRouteOverrideCriteria(data acceptance.TestData, overrideCriteria string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctest-vhubconn-%[2]d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+
+  routing {
+    propagated_route_table {
+      labels = ["label1", "label2"]
+    }
+
+    static_vnet_route {
+      name                = "testvnetroute"
+      address_prefixes    = ["10.0.3.0/24", "10.0.4.0/24"]
+      next_hop_ip_address = "10.0.3.5"
+    }
+
+    static_vnet_local_route_override_criteria = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomInteger, overrideCriteria)
+}
+
 func (r VirtualHubConnectionResource) updateRoutingConfiguration(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
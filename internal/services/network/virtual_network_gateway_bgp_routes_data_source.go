@@ -0,0 +1,216 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func dataSourceVirtualNetworkGatewayBgpRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkGatewayBgpRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"peer_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+
+			"learned_routes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: virtualNetworkGatewayBgpRouteDataSourceSchema(),
+				},
+			},
+
+			"advertised_routes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: func() map[string]*pluginsdk.Schema {
+						s := virtualNetworkGatewayBgpRouteDataSourceSchema()
+						s["peer_ip_address"] = &pluginsdk.Schema{
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						}
+						return s
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func virtualNetworkGatewayBgpRouteDataSourceSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_hop": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"local_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source_peer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"origin": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"as_path": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"weight": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceVirtualNetworkGatewayBgpRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	learnedFuture, err := client.GetLearnedRoutes(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the learned routes for %s: %+v", *id, err)
+	}
+	if err := learnedFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the learned routes for %s: %+v", *id, err)
+	}
+	learned, err := learnedFuture.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the learned routes for %s: %+v", *id, err)
+	}
+
+	// when no peers are specified the advertised routes are retrieved for each of the gateway's connected BGP peers
+	peers := utils.ExpandStringSlice(d.Get("peer_ip_addresses").([]interface{}))
+	if len(*peers) == 0 {
+		peerFuture, err := client.GetBgpPeerStatus(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving the BGP peer status for %s: %+v", *id, err)
+		}
+		if err := peerFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the BGP peer status for %s: %+v", *id, err)
+		}
+		peerStatus, err := peerFuture.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving the BGP peer status for %s: %+v", *id, err)
+		}
+
+		connectedPeers := make([]string, 0)
+		if peerStatus.Value != nil {
+			for _, peer := range *peerStatus.Value {
+				if peer.State != network.BgpPeerStateConnected || peer.Neighbor == nil {
+					continue
+				}
+				connectedPeers = append(connectedPeers, *peer.Neighbor)
+			}
+		}
+		peers = &connectedPeers
+	}
+
+	advertisedRoutes := make([]interface{}, 0)
+	for _, peer := range *peers {
+		advertisedFuture, err := client.GetAdvertisedRoutes(ctx, id.ResourceGroup, id.Name, peer)
+		if err != nil {
+			return fmt.Errorf("retrieving the routes advertised to peer %q for %s: %+v", peer, *id, err)
+		}
+		if err := advertisedFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the routes advertised to peer %q for %s: %+v", peer, *id, err)
+		}
+		advertised, err := advertisedFuture.Result(*client)
+		if err != nil {
+			return fmt.Errorf("retrieving the routes advertised to peer %q for %s: %+v", peer, *id, err)
+		}
+
+		for _, route := range flattenVirtualNetworkGatewayBgpRoutes(advertised.Value) {
+			route.(map[string]interface{})["peer_ip_address"] = peer
+			advertisedRoutes = append(advertisedRoutes, route)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("virtual_network_gateway_id", id.ID())
+	d.Set("peer_ip_addresses", utils.FlattenStringSlice(peers))
+
+	if err := d.Set("learned_routes", flattenVirtualNetworkGatewayBgpRoutes(learned.Value)); err != nil {
+		return fmt.Errorf("setting `learned_routes`: %+v", err)
+	}
+
+	if err := d.Set("advertised_routes", advertisedRoutes); err != nil {
+		return fmt.Errorf("setting `advertised_routes`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualNetworkGatewayBgpRoutes(input *[]network.GatewayRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		weight := 0
+		if route.Weight != nil {
+			weight = int(*route.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"network":       utils.NormalizeNilableString(route.NetworkProperty),
+			"next_hop":      utils.NormalizeNilableString(route.NextHop),
+			"local_address": utils.NormalizeNilableString(route.LocalAddress),
+			"source_peer":   utils.NormalizeNilableString(route.SourcePeer),
+			"origin":        utils.NormalizeNilableString(route.Origin),
+			"as_path":       utils.NormalizeNilableString(route.AsPath),
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
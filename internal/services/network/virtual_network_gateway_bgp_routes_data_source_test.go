@@ -0,0 +1,114 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkGatewayBgpRoutesDataSource struct{}
+
+func TestAccVirtualNetworkGatewayBgpRoutesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_routes", "test")
+	r := VirtualNetworkGatewayBgpRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("learned_routes.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_routes.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccVirtualNetworkGatewayBgpRoutesDataSource_peerIPAddresses(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_gateway_bgp_routes", "test")
+	r := VirtualNetworkGatewayBgpRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.peerIPAddresses(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("peer_ip_addresses.#").HasValue("1"),
+				check.That(data.ResourceName).Key("peer_ip_addresses.0").HasValue("10.1.0.1"),
+				check.That(data.ResourceName).Key("learned_routes.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayBgpRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_routes" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+}
+`, VirtualNetworkGatewayBgpRoutesDataSource{}.template(data))
+}
+
+func (VirtualNetworkGatewayBgpRoutesDataSource) peerIPAddresses(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_bgp_routes" "test" {
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  peer_ip_addresses          = ["10.1.0.1"]
+}
+`, VirtualNetworkGatewayBgpRoutesDataSource{}.template(data))
+}
+
+func (VirtualNetworkGatewayBgpRoutesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  type       = "Vpn"
+  vpn_type   = "RouteBased"
+  sku        = "VpnGw1"
+  enable_bgp = true
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -2,8 +2,10 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -41,6 +43,13 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 		},
 
 		Schema: resourceVirtualNetworkGatewaySchema(),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("sku", func(ctx context.Context, old, new, meta interface{}) bool {
+				return virtualNetworkGatewaySkuChangeRequiresNewResource(old.(string), new.(string))
+			}),
+			validateVirtualNetworkGatewayBgpPeeringAddresses,
+		),
 	}
 }
 
@@ -1027,6 +1036,96 @@ func validateVirtualNetworkGatewayExpressRouteSku() pluginsdk.SchemaValidateFunc
 	}, false)
 }
 
+// virtualNetworkGatewaySkuChangeRequiresNewResource determines whether the SKU of a Virtual Network Gateway can be
+// changed in-place - the SKU can be resized within the same family, and a Gateway can be migrated from a non
+// zone-redundant VpnGw SKU to a zone-redundant (AZ) SKU, but other changes require the Gateway to be recreated.
+func virtualNetworkGatewaySkuChangeRequiresNewResource(old, new string) bool {
+	if old == "" || strings.EqualFold(old, new) {
+		return false
+	}
+
+	basic := string(network.VirtualNetworkGatewaySkuNameBasic)
+	if strings.EqualFold(old, basic) || strings.EqualFold(new, basic) {
+		return true
+	}
+
+	// the legacy Standard and HighPerformance SKUs can't be migrated to the VpnGw SKUs in-place
+	isVpnGw := func(sku string) bool {
+		return strings.HasPrefix(strings.ToLower(sku), "vpngw")
+	}
+	isLegacy := func(sku string) bool {
+		return strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuNameStandard)) || strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuNameHighPerformance))
+	}
+	if (isLegacy(old) && isVpnGw(new)) || (isVpnGw(old) && isLegacy(new)) {
+		return true
+	}
+
+	// a zone-redundant Gateway can't be migrated back to a non zone-redundant SKU
+	isZoneRedundant := func(sku string) bool {
+		return strings.HasSuffix(strings.ToLower(sku), "az")
+	}
+	if isVpnGw(old) && isVpnGw(new) && isZoneRedundant(old) && !isZoneRedundant(new) {
+		return true
+	}
+
+	return false
+}
+
+// validateVirtualNetworkGatewayBgpPeeringAddresses validates the BGP Peering Addresses for each Gateway instance at plan
+// time, since the API only surfaces these errors once the (lengthy) Create/Update has been attempted.
+func validateVirtualNetworkGatewayBgpPeeringAddresses(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	peeringAddresses := d.Get("bgp_settings.0.peering_addresses").([]interface{})
+	if len(peeringAddresses) == 0 {
+		return nil
+	}
+
+	if len(peeringAddresses) > 1 && !d.Get("active_active").(bool) {
+		return fmt.Errorf("only one `bgp_settings.0.peering_addresses` block can be specified when `active_active` is disabled")
+	}
+
+	ipConfigurationNames := make(map[string]struct{})
+	for _, raw := range d.Get("ip_configuration").([]interface{}) {
+		if v, ok := raw.(map[string]interface{}); ok {
+			ipConfigurationNames[v["name"].(string)] = struct{}{}
+		}
+	}
+
+	instances := make(map[string]struct{})
+	apipaAddresses := make(map[string]struct{})
+	for i, raw := range peeringAddresses {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// the `ip_configuration_name` is optional when there's a single `ip_configuration` block and can't be
+		// validated when it isn't known yet
+		ipConfigurationName := v["ip_configuration_name"].(string)
+		if ipConfigurationName != "" {
+			if _, ok := ipConfigurationNames[ipConfigurationName]; !ok {
+				return fmt.Errorf("`bgp_settings.0.peering_addresses.%d.ip_configuration_name` must reference the name of an `ip_configuration` block, got %q", i, ipConfigurationName)
+			}
+			if _, ok := instances[ipConfigurationName]; ok {
+				return fmt.Errorf("only one `bgp_settings.0.peering_addresses` block can be specified for each Gateway instance but %q is referenced more than once", ipConfigurationName)
+			}
+			instances[ipConfigurationName] = struct{}{}
+		}
+
+		for _, address := range v["apipa_addresses"].([]interface{}) {
+			addr, ok := address.(string)
+			if !ok || addr == "" {
+				continue
+			}
+			if _, ok := apipaAddresses[addr]; ok {
+				return fmt.Errorf("the APIPA address %q is specified more than once within `bgp_settings.0.peering_addresses` - each Gateway instance must use distinct APIPA addresses", addr)
+			}
+			apipaAddresses[addr] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func flattenVirtualNetworkGatewayAddressSpace(input *network.AddressSpace) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -48,6 +49,8 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"subscription_id": sdk.SubscriptionIdOverrideSchema(),
+
 			"virtual_network_name": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
@@ -88,8 +91,8 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 }
 
 func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	subscriptionId := sdk.SubscriptionIdOverride(d, meta.(*clients.Client).Account.SubscriptionId)
+	client := meta.(*clients.Client).Network.VnetPeeringsClientForSubscription(subscriptionId)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +121,7 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta i
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	if err := pluginsdk.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(d, id.ResourceGroup, id.VirtualNetworkName, id.Name, peer, meta)); err != nil {
+	if err := pluginsdk.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(d, client, id.ResourceGroup, id.VirtualNetworkName, id.Name, peer, meta)); err != nil {
 		return err
 	}
 
@@ -128,7 +131,6 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *pluginsdk.ResourceData, meta i
 }
 
 func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,6 +139,8 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	client := meta.(*clients.Client).Network.VnetPeeringsClientForSubscription(id.SubscriptionId)
+
 	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -148,6 +152,7 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 
 	// update appropriate values
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("subscription_id", id.SubscriptionId)
 	d.Set("name", id.Name)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
 
@@ -165,7 +170,6 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 }
 
 func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,6 +178,8 @@ func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
+	client := meta.(*clients.Client).Network.VnetPeeringsClientForSubscription(id.SubscriptionId)
+
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
@@ -207,9 +213,8 @@ func getVirtualNetworkPeeringProperties(d *pluginsdk.ResourceData) *network.Virt
 	}
 }
 
-func retryVnetPeeringsClientCreateUpdate(d *pluginsdk.ResourceData, resGroup string, vnetName string, name string, peer network.VirtualNetworkPeering, meta interface{}) func() *pluginsdk.RetryError {
+func retryVnetPeeringsClientCreateUpdate(d *pluginsdk.ResourceData, vnetPeeringsClient *network.VirtualNetworkPeeringsClient, resGroup string, vnetName string, name string, peer network.VirtualNetworkPeering, meta interface{}) func() *pluginsdk.RetryError {
 	return func() *pluginsdk.RetryError {
-		vnetPeeringsClient := meta.(*clients.Client).Network.VnetPeeringsClient
 		ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -220,6 +225,8 @@ func retryVnetPeeringsClientCreateUpdate(d *pluginsdk.ResourceData, resGroup str
 			} else if future.Response().StatusCode == 400 && strings.Contains(err.Error(), "ReferencedResourceNotProvisioned") {
 				// Resource is not yet ready, this may be the case if the Vnet was just created or another peering was just initiated.
 				return pluginsdk.RetryableError(err)
+			} else if strings.Contains(err.Error(), "LinkedAuthorizationFailed") {
+				return pluginsdk.NonRetryableError(virtualNetworkPeeringLinkedAuthorizationError(err, meta.(*clients.Client).Account.AuxiliaryTenantIds))
 			}
 
 			return pluginsdk.NonRetryableError(err)
@@ -232,3 +239,14 @@ func retryVnetPeeringsClientCreateUpdate(d *pluginsdk.ResourceData, resGroup str
 		return nil
 	}
 }
+
+// virtualNetworkPeeringLinkedAuthorizationError returns a more descriptive error when the peering can't be created since
+// the provider isn't authorized against the remote Virtual Network, which is the case when it's in another tenant that
+// a token hasn't been obtained for
+func virtualNetworkPeeringLinkedAuthorizationError(err error, auxiliaryTenantIds []string) error {
+	if len(auxiliaryTenantIds) == 0 {
+		return fmt.Errorf("%+v\n\nwhen the remote Virtual Network is in a different tenant, the ID of that tenant must be specified using the `auxiliary_tenant_ids` property in the Provider block, so that the Provider is authorized against the remote Virtual Network", err)
+	}
+
+	return fmt.Errorf("%+v\n\nwhen the remote Virtual Network is in a different tenant, the ID of that tenant must be one of the `auxiliary_tenant_ids` specified in the Provider block (currently %q) and the Provider must be authorized against the remote Virtual Network in that tenant", err, strings.Join(auxiliaryTenantIds, ", "))
+}
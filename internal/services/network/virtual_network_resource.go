@@ -45,9 +45,27 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 		},
 
 		Schema: resourceVirtualNetworkSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.Get("subnet_management").(string) != virtualNetworkSubnetManagementExternal {
+				return nil
+			}
+
+			// `subnet` is Computed, so the raw config has to be checked to determine whether it's been specified
+			if subnets := d.GetRawConfig().AsValueMap()["subnet"]; !subnets.IsNull() && subnets.IsKnown() && subnets.LengthInt() > 0 {
+				return fmt.Errorf("`subnet` cannot be specified when `subnet_management` is set to `%s` - Subnets should be managed using the `azurerm_subnet` resource instead", virtualNetworkSubnetManagementExternal)
+			}
+
+			return nil
+		}),
 	}
 }
 
+const (
+	virtualNetworkSubnetManagementExternal = "external"
+	virtualNetworkSubnetManagementInline   = "inline"
+)
+
 func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -121,6 +139,16 @@ func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"subnet_management": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  virtualNetworkSubnetManagementInline,
+			ValidateFunc: validation.StringInSlice([]string{
+				virtualNetworkSubnetManagementExternal,
+				virtualNetworkSubnetManagementInline,
+			}, false),
+		},
+
 		"subnet": {
 			Type:       pluginsdk.TypeSet,
 			Optional:   true,
@@ -181,11 +209,29 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
+	subnetManagementExternal := d.Get("subnet_management").(string) == virtualNetworkSubnetManagementExternal
+	if subnetManagementExternal {
+		// the Subnets are managed using the `azurerm_subnet` resource, which locks on the Virtual Network's name
+		locks.ByName(id.Name, VirtualNetworkResourceName)
+		defer locks.UnlockByName(id.Name, VirtualNetworkResourceName)
+	}
+
 	vnetProperties, err := expandVirtualNetworkProperties(ctx, d, meta)
 	if err != nil {
 		return err
 	}
 
+	if subnetManagementExternal && !d.IsNewResource() {
+		// the existing Subnets have to be sent to the API otherwise they'd be removed
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if props := existing.VirtualNetworkPropertiesFormat; props != nil && props.Subnets != nil {
+			vnetProperties.Subnets = props.Subnets
+		}
+	}
+
 	vnet := network.VirtualNetwork{
 		Name:                           utils.String(id.Name),
 		ExtendedLocation:               expandEdgeZone(d.Get("edge_zone").(string)),
@@ -200,7 +246,7 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	networkSecurityGroupNames := make([]string, 0)
 	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
-		if subnet.NetworkSecurityGroup != nil {
+		if subnet.NetworkSecurityGroup != nil && subnet.NetworkSecurityGroup.ID != nil {
 			parsedNsgID, err := parse.NetworkSecurityGroupID(*subnet.NetworkSecurityGroup.ID)
 			if err != nil {
 				return err
@@ -266,6 +312,13 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("edge_zone", flattenEdgeZone(resp.ExtendedLocation))
 
+	// `subnet_management` isn't returned by the API, so default this when importing
+	subnetManagement := d.Get("subnet_management").(string)
+	if subnetManagement == "" {
+		subnetManagement = virtualNetworkSubnetManagementInline
+	}
+	d.Set("subnet_management", subnetManagement)
+
 	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
 		d.Set("guid", props.ResourceGUID)
 		d.Set("flow_timeout_in_minutes", props.FlowTimeoutInMinutes)
@@ -278,7 +331,12 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `ddos_protection_plan`: %+v", err)
 		}
 
-		if err := d.Set("subnet", flattenVirtualNetworkSubnets(props.Subnets)); err != nil {
+		// when the Subnets are managed externally they're ignored entirely, to avoid a diff with `azurerm_subnet`
+		subnets := pluginsdk.NewSet(resourceAzureSubnetHash, []interface{}{})
+		if subnetManagement != virtualNetworkSubnetManagementExternal {
+			subnets = flattenVirtualNetworkSubnets(props.Subnets)
+		}
+		if err := d.Set("subnet", subnets); err != nil {
 			return fmt.Errorf("setting `subnets`: %+v", err)
 		}
 
@@ -332,7 +390,7 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 
 func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (*network.VirtualNetworkPropertiesFormat, error) {
 	subnets := make([]network.Subnet, 0)
-	if subs := d.Get("subnet").(*pluginsdk.Set); subs.Len() > 0 {
+	if subs := d.Get("subnet").(*pluginsdk.Set); subs.Len() > 0 && d.Get("subnet_management").(string) != virtualNetworkSubnetManagementExternal {
 		for _, subnet := range subs.List() {
 			subnet := subnet.(map[string]interface{})
 
@@ -110,6 +110,7 @@ func resourceVPNGatewayConnection() *pluginsdk.Resource {
 									"labels": {
 										Type:     pluginsdk.TypeSet,
 										Optional: true,
+										Computed: true,
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
 											ValidateFunc: validation.StringIsNotEmpty,
@@ -378,6 +378,24 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 		},
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			for _, exclusionRaw := range diff.Get("managed_rules.0.exclusion").([]interface{}) {
+				exclusion, ok := exclusionRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for _, ruleSetRaw := range exclusion["excluded_rule_set"].([]interface{}) {
+					ruleSet, ok := ruleSetRaw.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					ruleSetType := ruleSet["type"].(string)
+					ruleSetVersion := ruleSet["version"].(string)
+					if expected, ok := validate.WebApplicationFirewallPolicyExclusionRuleSetVersions[ruleSetType]; ok && ruleSetVersion != "" && ruleSetVersion != expected {
+						return fmt.Errorf("`version` must be %q when the `type` of an `excluded_rule_set` is %q, got %q", expected, ruleSetType, ruleSetVersion)
+					}
+				}
+			}
+
 			if !features.FourPointOhBeta() {
 				// Since ConflictsWith cannot be used on these properties and the properties are optional and computed, diff.GetOK may still return value even the property is not configured. Have to check the configuration with GetRawConfig
 				managedRuleSetList := diff.GetRawConfig().AsValueMap()["managed_rules"].AsValueSlice()[0].AsValueMap()["managed_rule_set"].AsValueSlice()
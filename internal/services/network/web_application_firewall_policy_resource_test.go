@@ -246,6 +246,21 @@ func TestAccWebApplicationFirewallPolicy_excludedRules(t *testing.T) {
 	})
 }
 
+func TestAccWebApplicationFirewallPolicy_excludedRulesDefaultRuleSet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
+	r := WebApplicationFirewallResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.excludedRulesDefaultRuleSet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccWebApplicationFirewallPolicy_updateDisabledRules(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
 	r := WebApplicationFirewallResource{}
@@ -800,6 +815,66 @@ resource "azurerm_web_application_firewall_policy" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (WebApplicationFirewallResource) excludedRulesDefaultRuleSet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    exclusion {
+      match_variable          = "RequestArgNames"
+      selector                = "password"
+      selector_match_operator = "Equals"
+
+      excluded_rule_set {
+        type    = "Microsoft_DefaultRuleSet"
+        version = "2.1"
+
+        rule_group {
+          rule_group_name = "APPLICATION-ATTACK-SQLI"
+          excluded_rules = [
+            "942100",
+            "942110",
+          ]
+        }
+      }
+    }
+
+    managed_rule_set {
+      type    = "Microsoft_DefaultRuleSet"
+      version = "2.1"
+
+      rule_group_override {
+        rule_group_name = "PROTOCOL-ENFORCEMENT"
+
+        rule {
+          id      = "920300"
+          enabled = true
+          action  = "Log"
+        }
+      }
+    }
+  }
+
+  policy_settings {
+    enabled = true
+    mode    = "Prevention"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (WebApplicationFirewallResource) disabledRules(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
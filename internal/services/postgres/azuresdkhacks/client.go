@@ -0,0 +1,41 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// TODO 4.0: check if this can be removed once the vendored SDK includes a PostgreSQL Flexible Server API Version with Virtual Endpoints
+// Virtual Endpoints and the `promoteMode`/`promoteOption` properties of a Replica were introduced in API Version
+// `2023-06-01-preview`, however the vendored SDK only includes `2022-12-01` so the clients for these are defined here.
+
+const defaultApiVersion = "2023-06-01-preview"
+
+func userAgent(resourceName string) string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/%s/%s", resourceName, defaultApiVersion)
+}
+
+type VirtualEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualEndpointsClientWithBaseURI(endpoint string) VirtualEndpointsClient {
+	return VirtualEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent("virtualendpoints")),
+		baseUri: endpoint,
+	}
+}
+
+type ServersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewServersClientWithBaseURI(endpoint string) ServersClient {
+	return ServersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent("servers")),
+		baseUri: endpoint,
+	}
+}
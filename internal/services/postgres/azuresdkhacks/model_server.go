@@ -0,0 +1,50 @@
+package azuresdkhacks
+
+type ReadReplicaPromoteMode string
+
+const (
+	ReadReplicaPromoteModeStandalone ReadReplicaPromoteMode = "standalone"
+	ReadReplicaPromoteModeSwitchover ReadReplicaPromoteMode = "switchover"
+)
+
+func PossibleValuesForReadReplicaPromoteMode() []string {
+	return []string{
+		string(ReadReplicaPromoteModeStandalone),
+		string(ReadReplicaPromoteModeSwitchover),
+	}
+}
+
+type ReplicationPromoteOption string
+
+const (
+	ReplicationPromoteOptionForced  ReplicationPromoteOption = "forced"
+	ReplicationPromoteOptionPlanned ReplicationPromoteOption = "planned"
+)
+
+func PossibleValuesForReplicationPromoteOption() []string {
+	return []string{
+		string(ReplicationPromoteOptionForced),
+		string(ReplicationPromoteOptionPlanned),
+	}
+}
+
+type ReplicationRole string
+
+const (
+	ReplicationRoleNone    ReplicationRole = "None"
+	ReplicationRolePrimary ReplicationRole = "Primary"
+)
+
+type Replica struct {
+	PromoteMode   *ReadReplicaPromoteMode   `json:"promoteMode,omitempty"`
+	PromoteOption *ReplicationPromoteOption `json:"promoteOption,omitempty"`
+	Role          *ReplicationRole          `json:"role,omitempty"`
+}
+
+type ServerForUpdate struct {
+	Properties *ServerPropertiesForUpdate `json:"properties,omitempty"`
+}
+
+type ServerPropertiesForUpdate struct {
+	Replica *Replica `json:"replica,omitempty"`
+}
@@ -0,0 +1,26 @@
+package azuresdkhacks
+
+type VirtualEndpointType string
+
+const (
+	VirtualEndpointTypeReadWrite VirtualEndpointType = "ReadWrite"
+)
+
+func PossibleValuesForVirtualEndpointType() []string {
+	return []string{
+		string(VirtualEndpointTypeReadWrite),
+	}
+}
+
+type VirtualEndpointResource struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *VirtualEndpointResourceProperties `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
+
+type VirtualEndpointResourceProperties struct {
+	EndpointType     *VirtualEndpointType `json:"endpointType,omitempty"`
+	Members          *[]string            `json:"members,omitempty"`
+	VirtualEndpoints *[]string            `json:"virtualEndpoints,omitempty"`
+}
@@ -17,6 +17,7 @@ import (
 	flexibleserverfirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/firewallrules"
 	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/azuresdkhacks"
 )
 
 type Client struct {
@@ -28,6 +29,8 @@ type Client struct {
 	FlexibleServerFirewallRuleClient    *flexibleserverfirewallrules.FirewallRulesClient
 	FlexibleServerDatabaseClient        *flexibleserverdatabases.DatabasesClient
 	FlexibleServerAdministratorsClient  *flexibleserveradministrators.AdministratorsClient
+	FlexibleServerReplicasClient        *azuresdkhacks.ServersClient
+	FlexibleServerVirtualEndpointClient *azuresdkhacks.VirtualEndpointsClient
 	ServersClient                       *servers.ServersClient
 	ServerRestartClient                 *serverrestart.ServerRestartClient
 	ServerKeysClient                    *serverkeys.ServerKeysClient
@@ -83,6 +86,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerAdministratorsClient := flexibleserveradministrators.NewAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&flexibleServerAdministratorsClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerReplicasClient := azuresdkhacks.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerReplicasClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerVirtualEndpointClient := azuresdkhacks.NewVirtualEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerVirtualEndpointClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationsClient:                &configurationsClient,
 		DatabasesClient:                     &databasesClient,
@@ -93,6 +102,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
 		FlexibleServerAdministratorsClient:  &flexibleServerAdministratorsClient,
+		FlexibleServerReplicasClient:        &flexibleServerReplicasClient,
+		FlexibleServerVirtualEndpointClient: &flexibleServerVirtualEndpointClient,
 		ServersClient:                       &serversClient,
 		ServerKeysClient:                    &serverKeysClient,
 		ServerSecurityAlertPoliciesClient:   &serverSecurityAlertPoliciesClient,
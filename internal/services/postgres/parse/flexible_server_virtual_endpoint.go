@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FlexibleServerVirtualEndpointId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	FlexibleServerName  string
+	VirtualEndpointName string
+}
+
+func NewFlexibleServerVirtualEndpointID(subscriptionId, resourceGroup, flexibleServerName, virtualEndpointName string) FlexibleServerVirtualEndpointId {
+	return FlexibleServerVirtualEndpointId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		FlexibleServerName:  flexibleServerName,
+		VirtualEndpointName: virtualEndpointName,
+	}
+}
+
+func (id FlexibleServerVirtualEndpointId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Endpoint Name %q", id.VirtualEndpointName),
+		fmt.Sprintf("Flexible Server Name %q", id.FlexibleServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Flexible Server Virtual Endpoint", segmentsStr)
+}
+
+func (id FlexibleServerVirtualEndpointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/flexibleServers/%s/virtualEndpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.VirtualEndpointName)
+}
+
+// FlexibleServerVirtualEndpointID parses a FlexibleServerVirtualEndpoint ID into an FlexibleServerVirtualEndpointId struct
+func FlexibleServerVirtualEndpointID(input string) (*FlexibleServerVirtualEndpointId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FlexibleServerVirtualEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualEndpointName, err = id.PopSegment("virtualEndpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
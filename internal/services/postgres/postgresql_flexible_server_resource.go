@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -257,6 +259,18 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"replication_promote_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForReadReplicaPromoteMode(), false),
+			},
+
+			"replication_promote_option": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForReplicationPromoteOption(), false),
+			},
+
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 			"customer_managed_key": {
@@ -525,6 +539,8 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 
 			// Currently, `replicationRole` is set to `Primary` when `createMode` is `Replica` and `replicationRole` is updated to `None`. Service team confirmed it should be set to `None` for this scenario. See more details from https://github.com/Azure/azure-rest-api-specs/issues/22499
 			d.Set("replication_role", d.Get("replication_role").(string))
+			d.Set("replication_promote_mode", d.Get("replication_promote_mode").(string))
+			d.Set("replication_promote_option", d.Get("replication_promote_option").(string))
 
 			if network := props.Network; network != nil {
 				publicNetworkAccess := false
@@ -682,15 +698,50 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		createMode := d.Get("create_mode").(string)
 		replicationRole := d.Get("replication_role").(string)
 		if createMode == string(servers.CreateModeReplica) && replicationRole == string(servers.ReplicationRoleNone) {
-			replicationRole := servers.ReplicationRoleNone
-			parameters := servers.ServerForUpdate{
-				Properties: &servers.ServerPropertiesForUpdate{
-					ReplicationRole: &replicationRole,
-				},
-			}
+			promoteMode := d.Get("replication_promote_mode").(string)
+			promoteOption := d.Get("replication_promote_option").(string)
+
+			if promoteMode == "" && promoteOption == "" {
+				replicationRole := servers.ReplicationRoleNone
+				parameters := servers.ServerForUpdate{
+					Properties: &servers.ServerPropertiesForUpdate{
+						ReplicationRole: &replicationRole,
+					},
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating `replication_role` for %s: %+v", *id, err)
+				}
+			} else {
+				// `promoteMode` and `promoteOption` aren't available in the vendored SDK, so the promotion goes through the workaround client
+				replicasClient := meta.(*clients.Client).Postgres.FlexibleServerReplicasClient
 
-			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
-				return fmt.Errorf("updating `replication_role` for %s: %+v", *id, err)
+				if promoteMode == "" {
+					promoteMode = string(azuresdkhacks.ReadReplicaPromoteModeStandalone)
+				}
+				if promoteOption == "" {
+					promoteOption = string(azuresdkhacks.ReplicationPromoteOptionPlanned)
+				}
+
+				// a switchover swaps the roles of the replica and the source server, whereas a standalone promotion detaches the replica
+				role := azuresdkhacks.ReplicationRoleNone
+				if promoteMode == string(azuresdkhacks.ReadReplicaPromoteModeSwitchover) {
+					role = azuresdkhacks.ReplicationRolePrimary
+				}
+
+				parameters := azuresdkhacks.ServerForUpdate{
+					Properties: &azuresdkhacks.ServerPropertiesForUpdate{
+						Replica: &azuresdkhacks.Replica{
+							PromoteMode:   pointer.To(azuresdkhacks.ReadReplicaPromoteMode(promoteMode)),
+							PromoteOption: pointer.To(azuresdkhacks.ReplicationPromoteOption(promoteOption)),
+							Role:          pointer.To(role),
+						},
+					},
+				}
+
+				if err := replicasClient.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("promoting %s: %+v", *id, err)
+				}
 			}
 		} else {
 			return fmt.Errorf("`replication_role` only can be updated to `None` for replica server")
@@ -750,9 +801,34 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.CreateMode = &createMode
 	}
 
+	// an in-place major version upgrade is a long running operation which is performed on its own, prior to any other changes
 	if d.HasChange("version") {
+		resp, err := client.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if model := resp.Model; model != nil && model.Properties != nil {
+			props := model.Properties
+			if props.State != nil && *props.State != servers.ServerStateReady {
+				return fmt.Errorf("upgrading the major version of %s: the server must be in the `Ready` state but was %q", *id, string(*props.State))
+			}
+			if props.ReplicationRole != nil && *props.ReplicationRole != servers.ReplicationRoleNone {
+				return fmt.Errorf("upgrading the major version of %s: an in-place major version upgrade isn't supported for servers with a `replication_role` of %q - the read replicas must be removed first", *id, string(*props.ReplicationRole))
+			}
+		}
+
 		version := servers.ServerVersion(d.Get("version").(string))
-		parameters.Properties.Version = &version
+		updateMode := servers.CreateModeForUpdateUpdate
+		upgradeParameters := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				CreateMode: &updateMode,
+				Version:    &version,
+			},
+		}
+		if err = client.UpdateThenPoll(ctx, *id, upgradeParameters); err != nil {
+			return fmt.Errorf("upgrading the major version of %s to %q: %+v", *id, string(version), err)
+		}
 	}
 
 	if requireUpdateOnLogin {
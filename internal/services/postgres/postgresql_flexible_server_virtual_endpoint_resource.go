@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePostgresqlFlexibleServerVirtualEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePostgresqlFlexibleServerVirtualEndpointCreate,
+		Read:   resourcePostgresqlFlexibleServerVirtualEndpointRead,
+		Update: resourcePostgresqlFlexibleServerVirtualEndpointUpdate,
+		Delete: resourcePostgresqlFlexibleServerVirtualEndpointDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FlexibleServerVirtualEndpointID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerName,
+			},
+
+			"source_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: servers.ValidateFlexibleServerID,
+			},
+
+			"replica_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: servers.ValidateFlexibleServerID,
+			},
+
+			"type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForVirtualEndpointType(), false),
+			},
+		},
+	}
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	sourceServerId, err := servers.ParseFlexibleServerID(d.Get("source_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	replicaServerId, err := servers.ParseFlexibleServerID(d.Get("replica_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFlexibleServerVirtualEndpointID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroupName, sourceServerId.FlexibleServerName, d.Get("name").(string))
+
+	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_postgresql_flexible_server_virtual_endpoint", id.ID())
+	}
+
+	parameters := azuresdkhacks.VirtualEndpointResource{
+		Properties: &azuresdkhacks.VirtualEndpointResourceProperties{
+			EndpointType: pointer.To(azuresdkhacks.VirtualEndpointType(d.Get("type").(string))),
+			Members:      &[]string{replicaServerId.FlexibleServerName},
+		},
+	}
+
+	if err = client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePostgresqlFlexibleServerVirtualEndpointRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.VirtualEndpointName)
+	d.Set("source_server_id", servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName).ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		endpointType := ""
+		if model.Properties.EndpointType != nil {
+			endpointType = string(*model.Properties.EndpointType)
+		}
+		d.Set("type", endpointType)
+
+		// the API only returns the names of the member servers, so the Resource Group of the replica is taken from
+		// the existing value where the name matches, since a replica can live in a different Resource Group
+		replicaServerId := ""
+		if model.Properties.Members != nil {
+			for _, member := range *model.Properties.Members {
+				if member == id.FlexibleServerName {
+					continue
+				}
+
+				replicaServerId = servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, member).ID()
+				if existing, err := servers.ParseFlexibleServerID(d.Get("replica_server_id").(string)); err == nil && existing.FlexibleServerName == member {
+					replicaServerId = existing.ID()
+				}
+				break
+			}
+		}
+		d.Set("replica_server_id", replicaServerId)
+	}
+
+	return nil
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	replicaServerId, err := servers.ParseFlexibleServerID(d.Get("replica_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+	parameters := azuresdkhacks.VirtualEndpointResource{
+		Properties: &azuresdkhacks.VirtualEndpointResourceProperties{
+			EndpointType: pointer.To(azuresdkhacks.VirtualEndpointType(d.Get("type").(string))),
+			Members:      &[]string{replicaServerId.FlexibleServerName},
+		},
+	}
+
+	if err = client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourcePostgresqlFlexibleServerVirtualEndpointRead(d, meta)
+}
+
+func resourcePostgresqlFlexibleServerVirtualEndpointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServerVirtualEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerVirtualEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+	if err = client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
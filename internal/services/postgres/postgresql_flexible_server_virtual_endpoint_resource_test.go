@@ -0,0 +1,159 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PostgresqlFlexibleServerVirtualEndpointResource struct{}
+
+func TestAccPostgresqlFlexibleServerVirtualEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_virtual_endpoint", "test")
+	r := PostgresqlFlexibleServerVirtualEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: PostgresqlFlexibleServerResource{}.basic(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPostgresqlFlexibleServerVirtualEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_virtual_endpoint", "test")
+	r := PostgresqlFlexibleServerVirtualEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: PostgresqlFlexibleServerResource{}.basic(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPostgresqlFlexibleServerVirtualEndpoint_switchover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_virtual_endpoint", "test")
+	r := PostgresqlFlexibleServerVirtualEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: PostgresqlFlexibleServerResource{}.basic(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.switchover(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FlexibleServerVirtualEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Postgres.FlexibleServerVirtualEndpointClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) template(data acceptance.TestData, replicaConfig string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_postgresql_flexible_server" "replica" {
+  name                = "acctest-fs-replica-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  zone                = "2"
+  create_mode         = "Replica"
+  source_server_id    = azurerm_postgresql_flexible_server.test.id
+%[3]s}
+`, PostgresqlFlexibleServerResource{}.basic(data), data.RandomInteger, replicaConfig)
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_postgresql_flexible_server_virtual_endpoint" "test" {
+  name              = "acctest-ve-%[2]d"
+  source_server_id  = azurerm_postgresql_flexible_server.test.id
+  replica_server_id = azurerm_postgresql_flexible_server.replica.id
+  type              = "ReadWrite"
+}
+`, r.template(data, ""), data.RandomInteger)
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server_virtual_endpoint" "import" {
+  name              = azurerm_postgresql_flexible_server_virtual_endpoint.test.name
+  source_server_id  = azurerm_postgresql_flexible_server_virtual_endpoint.test.source_server_id
+  replica_server_id = azurerm_postgresql_flexible_server_virtual_endpoint.test.replica_server_id
+  type              = azurerm_postgresql_flexible_server_virtual_endpoint.test.type
+}
+`, r.basic(data))
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) switchover(data acceptance.TestData) string {
+	replicaConfig := `
+  replication_role           = "None"
+  replication_promote_mode   = "switchover"
+  replication_promote_option = "planned"
+`
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_postgresql_flexible_server_virtual_endpoint" "test" {
+  name              = "acctest-ve-%[2]d"
+  source_server_id  = azurerm_postgresql_flexible_server.test.id
+  replica_server_id = azurerm_postgresql_flexible_server.replica.id
+  type              = "ReadWrite"
+}
+`, r.template(data, replicaConfig), data.RandomInteger)
+}
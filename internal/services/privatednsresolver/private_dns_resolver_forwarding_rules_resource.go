@@ -0,0 +1,406 @@
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDNSResolverForwardingRulesModel struct {
+	DnsForwardingRulesetId string                        `tfschema:"dns_forwarding_ruleset_id"`
+	Rules                  []PrivateDNSResolverRuleModel `tfschema:"rule"`
+	RuleCount              int64                         `tfschema:"rule_count"`
+	EnabledRuleCount       int64                         `tfschema:"enabled_rule_count"`
+}
+
+type PrivateDNSResolverRuleModel struct {
+	Name              string                 `tfschema:"name"`
+	DomainName        string                 `tfschema:"domain_name"`
+	Enabled           bool                   `tfschema:"enabled"`
+	Metadata          map[string]string      `tfschema:"metadata"`
+	TargetDnsServers  []TargetDnsServerModel `tfschema:"target_dns_servers"`
+	ProvisioningState string                 `tfschema:"provisioning_state"`
+}
+
+// PrivateDNSResolverForwardingRulesResource manages all of the Forwarding Rules within a DNS Forwarding Ruleset, which
+// allows for only the Forwarding Rules which have changed to be created/updated/deleted - rather than requiring a
+// separate resource (and as such separate requests to refresh it) per Forwarding Rule
+type PrivateDNSResolverForwardingRulesResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDNSResolverForwardingRulesResource{}
+
+func (r PrivateDNSResolverForwardingRulesResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rules"
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) ModelObject() interface{} {
+	return &PrivateDNSResolverForwardingRulesModel{}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"domain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_dns_servers": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"port": {
+									Type:     pluginsdk.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"provisioning_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"rule_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"enabled_rule_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			if err := validatePrivateDNSResolverRuleNames(model.Rules); err != nil {
+				return err
+			}
+
+			existing, err := client.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing the Forwarding Rules within %s: %+v", *id, err)
+			}
+
+			// the Forwarding Rules within the Ruleset are managed exclusively by this resource, so any existing
+			// Forwarding Rules need to be imported
+			if len(existing.Items) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := applyPrivateDNSResolverForwardingRules(ctx, client, *id, existing.Items, model.Rules); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validatePrivateDNSResolverRuleNames(model.Rules); err != nil {
+				return err
+			}
+
+			existing, err := client.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing the Forwarding Rules within %s: %+v", *id, err)
+			}
+
+			return applyPrivateDNSResolverForwardingRules(ctx, client, *id, existing.Items, model.Rules)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+			rulesetsClient := metadata.Client.PrivateDnsResolver.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			ruleset, err := rulesetsClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(ruleset.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			resp, err := client.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing the Forwarding Rules within %s: %+v", *id, err)
+			}
+
+			var existing PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := PrivateDNSResolverForwardingRulesModel{
+				DnsForwardingRulesetId: id.ID(),
+				Rules:                  make([]PrivateDNSResolverRuleModel, 0),
+			}
+
+			for _, item := range resp.Items {
+				rule := flattenPrivateDNSResolverRule(item)
+				if rule.Enabled {
+					state.EnabledRuleCount++
+				}
+				state.Rules = append(state.Rules, rule)
+			}
+			state.RuleCount = int64(len(state.Rules))
+
+			// the API returns the Forwarding Rules ordered by name, so the order within the config is retained to avoid a diff
+			positions := make(map[string]int)
+			for i, rule := range existing.Rules {
+				positions[rule.Name] = i
+			}
+			sort.SliceStable(state.Rules, func(i, j int) bool {
+				iPosition, iExists := positions[state.Rules[i].Name]
+				jPosition, jExists := positions[state.Rules[j].Name]
+				if iExists && jExists {
+					return iPosition < jPosition
+				}
+				if iExists != jExists {
+					return iExists
+				}
+				return state.Rules[i].Name < state.Rules[j].Name
+			})
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing the Forwarding Rules within %s: %+v", *id, err)
+			}
+
+			return applyPrivateDNSResolverForwardingRules(ctx, client, *id, existing.Items, []PrivateDNSResolverRuleModel{})
+		},
+	}
+}
+
+// applyPrivateDNSResolverForwardingRules creates/updates the Forwarding Rules which differ from those which exist
+// and deletes those which exist but are no longer specified - leaving any unchanged Forwarding Rules alone
+func applyPrivateDNSResolverForwardingRules(ctx context.Context, client *forwardingrules.ForwardingRulesClient, id dnsforwardingrulesets.DnsForwardingRulesetId, existing []forwardingrules.ForwardingRule, rules []PrivateDNSResolverRuleModel) error {
+	existingRules := make(map[string]PrivateDNSResolverRuleModel)
+	for _, item := range existing {
+		rule := flattenPrivateDNSResolverRule(item)
+		existingRules[rule.Name] = rule
+	}
+
+	for _, rule := range rules {
+		if current, ok := existingRules[rule.Name]; ok && privateDNSResolverRuleEqual(current, rule) {
+			continue
+		}
+
+		ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, rule.Name)
+
+		forwardingRuleState := forwardingrules.ForwardingRuleStateEnabled
+		if !rule.Enabled {
+			forwardingRuleState = forwardingrules.ForwardingRuleStateDisabled
+		}
+		metadata := rule.Metadata
+		payload := forwardingrules.ForwardingRule{
+			Properties: forwardingrules.ForwardingRuleProperties{
+				DomainName:          rule.DomainName,
+				ForwardingRuleState: &forwardingRuleState,
+				Metadata:            &metadata,
+			},
+		}
+		if targetDnsServers := expandTargetDnsServerModel(rule.TargetDnsServers); targetDnsServers != nil {
+			payload.Properties.TargetDnsServers = *targetDnsServers
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, ruleId, payload, forwardingrules.CreateOrUpdateOperationOptions{}); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+		}
+	}
+
+	configured := make(map[string]struct{})
+	for _, rule := range rules {
+		configured[rule.Name] = struct{}{}
+	}
+	for name := range existingRules {
+		if _, ok := configured[name]; ok {
+			continue
+		}
+
+		ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, name)
+		if _, err := client.Delete(ctx, ruleId, forwardingrules.DeleteOperationOptions{}); err != nil {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	return nil
+}
+
+func validatePrivateDNSResolverRuleNames(rules []PrivateDNSResolverRuleModel) error {
+	names := make(map[string]struct{})
+	for _, rule := range rules {
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("the Forwarding Rule %q is specified more than once", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func privateDNSResolverRuleEqual(current PrivateDNSResolverRuleModel, desired PrivateDNSResolverRuleModel) bool {
+	if current.DomainName != desired.DomainName || current.Enabled != desired.Enabled {
+		return false
+	}
+
+	if len(current.Metadata) != len(desired.Metadata) || (len(desired.Metadata) > 0 && !reflect.DeepEqual(current.Metadata, desired.Metadata)) {
+		return false
+	}
+
+	if len(current.TargetDnsServers) != len(desired.TargetDnsServers) {
+		return false
+	}
+	for i := range desired.TargetDnsServers {
+		if current.TargetDnsServers[i] != desired.TargetDnsServers[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenPrivateDNSResolverRule(input forwardingrules.ForwardingRule) PrivateDNSResolverRuleModel {
+	output := PrivateDNSResolverRuleModel{
+		DomainName:       input.Properties.DomainName,
+		TargetDnsServers: flattenTargetDnsServerModel(&input.Properties.TargetDnsServers),
+	}
+
+	if input.Name != nil {
+		output.Name = *input.Name
+	}
+
+	if input.Properties.ForwardingRuleState != nil && *input.Properties.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled {
+		output.Enabled = true
+	}
+
+	if input.Properties.Metadata != nil {
+		output.Metadata = *input.Properties.Metadata
+	}
+
+	if input.Properties.ProvisioningState != nil {
+		output.ProvisioningState = string(*input.Properties.ProvisioningState)
+	}
+
+	return output
+}
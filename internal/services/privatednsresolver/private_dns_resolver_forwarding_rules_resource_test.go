@@ -0,0 +1,178 @@
+package privatednsresolver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDNSResolverForwardingRulesResource struct{}
+
+func TestAccPrivateDNSResolverForwardingRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule_count").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRules_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule_count").HasValue("3"),
+				check.That(data.ResourceName).Key("enabled_rule_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDnsResolver.ForwardingRulesClient.ListComplete(ctx, forwardingrules.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName), forwardingrules.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Forwarding Rules within %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) basic(data acceptance.TestData) string {
+	template := PrivateDNSResolverForwardingRuleResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "test" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "acctest-drfr-%d"
+    domain_name = "onprem.local."
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+      port       = 53
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "import" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_forwarding_rules.test.dns_forwarding_ruleset_id
+
+  rule {
+    name        = "acctest-drfr-%d"
+    domain_name = "onprem.local."
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+      port       = 53
+    }
+  }
+}
+`, config, data.RandomInteger)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) complete(data acceptance.TestData) string {
+	template := PrivateDNSResolverForwardingRuleResource{}.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "test" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "acctest-drfr-%[2]d-c"
+    domain_name = "contoso.local."
+    enabled     = false
+    target_dns_servers {
+      ip_address = "10.10.0.3"
+      port       = 53
+    }
+  }
+
+  rule {
+    name        = "acctest-drfr-%[2]d"
+    domain_name = "onprem.local."
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+      port       = 53
+    }
+    target_dns_servers {
+      ip_address = "10.10.0.2"
+      port       = 53
+    }
+    metadata = {
+      key = "value"
+    }
+  }
+
+  rule {
+    name        = "acctest-drfr-%[2]d-b"
+    domain_name = "fabrikam.local."
+    target_dns_servers {
+      ip_address = "10.10.0.4"
+      port       = 53
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
@@ -56,6 +56,7 @@ func (r Registration) Resources() []sdk.Resource {
 		PrivateDNSResolverDnsForwardingRulesetResource{},
 		PrivateDNSResolverDnsResolverResource{},
 		PrivateDNSResolverForwardingRuleResource{},
+		PrivateDNSResolverForwardingRulesResource{},
 		PrivateDNSResolverInboundEndpointResource{},
 		PrivateDNSResolverOutboundEndpointResource{},
 		PrivateDNSResolverVirtualNetworkLinkResource{},
@@ -0,0 +1,42 @@
+package purview_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PurviewAccountDataSource struct{}
+
+func TestAccPurviewAccountDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_purview_account", "test")
+	r := PurviewAccountDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("catalog_endpoint").Exists(),
+				check.That(data.ResourceName).Key("guardian_endpoint").Exists(),
+				check.That(data.ResourceName).Key("scan_endpoint").Exists(),
+				check.That(data.ResourceName).Key("atlas_kafka_endpoint_primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (PurviewAccountDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_purview_account" "test" {
+  name                = azurerm_purview_account.test.name
+  resource_group_name = azurerm_purview_account.test.resource_group_name
+}
+`, PurviewAccountResource{}.basic(data))
+}
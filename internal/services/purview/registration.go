@@ -24,7 +24,9 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurerm_purview_account": dataSourcePurviewAccount(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
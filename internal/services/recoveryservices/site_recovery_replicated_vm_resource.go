@@ -267,6 +267,12 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: azure.ValidateResourceID,
 			},
+			"target_vm_size": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 			"network_interface": {
 				Type:       pluginsdk.TypeSet, // use set to avoid diff caused by different orders.
 				Set:        resourceSiteRecoveryReplicatedVMNicHash,
@@ -452,17 +458,21 @@ func resourceSiteRecoveryReplicatedItemCreate(d *pluginsdk.ResourceData, meta in
 		recoveryResourceGroupId := diskInput["target_resource_group_id"].(string)
 		targetReplicaDiskType := diskInput["target_replica_disk_type"].(string)
 		targetDiskType := diskInput["target_disk_type"].(string)
-		targetEncryptionDiskSetID := diskInput["target_disk_encryption_set_id"].(string)
-
-		managedDisks = append(managedDisks, replicationprotecteditems.A2AVMManagedDiskInputDetails{
+		managedDisk := replicationprotecteditems.A2AVMManagedDiskInputDetails{
 			DiskId:                              diskId,
 			PrimaryStagingAzureStorageAccountId: primaryStagingAzureStorageAccountID,
 			RecoveryResourceGroupId:             recoveryResourceGroupId,
 			RecoveryReplicaDiskAccountType:      &targetReplicaDiskType,
 			RecoveryTargetDiskAccountType:       &targetDiskType,
-			RecoveryDiskEncryptionSetId:         &targetEncryptionDiskSetID,
 			DiskEncryptionInfo:                  expandDiskEncryption(diskInput["target_disk_encryption"].([]interface{})),
-		})
+		}
+
+		// each disk can be encrypted with its own Disk Encryption Set, an empty ID is rejected for the disks which aren't
+		if targetEncryptionDiskSetID := diskInput["target_disk_encryption_set_id"].(string); targetEncryptionDiskSetID != "" {
+			managedDisk.RecoveryDiskEncryptionSetId = &targetEncryptionDiskSetID
+		}
+
+		managedDisks = append(managedDisks, managedDisk)
 	}
 
 	var vmDisks []replicationprotecteditems.A2AVMDiskInputDetails
@@ -617,9 +627,15 @@ func resourceSiteRecoveryReplicatedItemUpdateInternal(ctx context.Context, d *pl
 		}
 	}
 
+	var targetVmSize *string
+	if v, ok := d.GetOk("target_vm_size"); ok {
+		targetVmSize = utils.String(v.(string))
+	}
+
 	parameters := replicationprotecteditems.UpdateReplicationProtectedItemInput{
 		Properties: &replicationprotecteditems.UpdateReplicationProtectedItemInputProperties{
 			RecoveryAzureVMName:            &name,
+			RecoveryAzureVMSize:            targetVmSize,
 			SelectedRecoveryAzureNetworkId: &targetNetworkId,
 			SelectedTfoAzureNetworkId:      &testNetworkId,
 			VMNics:                         &vmNics,
@@ -702,6 +718,7 @@ func resourceSiteRecoveryReplicatedItemRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("target_boot_diagnostic_storage_account_id", a2aDetails.RecoveryBootDiagStorageAccountId)
 			d.Set("target_capacity_reservation_group_id", a2aDetails.RecoveryCapacityReservationGroupId)
 			d.Set("target_virtual_machine_scale_set_id", a2aDetails.RecoveryVirtualMachineScaleSetId)
+			d.Set("target_vm_size", a2aDetails.RecoveryAzureVMSize)
 			d.Set("target_edge_zone", flattenEdgeZone(a2aDetails.RecoveryExtendedLocation))
 			d.Set("multi_vm_group_name", a2aDetails.MultiVMGroupName)
 
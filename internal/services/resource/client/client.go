@@ -79,3 +79,15 @@ func (c Client) TagsClientForSubscription(subscriptionID string) *resources.Tags
 	c.options.ConfigureClient(&tagsClient.Client, c.options.ResourceManagerAuthorizer)
 	return &tagsClient
 }
+
+func (c Client) GroupsClientForSubscription(subscriptionID string) *resources.GroupsClient {
+	groupsClient := resources.NewGroupsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&groupsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &groupsClient
+}
+
+func (c Client) ResourcesClientForSubscription(subscriptionID string) *resources.Client {
+	resourcesClient := resources.NewClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&resourcesClient.Client, c.options.ResourceManagerAuthorizer)
+	return &resourcesClient
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -42,13 +43,16 @@ func resourceResourceGroup() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
+			"subscription_id": sdk.SubscriptionIdOverrideSchema(),
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
 func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	subscriptionId := sdk.SubscriptionIdOverride(d, meta.(*clients.Client).Account.SubscriptionId)
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(subscriptionId)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +96,6 @@ func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface
 }
 
 func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,6 +104,8 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	resp, err := client.Get(ctx, id.ResourceGroup)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -114,11 +119,11 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("subscription_id", id.SubscriptionId)
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,9 +132,11 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	// conditionally check for nested resources and error if they exist
 	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
-		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
+		resourceClient := meta.(*clients.Client).Resource.ResourcesClientForSubscription(id.SubscriptionId)
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
 			results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "provisioningState", utils.Int32(500))
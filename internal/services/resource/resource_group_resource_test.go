@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
@@ -34,6 +35,25 @@ func TestAccResourceGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_subscriptionId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` is not specified")
+	}
+
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.subscriptionIdConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(testResource),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	testResource := ResourceGroupResource{}
@@ -118,11 +138,14 @@ func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Clie
 }
 
 func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	name := state.Attributes["name"]
+	id, err := parse.ResourceGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := client.Resource.GroupsClient.Get(ctx, name)
+	resp, err := client.Resource.GroupsClientForSubscription(id.SubscriptionId).Get(ctx, id.ResourceGroup)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	return utils.Bool(resp.Properties != nil), nil
@@ -169,6 +192,20 @@ resource "azurerm_resource_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) subscriptionIdConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name            = "acctestRG-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionIDAlt)
+}
+
 func (t ResourceGroupResource) requiresImportConfig(data acceptance.TestData) string {
 	template := t.basicConfig(data)
 	return fmt.Sprintf(`
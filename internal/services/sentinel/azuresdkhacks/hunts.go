@@ -0,0 +1,231 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
+)
+
+// TODO 4.0 check if this can be removed
+// Hunts are not available in the vendored SDK (2022-10-01-preview), so the client for the `hunts` and `hunts/relations`
+// endpoints is defined here against the API version in which they were introduced.
+
+const huntsAPIVersion = "2023-04-01-preview"
+
+type HuntsClient struct {
+	securityinsight.BaseClient
+}
+
+func NewHuntsClientWithBaseURI(baseURI string, subscriptionID string) HuntsClient {
+	return HuntsClient{securityinsight.NewWithBaseURI(baseURI, subscriptionID)}
+}
+
+func (client HuntsClient) Get(ctx context.Context, resourceGroupName string, workspaceName string, huntID string) (result Hunt, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, hunt Hunt) (result Hunt, err error) {
+	hunt.ID = nil
+	hunt.Name = nil
+	hunt.Type = nil
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(hunt))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntsClient) Delete(ctx context.Context, resourceGroupName string, workspaceName string, huntID string) (result autorest.Response, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntsClient", "Delete", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntsClient) prepare(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"huntId":            autorest.Encode("path", huntID),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": huntsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/hunts/{huntId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+type HuntRelationsClient struct {
+	securityinsight.BaseClient
+}
+
+func NewHuntRelationsClientWithBaseURI(baseURI string, subscriptionID string) HuntRelationsClient {
+	return HuntRelationsClient{securityinsight.NewWithBaseURI(baseURI, subscriptionID)}
+}
+
+func (client HuntRelationsClient) Get(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, huntRelationID string) (result HuntRelation, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, huntRelationID, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntRelationsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, huntRelationID string, relation HuntRelation) (result HuntRelation, err error) {
+	relation.ID = nil
+	relation.Name = nil
+	relation.Type = nil
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, huntRelationID, autorest.AsContentType("application/json; charset=utf-8"), autorest.AsPut(), autorest.WithJSON(relation))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntRelationsClient) Delete(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, huntRelationID string) (result autorest.Response, err error) {
+	req, err := client.prepare(ctx, resourceGroupName, workspaceName, huntID, huntRelationID, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securityinsight.HuntRelationsClient", "Delete", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client HuntRelationsClient) prepare(ctx context.Context, resourceGroupName string, workspaceName string, huntID string, huntRelationID string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"huntId":            autorest.Encode("path", huntID),
+		"huntRelationId":    autorest.Encode("path", huntRelationID),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": huntsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/hunts/{huntId}/relations/{huntRelationId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,79 @@
+package azuresdkhacks
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type HuntStatus string
+
+const (
+	HuntStatusActive   HuntStatus = "Active"
+	HuntStatusBacklog  HuntStatus = "Backlog"
+	HuntStatusClosed   HuntStatus = "Closed"
+	HuntStatusNew      HuntStatus = "New"
+	HuntStatusApproved HuntStatus = "Approved"
+)
+
+func PossibleHuntStatusValues() []HuntStatus {
+	return []HuntStatus{HuntStatusActive, HuntStatusApproved, HuntStatusBacklog, HuntStatusClosed, HuntStatusNew}
+}
+
+type HypothesisStatus string
+
+const (
+	HypothesisStatusInvalidated HypothesisStatus = "Invalidated"
+	HypothesisStatusUnknown     HypothesisStatus = "Unknown"
+	HypothesisStatusValidated   HypothesisStatus = "Validated"
+)
+
+func PossibleHypothesisStatusValues() []HypothesisStatus {
+	return []HypothesisStatus{HypothesisStatusInvalidated, HypothesisStatusUnknown, HypothesisStatusValidated}
+}
+
+type Hunt struct {
+	autorest.Response `json:"-"`
+	Properties        *HuntProperties `json:"properties,omitempty"`
+	Etag              *string         `json:"etag,omitempty"`
+	ID                *string         `json:"id,omitempty"`
+	Name              *string         `json:"name,omitempty"`
+	Type              *string         `json:"type,omitempty"`
+}
+
+type HuntProperties struct {
+	DisplayName      *string          `json:"displayName,omitempty"`
+	Description      *string          `json:"description,omitempty"`
+	Status           HuntStatus       `json:"status,omitempty"`
+	HypothesisStatus HypothesisStatus `json:"hypothesisStatus,omitempty"`
+	AttackTactics    *[]string        `json:"attackTactics,omitempty"`
+	AttackTechniques *[]string        `json:"attackTechniques,omitempty"`
+	Labels           *[]string        `json:"labels,omitempty"`
+	Owner            *HuntOwner       `json:"owner,omitempty"`
+}
+
+type HuntOwner struct {
+	ObjectID          *string `json:"objectId,omitempty"`
+	AssignedTo        *string `json:"assignedTo,omitempty"`
+	Email             *string `json:"email,omitempty"`
+	UserPrincipalName *string `json:"userPrincipalName,omitempty"`
+	OwnerType         *string `json:"ownerType,omitempty"`
+}
+
+type HuntRelation struct {
+	autorest.Response `json:"-"`
+	Properties        *HuntRelationProperties `json:"properties,omitempty"`
+	Etag              *string                 `json:"etag,omitempty"`
+	ID                *string                 `json:"id,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	Type              *string                 `json:"type,omitempty"`
+}
+
+type HuntRelationProperties struct {
+	RelatedResourceID *string   `json:"relatedResourceId,omitempty"`
+	Labels            *[]string `json:"labels,omitempty"`
+	// READ-ONLY
+	RelatedResourceName *string `json:"relatedResourceName,omitempty"`
+	// READ-ONLY
+	RelationType *string `json:"relationType,omitempty"`
+	// READ-ONLY
+	RelatedResourceType *string `json:"relatedResourceType,omitempty"`
+}
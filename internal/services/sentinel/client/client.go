@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/automationrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
 	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
@@ -18,6 +19,8 @@ type Client struct {
 	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
 	OnboardingStatesClient   *sentinelonboardingstates.SentinelOnboardingStatesClient
 	AnalyticsSettingsClient  *securityinsight.SecurityMLAnalyticsSettingsClient
+	HuntsClient              *azuresdkhacks.HuntsClient
+	HuntRelationsClient      *azuresdkhacks.HuntRelationsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -45,6 +48,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	analyticsSettingsClient := securityinsight.NewSecurityMLAnalyticsSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&analyticsSettingsClient.Client, o.ResourceManagerAuthorizer)
 
+	huntsClient := azuresdkhacks.NewHuntsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&huntsClient.Client, o.ResourceManagerAuthorizer)
+
+	huntRelationsClient := azuresdkhacks.NewHuntRelationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&huntRelationsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AlertRulesClient:         &alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
@@ -54,5 +63,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		WatchlistItemsClient:     &watchListItemsClient,
 		OnboardingStatesClient:   &onboardingStatesClient,
 		AnalyticsSettingsClient:  &analyticsSettingsClient,
+		HuntsClient:              &huntsClient,
+		HuntRelationsClient:      &huntRelationsClient,
 	}
 }
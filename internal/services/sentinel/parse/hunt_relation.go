@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type HuntRelationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	HuntName       string
+	RelationName   string
+}
+
+func NewHuntRelationID(subscriptionId, resourceGroup, workspaceName, huntName, relationName string) HuntRelationId {
+	return HuntRelationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		HuntName:       huntName,
+		RelationName:   relationName,
+	}
+}
+
+func (id HuntRelationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Relation Name %q", id.RelationName),
+		fmt.Sprintf("Hunt Name %q", id.HuntName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Hunt Relation", segmentsStr)
+}
+
+func (id HuntRelationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/hunts/%s/relations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName)
+}
+
+// HuntRelationID parses a HuntRelation ID into an HuntRelationId struct
+func HuntRelationID(input string) (*HuntRelationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := HuntRelationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.HuntName, err = id.PopSegment("hunts"); err != nil {
+		return nil, err
+	}
+	if resourceId.RelationName, err = id.PopSegment("relations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -76,5 +76,7 @@ func (r Registration) Resources() []sdk.Resource {
 		DataConnectorThreatIntelligenceTAXIIResource{},
 		DataConnectorMicrosoftThreatIntelligenceResource{},
 		AlertRuleAnomalyBuiltInResource{},
+		HuntResource{},
+		HuntRelationResource{},
 	}
 }
@@ -0,0 +1,237 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HuntRelationResource struct{}
+
+var _ sdk.ResourceWithUpdate = HuntRelationResource{}
+
+type HuntRelationModel struct {
+	Name                string   `tfschema:"name"`
+	HuntId              string   `tfschema:"hunt_id"`
+	RelatedResourceId   string   `tfschema:"related_resource_id"`
+	Labels              []string `tfschema:"labels"`
+	RelatedResourceName string   `tfschema:"related_resource_name"`
+	RelatedResourceType string   `tfschema:"related_resource_type"`
+	RelationType        string   `tfschema:"relation_type"`
+}
+
+func (r HuntRelationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"hunt_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.HuntID,
+		},
+		"related_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+		"labels": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r HuntRelationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"related_resource_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"related_resource_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"relation_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r HuntRelationResource) ResourceType() string {
+	return "azurerm_sentinel_hunt_relation"
+}
+
+func (r HuntRelationResource) ModelObject() interface{} {
+	return &HuntRelationModel{}
+}
+
+func (r HuntRelationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.HuntRelationID
+}
+
+func (r HuntRelationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntRelationsClient
+
+			var model HuntRelationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// Generate a random UUID as the resource name if the user doesn't specify it.
+			if model.Name == "" {
+				model.Name = uuid.New().String()
+			}
+
+			huntId, err := parse.HuntID(model.HuntId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewHuntRelationID(huntId.SubscriptionId, huntId.ResourceGroup, huntId.WorkspaceName, huntId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := azuresdkhacks.HuntRelation{
+				Properties: &azuresdkhacks.HuntRelationProperties{
+					RelatedResourceID: utils.String(model.RelatedResourceId),
+					Labels:            &model.Labels,
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntRelationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntRelationsClient
+			id, err := parse.HuntRelationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := HuntRelationModel{
+				Name:   id.RelationName,
+				HuntId: parse.NewHuntID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.HuntName).ID(),
+			}
+
+			if props := resp.Properties; props != nil {
+				model.RelatedResourceId = utils.NormalizeNilableString(props.RelatedResourceID)
+				model.RelatedResourceName = utils.NormalizeNilableString(props.RelatedResourceName)
+				model.RelatedResourceType = utils.NormalizeNilableString(props.RelatedResourceType)
+				model.RelationType = utils.NormalizeNilableString(props.RelationType)
+				if props.Labels != nil {
+					model.Labels = *props.Labels
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r HuntRelationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntRelationsClient
+
+			id, err := parse.HuntRelationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model HuntRelationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			if metadata.ResourceData.HasChange("labels") {
+				existing.Properties.Labels = &model.Labels
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName, existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntRelationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntRelationsClient
+
+			id, err := parse.HuntRelationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HuntRelationResource struct{}
+
+func TestAccHuntRelation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunt_relation", "test")
+	r := HuntRelationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("relation_type").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHuntRelation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunt_relation", "test")
+	r := HuntRelationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.labels(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHuntRelation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunt_relation", "test")
+	r := HuntRelationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r HuntRelationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.HuntRelationsClient
+
+	id, err := parse.HuntRelationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.HuntName, id.RelationName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r HuntRelationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunt_relation" "test" {
+  hunt_id             = azurerm_sentinel_hunt.test.id
+  related_resource_id = azurerm_log_analytics_saved_search.test.id
+}
+`, r.template(data))
+}
+
+func (r HuntRelationResource) labels(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunt_relation" "test" {
+  hunt_id             = azurerm_sentinel_hunt.test.id
+  related_resource_id = azurerm_log_analytics_saved_search.test.id
+  labels              = ["label1"]
+}
+`, r.template(data))
+}
+
+func (r HuntRelationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunt_relation" "import" {
+  name                = azurerm_sentinel_hunt_relation.test.name
+  hunt_id             = azurerm_sentinel_hunt_relation.test.hunt_id
+  related_resource_id = azurerm_sentinel_hunt_relation.test.related_resource_id
+}
+`, r.basic(data))
+}
+
+func (r HuntRelationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_saved_search" "test" {
+  name                       = "acctestLASS-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  category                   = "Hunting Queries"
+  display_name               = "acctest-query-%d"
+  query                      = "Heartbeat | take 1"
+}
+`, HuntResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,298 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HuntResource struct{}
+
+var _ sdk.ResourceWithUpdate = HuntResource{}
+
+type HuntModel struct {
+	Name                    string   `tfschema:"name"`
+	LogAnalyticsWorkspaceId string   `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string   `tfschema:"display_name"`
+	Description             string   `tfschema:"description"`
+	Status                  string   `tfschema:"status"`
+	HypothesisStatus        string   `tfschema:"hypothesis_status"`
+	AttackTactics           []string `tfschema:"attack_tactics"`
+	AttackTechniques        []string `tfschema:"attack_techniques"`
+	Labels                  []string `tfschema:"labels"`
+	OwnerObjectId           string   `tfschema:"owner_object_id"`
+}
+
+func (r HuntResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(azuresdkhacks.HuntStatusNew),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.HuntStatusNew),
+				string(azuresdkhacks.HuntStatusActive),
+				string(azuresdkhacks.HuntStatusClosed),
+				string(azuresdkhacks.HuntStatusBacklog),
+				string(azuresdkhacks.HuntStatusApproved),
+			}, false),
+		},
+		"hypothesis_status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(azuresdkhacks.HypothesisStatusUnknown),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.HypothesisStatusUnknown),
+				string(azuresdkhacks.HypothesisStatusInvalidated),
+				string(azuresdkhacks.HypothesisStatusValidated),
+			}, false),
+		},
+		"attack_tactics": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"attack_techniques": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"labels": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"owner_object_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r HuntResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HuntResource) ResourceType() string {
+	return "azurerm_sentinel_hunt"
+}
+
+func (r HuntResource) ModelObject() interface{} {
+	return &HuntModel{}
+}
+
+func (r HuntResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.HuntID
+}
+
+func (r HuntResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntsClient
+
+			var model HuntModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// Generate a random UUID as the resource name if the user doesn't specify it.
+			if model.Name == "" {
+				model.Name = uuid.New().String()
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewHuntID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := azuresdkhacks.Hunt{
+				Properties: expandSentinelHuntProperties(model),
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntsClient
+			id, err := parse.HuntID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := HuntModel{
+				Name:                    id.Name,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if props := resp.Properties; props != nil {
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.Status = string(props.Status)
+				model.HypothesisStatus = string(props.HypothesisStatus)
+				if props.AttackTactics != nil {
+					model.AttackTactics = *props.AttackTactics
+				}
+				if props.AttackTechniques != nil {
+					model.AttackTechniques = *props.AttackTechniques
+				}
+				if props.Labels != nil {
+					model.Labels = *props.Labels
+				}
+				if props.Owner != nil {
+					model.OwnerObjectId = utils.NormalizeNilableString(props.Owner.ObjectID)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r HuntResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntsClient
+
+			id, err := parse.HuntID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model HuntModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			param := azuresdkhacks.Hunt{
+				Etag:       existing.Etag,
+				Properties: expandSentinelHuntProperties(model),
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.HuntsClient
+
+			id, err := parse.HuntID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelHuntProperties(model HuntModel) *azuresdkhacks.HuntProperties {
+	props := &azuresdkhacks.HuntProperties{
+		DisplayName:      utils.String(model.DisplayName),
+		Description:      utils.String(model.Description),
+		Status:           azuresdkhacks.HuntStatus(model.Status),
+		HypothesisStatus: azuresdkhacks.HypothesisStatus(model.HypothesisStatus),
+		AttackTactics:    &model.AttackTactics,
+		AttackTechniques: &model.AttackTechniques,
+		Labels:           &model.Labels,
+	}
+
+	if model.OwnerObjectId != "" {
+		props.Owner = &azuresdkhacks.HuntOwner{
+			ObjectID: utils.String(model.OwnerObjectId),
+		}
+	}
+
+	return props
+}
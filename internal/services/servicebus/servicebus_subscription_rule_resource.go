@@ -90,93 +90,67 @@ func resourceServicebusSubscriptionRuleSchema() map[string]*pluginsdk.Schema {
 			Optional:      true,
 			MaxItems:      1,
 			ConflictsWith: []string{"sql_filter"},
-			Elem: &pluginsdk.Resource{
-				Schema: map[string]*pluginsdk.Schema{
-					"correlation_id": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"message_id": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"to": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"reply_to": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"label": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"session_id": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"reply_to_session_id": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"content_type": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
-					"properties": {
-						Type:     pluginsdk.TypeMap,
-						Optional: true,
-						Elem: &pluginsdk.Schema{
-							Type: pluginsdk.TypeString,
-						},
-						AtLeastOneOf: []string{
-							"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
-							"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
-							"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
-						},
-					},
+			Elem: serviceBusCorrelationFilterResource([]string{
+				"correlation_filter.0.correlation_id", "correlation_filter.0.message_id", "correlation_filter.0.to",
+				"correlation_filter.0.reply_to", "correlation_filter.0.label", "correlation_filter.0.session_id",
+				"correlation_filter.0.reply_to_session_id", "correlation_filter.0.content_type", "correlation_filter.0.properties",
+			}),
+		},
+	}
+}
+
+// serviceBusCorrelationFilterResource returns the schema of a `correlation_filter` block, where atLeastOneOf contains the
+// addresses of its fields - which can't be specified when the block is nested within a list
+func serviceBusCorrelationFilterResource(atLeastOneOf []string) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"correlation_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"message_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"to": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"reply_to": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"label": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"session_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"reply_to_session_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"content_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				AtLeastOneOf: atLeastOneOf,
+			},
+			"properties": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
 				},
+				AtLeastOneOf: atLeastOneOf,
 			},
 		},
 	}
@@ -230,7 +204,7 @@ func resourceServiceBusSubscriptionRuleCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	if *rule.Properties.FilterType == rules.FilterTypeCorrelationFilter {
-		correlationFilter, err := expandAzureRmServiceBusCorrelationFilter(d)
+		correlationFilter, err := expandAzureRmServiceBusCorrelationFilter(d.Get("correlation_filter").([]interface{}))
 		if err != nil {
 			return fmt.Errorf("expanding `correlation_filter`: %+v", err)
 		}
@@ -324,8 +298,7 @@ func resourceServiceBusSubscriptionRuleDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
-func expandAzureRmServiceBusCorrelationFilter(d *pluginsdk.ResourceData) (*rules.CorrelationFilter, error) {
-	configs := d.Get("correlation_filter").([]interface{})
+func expandAzureRmServiceBusCorrelationFilter(configs []interface{}) (*rules.CorrelationFilter, error) {
 	if len(configs) == 0 {
 		return nil, fmt.Errorf("`correlation_filter` is required when `filter_type` is set to `CorrelationFilter`")
 	}
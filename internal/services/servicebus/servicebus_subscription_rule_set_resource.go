@@ -0,0 +1,407 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/subscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// serviceBusDefaultSubscriptionRuleName is the name of the rule which matches all messages, which is added to a
+// Subscription when it's created
+const serviceBusDefaultSubscriptionRuleName = "$Default"
+
+func resourceServiceBusSubscriptionRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusSubscriptionRuleSetCreateUpdate,
+		Read:   resourceServiceBusSubscriptionRuleSetRead,
+		Update: resourceServiceBusSubscriptionRuleSetCreateUpdate,
+		Delete: resourceServiceBusSubscriptionRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := rules.ParseSubscriptions2ID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceServiceBusSubscriptionRuleSetSchema(),
+	}
+}
+
+func resourceServiceBusSubscriptionRuleSetSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		//lintignore: S013
+		"subscription_id": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     subscriptions.ValidateSubscriptions2ID,
+			DiffSuppressFunc: suppress.CaseDifference,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 50),
+					},
+
+					"filter_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(rules.FilterTypeSqlFilter),
+							string(rules.FilterTypeCorrelationFilter),
+						}, false),
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"sql_filter": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.SqlFilter,
+					},
+
+					// Reserved for future use, currently hard-coded to 20
+					"sql_filter_compatibility_level": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"correlation_filter": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     serviceBusCorrelationFilterResource(nil),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceBusSubscriptionRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	subscriptionsClient := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	if _, err := subscriptionsClient.Get(ctx, subscriptions.NewSubscriptions2ID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName)); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	existing, err := client.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing the rules for %s: %+v", id, err)
+	}
+
+	if d.IsNewResource() {
+		// only the default rule exists when the rules of the Subscription haven't been managed
+		for _, v := range existing.Items {
+			if v.Name != nil && !strings.EqualFold(*v.Name, serviceBusDefaultSubscriptionRuleName) {
+				return tf.ImportAsExistsError("azurerm_servicebus_subscription_rule_set", id.ID())
+			}
+		}
+	}
+
+	configured := d.Get("rule").([]interface{})
+	names := make(map[string]struct{})
+	for _, raw := range configured {
+		name := raw.(map[string]interface{})["name"].(string)
+		if _, ok := names[strings.ToLower(name)]; ok {
+			return fmt.Errorf("the rule %q is specified more than once", name)
+		}
+		names[strings.ToLower(name)] = struct{}{}
+	}
+
+	// rules which haven't changed since they were last applied don't need to be updated
+	previous := make(map[string]interface{})
+	if !d.IsNewResource() {
+		old, _ := d.GetChange("rule")
+		for _, raw := range old.([]interface{}) {
+			v := raw.(map[string]interface{})
+			previous[strings.ToLower(v["name"].(string))] = v
+		}
+	}
+
+	if err := reconcileServiceBusSubscriptionRules(ctx, client, *id, existing.Items, configured, previous); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusSubscriptionRuleSetRead(d, meta)
+}
+
+func resourceServiceBusSubscriptionRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	subscriptionsClient := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subscription, err := subscriptionsClient.Get(ctx, subscriptions.NewSubscriptions2ID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName))
+	if err != nil {
+		if response.WasNotFound(subscription.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	existing, err := client.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing the rules for %s: %+v", id, err)
+	}
+
+	d.Set("subscription_id", id.ID())
+
+	// the rules are returned in no particular order, so they're ordered as they were specified
+	order := make([]string, 0)
+	for _, raw := range d.Get("rule").([]interface{}) {
+		if v, ok := raw.(map[string]interface{}); ok {
+			order = append(order, v["name"].(string))
+		}
+	}
+	if err := d.Set("rule", flattenServiceBusSubscriptionRuleSetRules(existing.Items, order)); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceServiceBusSubscriptionRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	for _, raw := range d.Get("rule").([]interface{}) {
+		v := raw.(map[string]interface{})
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, v["name"].(string))
+		if resp, err := client.Delete(ctx, ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	return nil
+}
+
+// serviceBusSubscriptionRulesClient is the subset of the Rules client used to reconcile the rules of a Subscription
+type serviceBusSubscriptionRulesClient interface {
+	CreateOrUpdate(ctx context.Context, id rules.RuleId, input rules.Rule) (rules.CreateOrUpdateOperationResponse, error)
+	Delete(ctx context.Context, id rules.RuleId) (rules.DeleteOperationResponse, error)
+}
+
+// reconcileServiceBusSubscriptionRules creates/updates the configured rules before removing any other rules (e.g. the
+// default rule), so that the Subscription is never left without any rules (and so silently dropping all messages) if
+// a rule can't be created
+func reconcileServiceBusSubscriptionRules(ctx context.Context, client serviceBusSubscriptionRulesClient, id rules.Subscriptions2Id, existing []rules.Rule, configured []interface{}, previous map[string]interface{}) error {
+	names := make(map[string]struct{})
+	for _, raw := range configured {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		names[strings.ToLower(name)] = struct{}{}
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, name)
+
+		if old, ok := previous[strings.ToLower(name)]; ok && reflect.DeepEqual(old, v) && serviceBusSubscriptionRuleExists(existing, name) {
+			log.Printf("[DEBUG] %s hasn't changed - skipping", ruleId)
+			continue
+		}
+
+		rule, err := expandServiceBusSubscriptionRuleSetRule(v)
+		if err != nil {
+			return fmt.Errorf("expanding %s: %+v", ruleId, err)
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, ruleId, *rule); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+		}
+	}
+
+	// this resource manages the complete set of rules, so any other rules (e.g. the default rule) are removed
+	for _, v := range existing {
+		if v.Name == nil {
+			continue
+		}
+		if _, ok := names[strings.ToLower(*v.Name)]; ok {
+			continue
+		}
+
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, *v.Name)
+		if resp, err := client.Delete(ctx, ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	return nil
+}
+
+func serviceBusSubscriptionRuleExists(input []rules.Rule, name string) bool {
+	for _, v := range input {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func expandServiceBusSubscriptionRuleSetRule(input map[string]interface{}) (*rules.Rule, error) {
+	filterType := rules.FilterType(input["filter_type"].(string))
+	rule := rules.Rule{
+		Properties: &rules.Ruleproperties{
+			FilterType: &filterType,
+		},
+	}
+
+	if action := input["action"].(string); action != "" {
+		rule.Properties.Action = &rules.Action{
+			SqlExpression: &action,
+		}
+	}
+
+	sqlFilter := input["sql_filter"].(string)
+	correlationFilter := input["correlation_filter"].([]interface{})
+
+	switch filterType {
+	case rules.FilterTypeCorrelationFilter:
+		if sqlFilter != "" {
+			return nil, fmt.Errorf("`sql_filter` cannot be specified when `filter_type` is set to `CorrelationFilter`")
+		}
+
+		filter, err := expandAzureRmServiceBusCorrelationFilter(correlationFilter)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `correlation_filter`: %+v", err)
+		}
+		rule.Properties.CorrelationFilter = filter
+
+	case rules.FilterTypeSqlFilter:
+		if len(correlationFilter) > 0 {
+			return nil, fmt.Errorf("`correlation_filter` cannot be specified when `filter_type` is set to `SqlFilter`")
+		}
+
+		rule.Properties.SqlFilter = &rules.SqlFilter{
+			SqlExpression: &sqlFilter,
+		}
+	}
+
+	return &rule, nil
+}
+
+func flattenServiceBusSubscriptionRuleSetRules(input []rules.Rule, order []string) []interface{} {
+	position := make(map[string]int)
+	for i, name := range order {
+		position[strings.ToLower(name)] = i
+	}
+
+	ordered := make([]interface{}, len(order))
+	found := make([]bool, len(order))
+	unordered := make([]interface{}, 0)
+
+	for _, v := range input {
+		if v.Name == nil {
+			continue
+		}
+
+		rule := map[string]interface{}{
+			"name":                           *v.Name,
+			"filter_type":                    "",
+			"action":                         "",
+			"sql_filter":                     "",
+			"sql_filter_compatibility_level": 0,
+			"correlation_filter":             []interface{}{},
+		}
+
+		if props := v.Properties; props != nil {
+			if props.FilterType != nil {
+				rule["filter_type"] = string(*props.FilterType)
+			}
+
+			if props.Action != nil && props.Action.SqlExpression != nil {
+				rule["action"] = *props.Action.SqlExpression
+			}
+
+			if props.SqlFilter != nil {
+				if props.SqlFilter.SqlExpression != nil {
+					rule["sql_filter"] = *props.SqlFilter.SqlExpression
+				}
+				if props.SqlFilter.CompatibilityLevel != nil {
+					rule["sql_filter_compatibility_level"] = int(*props.SqlFilter.CompatibilityLevel)
+				}
+			}
+
+			// the Correlation Filter is the same model for both the Rules and Subscriptions API's
+			rule["correlation_filter"] = flattenAzureRmServiceBusCorrelationFilter((*subscriptions.CorrelationFilter)(props.CorrelationFilter))
+		}
+
+		if i, ok := position[strings.ToLower(*v.Name)]; ok {
+			ordered[i] = rule
+			found[i] = true
+			continue
+		}
+
+		// rules which weren't specified (e.g. those created outside of Terraform) are appended, so that they're removed
+		unordered = append(unordered, rule)
+	}
+
+	sort.Slice(unordered, func(i, j int) bool {
+		return unordered[i].(map[string]interface{})["name"].(string) < unordered[j].(map[string]interface{})["name"].(string)
+	})
+
+	output := make([]interface{}, 0)
+	for i, v := range ordered {
+		if found[i] {
+			output = append(output, v)
+		}
+	}
+	return append(output, unordered...)
+}
@@ -0,0 +1,176 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusSubscriptionRuleSetResource struct{}
+
+func TestAccServiceBusSubscriptionRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rule_set", "test")
+	r := ServiceBusSubscriptionRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceBusSubscriptionRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rule_set", "test")
+	r := ServiceBusSubscriptionRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceBusSubscriptionRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rule_set", "test")
+	r := ServiceBusSubscriptionRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("3"),
+				check.That(data.ResourceName).Key("rule.0.name").HasValue("action"),
+				check.That(data.ResourceName).Key("rule.1.name").HasValue("correlation"),
+				check.That(data.ResourceName).Key("rule.2.name").HasValue("sql"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ServiceBusSubscriptionRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := rules.ParseSubscriptions2ID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.SubscriptionRulesClient.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the rules for %s: %+v", *id, err)
+	}
+
+	for _, v := range resp.Items {
+		if v.Name != nil && *v.Name == "$Default" {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (r ServiceBusSubscriptionRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rule_set" "test" {
+  subscription_id = azurerm_servicebus_subscription.test.id
+
+  rule {
+    name        = "sql"
+    filter_type = "SqlFilter"
+    sql_filter  = "2=2"
+  }
+}
+`, r.template(data))
+}
+
+func (r ServiceBusSubscriptionRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rule_set" "import" {
+  subscription_id = azurerm_servicebus_subscription_rule_set.test.subscription_id
+
+  rule {
+    name        = "sql"
+    filter_type = "SqlFilter"
+    sql_filter  = "2=2"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ServiceBusSubscriptionRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rule_set" "test" {
+  subscription_id = azurerm_servicebus_subscription.test.id
+
+  rule {
+    name        = "action"
+    filter_type = "SqlFilter"
+    sql_filter  = "2=2"
+    action      = "SET Test='true'"
+  }
+
+  rule {
+    name        = "correlation"
+    filter_type = "CorrelationFilter"
+
+    correlation_filter {
+      correlation_id      = "high"
+      message_id          = "message"
+      session_id          = "session"
+      reply_to_session_id = "reply"
+
+      properties = {
+        customProperty = "value"
+      }
+    }
+  }
+
+  rule {
+    name        = "sql"
+    filter_type = "SqlFilter"
+    sql_filter  = "3=3"
+  }
+}
+`, r.template(data))
+}
+
+func (ServiceBusSubscriptionRuleSetResource) template(data acceptance.TestData) string {
+	return ServiceBusSubscriptionRuleResource{}.template(data)
+}
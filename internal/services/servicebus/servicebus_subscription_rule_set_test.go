@@ -0,0 +1,120 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+)
+
+type fakeServiceBusSubscriptionRulesClient struct {
+	failCreateFor string
+	rules         map[string]struct{}
+	operations    []string
+}
+
+func (c *fakeServiceBusSubscriptionRulesClient) CreateOrUpdate(_ context.Context, id rules.RuleId, _ rules.Rule) (rules.CreateOrUpdateOperationResponse, error) {
+	c.operations = append(c.operations, fmt.Sprintf("create %s", id.RuleName))
+	if strings.EqualFold(id.RuleName, c.failCreateFor) {
+		return rules.CreateOrUpdateOperationResponse{}, fmt.Errorf("invalid sql expression")
+	}
+	c.rules[strings.ToLower(id.RuleName)] = struct{}{}
+	return rules.CreateOrUpdateOperationResponse{}, nil
+}
+
+func (c *fakeServiceBusSubscriptionRulesClient) Delete(_ context.Context, id rules.RuleId) (rules.DeleteOperationResponse, error) {
+	c.operations = append(c.operations, fmt.Sprintf("delete %s", id.RuleName))
+	delete(c.rules, strings.ToLower(id.RuleName))
+	return rules.DeleteOperationResponse{}, nil
+}
+
+func TestReconcileServiceBusSubscriptionRules(t *testing.T) {
+	id := rules.NewSubscriptions2ID("00000000-0000-0000-0000-000000000000", "rg1", "namespace1", "topic1", "subscription1")
+	existing := []rules.Rule{
+		{Name: pointer.To(serviceBusDefaultSubscriptionRuleName)},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"name":               "first",
+			"filter_type":        string(rules.FilterTypeSqlFilter),
+			"sql_filter":         "1=1",
+			"action":             "",
+			"correlation_filter": []interface{}{},
+		},
+		map[string]interface{}{
+			"name":               "second",
+			"filter_type":        string(rules.FilterTypeSqlFilter),
+			"sql_filter":         "2=2",
+			"action":             "",
+			"correlation_filter": []interface{}{},
+		},
+	}
+
+	testData := []struct {
+		name            string
+		failCreateFor   string
+		expectError     bool
+		expectedRules   []string
+		expectedDeleted bool
+	}{
+		{
+			name:            "success",
+			expectedRules:   []string{"first", "second"},
+			expectedDeleted: true,
+		},
+		{
+			name:          "creating a rule fails",
+			failCreateFor: "second",
+			expectError:   true,
+			// the default rule must remain so that the Subscription keeps receiving messages
+			expectedRules:   []string{serviceBusDefaultSubscriptionRuleName, "first"},
+			expectedDeleted: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		client := &fakeServiceBusSubscriptionRulesClient{
+			failCreateFor: v.failCreateFor,
+			rules: map[string]struct{}{
+				strings.ToLower(serviceBusDefaultSubscriptionRuleName): {},
+			},
+		}
+
+		err := reconcileServiceBusSubscriptionRules(context.TODO(), client, id, existing, configured, map[string]interface{}{})
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if len(client.rules) != len(v.expectedRules) {
+			t.Fatalf("expected the rules %+v but got %+v", v.expectedRules, client.rules)
+		}
+		for _, name := range v.expectedRules {
+			if _, ok := client.rules[strings.ToLower(name)]; !ok {
+				t.Fatalf("expected the rule %q to exist but got %+v", name, client.rules)
+			}
+		}
+
+		deleted := false
+		for i, operation := range client.operations {
+			if strings.HasPrefix(operation, "delete") {
+				deleted = true
+				for _, later := range client.operations[i:] {
+					if strings.HasPrefix(later, "create") {
+						t.Fatalf("expected rules to be removed after the configured rules were created but got %+v", client.operations)
+					}
+				}
+			}
+		}
+		if deleted != v.expectedDeleted {
+			t.Fatalf("expected deleted to be %t but got %+v", v.expectedDeleted, client.operations)
+		}
+	}
+}
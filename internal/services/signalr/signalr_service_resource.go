@@ -7,8 +7,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2022-02-01/signalr"
@@ -104,8 +107,14 @@ func resourceArmSignalRServiceCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("Upstream configurations are only allowed when the SignalR Service is in `Serverless` mode")
 	}
 
+	expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
 	resourceType := signalr.SignalRResource{
 		Location: utils.String(location),
+		Identity: expandedIdentity,
 		Properties: &signalr.SignalRProperties{
 			Cors:                   expandSignalRCors(cors),
 			Features:               &expandedFeatures,
@@ -160,6 +169,14 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `sku`: %+v", err)
 		}
 
+		flattenedIdentity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
 		if props := model.Properties; props != nil {
 			d.Set("hostname", props.HostName)
 			d.Set("ip_address", props.ExternalIP)
@@ -288,6 +305,14 @@ func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		resourceType.Sku = expandSignalRServiceSku(sku)
 	}
 
+	if d.HasChange("identity") {
+		expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		resourceType.Identity = expandedIdentity
+	}
+
 	if d.HasChange("tags") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		resourceType.Tags = tags.Expand(tagsRaw)
@@ -357,6 +382,7 @@ func expandUpstreamSettings(input []interface{}) *signalr.ServerlessUpstreamSett
 			EventPattern:    utils.String(strings.Join(*utils.ExpandStringSlice(setting["event_pattern"].([]interface{})), ",")),
 			CategoryPattern: utils.String(strings.Join(*utils.ExpandStringSlice(setting["category_pattern"].([]interface{})), ",")),
 			UrlTemplate:     setting["url_template"].(string),
+			Auth:            expandSignalRUpstreamAuth(setting["auth"].([]interface{})),
 		}
 
 		upstreamTemplates = append(upstreamTemplates, upstreamTemplate)
@@ -397,11 +423,40 @@ func flattenUpstreamSettings(upstreamSettings *signalr.ServerlessUpstreamSetting
 			"hub_pattern":      hubPattern,
 			"event_pattern":    eventPattern,
 			"category_pattern": categoryPattern,
+			"auth":             flattenSignalRUpstreamAuth(settings.Auth),
 		})
 	}
 	return result
 }
 
+func expandSignalRUpstreamAuth(input []interface{}) *signalr.UpstreamAuthSettings {
+	if len(input) == 0 || input[0] == nil {
+		return &signalr.UpstreamAuthSettings{
+			Type: pointer.To(signalr.UpstreamAuthTypeNone),
+		}
+	}
+
+	authRaw := input[0].(map[string]interface{})
+	return &signalr.UpstreamAuthSettings{
+		Type: pointer.To(signalr.UpstreamAuthTypeManagedIdentity),
+		ManagedIdentity: &signalr.ManagedIdentitySettings{
+			Resource: pointer.To(authRaw["managed_identity_id"].(string)),
+		},
+	}
+}
+
+func flattenSignalRUpstreamAuth(input *signalr.UpstreamAuthSettings) []interface{} {
+	if input == nil || input.Type == nil || *input.Type == signalr.UpstreamAuthTypeNone || input.ManagedIdentity == nil || input.ManagedIdentity.Resource == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"managed_identity_id": *input.ManagedIdentity.Resource,
+		},
+	}
+}
+
 func expandSignalRCors(input []interface{}) *signalr.SignalRCorsSettings {
 	corsSettings := signalr.SignalRCorsSettings{}
 
@@ -600,6 +655,8 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+
 		"connectivity_logs_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -700,6 +757,24 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 						Required:     true,
 						ValidateFunc: signalrValidate.UrlTemplate,
 					},
+
+					"auth": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"managed_identity_id": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.Any(
+										validation.IsUUID,
+										commonids.ValidateUserAssignedIdentityID,
+									),
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -0,0 +1,148 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the Storage Account Migrations API (used to convert the redundancy
+// of a Storage Account, e.g. from LRS to ZRS) is only available in API Version 2023-01-01 and later, which isn't
+// available in the version of the Azure SDK we're using
+type StorageAccountMigrationsWorkaroundClient struct {
+	sdkClient *storage.AccountsClient
+}
+
+func NewStorageAccountMigrationsWorkaroundClient(client *storage.AccountsClient) StorageAccountMigrationsWorkaroundClient {
+	return StorageAccountMigrationsWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+const storageAccountMigrationsAPIVersion = "2023-01-01"
+
+type StorageAccountMigrationStatus string
+
+const (
+	StorageAccountMigrationStatusComplete               StorageAccountMigrationStatus = "Complete"
+	StorageAccountMigrationStatusFailed                 StorageAccountMigrationStatus = "Failed"
+	StorageAccountMigrationStatusInProgress             StorageAccountMigrationStatus = "InProgress"
+	StorageAccountMigrationStatusInvalid                StorageAccountMigrationStatus = "Invalid"
+	StorageAccountMigrationStatusSubmittedForConversion StorageAccountMigrationStatus = "SubmittedForConversion"
+)
+
+type StorageAccountMigration struct {
+	autorest.Response `json:"-"`
+	Properties        *StorageAccountMigrationProperties `json:"properties,omitempty"`
+}
+
+type StorageAccountMigrationProperties struct {
+	TargetSkuName                 storage.SkuName                `json:"targetSkuName"`
+	MigrationStatus               *StorageAccountMigrationStatus `json:"migrationStatus,omitempty"`
+	MigrationFailedReason         *string                        `json:"migrationFailedReason,omitempty"`
+	MigrationFailedDetailedReason *string                        `json:"migrationFailedDetailedReason,omitempty"`
+}
+
+// Start submits a request to convert the redundancy of the specified Storage Account to the specified SKU - the
+// conversion itself happens asynchronously and its progress can be tracked using Get.
+func (c StorageAccountMigrationsWorkaroundClient) Start(ctx context.Context, resourceGroupName string, accountName string, targetSkuName storage.SkuName) (result autorest.Response, err error) {
+	req, err := c.startPreparer(ctx, resourceGroupName, accountName, targetSkuName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "CustomerInitiatedMigration", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "CustomerInitiatedMigration", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "CustomerInitiatedMigration", resp, "Failure responding to request")
+	}
+	return
+}
+
+// Get retrieves the status of the latest redundancy conversion for the specified Storage Account.
+func (c StorageAccountMigrationsWorkaroundClient) Get(ctx context.Context, resourceGroupName string, accountName string) (result StorageAccountMigration, err error) {
+	req, err := c.getPreparer(ctx, resourceGroupName, accountName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetCustomerInitiatedMigration", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.Send(req, azure.DoRetryWithRegistration(c.sdkClient.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetCustomerInitiatedMigration", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountsClient", "GetCustomerInitiatedMigration", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c StorageAccountMigrationsWorkaroundClient) startPreparer(ctx context.Context, resourceGroupName string, accountName string, targetSkuName storage.SkuName) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": storageAccountMigrationsAPIVersion,
+	}
+
+	parameters := StorageAccountMigration{
+		Properties: &StorageAccountMigrationProperties{
+			TargetSkuName: targetSkuName,
+		},
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}/startAccountMigration", pathParameters),
+		autorest.WithJSON(parameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c StorageAccountMigrationsWorkaroundClient) getPreparer(ctx context.Context, resourceGroupName string, accountName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"accountName":       autorest.Encode("path", accountName),
+		"migrationName":     autorest.Encode("path", "default"),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": storageAccountMigrationsAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}/accountMigrations/{migrationName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -3,10 +3,12 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"runtime"
 	"strings"
@@ -27,6 +29,7 @@ type BlobUpload struct {
 	ContainerName string
 
 	BlobType      string
+	BlockSize     int
 	CacheControl  string
 	ContentType   string
 	ContentMD5    string
@@ -151,6 +154,16 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// files larger than a single block are streamed from disk in blocks, rather than being read into memory
+	if info.Size() > int64(sbu.BlockSize) {
+		return sbu.blockUploadFromSource(ctx, file, info.Size())
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -364,6 +377,174 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+// maxBlocksPerBlob is the maximum number of blocks which can be committed to a Block Blob
+const maxBlocksPerBlob = 50000
+
+type storageBlobBlock struct {
+	id      string
+	md5     string
+	section *io.SectionReader
+}
+
+// blockUploadFromSource uploads the file as a series of blocks which are then committed to the blob. Since the
+// ID of each block is derived from its position and contents, any blocks uploaded by a previous (interrupted)
+// attempt which haven't been committed are re-used rather than being uploaded again.
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	blockSize := int64(sbu.BlockSize)
+	if blockCount := (fileSize + blockSize - 1) / blockSize; blockCount > maxBlocksPerBlob {
+		return fmt.Errorf("source file %q would be split into %d blocks which exceeds the maximum of %d blocks per blob - `block_size` must be increased", sbu.Source, blockCount, maxBlocksPerBlob)
+	}
+
+	blockList, contentMD5, err := sbu.storageBlobBlockSplit(file, fileSize)
+	if err != nil {
+		return fmt.Errorf("splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	if sbu.ContentMD5 != "" && sbu.ContentMD5 != contentMD5 {
+		return fmt.Errorf("the MD5 of the source file %q doesn't match the value of `content_md5`", sbu.Source)
+	}
+
+	// blocks which haven't been committed are retained for a week, so check for blocks uploaded previously
+	uncommittedBlocks := make(map[string]int64)
+	existing, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	})
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("retrieving uncommitted blocks: %s", err)
+		}
+	}
+	for _, block := range existing.UncommittedBlocks.Blocks {
+		uncommittedBlocks[block.Name] = block.Size
+	}
+
+	blockIds := make([]blobs.BlockID, 0, len(blockList))
+	pending := make([]storageBlobBlock, 0)
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{Value: block.id})
+		if size, ok := uncommittedBlocks[block.id]; ok && size == block.section.Size() {
+			continue
+		}
+		pending = append(pending, block)
+	}
+	log.Printf("[DEBUG] Uploading %d of %d blocks for Blob %q (Container %q / Account %q)", len(pending), len(blockList), sbu.BlobName, sbu.ContainerName, sbu.AccountName)
+
+	if len(pending) > 0 {
+		workerCount := sbu.Parallelism * runtime.NumCPU()
+		if workerCount > len(pending) {
+			workerCount = len(pending)
+		}
+
+		blocks := make(chan storageBlobBlock, len(pending))
+		errors := make(chan error, len(pending))
+		wg := &sync.WaitGroup{}
+		wg.Add(len(pending))
+
+		for _, block := range pending {
+			blocks <- block
+		}
+		close(blocks)
+
+		for i := 0; i < workerCount; i++ {
+			go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+				blocks: blocks,
+				errors: errors,
+				wg:     wg,
+			})
+		}
+
+		wg.Wait()
+
+		if len(errors) > 0 {
+			return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+		}
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentMD5:  utils.String(contentMD5),
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+// storageBlobBlockSplit reads through the file once, returning the blocks which make up the file and the Base64
+// encoded MD5 of the whole file - only a single block is held in memory at a time.
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64) ([]storageBlobBlock, string, error) {
+	blockSize := int64(sbu.BlockSize)
+	fileHash := md5.New()
+	buf := make([]byte, blockSize)
+
+	var blocks []storageBlobBlock
+	for offset := int64(0); offset < fileSize; offset += blockSize {
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		section := io.NewSectionReader(file, offset, length)
+		if _, err := io.ReadFull(section, buf[:length]); err != nil {
+			return nil, "", fmt.Errorf("Could not read chunk at %d: %s", offset, err)
+		}
+		fileHash.Write(buf[:length])
+		blockHash := md5.Sum(buf[:length])
+
+		// Block IDs must be the same length for every block within a blob
+		blockId := fmt.Sprintf("%05d-%x", len(blocks), blockHash)
+		blocks = append(blocks, storageBlobBlock{
+			id:      base64.StdEncoding.EncodeToString([]byte(blockId)),
+			md5:     base64.StdEncoding.EncodeToString(blockHash[:]),
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+
+	return blocks, base64.StdEncoding.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := io.ReadFull(block.section, chunk); err != nil {
+			uploadCtx.errors <- fmt.Errorf("reading source file %q for block %q: %s", sbu.Source, block.id, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		result, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err != nil {
+			uploadCtx.errors <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		// the service returns the MD5 of the content it received, which allows us to verify the block's integrity
+		if result.ContentMD5 != "" && result.ContentMD5 != block.md5 {
+			uploadCtx.errors <- fmt.Errorf("verifying block %q for file %q: expected the MD5 to be %q but got %q", block.id, sbu.Source, block.md5, result.ContentMD5)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -43,8 +43,18 @@ type Client struct {
 
 	ResourceManager *storage_v2022_05_01.Client
 
+	// the following clients are used to manage Containers, Queues and Shares when Data Plane access is disabled
+	BlobContainersClient *storage.BlobContainersClient
+	FileSharesRMClient   *storage.FileSharesClient
+	QueuesRMClient       *storage.QueueClient
+
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
+	storageAuthorizer         autorest.Authorizer
+
+	// dataPlaneAccessEnabled specifies whether Containers, Queues and Shares should be managed using the
+	// Storage Data Plane API - or when disabled, using the Resource Manager (Microsoft.Storage) API.
+	dataPlaneAccessEnabled bool
 }
 
 func NewClient(options *common.ClientOptions) *Client {
@@ -66,6 +76,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	blobInventoryPoliciesClient := storage.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobInventoryPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -78,6 +91,12 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileSharesRMClient := storage.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileSharesRMClient.Client, options.ResourceManagerAuthorizer)
+
+	queuesRMClient := storage.NewQueueClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&queuesRMClient.Client, options.ResourceManagerAuthorizer)
+
 	resourceManager := storage_v2022_05_01.NewClientWithBaseURI(options.ResourceManagerEndpoint,
 		func(c *autorest.Client) {
 			c.Authorizer = options.ResourceManagerAuthorizer
@@ -98,17 +117,22 @@ func NewClient(options *common.ClientOptions) *Client {
 		ADLSGen2PathsClient:         &adlsGen2PathsClient,
 		ManagementPoliciesClient:    &managementPoliciesClient,
 		BlobServicesClient:          &blobServicesClient,
+		BlobContainersClient:        &blobContainersClient,
 		BlobInventoryPoliciesClient: &blobInventoryPoliciesClient,
 		CloudEndpointsClient:        &cloudEndpointsClient,
 		EncryptionScopesClient:      &encryptionScopesClient,
 		Environment:                 options.AzureEnvironment,
 		FileServicesClient:          &fileServicesClient,
+		FileSharesRMClient:          &fileSharesRMClient,
+		QueuesRMClient:              &queuesRMClient,
 		ResourceManager:             &resourceManager,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
+		storageAuthorizer:         options.StorageAuthorizer,
+		dataPlaneAccessEnabled:    options.Features.Storage.DataPlaneAccessEnabled,
 	}
 
 	if options.StorageUseAzureAD {
@@ -118,10 +142,38 @@ func NewClient(options *common.ClientOptions) *Client {
 	return &client
 }
 
-func (client Client) AccountsDataPlaneClient(ctx context.Context, account accountDetails) (*accounts.Client, error) {
+// DataPlaneAccessEnabled returns whether Containers, Queues and Shares are managed using the Storage Data Plane API
+func (client Client) DataPlaneAccessEnabled() bool {
+	return client.dataPlaneAccessEnabled
+}
+
+// WithDataPlaneAccess returns a copy of this Client which manages Containers, Queues and Shares using either the
+// Storage Data Plane API (when enabled) or the Resource Manager API (when disabled).
+func (client Client) WithDataPlaneAccess(enabled bool) Client {
+	client.dataPlaneAccessEnabled = enabled
+	return client
+}
+
+// azureADAuthorizerForAccount returns the AzureAD Authorizer which should be used to access the Data Plane of the
+// specified Storage Account - which is used when `storage_use_azuread` is enabled, or when Shared Key access has been
+// disabled for this Storage Account, since the Account Key can't be used in that case. When this returns nil the
+// Account Key should be used instead.
+func (client Client) azureADAuthorizerForAccount(account accountDetails) *autorest.Authorizer {
 	if client.storageAdAuth != nil {
+		return client.storageAdAuth
+	}
+
+	if !account.sharedKeyAccessEnabled() {
+		return &client.storageAuthorizer
+	}
+
+	return nil
+}
+
+func (client Client) AccountsDataPlaneClient(ctx context.Context, account accountDetails) (*accounts.Client, error) {
+	if azureADAuth := client.azureADAuthorizerForAccount(account); azureADAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
-		accountsClient.Client.Authorizer = *client.storageAdAuth
+		accountsClient.Client.Authorizer = *azureADAuth
 		return &accountsClient, nil
 	}
 
@@ -141,9 +193,9 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 }
 
 func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*blobs.Client, error) {
-	if client.storageAdAuth != nil {
+	if azureADAuth := client.azureADAuthorizerForAccount(account); azureADAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
-		blobsClient.Client.Authorizer = *client.storageAdAuth
+		blobsClient.Client.Authorizer = *azureADAuth
 		return &blobsClient, nil
 	}
 
@@ -163,9 +215,13 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
-	if client.storageAdAuth != nil {
+	if !client.dataPlaneAccessEnabled {
+		return shim.NewResourceManagerStorageContainerWrapper(client.BlobContainersClient), nil
+	}
+
+	if azureADAuth := client.azureADAuthorizerForAccount(account); azureADAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
-		containersClient.Client.Authorizer = *client.storageAdAuth
+		containersClient.Client.Authorizer = *azureADAuth
 		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 		return shim, nil
 	}
@@ -189,6 +245,9 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
 	// NOTE: Files do not support AzureAD Authentication
+	if !account.sharedKeyAccessEnabled() {
+		return nil, fmt.Errorf("unable to access Files within Storage Account %q: Shared Key access is disabled, which is required since Files don't support AzureAD authentication", account.name)
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -207,6 +266,9 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 func (client Client) FileShareFilesClient(ctx context.Context, account accountDetails) (*files.Client, error) {
 	// NOTE: Files do not support AzureAD Authentication
+	if !account.sharedKeyAccessEnabled() {
+		return nil, fmt.Errorf("unable to access Files within Storage Account %q: Shared Key access is disabled, which is required since Files don't support AzureAD authentication", account.name)
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -224,7 +286,11 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 }
 
 func (client Client) FileSharesClient(ctx context.Context, account accountDetails) (shim.StorageShareWrapper, error) {
-	// NOTE: Files do not support AzureAD Authentication
+	// NOTE: Files do not support AzureAD Authentication, so when Shared Key access is disabled for this Storage
+	// Account, the File Shares are managed using the Resource Manager API instead
+	if !client.dataPlaneAccessEnabled || !account.sharedKeyAccessEnabled() {
+		return shim.NewResourceManagerStorageShareWrapper(client.FileSharesRMClient), nil
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -243,9 +309,13 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 }
 
 func (client Client) QueuesClient(ctx context.Context, account accountDetails) (shim.StorageQueuesWrapper, error) {
-	if client.storageAdAuth != nil {
+	if !client.dataPlaneAccessEnabled {
+		return shim.NewResourceManagerStorageQueueWrapper(client.QueuesRMClient), nil
+	}
+
+	if azureADAuth := client.azureADAuthorizerForAccount(account); azureADAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
-		queueClient.Client.Authorizer = *client.storageAdAuth
+		queueClient.Client.Authorizer = *azureADAuth
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -266,6 +336,9 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 func (client Client) TableEntityClient(ctx context.Context, account accountDetails) (*entities.Client, error) {
 	// NOTE: Table Entity does not support AzureAD Authentication
+	if !account.sharedKeyAccessEnabled() {
+		return nil, fmt.Errorf("unable to access Table Entities within Storage Account %q: Shared Key access is disabled, which is required since Table Entities don't support AzureAD authentication", account.name)
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
@@ -284,6 +357,9 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
 	// NOTE: Tables do not support AzureAD Authentication
+	if !account.sharedKeyAccessEnabled() {
+		return nil, fmt.Errorf("unable to access Tables within Storage Account %q: Shared Key access is disabled, which is required since Tables don't support AzureAD authentication", account.name)
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
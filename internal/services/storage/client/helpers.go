@@ -26,6 +26,16 @@ type accountDetails struct {
 	name       string
 }
 
+// sharedKeyAccessEnabled returns whether Shared Key access (e.g. using the Account Key) is enabled for this Storage
+// Account - which is the default when this isn't specified.
+func (ad accountDetails) sharedKeyAccessEnabled() bool {
+	if ad.Properties == nil || ad.Properties.AllowSharedKeyAccess == nil {
+		return true
+	}
+
+	return *ad.Properties.AllowSharedKeyAccess
+}
+
 func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*string, error) {
 	credentialsLock.Lock()
 	defer credentialsLock.Unlock()
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func DataPlaneAccessEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+	}
+}
+
+// dataPlaneAccessEnabled returns whether the Storage Data Plane API should be used to manage this resource, which is
+// the value of `data_plane_access_enabled` when it's specified - otherwise the value from the `features` block.
+func dataPlaneAccessEnabled(d *pluginsdk.ResourceData, storageClient *client.Client) bool {
+	// the Raw Config is available during Create/Update - otherwise (e.g. during a Refresh or Delete) fall back to the
+	// Raw State, which contains the value specified in the Config when this resource was last applied
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if v, ok := dataPlaneAccessEnabledFromRawValue(raw); ok {
+			return v
+		}
+	}
+
+	return storageClient.DataPlaneAccessEnabled()
+}
+
+func dataPlaneAccessEnabledFromRawValue(raw cty.Value) (bool, bool) {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute("data_plane_access_enabled") {
+		return false, false
+	}
+
+	v := raw.GetAttr("data_plane_access_enabled")
+	if v.IsNull() || !v.IsKnown() {
+		return false, false
+	}
+
+	return v.True(), true
+}
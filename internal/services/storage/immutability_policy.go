@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const immutabilityPolicyStateLocked = "Locked"
+
+// validateImmutabilityPolicyChange ensures that an Immutability Policy (defined in the block `key`) is only Locked when
+// this has been confirmed - and that once Locked, the policy is only changed in ways the API allows, since a Locked
+// policy can't be unlocked, removed or have its retention period reduced.
+func validateImmutabilityPolicyChange(d *pluginsdk.ResourceDiff, key string) error {
+	oldRaw, newRaw := d.GetChange(key)
+	oldPolicy := immutabilityPolicyFromRaw(oldRaw.([]interface{}))
+	newPolicy := immutabilityPolicyFromRaw(newRaw.([]interface{}))
+
+	if newPolicy != nil && newPolicy["state"].(string) == immutabilityPolicyStateLocked && !newPolicy["lock_confirmed"].(bool) {
+		return fmt.Errorf("`%[1]s.0.lock_confirmed` must be set to `true` to lock the Immutability Policy, since a Locked policy cannot be unlocked or removed", key)
+	}
+
+	if oldPolicy == nil || oldPolicy["state"].(string) != immutabilityPolicyStateLocked {
+		return nil
+	}
+
+	if newPolicy == nil {
+		return fmt.Errorf("`%s` cannot be removed once it's been Locked", key)
+	}
+	if newPolicy["state"].(string) != immutabilityPolicyStateLocked {
+		return fmt.Errorf("`%s.0.state` cannot be changed once the Immutability Policy has been Locked", key)
+	}
+	if newPolicy["period_since_creation_in_days"].(int) < oldPolicy["period_since_creation_in_days"].(int) {
+		return fmt.Errorf("`%s.0.period_since_creation_in_days` can only be increased once the Immutability Policy has been Locked", key)
+	}
+	if newPolicy["allow_protected_append_writes"].(bool) != oldPolicy["allow_protected_append_writes"].(bool) {
+		return fmt.Errorf("`%s.0.allow_protected_append_writes` cannot be changed once the Immutability Policy has been Locked", key)
+	}
+
+	return nil
+}
+
+func immutabilityPolicyFromRaw(input []interface{}) map[string]interface{} {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	return input[0].(map[string]interface{})
+}
+
+// immutabilityPolicyLockConfirmed returns the value of `lock_confirmed` from the existing Immutability Policy block
+// `key`, since this isn't returned by the API
+func immutabilityPolicyLockConfirmed(d *pluginsdk.ResourceData, key string) bool {
+	if policy := immutabilityPolicyFromRaw(d.Get(key).([]interface{})); policy != nil {
+		return policy["lock_confirmed"].(bool)
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+type ResourceManagerStorageContainerWrapper struct {
+	client *storage.BlobContainersClient
+}
+
+func NewResourceManagerStorageContainerWrapper(client *storage.BlobContainersClient) StorageContainerWrapper {
+	return ResourceManagerStorageContainerWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageContainerWrapper) Create(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput) error {
+	return w.create(ctx, resourceGroup, accountName, containerName, input, false)
+}
+
+// CreateWithImmutableStorageWithVersioning creates the specified Container with version-level immutability enabled,
+// which can only be enabled when the Container is created
+func (w ResourceManagerStorageContainerWrapper) CreateWithImmutableStorageWithVersioning(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput) error {
+	return w.create(ctx, resourceGroup, accountName, containerName, input, true)
+}
+
+func (w ResourceManagerStorageContainerWrapper) create(ctx context.Context, resourceGroup, accountName, containerName string, input containers.CreateInput, immutableStorageWithVersioning bool) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: w.mapAccessLevel(input.AccessLevel),
+			Metadata:     w.mapMetaData(input.MetaData),
+		},
+	}
+	if immutableStorageWithVersioning {
+		container.ContainerProperties.ImmutableStorageWithVersioning = &storage.ImmutableStorageWithVersioning{
+			Enabled: utils.Bool(true),
+		}
+	}
+
+	if _, err := w.client.Create(ctx, resourceGroup, accountName, containerName, container); err != nil {
+		return fmt.Errorf("creating container: %+v", err)
+	}
+
+	return nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Delete(ctx context.Context, resourceGroup, accountName, containerName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, containerName)
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) Exists(ctx context.Context, resourceGroup, accountName, containerName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return nil, err
+		}
+	}
+
+	exists := !utils.ResponseWasNotFound(existing.Response)
+	return &exists, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) Get(ctx context.Context, resourceGroup, accountName, containerName string) (*StorageContainerProperties, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	output := StorageContainerProperties{
+		AccessLevel: containers.Private,
+		MetaData:    map[string]string{},
+	}
+
+	if props := existing.ContainerProperties; props != nil {
+		switch props.PublicAccess {
+		case storage.PublicAccessBlob:
+			output.AccessLevel = containers.Blob
+		case storage.PublicAccessContainer:
+			output.AccessLevel = containers.Container
+		}
+
+		for k, v := range props.Metadata {
+			if v != nil {
+				output.MetaData[k] = *v
+			}
+		}
+
+		if props.HasImmutabilityPolicy != nil {
+			output.HasImmutabilityPolicy = *props.HasImmutabilityPolicy
+		}
+		if props.HasLegalHold != nil {
+			output.HasLegalHold = *props.HasLegalHold
+		}
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, resourceGroup, accountName, containerName string, level containers.AccessLevel) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: w.mapAccessLevel(level),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, containerName, container)
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, containerName string, metaData map[string]string) error {
+	container := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			Metadata: w.mapMetaData(metaData),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, containerName, container)
+	return err
+}
+
+func (w ResourceManagerStorageContainerWrapper) mapAccessLevel(level containers.AccessLevel) storage.PublicAccess {
+	switch level {
+	case containers.Blob:
+		return storage.PublicAccessBlob
+	case containers.Container:
+		return storage.PublicAccessContainer
+	}
+
+	return storage.PublicAccessNone
+}
+
+func (w ResourceManagerStorageContainerWrapper) mapMetaData(input map[string]string) map[string]*string {
+	// NOTE: this intentionally returns an empty map (rather than nil) so that any existing MetaData is removed
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+	return output
+}
@@ -0,0 +1,203 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
+)
+
+// shareAccessPolicyTimeFormat is the format the Storage Data Plane API uses for the Start/Expiry of an Access Policy
+const shareAccessPolicyTimeFormat = "2006-01-02T15:04:05.0000000Z07:00"
+
+type ResourceManagerStorageShareWrapper struct {
+	client *storage.FileSharesClient
+}
+
+func NewResourceManagerStorageShareWrapper(client *storage.FileSharesClient) StorageShareWrapper {
+	return ResourceManagerStorageShareWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageShareWrapper) Create(ctx context.Context, resourceGroup, accountName, shareName string, input shares.CreateInput) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			EnabledProtocols: storage.EnabledProtocols(input.EnabledProtocol),
+			Metadata:         w.mapMetaData(input.MetaData),
+			ShareQuota:       utils.Int32(int32(input.QuotaInGB)),
+		},
+	}
+	if input.AccessTier != nil {
+		share.FileShareProperties.AccessTier = storage.ShareAccessTier(*input.AccessTier)
+	}
+
+	_, err := w.client.Create(ctx, resourceGroup, accountName, shareName, share, "")
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Delete(ctx context.Context, resourceGroup, accountName, shareName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, shareName, "", "snapshots")
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageShareWrapper) Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	output := StorageShareProperties{
+		ACLs:     []shares.SignedIdentifier{},
+		MetaData: map[string]string{},
+	}
+	if props := existing.FileShareProperties; props != nil {
+		if props.AccessTier != "" {
+			tier := shares.AccessTier(props.AccessTier)
+			output.AccessTier = &tier
+		}
+
+		output.EnabledProtocol = shares.SMB
+		if props.EnabledProtocols != "" {
+			output.EnabledProtocol = shares.ShareProtocol(props.EnabledProtocols)
+		}
+
+		for k, v := range props.Metadata {
+			if v != nil {
+				output.MetaData[k] = *v
+			}
+		}
+
+		if props.ShareQuota != nil {
+			output.QuotaGB = int(*props.ShareQuota)
+		}
+
+		if props.SignedIdentifiers != nil {
+			for _, v := range *props.SignedIdentifiers {
+				acl := shares.SignedIdentifier{}
+				if v.ID != nil {
+					acl.Id = *v.ID
+				}
+				if policy := v.AccessPolicy; policy != nil {
+					if policy.StartTime != nil {
+						acl.AccessPolicy.Start = policy.StartTime.UTC().Format(shareAccessPolicyTimeFormat)
+					}
+					if policy.ExpiryTime != nil {
+						acl.AccessPolicy.Expiry = policy.ExpiryTime.UTC().Format(shareAccessPolicyTimeFormat)
+					}
+					if policy.Permission != nil {
+						acl.AccessPolicy.Permission = *policy.Permission
+					}
+				}
+				output.ACLs = append(output.ACLs, acl)
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error {
+	identifiers := make([]storage.SignedIdentifier, 0)
+	for _, v := range acls {
+		policy := storage.AccessPolicy{
+			Permission: utils.String(v.AccessPolicy.Permission),
+		}
+
+		if v.AccessPolicy.Start != "" {
+			start, err := time.Parse(time.RFC3339, v.AccessPolicy.Start)
+			if err != nil {
+				return fmt.Errorf("parsing `start` %q for the Access Policy %q: %+v", v.AccessPolicy.Start, v.Id, err)
+			}
+			policy.StartTime = &date.Time{Time: start}
+		}
+
+		if v.AccessPolicy.Expiry != "" {
+			expiry, err := time.Parse(time.RFC3339, v.AccessPolicy.Expiry)
+			if err != nil {
+				return fmt.Errorf("parsing `expiry` %q for the Access Policy %q: %+v", v.AccessPolicy.Expiry, v.Id, err)
+			}
+			policy.ExpiryTime = &date.Time{Time: expiry}
+		}
+
+		identifiers = append(identifiers, storage.SignedIdentifier{
+			ID:           utils.String(v.Id),
+			AccessPolicy: &policy,
+		})
+	}
+
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			SignedIdentifiers: &identifiers,
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			Metadata: w.mapMetaData(metaData),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			ShareQuota: utils.Int32(int32(quotaGB)),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateTier(ctx context.Context, resourceGroup, accountName, shareName string, tier shares.AccessTier) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			AccessTier: storage.ShareAccessTier(tier),
+		},
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) mapMetaData(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+	return output
+}
@@ -49,13 +49,24 @@ func resourceStorageAccountCustomerManagedKey() *pluginsdk.Resource {
 
 			"key_vault_id": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ValidateFunc: validation.Any(
 					// Storage Account Customer Managed Keys support both Key Vault and Key Vault Managed HSM keys:
 					// https://learn.microsoft.com/en-us/azure/storage/common/customer-managed-keys-overview
 					keyVaultValidate.VaultID,
 					keyVaultValidate.ManagedHSMID,
 				),
+				ExactlyOneOf: []string{"key_vault_id", "key_vault_uri"},
+			},
+
+			// the Key Vault can't be looked up when it's in another tenant, so it can be specified using its URI instead
+			"key_vault_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				ExactlyOneOf: []string{"key_vault_id", "key_vault_uri"},
 			},
 
 			"key_name": {
@@ -75,6 +86,13 @@ func resourceStorageAccountCustomerManagedKey() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 			},
+
+			"federated_identity_client_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				RequiredWith: []string{"user_assigned_identity_id"},
+			},
 		},
 	}
 }
@@ -116,43 +134,59 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceD
 		}
 	}
 
-	keyVaultID, err := keyVaultParse.VaultID(d.Get("key_vault_id").(string))
-	if err != nil {
-		return err
-	}
+	keyVaultURI := ""
+	if !d.GetRawConfig().AsValueMap()["key_vault_uri"].IsNull() {
+		// a Key Vault in another tenant can't be retrieved, so it's up to the user to ensure it's configured correctly
+		keyVaultURI = d.Get("key_vault_uri").(string)
+	} else {
+		keyVaultID, err := keyVaultParse.VaultID(d.Get("key_vault_id").(string))
+		if err != nil {
+			return err
+		}
 
-	// If the Keyvault is in another subscription we need to update the client
-	if keyVaultID.SubscriptionId != vaultsClient.SubscriptionID {
-		vaultsClient = meta.(*clients.Client).KeyVault.KeyVaultClientForSubscription(keyVaultID.SubscriptionId)
-	}
+		// If the Keyvault is in another subscription we need to update the client
+		if keyVaultID.SubscriptionId != vaultsClient.SubscriptionID {
+			vaultsClient = meta.(*clients.Client).KeyVault.KeyVaultClientForSubscription(keyVaultID.SubscriptionId)
+		}
 
-	keyVault, err := vaultsClient.Get(ctx, keyVaultID.ResourceGroup, keyVaultID.Name)
-	if err != nil {
-		return fmt.Errorf("retrieving Key Vault %q (Resource Group %q): %+v", keyVaultID.Name, keyVaultID.ResourceGroup, err)
-	}
+		keyVault, err := vaultsClient.Get(ctx, keyVaultID.ResourceGroup, keyVaultID.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving Key Vault %q (Resource Group %q): %+v", keyVaultID.Name, keyVaultID.ResourceGroup, err)
+		}
 
-	softDeleteEnabled := false
-	purgeProtectionEnabled := false
-	if props := keyVault.Properties; props != nil {
-		if esd := props.EnableSoftDelete; esd != nil {
-			softDeleteEnabled = *esd
+		softDeleteEnabled := false
+		purgeProtectionEnabled := false
+		if props := keyVault.Properties; props != nil {
+			if esd := props.EnableSoftDelete; esd != nil {
+				softDeleteEnabled = *esd
+			}
+			if epp := props.EnablePurgeProtection; epp != nil {
+				purgeProtectionEnabled = *epp
+			}
 		}
-		if epp := props.EnablePurgeProtection; epp != nil {
-			purgeProtectionEnabled = *epp
+		if !softDeleteEnabled || !purgeProtectionEnabled {
+			return fmt.Errorf("Key Vault %q (Resource Group %q) must be configured for both Purge Protection and Soft Delete", keyVaultID.Name, keyVaultID.ResourceGroup)
 		}
-	}
-	if !softDeleteEnabled || !purgeProtectionEnabled {
-		return fmt.Errorf("Key Vault %q (Resource Group %q) must be configured for both Purge Protection and Soft Delete", keyVaultID.Name, keyVaultID.ResourceGroup)
-	}
 
-	keyVaultBaseURL, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultID)
-	if err != nil {
-		return fmt.Errorf("looking up Key Vault URI from Key Vault %q (Resource Group %q) (Subscription %q): %+v", keyVaultID.Name, keyVaultID.ResourceGroup, keyVaultsClient.VaultsClient.SubscriptionID, err)
+		keyVaultBaseURL, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultID)
+		if err != nil {
+			return fmt.Errorf("looking up Key Vault URI from Key Vault %q (Resource Group %q) (Subscription %q): %+v", keyVaultID.Name, keyVaultID.ResourceGroup, keyVaultsClient.VaultsClient.SubscriptionID, err)
+		}
+		keyVaultURI = *keyVaultBaseURL
 	}
 
 	keyName := d.Get("key_name").(string)
 	keyVersion := d.Get("key_version").(string)
 	userAssignedIdentity := d.Get("user_assigned_identity_id").(string)
+	federatedIdentityClientId := d.Get("federated_identity_client_id").(string)
+
+	encryptionIdentity := &storage.EncryptionIdentity{
+		EncryptionUserAssignedIdentity: utils.String(userAssignedIdentity),
+	}
+	if federatedIdentityClientId != "" {
+		// the multi-tenant application used alongside the User Assigned Identity to access a Key Vault in another tenant
+		encryptionIdentity.EncryptionFederatedIdentityClientID = utils.String(federatedIdentityClientId)
+	}
 
 	props := storage.AccountUpdateParameters{
 		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -165,14 +199,12 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceD
 						Enabled: utils.Bool(true),
 					},
 				},
-				EncryptionIdentity: &storage.EncryptionIdentity{
-					EncryptionUserAssignedIdentity: utils.String(userAssignedIdentity),
-				},
-				KeySource: storage.KeySourceMicrosoftKeyvault,
+				EncryptionIdentity: encryptionIdentity,
+				KeySource:          storage.KeySourceMicrosoftKeyvault,
 				KeyVaultProperties: &storage.KeyVaultProperties{
 					KeyName:     utils.String(keyName),
 					KeyVersion:  utils.String(keyVersion),
-					KeyVaultURI: utils.String(*keyVaultBaseURL),
+					KeyVaultURI: utils.String(keyVaultURI),
 				},
 			},
 		},
@@ -235,10 +267,14 @@ func resourceStorageAccountCustomerManagedKeyRead(d *pluginsdk.ResourceData, met
 	}
 
 	userAssignedIdentity := ""
+	federatedIdentityClientId := ""
 	if props := encryption.EncryptionIdentity; props != nil {
 		if props.EncryptionUserAssignedIdentity != nil {
 			userAssignedIdentity = *props.EncryptionUserAssignedIdentity
 		}
+		if props.EncryptionFederatedIdentityClientID != nil {
+			federatedIdentityClientId = *props.EncryptionFederatedIdentityClientID
+		}
 	}
 
 	if keyVaultURI == "" {
@@ -250,13 +286,15 @@ func resourceStorageAccountCustomerManagedKeyRead(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("retrieving Key Vault ID from the Base URI %q: %+v", keyVaultURI, err)
 	}
 
-	// now we have the key vault uri we can look up the ID
+	// now we have the key vault uri we can look up the ID - which won't be found when the Key Vault is in another tenant
 
 	d.Set("storage_account_id", d.Id())
 	d.Set("key_vault_id", keyVaultID)
+	d.Set("key_vault_uri", keyVaultURI)
 	d.Set("key_name", keyName)
 	d.Set("key_version", keyVersion)
 	d.Set("user_assigned_identity_id", userAssignedIdentity)
+	d.Set("federated_identity_client_id", federatedIdentityClientId)
 
 	return nil
 }
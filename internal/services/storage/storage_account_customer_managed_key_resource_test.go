@@ -40,6 +40,21 @@ func TestAccStorageAccountCustomerManagedKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccountCustomerManagedKey_keyVaultUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_customer_managed_key", "test")
+	r := StorageAccountCustomerManagedKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultUri(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageAccountCustomerManagedKey_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account_customer_managed_key", "test")
 	r := StorageAccountCustomerManagedKeyResource{}
@@ -210,6 +225,20 @@ resource "azurerm_storage_account_customer_managed_key" "test" {
 `, template)
 }
 
+func (r StorageAccountCustomerManagedKeyResource) keyVaultUri(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  key_vault_uri      = azurerm_key_vault.test.vault_uri
+  key_name           = azurerm_key_vault_key.first.name
+  key_version        = azurerm_key_vault_key.first.version
+}
+`, template)
+}
+
 func (r StorageAccountCustomerManagedKeyResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyvault "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
@@ -29,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	vnetParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	resource "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -46,6 +48,20 @@ var (
 	storageAccountResourceName = "azurerm_storage_account"
 )
 
+// storageAccountZoneRedundantMigrations is a map of the Replication Types which can be converted in-place (using a
+// customer-initiated migration) to the zone-redundant Replication Type, rather than requiring the Storage Account
+// to be recreated.
+var storageAccountZoneRedundantMigrations = map[string]string{
+	"LRS":   "ZRS",
+	"GRS":   "GZRS",
+	"RAGRS": "RAGZRS",
+}
+
+func storageAccountReplicationTypeCanBeMigrated(old, new string) bool {
+	target, ok := storageAccountZoneRedundantMigrations[strings.ToUpper(old)]
+	return ok && strings.EqualFold(target, new)
+}
+
 type storageAccountServiceSupportLevel struct {
 	supportBlob          bool
 	supportQueue         bool
@@ -270,6 +286,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 							Required:     true,
 							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 						},
+
+						"federated_identity_client_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
 					},
 				},
 			},
@@ -287,7 +309,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				MaxItems: 1,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"period_since_creation_in_days": {
@@ -307,6 +328,11 @@ func resourceStorageAccount() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeBool,
 							Required: true,
 						},
+						"lock_confirmed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -759,7 +785,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			"static_website": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				MaxItems: 1,
+				// the Static Website can also be managed by the `azurerm_storage_account_static_website` resource
+				Computed:   features.FourPointOhBeta(),
+				Deprecated: features.DeprecatedInFourPointOh("the `static_website` block has been superseded by the `azurerm_storage_account_static_website` resource and will be removed in v5.0 of the AzureRM Provider"),
+				MaxItems:   1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"index_document": {
@@ -830,6 +859,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"key_expiration_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"allowed_copy_scope": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -845,6 +880,8 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"data_plane_access_enabled": DataPlaneAccessEnabledSchema(),
+
 			"large_file_share_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1045,15 +1082,34 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
+
+				if d.HasChange("immutability_policy") {
+					if err := validateImmutabilityPolicyChange(d, "immutability_policy"); err != nil {
+						return err
+					}
+				}
+
+				if d.HasChange("key_expiration_period_in_days") {
+					// the Track1 SDK has no way to represent a `null` value in the payload, so the Key Policy can't be removed once set
+					oldPeriod, newPeriod := d.GetChange("key_expiration_period_in_days")
+					if oldPeriod.(int) != 0 && newPeriod.(int) == 0 {
+						return fmt.Errorf("`key_expiration_period_in_days` cannot be removed once it's been set")
+					}
+				}
 				return nil
 			}),
+			// account-level immutability can only be enabled or disabled when the Storage Account is created
+			pluginsdk.ForceNewIfChange("immutability_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
 				switch strings.ToUpper(old.(string)) {
 				case "LRS", "GRS", "RAGRS":
 					if newAccRep == "GZRS" || newAccRep == "RAGZRS" || newAccRep == "ZRS" {
-						return true
+						// converting to the zone-redundant equivalent can be done in-place using a customer-initiated migration
+						return !storageAccountReplicationTypeCanBeMigrated(old.(string), new.(string))
 					}
 				case "ZRS", "GZRS", "RAGZRS":
 					if newAccRep == "LRS" || newAccRep == "GRS" || newAccRep == "RAGRS" {
@@ -1140,6 +1196,12 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.AccountPropertiesCreateParameters.AllowedCopyScope = storage.AllowedCopyScope(v)
 	}
 
+	if v := d.Get("key_expiration_period_in_days").(int); v != 0 {
+		parameters.AccountPropertiesCreateParameters.KeyPolicy = &storage.KeyPolicy{
+			KeyExpirationPeriodInDays: utils.Int32(int32(v)),
+		}
+	}
+
 	// For all Clouds except Public, China, and USGovernmentCloud, don't specify "allow_blob_public_access" and "min_tls_version" in request body.
 	// https://github.com/hashicorp/terraform-provider-azurerm/issues/7812
 	// https://github.com/hashicorp/terraform-provider-azurerm/issues/8083
@@ -1172,8 +1234,15 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.CustomDomain = expandStorageAccountCustomDomain(d)
 	}
 
+	lockImmutabilityPolicy := false
 	if v, ok := d.GetOk("immutability_policy"); ok {
 		parameters.ImmutableStorageWithVersioning = expandStorageAccountImmutabilityPolicy(v.([]interface{}))
+
+		// an Immutability Policy can't be created in a Locked state - so it's created Unlocked and then Locked below
+		if policy := parameters.ImmutableStorageWithVersioning.ImmutabilityPolicy; policy.State == storage.AccountImmutabilityPolicyStateLocked {
+			policy.State = storage.AccountImmutabilityPolicyStateUnlocked
+			lockImmutabilityPolicy = true
+		}
 	}
 
 	// BlobStorage does not support ZRS
@@ -1305,6 +1374,19 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	d.SetId(id.ID())
 
+	if lockImmutabilityPolicy {
+		log.Printf("[DEBUG] Locking the Immutability Policy for %s..", id)
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{})),
+			},
+		}
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("locking the Immutability Policy for %s: %+v", id, err)
+		}
+		log.Printf("[DEBUG] Locked the Immutability Policy for %s.", id)
+	}
+
 	// populate the cache
 	account, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -1359,6 +1441,9 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("`queue_properties` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
 		storageClient := meta.(*clients.Client).Storage
+		if !dataPlaneAccessEnabled(d, storageClient) {
+			return fmt.Errorf("`queue_properties` can only be configured when Data Plane access is enabled")
+		}
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
@@ -1367,7 +1452,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("Unable to locate Storage Account %q!", id.Name)
 		}
 
-		queueClient, err := storageClient.QueuesClient(ctx, *account)
+		queueClient, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("building Queues Client: %s", err)
 		}
@@ -1415,6 +1500,9 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("`static_website` aren't supported for account kind %q in sku tier %q", accountKind, accountTier)
 		}
 		storageClient := meta.(*clients.Client).Storage
+		if !dataPlaneAccessEnabled(d, storageClient) {
+			return fmt.Errorf("`static_website` can only be configured when Data Plane access is enabled")
+		}
 
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
@@ -1512,17 +1600,31 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("updating Azure Storage Account AllowSharedKeyAccess %q: %+v", id.Name, err)
 	}
 
+	if d.HasChange("shared_access_key_enabled") {
+		// the cached account details determine which authentication method is used for the Data Plane API
+		meta.(*clients.Client).Storage.RemoveAccountFromCache(id.Name)
+	}
+
 	if d.HasChange("account_replication_type") {
-		sku := storage.Sku{
-			Name: storage.SkuName(storageType),
-		}
+		oldReplicationType, _ := d.GetChange("account_replication_type")
+		if storageAccountReplicationTypeCanBeMigrated(oldReplicationType.(string), replicationType) {
+			log.Printf("[DEBUG] Migrating the Replication Type for %s from %q to %q..", id, oldReplicationType.(string), replicationType)
+			if err := migrateStorageAccountReplicationType(ctx, client, *id, storage.SkuName(storageType)); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Migrated the Replication Type for %s from %q to %q.", id, oldReplicationType.(string), replicationType)
+		} else {
+			sku := storage.Sku{
+				Name: storage.SkuName(storageType),
+			}
 
-		opts := storage.AccountUpdateParameters{
-			Sku: &sku,
-		}
+			opts := storage.AccountUpdateParameters{
+				Sku: &sku,
+			}
 
-		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
-			return fmt.Errorf("updating Azure Storage Account type %q: %+v", id.Name, err)
+			if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+				return fmt.Errorf("updating Azure Storage Account type %q: %+v", id.Name, err)
+			}
 		}
 	}
 
@@ -1784,6 +1886,32 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("immutability_policy") {
+		// NOTE: adding or removing the Immutability Policy forces a new resource to be created, so this only needs to
+		// handle updating an existing policy (including Locking it)
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				ImmutableStorageWithVersioning: expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{})),
+			},
+		}
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account immutability_policy %q: %+v", id.Name, err)
+		}
+	}
+
+	if d.HasChange("key_expiration_period_in_days") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				KeyPolicy: &storage.KeyPolicy{
+					KeyExpirationPeriodInDays: utils.Int32(int32(d.Get("key_expiration_period_in_days").(int))),
+				},
+			},
+		}
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account key_expiration_period_in_days %q: %+v", id.Name, err)
+		}
+	}
+
 	if d.HasChange("allowed_copy_scope") {
 		// TODO: Currently, due to Track1 SDK has no way to represent a `null` value in the payload - instead it will be omitted, `allowed_copy_scope` can not be disabled once enabled.
 		opts := storage.AccountUpdateParameters{
@@ -1840,6 +1968,10 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 
 		storageClient := meta.(*clients.Client).Storage
+		if !dataPlaneAccessEnabled(d, storageClient) {
+			return fmt.Errorf("`queue_properties` can only be configured when Data Plane access is enabled")
+		}
+
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
 			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
@@ -1848,7 +1980,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("Unable to locate Storage Account %q!", id.Name)
 		}
 
-		queueClient, err := storageClient.QueuesClient(ctx, *account)
+		queueClient, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("building Queues Client: %s", err)
 		}
@@ -1894,6 +2026,9 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 
 		storageClient := meta.(*clients.Client).Storage
+		if !dataPlaneAccessEnabled(d, storageClient) {
+			return fmt.Errorf("`static_website` can only be configured when Data Plane access is enabled")
+		}
 
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
@@ -2034,7 +2169,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 
 		if immutabilityPolicy := props.ImmutableStorageWithVersioning; immutabilityPolicy != nil && immutabilityPolicy.ImmutabilityPolicy != nil {
-			if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(props.ImmutableStorageWithVersioning)); err != nil {
+			if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(props.ImmutableStorageWithVersioning, immutabilityPolicyLockConfirmed(d, "immutability_policy"))); err != nil {
 				return fmt.Errorf("setting `immutability_policy`: %+v", err)
 			}
 		}
@@ -2138,6 +2273,12 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `sas_policy`: %+v", err)
 		}
 
+		keyExpirationPeriodInDays := 0
+		if policy := props.KeyPolicy; policy != nil && policy.KeyExpirationPeriodInDays != nil {
+			keyExpirationPeriodInDays = int(*policy.KeyExpirationPeriodInDays)
+		}
+		d.Set("key_expiration_period_in_days", keyExpirationPeriodInDays)
+
 		d.Set("allowed_copy_scope", props.AllowedCopyScope)
 		d.Set("sftp_enabled", props.IsSftpEnabled)
 	}
@@ -2171,6 +2312,9 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 	supportLevel := resolveStorageAccountServiceSupportLevel(resp.Kind, tier)
 
+	// the Queue Properties and Static Website can only be retrieved from the Data Plane API
+	dataPlaneAvailable := dataPlaneAccessEnabled(d, storageClient)
+
 	if supportLevel.supportBlob {
 		blobClient := storageClient.BlobServicesClient
 		blobProps, err := blobClient.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
@@ -2182,8 +2326,8 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportQueue {
-		queueClient, err := storageClient.QueuesClient(ctx, *account)
+	if supportLevel.supportQueue && dataPlaneAvailable {
+		queueClient, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("building Queues Client: %s", err)
 		}
@@ -2211,7 +2355,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if supportLevel.supportStaticWebsite {
+	if supportLevel.supportStaticWebsite && dataPlaneAvailable {
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, id.Name)
 		if err != nil {
@@ -2302,6 +2446,85 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// migrateStorageAccountReplicationType converts the redundancy of the Storage Account to the specified SKU using a
+// customer-initiated migration, and then waits for this migration to complete - which can take a considerable time.
+func migrateStorageAccountReplicationType(ctx context.Context, accountsClient *storage.AccountsClient, id parse.StorageAccountId, targetSku storage.SkuName) error {
+	client := azuresdkhacks.NewStorageAccountMigrationsWorkaroundClient(accountsClient)
+
+	// a previous apply may have timed out whilst waiting for this migration, in which case we only need to wait for it
+	inProgress := false
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("retrieving the Account Migration for %s: %+v", id, err)
+	}
+	if props := existing.Properties; props != nil && props.MigrationStatus != nil && strings.EqualFold(string(props.TargetSkuName), string(targetSku)) {
+		switch *props.MigrationStatus {
+		case azuresdkhacks.StorageAccountMigrationStatusSubmittedForConversion, azuresdkhacks.StorageAccountMigrationStatusInProgress:
+			inProgress = true
+		}
+	}
+
+	if !inProgress {
+		if _, err := client.Start(ctx, id.ResourceGroup, id.Name, targetSku); err != nil {
+			return fmt.Errorf("starting the migration of %s to %q: %+v", id, string(targetSku), err)
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(azuresdkhacks.StorageAccountMigrationStatusSubmittedForConversion),
+			string(azuresdkhacks.StorageAccountMigrationStatusInProgress),
+		},
+		Target: []string{
+			string(azuresdkhacks.StorageAccountMigrationStatusComplete),
+		},
+		Refresh:                   storageAccountMigrationStateRefreshFunc(ctx, client, id),
+		MinTimeout:                1 * time.Minute,
+		ContinuousTargetOccurence: 1,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the migration of %s to %q to complete: %+v", id, string(targetSku), err)
+	}
+
+	return nil
+}
+
+func storageAccountMigrationStateRefreshFunc(ctx context.Context, client azuresdkhacks.StorageAccountMigrationsWorkaroundClient, id parse.StorageAccountId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				// the migration can take a few moments to be registered
+				return resp, string(azuresdkhacks.StorageAccountMigrationStatusSubmittedForConversion), nil
+			}
+			return nil, "", fmt.Errorf("retrieving the Account Migration for %s: %+v", id, err)
+		}
+
+		if resp.Properties == nil || resp.Properties.MigrationStatus == nil {
+			return nil, "", fmt.Errorf("retrieving the Account Migration for %s: `properties.migrationStatus` was nil", id)
+		}
+
+		status := *resp.Properties.MigrationStatus
+		if status == azuresdkhacks.StorageAccountMigrationStatusFailed || status == azuresdkhacks.StorageAccountMigrationStatusInvalid {
+			reason := ""
+			if v := resp.Properties.MigrationFailedReason; v != nil {
+				reason = *v
+			}
+			if v := resp.Properties.MigrationFailedDetailedReason; v != nil {
+				reason = fmt.Sprintf("%s: %s", reason, *v)
+			}
+			return resp, string(status), fmt.Errorf("the migration of %s failed: %s", id, reason)
+		}
+
+		return resp, string(status), nil
+	}
+}
+
 func expandStorageAccountCustomDomain(d *pluginsdk.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if len(domains) == 0 {
@@ -2342,41 +2565,46 @@ func expandStorageAccountCustomerManagedKey(ctx context.Context, keyVaultClient
 		return nil, err
 	}
 
-	keyVaultIdRaw, err := keyVaultClient.KeyVaultIDFromBaseUrl(ctx, resourceClient, keyId.KeyVaultBaseUrl)
-	if err != nil {
-		return nil, err
-	}
-	if keyVaultIdRaw == nil {
-		return nil, fmt.Errorf("unexpected nil Key Vault ID retrieved at URL %s", keyId.KeyVaultBaseUrl)
-	}
-	keyVaultId, err := keyVaultParse.VaultID(*keyVaultIdRaw)
-	if err != nil {
-		return nil, err
-	}
+	federatedIdentityClientId := v["federated_identity_client_id"].(string)
 
-	vaultsClient := keyVaultClient.VaultsClient
-	if keyVaultId.SubscriptionId != vaultsClient.SubscriptionID {
-		vaultsClient = keyVaultClient.KeyVaultClientForSubscription(keyVaultId.SubscriptionId)
-	}
+	// when a federated identity is used the Key Vault is in another tenant, where it can't be looked up
+	if federatedIdentityClientId == "" {
+		keyVaultIdRaw, err := keyVaultClient.KeyVaultIDFromBaseUrl(ctx, resourceClient, keyId.KeyVaultBaseUrl)
+		if err != nil {
+			return nil, err
+		}
+		if keyVaultIdRaw == nil {
+			return nil, fmt.Errorf("unexpected nil Key Vault ID retrieved at URL %s", keyId.KeyVaultBaseUrl)
+		}
+		keyVaultId, err := keyVaultParse.VaultID(*keyVaultIdRaw)
+		if err != nil {
+			return nil, err
+		}
 
-	keyVault, err := vaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
-	if err != nil {
-		return nil, fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
-	}
+		vaultsClient := keyVaultClient.VaultsClient
+		if keyVaultId.SubscriptionId != vaultsClient.SubscriptionID {
+			vaultsClient = keyVaultClient.KeyVaultClientForSubscription(keyVaultId.SubscriptionId)
+		}
+
+		keyVault, err := vaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
+		}
 
-	softDeleteEnabled := false
-	purgeProtectionEnabled := false
-	if props := keyVault.Properties; props != nil {
-		if esd := props.EnableSoftDelete; esd != nil {
-			softDeleteEnabled = *esd
+		softDeleteEnabled := false
+		purgeProtectionEnabled := false
+		if props := keyVault.Properties; props != nil {
+			if esd := props.EnableSoftDelete; esd != nil {
+				softDeleteEnabled = *esd
+			}
+			if epp := props.EnablePurgeProtection; epp != nil {
+				purgeProtectionEnabled = *epp
+			}
 		}
-		if epp := props.EnablePurgeProtection; epp != nil {
-			purgeProtectionEnabled = *epp
+		if !softDeleteEnabled || !purgeProtectionEnabled {
+			return nil, fmt.Errorf("%s must be configured for both Purge Protection and Soft Delete", *keyVaultId)
 		}
 	}
-	if !softDeleteEnabled || !purgeProtectionEnabled {
-		return nil, fmt.Errorf("%s must be configured for both Purge Protection and Soft Delete", *keyVaultId)
-	}
 
 	encryption := &storage.Encryption{
 		Services: &storage.EncryptionServices{
@@ -2400,6 +2628,10 @@ func expandStorageAccountCustomerManagedKey(ctx context.Context, keyVaultClient
 		},
 	}
 
+	if federatedIdentityClientId != "" {
+		encryption.EncryptionIdentity.EncryptionFederatedIdentityClientID = utils.String(federatedIdentityClientId)
+	}
+
 	return encryption, nil
 }
 
@@ -2422,7 +2654,7 @@ func expandStorageAccountImmutabilityPolicy(input []interface{}) *storage.Immuta
 	return &immutableStorageAccount
 }
 
-func flattenStorageAccountImmutabilityPolicy(policy *storage.ImmutableStorageAccount) []interface{} {
+func flattenStorageAccountImmutabilityPolicy(policy *storage.ImmutableStorageAccount, lockConfirmed bool) []interface{} {
 	if policy == nil || policy.ImmutabilityPolicy == nil {
 		return make([]interface{}, 0)
 	}
@@ -2432,6 +2664,7 @@ func flattenStorageAccountImmutabilityPolicy(policy *storage.ImmutableStorageAcc
 			"period_since_creation_in_days": policy.ImmutabilityPolicy.ImmutabilityPeriodSinceCreationInDays,
 			"state":                         policy.ImmutabilityPolicy.State,
 			"allow_protected_append_writes": policy.ImmutabilityPolicy.AllowProtectedAppendWrites,
+			"lock_confirmed":                lockConfirmed,
 		},
 	}
 }
@@ -2442,6 +2675,7 @@ func flattenStorageAccountCustomerManagedKey(storageAccountId *parse.StorageAcco
 	}
 
 	userAssignedIdentityId := ""
+	federatedIdentityClientId := ""
 	keyName := ""
 	keyVaultURI := ""
 	keyVersion := ""
@@ -2450,6 +2684,9 @@ func flattenStorageAccountCustomerManagedKey(storageAccountId *parse.StorageAcco
 		if props.EncryptionUserAssignedIdentity != nil {
 			userAssignedIdentityId = *props.EncryptionUserAssignedIdentity
 		}
+		if props.EncryptionFederatedIdentityClientID != nil {
+			federatedIdentityClientId = *props.EncryptionFederatedIdentityClientID
+		}
 	}
 
 	if props := input.KeyVaultProperties; props != nil {
@@ -2475,8 +2712,9 @@ func flattenStorageAccountCustomerManagedKey(storageAccountId *parse.StorageAcco
 
 	return []interface{}{
 		map[string]interface{}{
-			"key_vault_key_id":          keyId.ID(),
-			"user_assigned_identity_id": userAssignedIdentityId,
+			"key_vault_key_id":             keyId.ID(),
+			"user_assigned_identity_id":    userAssignedIdentityId,
+			"federated_identity_client_id": federatedIdentityClientId,
 		},
 	}, nil
 }
@@ -813,6 +813,31 @@ func TestAccStorageAccount_replicationTypeGZRS(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccount_replicationTypeMigrateToZRS(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.replicationTypeMigration(data, "LRS"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_replication_type").HasValue("LRS"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// this is converted in-place using a customer-initiated migration, rather than recreating the Storage Account
+			Config: r.replicationTypeMigration(data, "ZRS"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_replication_type").HasValue("ZRS"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageAccount_largeFileShare(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
 	r := StorageAccountResource{}
@@ -1193,9 +1218,17 @@ func TestAccStorageAccount_immutabilityPolicy(t *testing.T) {
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.immutabilityPolicy(data),
+			Config: r.immutabilityPolicy(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.immutabilityPolicy(data, 5),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_policy.0.period_since_creation_in_days").HasValue("5"),
 			),
 		},
 		data.ImportStep(),
@@ -1395,6 +1428,53 @@ func TestAccStorageAccount_sasPolicy(t *testing.T) {
 	})
 }
 
+func TestAccStorageAccount_keyExpirationPeriod(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyExpirationPeriod(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_expiration_period_in_days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyExpirationPeriod(data, 90),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_expiration_period_in_days").HasValue("90"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccount_sharedKeyAccessDisabledWithSubResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sharedKeyAccessDisabledWithSubResources(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("shared_access_key_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageAccount_allowedCopyScope(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
 	r := StorageAccountResource{}
@@ -3084,6 +3164,33 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) replicationTypeMigration(data acceptance.TestData, replicationType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "%s"
+
+  timeouts {
+    update = "72h"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, replicationType)
+}
+
 func (r StorageAccountResource) largeFileShareDisabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -3693,7 +3800,7 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
-func (r StorageAccountResource) immutabilityPolicy(data acceptance.TestData) string {
+func (r StorageAccountResource) immutabilityPolicy(data acceptance.TestData, days int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -3713,12 +3820,12 @@ resource "azurerm_storage_account" "test" {
   account_replication_type = "LRS"
 
   immutability_policy {
-    period_since_creation_in_days = 3
+    period_since_creation_in_days = %d
     state                         = "Unlocked"
     allow_protected_append_writes = false
   }
 }
-`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, days)
 }
 
 func (r StorageAccountResource) infrastructureEncryptionForBlockBlobStorage(data acceptance.TestData) string {
@@ -4283,6 +4390,86 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) keyExpirationPeriod(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                      = azurerm_resource_group.test.location
+  account_tier                  = "Standard"
+  account_replication_type      = "LRS"
+  key_expiration_period_in_days = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, days)
+}
+
+func (r StorageAccountResource) sharedKeyAccessDisabledWithSubResources(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                  = azurerm_resource_group.test.location
+  account_tier              = "Standard"
+  account_replication_type  = "LRS"
+  shared_access_key_enabled = false
+}
+
+resource "azurerm_role_assignment" "blob" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_role_assignment" "queue" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Queue Data Contributor"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_container" "test" {
+  name                 = "acctestcontainer"
+  storage_account_name = azurerm_storage_account.test.name
+
+  depends_on = [azurerm_role_assignment.blob]
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "acctestqueue"
+  storage_account_name = azurerm_storage_account.test.name
+
+  depends_on = [azurerm_role_assignment.queue]
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctestshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 5
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageAccountResource) allowedCopyScope(data acceptance.TestData, scope string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,223 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	storageClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
+)
+
+func resourceStorageAccountStaticWebsite() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountStaticWebsiteCreateUpdate,
+		Read:   resourceStorageAccountStaticWebsiteRead,
+		Update: resourceStorageAccountStaticWebsiteCreateUpdate,
+		Delete: resourceStorageAccountStaticWebsiteDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"index_document": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"error_404_document": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountStaticWebsiteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	dataPlaneClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	var tier storage.SkuTier
+	if storageAccount.Sku != nil {
+		tier = storageAccount.Sku.Tier
+	}
+	if !resolveStorageAccountServiceSupportLevel(storageAccount.Kind, tier).supportStaticWebsite {
+		return fmt.Errorf("a Static Website isn't supported for account kind %q in sku tier %q", storageAccount.Kind, tier)
+	}
+
+	accountsClient, err := storageAccountStaticWebsiteDataPlaneClient(ctx, dataPlaneClient, *id)
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := accountsClient.GetServiceProperties(ctx, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+		}
+		if len(flattenStaticWebsiteProperties(existing)) > 0 {
+			return tf.ImportAsExistsError("azurerm_storage_account_static_website", id.ID())
+		}
+	}
+
+	props := accounts.StorageServiceProperties{
+		StaticWebsite: &accounts.StaticWebsite{
+			Enabled:              true,
+			IndexDocument:        d.Get("index_document").(string),
+			ErrorDocument404Path: d.Get("error_404_document").(string),
+		},
+	}
+	if _, err := accountsClient.SetServiceProperties(ctx, id.Name, props); err != nil {
+		return fmt.Errorf("updating the Static Website for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceStorageAccountStaticWebsiteRead(d, meta)
+}
+
+func resourceStorageAccountStaticWebsiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	dataPlaneClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accountsClient, err := storageAccountStaticWebsiteDataPlaneClient(ctx, dataPlaneClient, *id)
+	if err != nil {
+		return err
+	}
+
+	props, err := accountsClient.GetServiceProperties(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+	}
+
+	staticWebsite := flattenStaticWebsiteProperties(props)
+	if len(staticWebsite) == 0 {
+		log.Printf("[DEBUG] the Static Website was not enabled for %s - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", id.ID())
+	if v, ok := staticWebsite[0].(map[string]interface{}); ok {
+		d.Set("index_document", v["index_document"])
+		d.Set("error_404_document", v["error_404_document"])
+	}
+
+	return nil
+}
+
+func resourceStorageAccountStaticWebsiteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	dataPlaneClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	// confirm it still exists prior to trying to update it, else we'll get an error
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accountsClient, err := storageAccountStaticWebsiteDataPlaneClient(ctx, dataPlaneClient, *id)
+	if err != nil {
+		return err
+	}
+
+	// the Static Website is a property of the Storage Account, so "deleting" it disables it
+	if _, err := accountsClient.SetServiceProperties(ctx, id.Name, expandStaticWebsiteProperties([]interface{}{})); err != nil {
+		return fmt.Errorf("disabling the Static Website for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// storageAccountStaticWebsiteDataPlaneClient returns a client for the Data Plane API of the Storage Account, which is the
+// only API which exposes the Static Website
+func storageAccountStaticWebsiteDataPlaneClient(ctx context.Context, client *storageClient.Client, id parse.StorageAccountId) (*accounts.Client, error) {
+	account, err := client.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q: %s", id.Name, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", id)
+	}
+
+	accountsClient, err := client.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %s", err)
+	}
+	return accountsClient, nil
+}
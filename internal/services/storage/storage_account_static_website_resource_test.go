@@ -0,0 +1,161 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageAccountStaticWebsiteResource struct{}
+
+func TestAccStorageAccountStaticWebsite_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountStaticWebsite_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountStaticWebsite_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountStaticWebsiteResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q: %+v", id.Name, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	accountsClient, err := client.Storage.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	props, err := accountsClient.GetServiceProperties(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+	}
+
+	enabled := props.StorageServiceProperties != nil && props.StorageServiceProperties.StaticWebsite != nil && props.StorageServiceProperties.StaticWebsite.Enabled
+	return utils.Bool(enabled), nil
+}
+
+func (r StorageAccountStaticWebsiteResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, r.template(data))
+}
+
+func (r StorageAccountStaticWebsiteResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "import" {
+  storage_account_id = azurerm_storage_account_static_website.test.storage_account_id
+}
+`, r.basic(data))
+}
+
+func (r StorageAccountStaticWebsiteResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  index_document     = "index.html"
+  error_404_document = "404.html"
+}
+`, r.template(data))
+}
+
+func (r StorageAccountStaticWebsiteResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  lifecycle {
+    ignore_changes = [static_website]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
@@ -44,6 +45,10 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 				if v["scope"] != string(storage.ObjectTypeBlob) && len(v["filter"].([]interface{})) != 0 {
 					return fmt.Errorf("the `filter` can only be set when the `scope` is `%s`", storage.ObjectTypeBlob)
 				}
+
+				if err := validateBlobInventoryPolicyRuleSchemaFields(v); err != nil {
+					return fmt.Errorf("validating the `schema_fields` for the rule %q: %+v", v["name"].(string), err)
+				}
 			}
 
 			return nil
@@ -368,3 +373,138 @@ func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter)
 		},
 	}
 }
+
+// blobInventoryPolicyBlobSchemaFields are the fields which can be included in the inventory when the `scope` is `Blob`
+var blobInventoryPolicyBlobSchemaFields = []string{
+	"Name",
+	"Creation-Time",
+	"Last-Modified",
+	"LastAccessTime",
+	"ETag",
+	"Content-Length",
+	"Content-Type",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-CRC64",
+	"Content-MD5",
+	"Cache-Control",
+	"Content-Disposition",
+	"BlobType",
+	"AccessTier",
+	"AccessTierChangeTime",
+	"AccessTierInferred",
+	"ArchiveStatus",
+	"RehydratePriority",
+	"Expiry-Time",
+	"hdi_isfolder",
+	"Owner",
+	"Group",
+	"Permissions",
+	"Acl",
+	"Snapshot",
+	"VersionId",
+	"IsCurrentVersion",
+	"Metadata",
+	"Tags",
+	"TagCount",
+	"CopyId",
+	"CopySource",
+	"CopyStatus",
+	"CopyProgress",
+	"CopyCompletionTime",
+	"CopyStatusDescription",
+	"ImmutabilityPolicyUntilDate",
+	"ImmutabilityPolicyMode",
+	"LegalHold",
+	"CustomerProvidedKeySha256",
+	"EncryptionScope",
+	"IncrementalCopy",
+	"x-ms-blob-sequence-number",
+	"ServerEncrypted",
+	"LeaseStatus",
+	"LeaseState",
+	"LeaseDuration",
+	"Deleted",
+	"DeletedTime",
+	"DeletionId",
+	"RemainingRetentionDays",
+	"Version",
+}
+
+// blobInventoryPolicyContainerSchemaFields are the fields which can be included in the inventory when the `scope` is `Container`
+var blobInventoryPolicyContainerSchemaFields = []string{
+	"Name",
+	"Last-Modified",
+	"ETag",
+	"LeaseStatus",
+	"LeaseState",
+	"LeaseDuration",
+	"PublicAccess",
+	"DefaultEncryptionScope",
+	"DenyEncryptionScopeOverride",
+	"HasImmutabilityPolicy",
+	"HasLegalHold",
+	"ImmutableStorageWithVersioningEnabled",
+	"Metadata",
+	"Deleted",
+	"Version",
+	"DeletedTime",
+	"RemainingRetentionDays",
+}
+
+func validateBlobInventoryPolicyRuleSchemaFields(rule map[string]interface{}) error {
+	fields := make(map[string]bool)
+	for _, raw := range rule["schema_fields"].([]interface{}) {
+		field, _ := raw.(string)
+		if field == "" {
+			// the value isn't known yet, so this can't be validated until apply time
+			return nil
+		}
+		fields[field] = true
+	}
+
+	if !fields["Name"] {
+		return fmt.Errorf("`Name` must be included")
+	}
+
+	supportedFields := blobInventoryPolicyBlobSchemaFields
+	if rule["scope"] == string(storage.ObjectTypeContainer) {
+		supportedFields = blobInventoryPolicyContainerSchemaFields
+	}
+	for field := range fields {
+		if !utils.SliceContainsValue(supportedFields, field) {
+			return fmt.Errorf("%q isn't supported when the `scope` is `%s` - supported values are: %s", field, rule["scope"].(string), strings.Join(supportedFields, ", "))
+		}
+	}
+
+	filters := rule["filter"].([]interface{})
+	if len(filters) == 0 || filters[0] == nil {
+		return nil
+	}
+	filter := filters[0].(map[string]interface{})
+
+	// the fields which must be included in the inventory when each of these properties is specified within the filter
+	requiredFields := []struct {
+		property  string
+		specified bool
+		fields    []string
+	}{
+		{property: "blob_types", specified: filter["blob_types"].(*pluginsdk.Set).Len() > 0, fields: []string{"BlobType"}},
+		{property: "include_blob_versions", specified: filter["include_blob_versions"].(bool), fields: []string{"IsCurrentVersion", "VersionId"}},
+		{property: "include_deleted", specified: filter["include_deleted"].(bool), fields: []string{"Deleted", "RemainingRetentionDays"}},
+		{property: "include_snapshots", specified: filter["include_snapshots"].(bool), fields: []string{"Snapshot"}},
+	}
+	for _, item := range requiredFields {
+		if !item.specified {
+			continue
+		}
+
+		for _, field := range item.fields {
+			if !fields[field] {
+				return fmt.Errorf("%q must be included when `%s` is specified within the `filter` block", field, item.property)
+			}
+		}
+	}
+
+	return nil
+}
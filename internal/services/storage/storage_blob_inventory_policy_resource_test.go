@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -57,6 +58,17 @@ func TestAccStorageBlobInventoryPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlobInventoryPolicy_filterMissingSchemaFields(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.filterMissingSchemaFields(data),
+			ExpectError: regexp.MustCompile("\"Snapshot\" must be included when `include_snapshots` is specified within the `filter` block"),
+		},
+	})
+}
+
 func TestAccStorageBlobInventoryPolicy_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
 	r := StorageBlobInventoryPolicyResource{}
@@ -226,6 +238,32 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
 `, template)
 }
 
+func (r StorageBlobInventoryPolicyResource) filterMissingSchemaFields(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  rules {
+    name                   = "rule1"
+    storage_container_name = azurerm_storage_container.test.name
+    format                 = "Parquet"
+    schedule               = "Daily"
+    scope                  = "Blob"
+    schema_fields = [
+      "Name",
+      "Creation-Time",
+      "BlobType",
+    ]
+    filter {
+      blob_types        = ["blockBlob"]
+      include_snapshots = true
+    }
+  }
+}
+`, r.template(data))
+}
+
 func (r StorageBlobInventoryPolicyResource) multipleRules(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
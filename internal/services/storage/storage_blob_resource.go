@@ -17,6 +17,9 @@ import (
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
 )
 
+// storageBlobDefaultBlockSize is the default size (in bytes) of each block used when uploading a Block blob from `source`
+const storageBlobDefaultBlockSize = 4 * 1024 * 1024
+
 func resourceStorageBlob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStorageBlobCreate,
@@ -138,7 +141,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -146,6 +148,15 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			// the block size only affects how `source` is uploaded, so changing it doesn't require the Blob be re-created
+			"block_size": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Default:  storageBlobDefaultBlockSize,
+				// the maximum size of a block is 4000MiB
+				ValidateFunc: validation.IntBetween(1, 4000*1024*1024),
+			},
+
 			"metadata": MetaDataComputedSchema(),
 		},
 	}
@@ -206,6 +217,7 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Client:        blobsClient,
 
 		BlobType:      d.Get("type").(string),
+		BlockSize:     d.Get("block_size").(int),
 		CacheControl:  d.Get("cache_control").(string),
 		ContentType:   d.Get("content_type").(string),
 		ContentMD5:    contentMD5,
@@ -340,6 +352,7 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("name", id.BlobName)
 	d.Set("storage_container_name", id.ContainerName)
 	d.Set("storage_account_name", id.AccountName)
+	setStorageBlobUploadDefaults(d)
 
 	d.Set("access_tier", string(props.AccessTier))
 	d.Set("content_type", props.ContentType)
@@ -403,3 +416,11 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// setStorageBlobUploadDefaults sets the default `block_size` when it's not present in the state, which is the case
+// for Blobs created prior to `block_size` being added and for imported Blobs, since it can't be read from the API
+func setStorageBlobUploadDefaults(d *pluginsdk.ResourceData) {
+	if v, ok := d.Get("block_size").(int); !ok || v == 0 {
+		d.Set("block_size", storageBlobDefaultBlockSize)
+	}
+}
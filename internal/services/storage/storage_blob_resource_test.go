@@ -40,7 +40,7 @@ func TestAccStorageBlob_appendEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -55,7 +55,7 @@ func TestAccStorageBlob_appendEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -70,7 +70,7 @@ func TestAccStorageBlob_blockEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -85,7 +85,7 @@ func TestAccStorageBlob_blockEmptyAzureADAuth(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -100,7 +100,7 @@ func TestAccStorageBlob_blockEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -116,7 +116,7 @@ func TestAccStorageBlob_blockEmptyAccessTier(t *testing.T) {
 				check.That(data.ResourceName).Key("access_tier").HasValue("Cool"),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 		{
 			Config: r.blockEmptyAccessTier(data, blobs.Hot),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -145,7 +145,7 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -160,7 +160,7 @@ func TestAccStorageBlob_blockFromPublicBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -175,7 +175,7 @@ func TestAccStorageBlob_blockFromPublicFile(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -190,7 +190,7 @@ func TestAccStorageBlob_blockFromExistingBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -214,7 +214,31 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileInBlocks(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlobInBlocks(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("block_size", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -239,7 +263,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -254,14 +278,14 @@ func TestAccStorageBlob_cacheControl(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 		{
 			Config: r.cacheControl(data, "max-age=3600"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -276,14 +300,14 @@ func TestAccStorageBlob_contentType(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 		{
 			Config: r.contentTypeUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -298,7 +322,7 @@ func TestAccStorageBlob_contentTypePremium(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -313,7 +337,7 @@ func TestAccStorageBlob_pageEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -328,7 +352,7 @@ func TestAccStorageBlob_pageEmptyPremium(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -343,7 +367,7 @@ func TestAccStorageBlob_pageEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -358,7 +382,7 @@ func TestAccStorageBlob_pageFromExistingBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source_uri"),
+		data.ImportStep("block_size", "parallelism", "size", "type", "source_uri"),
 	})
 }
 
@@ -382,7 +406,7 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStep("block_size", "parallelism", "size", "type", "source"),
 	})
 }
 
@@ -412,14 +436,14 @@ func TestAccStorageBlob_update(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 		{
 			Config: r.updateUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStep("block_size", "parallelism", "size", "type"),
 	})
 }
 
@@ -758,6 +782,27 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName)
 }
 
+func (r StorageBlobResource) blockFromLocalBlobInBlocks(data acceptance.TestData, fileName string) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  block_size             = 1048576
+  parallelism            = 2
+}
+`, template, fileName)
+}
+
 func (r StorageBlobResource) contentMd5ForLocalFile(data acceptance.TestData, fileName string) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
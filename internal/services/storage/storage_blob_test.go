@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestStorageBlobBlockSizeDefaultForExistingState(t *testing.T) {
+	resource := resourceStorageBlob()
+
+	// the state of a Blob created before `block_size` was added to the schema
+	state := &terraform.InstanceState{
+		ID: "https://some-account.blob.core.windows.net/some-container/some-name",
+		Attributes: map[string]string{
+			"id":                     "https://some-account.blob.core.windows.net/some-container/some-name",
+			"name":                   "some-name",
+			"storage_account_name":   "some-account",
+			"storage_container_name": "some-container",
+			"type":                   "Block",
+			"size":                   "0",
+			"access_tier":            "Hot",
+			"content_type":           "application/octet-stream",
+			"parallelism":            "8",
+			"url":                    "https://some-account.blob.core.windows.net/some-container/some-name",
+			"metadata.%":             "0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                   "some-name",
+		"storage_account_name":   "some-account",
+		"storage_container_name": "some-container",
+		"type":                   "Block",
+	})
+
+	diff, err := resource.Diff(context.TODO(), state, config, nil)
+	if err != nil {
+		t.Fatalf("computing the diff: %+v", err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected a missing `block_size` not to require replacement but got: %+v", diff)
+	}
+
+	// once refreshed the default `block_size` is set in the state, so no changes should be planned
+	d := resource.Data(state)
+	setStorageBlobUploadDefaults(d)
+	refreshed := d.State()
+
+	diff, err = resource.Diff(context.TODO(), refreshed, config, nil)
+	if err != nil {
+		t.Fatalf("computing the diff: %+v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected an empty plan for an existing Blob without `block_size` but got: %+v", diff.Attributes)
+	}
+}
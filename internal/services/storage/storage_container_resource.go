@@ -1,18 +1,22 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
 )
 
@@ -68,7 +72,51 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			"immutable_storage_with_versioning_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.ImmutabilityPolicyStateUnlocked),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.ImmutabilityPolicyStateLocked),
+								string(storage.ImmutabilityPolicyStateUnlocked),
+							}, false),
+						},
+
+						"lock_confirmed": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// TODO: support for ACL's and Legal Holds
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -79,11 +127,21 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"data_plane_access_enabled": DataPlaneAccessEnabledSchema(),
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.HasChange("immutability_policy") {
+				return validateImmutabilityPolicyChange(d, "immutability_policy")
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -108,7 +166,7 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
 	}
 
-	client, err := storageClient.ContainersClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).ContainersClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building storage client: %+v", err)
 	}
@@ -128,11 +186,26 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 		MetaData:    metaData,
 	}
 
-	if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
-		return fmt.Errorf("failed creating container: %+v", err)
+	if d.Get("immutable_storage_with_versioning_enabled").(bool) {
+		// version-level immutability can only be enabled when the Container is created, which is only possible using
+		// the Resource Manager API
+		rmClient := shim.NewResourceManagerStorageContainerWrapper(storageClient.BlobContainersClient).(shim.ResourceManagerStorageContainerWrapper)
+		if err := rmClient.CreateWithImmutableStorageWithVersioning(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
+	} else {
+		if err := client.Create(ctx, account.ResourceGroup, accountName, containerName, input); err != nil {
+			return fmt.Errorf("failed creating container: %+v", err)
+		}
 	}
 
 	d.SetId(id)
+
+	if v := d.Get("immutability_policy").([]interface{}); len(v) > 0 {
+		if err := updateStorageContainerImmutabilityPolicy(ctx, storageClient.BlobContainersClient, account.ResourceGroup, accountName, containerName, nil, v); err != nil {
+			return err
+		}
+	}
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -153,7 +226,7 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 	if account == nil {
 		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
 	}
-	client, err := storageClient.ContainersClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).ContainersClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Containers Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
@@ -182,6 +255,16 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
+	if d.HasChange("immutability_policy") {
+		log.Printf("[DEBUG] Updating the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
+		oldRaw, newRaw := d.GetChange("immutability_policy")
+		if err := updateStorageContainerImmutabilityPolicy(ctx, storageClient.BlobContainersClient, account.ResourceGroup, id.AccountName, id.Name, oldRaw.([]interface{}), newRaw.([]interface{})); err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Updated the Immutability Policy for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -205,7 +288,7 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.SetId("")
 		return nil
 	}
-	client, err := storageClient.ContainersClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).ContainersClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Containers Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
@@ -232,6 +315,26 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
+	// the Immutability Policy and version-level immutability are only exposed via the Resource Manager API
+	container, err := storageClient.BlobContainersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Immutability Policy for Container %q (Account %q / Resource Group %q): %s", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+
+	immutableStorageWithVersioningEnabled := false
+	immutabilityPolicy := make([]interface{}, 0)
+	if containerProps := container.ContainerProperties; containerProps != nil {
+		if v := containerProps.ImmutableStorageWithVersioning; v != nil && v.Enabled != nil {
+			immutableStorageWithVersioningEnabled = *v.Enabled
+		}
+
+		immutabilityPolicy = flattenStorageContainerImmutabilityPolicy(containerProps.ImmutabilityPolicy, immutabilityPolicyLockConfirmed(d, "immutability_policy"))
+	}
+	d.Set("immutable_storage_with_versioning_enabled", immutableStorageWithVersioningEnabled)
+	if err := d.Set("immutability_policy", immutabilityPolicy); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
@@ -255,7 +358,7 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 	if account == nil {
 		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
 	}
-	client, err := storageClient.ContainersClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).ContainersClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Containers Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
@@ -286,3 +389,90 @@ func flattenStorageContainerAccessLevel(input containers.AccessLevel) string {
 
 	return string(input)
 }
+
+// updateStorageContainerImmutabilityPolicy reconciles the Immutability Policy for the specified Container - since a
+// Locked policy can only be extended, the Unlocked policy is created/updated first and then Locked if required.
+func updateStorageContainerImmutabilityPolicy(ctx context.Context, client *storage.BlobContainersClient, resourceGroup, accountName, containerName string, oldRaw, newRaw []interface{}) error {
+	oldPolicy := immutabilityPolicyFromRaw(oldRaw)
+	newPolicy := immutabilityPolicyFromRaw(newRaw)
+
+	existing, err := client.GetImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, "")
+	if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("retrieving Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+	}
+	etag := ""
+	if existing.Etag != nil {
+		etag = *existing.Etag
+	}
+
+	if newPolicy == nil {
+		if oldPolicy == nil || etag == "" {
+			return nil
+		}
+
+		if _, err := client.DeleteImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag); err != nil {
+			return fmt.Errorf("deleting Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+		return nil
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(newPolicy["period_since_creation_in_days"].(int))),
+			AllowProtectedAppendWrites:            utils.Bool(newPolicy["allow_protected_append_writes"].(bool)),
+		},
+	}
+
+	if existing.ImmutabilityPolicyProperty != nil && existing.ImmutabilityPolicyProperty.State == storage.ImmutabilityPolicyStateLocked {
+		// a Locked policy can only have its retention period extended
+		if oldPolicy != nil && newPolicy["period_since_creation_in_days"].(int) > oldPolicy["period_since_creation_in_days"].(int) {
+			policy.ImmutabilityPolicyProperty.AllowProtectedAppendWrites = nil
+			if _, err := client.ExtendImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag, &policy); err != nil {
+				return fmt.Errorf("extending Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+			}
+		}
+		return nil
+	}
+
+	updated, err := client.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, &policy, etag)
+	if err != nil {
+		return fmt.Errorf("updating Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+	}
+
+	if newPolicy["state"].(string) == immutabilityPolicyStateLocked {
+		if updated.Etag == nil {
+			return fmt.Errorf("locking Immutability Policy for Container %q (Storage Account %q / Resource Group %q): `etag` was nil", containerName, accountName, resourceGroup)
+		}
+		if _, err := client.LockImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, *updated.Etag); err != nil {
+			return fmt.Errorf("locking Immutability Policy for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenStorageContainerImmutabilityPolicy(input *storage.ImmutabilityPolicyProperties, lockConfirmed bool) []interface{} {
+	if input == nil || input.ImmutabilityPolicyProperty == nil {
+		return []interface{}{}
+	}
+	props := input.ImmutabilityPolicyProperty
+
+	// the API returns an empty policy when one hasn't been configured
+	if props.ImmutabilityPeriodSinceCreationInDays == nil || *props.ImmutabilityPeriodSinceCreationInDays == 0 {
+		return []interface{}{}
+	}
+
+	allowProtectedAppendWrites := false
+	if props.AllowProtectedAppendWrites != nil {
+		allowProtectedAppendWrites = *props.AllowProtectedAppendWrites
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"period_since_creation_in_days": int(*props.ImmutabilityPeriodSinceCreationInDays),
+			"allow_protected_append_writes": allowProtectedAppendWrites,
+			"state":                         string(props.State),
+			"lock_confirmed":                lockConfirmed,
+		},
+	}
+}
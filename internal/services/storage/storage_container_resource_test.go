@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -72,6 +73,44 @@ func TestAccStorageContainer_basicAzureADAuth(t *testing.T) {
 	})
 }
 
+func TestAccStorageContainer_dataPlaneAccessDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataPlaneAccessDisabled(data, "private"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dataPlaneAccessDisabled(data, "container"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageContainer_dataPlaneAccessDisabledOnResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataPlaneAccessDisabledOnResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// `data_plane_access_enabled` isn't returned from either API
+		data.ImportStep("data_plane_access_enabled"),
+	})
+}
+
 func TestAccStorageContainer_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
 	r := StorageContainerResource{}
@@ -183,6 +222,65 @@ func TestAccStorageContainer_web(t *testing.T) {
 	})
 }
 
+func TestAccStorageContainer_immutabilityPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.immutabilityPolicy(data, 1, "Unlocked", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("has_immutability_policy").HasValue("true"),
+			),
+		},
+		data.ImportStep("immutability_policy.0.lock_confirmed"),
+		{
+			Config: r.immutabilityPolicy(data, 2, "Unlocked", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("immutability_policy.0.lock_confirmed"),
+		{
+			Config:      r.immutabilityPolicy(data, 2, "Locked", false),
+			ExpectError: regexp.MustCompile("`immutability_policy.0.lock_confirmed` must be set to `true`"),
+		},
+		{
+			// the Container is empty, so can be deleted once the policy has been Locked
+			Config: r.immutabilityPolicy(data, 2, "Locked", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_policy.0.state").HasValue("Locked"),
+			),
+		},
+		data.ImportStep("immutability_policy.0.lock_confirmed"),
+		{
+			Config: r.immutabilityPolicy(data, 3, "Locked", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("immutability_policy.0.lock_confirmed"),
+	})
+}
+
+func TestAccStorageContainer_immutableStorageWithVersioning(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container", "test")
+	r := StorageContainerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.immutableStorageWithVersioning(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutable_storage_with_versioning_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("immutability_policy.0.lock_confirmed"),
+	})
+}
+
 func (r StorageContainerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageContainerDataPlaneID(state.ID)
 	if err != nil {
@@ -274,6 +372,56 @@ resource "azurerm_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageContainerResource) dataPlaneAccessDisabled(data acceptance.TestData, accessType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    storage {
+      data_plane_access_enabled = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestacc%s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  allow_nested_items_to_be_public = true
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "%s"
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, accessType)
+}
+
+func (r StorageContainerResource) dataPlaneAccessDisabledOnResource(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                      = "vhds"
+  storage_account_name      = azurerm_storage_account.test.name
+  container_access_type     = "private"
+  data_plane_access_enabled = false
+}
+`, template)
+}
+
 func (r StorageContainerResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -376,6 +524,62 @@ resource "azurerm_storage_container" "test" {
 `, template)
 }
 
+func (r StorageContainerResource) immutabilityPolicy(data acceptance.TestData, days int, state string, lockConfirmed bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+
+  immutability_policy {
+    period_since_creation_in_days = %d
+    state                         = "%s"
+    lock_confirmed                = %t
+  }
+}
+`, template, days, state, lockConfirmed)
+}
+
+func (r StorageContainerResource) immutableStorageWithVersioning(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                                      = "vhds"
+  storage_account_name                      = azurerm_storage_account.test.name
+  container_access_type                     = "private"
+  immutable_storage_with_versioning_enabled = true
+
+  immutability_policy {
+    period_since_creation_in_days = 1
+    allow_protected_append_writes = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageContainerResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -55,6 +55,8 @@ func resourceStorageQueue() *pluginsdk.Resource {
 
 			"metadata": MetaDataSchema(),
 
+			"data_plane_access_enabled": DataPlaneAccessEnabledSchema(),
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -82,7 +84,7 @@ func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("unable to locate Storage Account %q", accountName)
 	}
 
-	client, err := storageClient.QueuesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
@@ -126,7 +128,7 @@ func resourceStorageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
 	}
 
-	client, err := storageClient.QueuesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
@@ -159,7 +161,7 @@ func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	client, err := storageClient.QueuesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
@@ -207,7 +209,7 @@ func resourceStorageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	client, err := storageClient.QueuesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).QueuesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building Queues Client: %s", err)
 	}
@@ -82,6 +82,29 @@ func TestAccStorageQueue_metaData(t *testing.T) {
 	})
 }
 
+func TestAccStorageQueue_dataPlaneAccessDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataPlaneAccessDisabled(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// `data_plane_access_enabled` isn't returned from either API
+		data.ImportStep("data_plane_access_enabled"),
+		{
+			Config: r.dataPlaneAccessDisabled(data, "pops"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data_plane_access_enabled"),
+	})
+}
+
 func (r StorageQueueResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.StorageQueueDataPlaneID(state.ID)
 	if err != nil {
@@ -117,6 +140,23 @@ resource "azurerm_storage_queue" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageQueueResource) dataPlaneAccessDisabled(data acceptance.TestData, value string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_queue" "test" {
+  name                      = "mysamplequeue-%d"
+  storage_account_name      = azurerm_storage_account.test.name
+  data_plane_access_enabled = false
+
+  metadata = {
+    hello = "%s"
+  }
+}
+`, template, data.RandomInteger, value)
+}
+
 func (r StorageQueueResource) basicAzureADAuth(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
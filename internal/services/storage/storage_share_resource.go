@@ -111,6 +111,8 @@ func resourceStorageShare() *pluginsdk.Resource {
 				Default: string(shares.SMB),
 			},
 
+			"data_plane_access_enabled": DataPlaneAccessEnabledSchema(),
+
 			"resource_manager_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -160,7 +162,7 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).FileSharesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building File Share Client: %s", err)
 	}
@@ -219,7 +221,7 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).FileSharesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
@@ -278,7 +280,7 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).FileSharesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
@@ -352,7 +354,7 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
 	}
 
-	client, err := storageClient.FileSharesClient(ctx, *account)
+	client, err := storageClient.WithDataPlaneAccess(dataPlaneAccessEnabled(d, storageClient)).FileSharesClient(ctx, *account)
 	if err != nil {
 		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
 	}
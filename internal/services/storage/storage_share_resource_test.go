@@ -127,6 +127,30 @@ func TestAccStorageShare_acl(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_dataPlaneAccessDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataPlaneAccessDisabled(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// `data_plane_access_enabled` isn't returned from either API
+		data.ImportStep("data_plane_access_enabled"),
+		{
+			Config: r.dataPlaneAccessDisabled(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("quota").HasValue("10"),
+			),
+		},
+		data.ImportStep("data_plane_access_enabled"),
+	})
+}
+
 func TestAccStorageShare_aclGhostedRecall(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
 	r := StorageShareResource{}
@@ -388,6 +412,34 @@ resource "azurerm_storage_share" "test" {
 `, template, data.RandomString)
 }
 
+func (r StorageShareResource) dataPlaneAccessDisabled(data acceptance.TestData, quota int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                      = "testshare%s"
+  storage_account_name      = azurerm_storage_account.test.name
+  quota                     = %d
+  data_plane_access_enabled = false
+
+  metadata = {
+    hello = "world"
+  }
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "rwd"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2019-07-02T10:38:21.0000000Z"
+    }
+  }
+}
+`, template, data.RandomString, quota)
+}
+
 func (r StorageShareResource) aclGhostedRecall(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,216 @@
+package subscription
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2021-01-01/subscriptions" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceLocations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceLocationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"geography_group": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"region_category": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(subscriptions.RegionCategoryExtended),
+					string(subscriptions.RegionCategoryOther),
+					string(subscriptions.RegionCategoryRecommended),
+				}, false),
+			},
+
+			"locations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"regional_display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"geography_group": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"physical_location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"region_category": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"region_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"latitude": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"longitude": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"paired_regions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLocationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Subscription.Client
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := commonids.NewSubscriptionID(subscriptionId)
+	resp, err := client.ListLocations(ctx, id.SubscriptionId, utils.Bool(false))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("listing the locations available to %s: %+v", id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/locations", id.ID()))
+
+	locations := flattenLocations(resp.Value, d.Get("geography_group").(string), d.Get("region_category").(string))
+	if err := d.Set("locations", locations); err != nil {
+		return fmt.Errorf("setting `locations`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenLocations(input *[]subscriptions.Location, geographyGroup string, regionCategory string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	items := make([]subscriptions.Location, 0)
+	for _, item := range *input {
+		// Edge Zones are exposed by the `azurerm_extended_locations` data source
+		if item.Type == subscriptions.LocationTypeEdgeZone || item.Name == nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return *items[i].Name < *items[j].Name
+	})
+
+	for _, item := range items {
+		displayName := ""
+		if item.DisplayName != nil {
+			displayName = *item.DisplayName
+		}
+
+		regionalDisplayName := ""
+		if item.RegionalDisplayName != nil {
+			regionalDisplayName = *item.RegionalDisplayName
+		}
+
+		group := ""
+		physicalLocation := ""
+		category := ""
+		regionType := ""
+		latitude := ""
+		longitude := ""
+		pairedRegions := make([]interface{}, 0)
+		if metadata := item.Metadata; metadata != nil {
+			if metadata.GeographyGroup != nil {
+				group = *metadata.GeographyGroup
+			}
+			if metadata.PhysicalLocation != nil {
+				physicalLocation = *metadata.PhysicalLocation
+			}
+			category = string(metadata.RegionCategory)
+			regionType = string(metadata.RegionType)
+			if metadata.Latitude != nil {
+				latitude = *metadata.Latitude
+			}
+			if metadata.Longitude != nil {
+				longitude = *metadata.Longitude
+			}
+			if metadata.PairedRegion != nil {
+				for _, paired := range *metadata.PairedRegion {
+					if paired.Name != nil {
+						pairedRegions = append(pairedRegions, location.Normalize(*paired.Name))
+					}
+				}
+			}
+		}
+
+		if geographyGroup != "" && !strings.EqualFold(group, geographyGroup) {
+			continue
+		}
+		if regionCategory != "" && !strings.EqualFold(category, regionCategory) {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                  location.Normalize(*item.Name),
+			"display_name":          displayName,
+			"regional_display_name": regionalDisplayName,
+			"geography_group":       group,
+			"physical_location":     physicalLocation,
+			"region_category":       category,
+			"region_type":           regionType,
+			"latitude":              latitude,
+			"longitude":             longitude,
+			"paired_regions":        pairedRegions,
+		})
+	}
+
+	return results
+}
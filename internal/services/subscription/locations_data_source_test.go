@@ -0,0 +1,62 @@
+package subscription_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LocationsDataSource struct{}
+
+func TestAccDataSourceLocations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_locations", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: LocationsDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("locations.#").Exists(),
+				check.That(data.ResourceName).Key("locations.0.name").Exists(),
+				check.That(data.ResourceName).Key("locations.0.display_name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceLocations_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_locations", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: LocationsDataSource{}.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("locations.0.geography_group").HasValue("Europe"),
+				check.That(data.ResourceName).Key("locations.0.region_category").HasValue("Recommended"),
+			),
+		},
+	})
+}
+
+func (d LocationsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_locations" "test" {}
+`
+}
+
+func (d LocationsDataSource) filtered() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_locations" "test" {
+  geography_group = "Europe"
+  region_category = "Recommended"
+}
+`
+}
@@ -31,6 +31,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_subscription":       dataSourceSubscription(),
 		"azurerm_subscriptions":      dataSourceSubscriptions(),
 		"azurerm_extended_locations": dataSourceExtendedLocations(),
+		"azurerm_locations":          dataSourceLocations(),
 	}
 }
 
@@ -16,11 +16,16 @@ type Client struct {
 	IntegrationRuntimeAuthKeysClient                  *synapse.IntegrationRuntimeAuthKeysClient
 	IntegrationRuntimesClient                         *synapse.IntegrationRuntimesClient
 	KeysClient                                        *synapse.KeysClient
+	KustoPoolClient                                   *synapse.KustoPoolsClient
+	KustoPoolDatabaseClient                           *synapse.KustoPoolDatabasesClient
+	KustoPoolDataConnectionClient                     *synapse.KustoPoolDataConnectionsClient
+	KustoPoolPrincipalAssignmentClient                *synapse.KustoPoolPrincipalAssignmentsClient
 	PrivateLinkHubsClient                             *synapse.PrivateLinkHubsClient
 	SparkPoolClient                                   *synapse.BigDataPoolsClient
 	SqlPoolClient                                     *synapse.SQLPoolsClient
 	SqlPoolExtendedBlobAuditingPoliciesClient         *synapse.ExtendedSQLPoolBlobAuditingPoliciesClient
 	SqlPoolGeoBackupPoliciesClient                    *synapse.SQLPoolGeoBackupPoliciesClient
+	SqlPoolRestorePointsClient                        *synapse.SQLPoolRestorePointsClient
 	SqlPoolSecurityAlertPolicyClient                  *synapse.SQLPoolSecurityAlertPoliciesClient
 	SqlPoolTransparentDataEncryptionClient            *synapse.SQLPoolTransparentDataEncryptionsClient
 	SqlPoolVulnerabilityAssessmentsClient             *synapse.SQLPoolVulnerabilityAssessmentsClient
@@ -51,6 +56,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	keysClient := synapse.NewKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&keysClient.Client, o.ResourceManagerAuthorizer)
 
+	kustoPoolClient := synapse.NewKustoPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kustoPoolClient.Client, o.ResourceManagerAuthorizer)
+
+	kustoPoolDatabaseClient := synapse.NewKustoPoolDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kustoPoolDatabaseClient.Client, o.ResourceManagerAuthorizer)
+
+	kustoPoolDataConnectionClient := synapse.NewKustoPoolDataConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kustoPoolDataConnectionClient.Client, o.ResourceManagerAuthorizer)
+
+	kustoPoolPrincipalAssignmentClient := synapse.NewKustoPoolPrincipalAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kustoPoolPrincipalAssignmentClient.Client, o.ResourceManagerAuthorizer)
+
 	privateLinkHubsClient := synapse.NewPrivateLinkHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&privateLinkHubsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -61,6 +78,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	sqlPoolClient := synapse.NewSQLPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlPoolClient.Client, o.ResourceManagerAuthorizer)
 
+	sqlPoolRestorePointsClient := synapse.NewSQLPoolRestorePointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&sqlPoolRestorePointsClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlPoolExtendedBlobAuditingPoliciesClient := synapse.NewExtendedSQLPoolBlobAuditingPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlPoolExtendedBlobAuditingPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -111,11 +131,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		IntegrationRuntimeAuthKeysClient:                  &integrationRuntimeAuthKeysClient,
 		IntegrationRuntimesClient:                         &integrationRuntimesClient,
 		KeysClient:                                        &keysClient,
+		KustoPoolClient:                                   &kustoPoolClient,
+		KustoPoolDatabaseClient:                           &kustoPoolDatabaseClient,
+		KustoPoolDataConnectionClient:                     &kustoPoolDataConnectionClient,
+		KustoPoolPrincipalAssignmentClient:                &kustoPoolPrincipalAssignmentClient,
 		PrivateLinkHubsClient:                             &privateLinkHubsClient,
 		SparkPoolClient:                                   &sparkPoolClient,
 		SqlPoolClient:                                     &sqlPoolClient,
 		SqlPoolExtendedBlobAuditingPoliciesClient:         &sqlPoolExtendedBlobAuditingPoliciesClient,
 		SqlPoolGeoBackupPoliciesClient:                    &sqlPoolGeoBackupPoliciesClient,
+		SqlPoolRestorePointsClient:                        &sqlPoolRestorePointsClient,
 		SqlPoolSecurityAlertPolicyClient:                  &sqlPoolSecurityAlertPolicyClient,
 		SqlPoolTransparentDataEncryptionClient:            &sqlPoolTransparentDataEncryptionClient,
 		SqlPoolVulnerabilityAssessmentsClient:             &sqlPoolVulnerabilityAssessmentsClient,
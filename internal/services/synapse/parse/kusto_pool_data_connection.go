@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KustoPoolDataConnectionId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	WorkspaceName      string
+	KustoPoolName      string
+	DatabaseName       string
+	DataConnectionName string
+}
+
+func NewKustoPoolDataConnectionID(subscriptionId, resourceGroup, workspaceName, kustoPoolName, databaseName, dataConnectionName string) KustoPoolDataConnectionId {
+	return KustoPoolDataConnectionId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		WorkspaceName:      workspaceName,
+		KustoPoolName:      kustoPoolName,
+		DatabaseName:       databaseName,
+		DataConnectionName: dataConnectionName,
+	}
+}
+
+func (id KustoPoolDataConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Data Connection Name %q", id.DataConnectionName),
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Kusto Pool Name %q", id.KustoPoolName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kusto Pool Data Connection", segmentsStr)
+}
+
+func (id KustoPoolDataConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/kustoPools/%s/databases/%s/dataConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName)
+}
+
+// KustoPoolDataConnectionID parses a KustoPoolDataConnection ID into an KustoPoolDataConnectionId struct
+func KustoPoolDataConnectionID(input string) (*KustoPoolDataConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KustoPoolDataConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.KustoPoolName, err = id.PopSegment("kustoPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.DataConnectionName, err = id.PopSegment("dataConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
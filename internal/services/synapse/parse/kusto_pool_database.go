@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KustoPoolDatabaseId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	KustoPoolName  string
+	DatabaseName   string
+}
+
+func NewKustoPoolDatabaseID(subscriptionId, resourceGroup, workspaceName, kustoPoolName, databaseName string) KustoPoolDatabaseId {
+	return KustoPoolDatabaseId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		KustoPoolName:  kustoPoolName,
+		DatabaseName:   databaseName,
+	}
+}
+
+func (id KustoPoolDatabaseId) String() string {
+	segments := []string{
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Kusto Pool Name %q", id.KustoPoolName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kusto Pool Database", segmentsStr)
+}
+
+func (id KustoPoolDatabaseId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/kustoPools/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName)
+}
+
+// KustoPoolDatabaseID parses a KustoPoolDatabase ID into an KustoPoolDatabaseId struct
+func KustoPoolDatabaseID(input string) (*KustoPoolDatabaseId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KustoPoolDatabaseId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.KustoPoolName, err = id.PopSegment("kustoPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KustoPoolPrincipalAssignmentId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	WorkspaceName           string
+	KustoPoolName           string
+	PrincipalAssignmentName string
+}
+
+func NewKustoPoolPrincipalAssignmentID(subscriptionId, resourceGroup, workspaceName, kustoPoolName, principalAssignmentName string) KustoPoolPrincipalAssignmentId {
+	return KustoPoolPrincipalAssignmentId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		WorkspaceName:           workspaceName,
+		KustoPoolName:           kustoPoolName,
+		PrincipalAssignmentName: principalAssignmentName,
+	}
+}
+
+func (id KustoPoolPrincipalAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Principal Assignment Name %q", id.PrincipalAssignmentName),
+		fmt.Sprintf("Kusto Pool Name %q", id.KustoPoolName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kusto Pool Principal Assignment", segmentsStr)
+}
+
+func (id KustoPoolPrincipalAssignmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/kustoPools/%s/principalAssignments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName)
+}
+
+// KustoPoolPrincipalAssignmentID parses a KustoPoolPrincipalAssignment ID into an KustoPoolPrincipalAssignmentId struct
+func KustoPoolPrincipalAssignmentID(input string) (*KustoPoolPrincipalAssignmentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KustoPoolPrincipalAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.KustoPoolName, err = id.PopSegment("kustoPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrincipalAssignmentName, err = id.PopSegment("principalAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
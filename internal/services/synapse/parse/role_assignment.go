@@ -56,5 +56,10 @@ func SynapseScope(synapseScope string) (string, string, error) {
 		return sparkPoolID.WorkspaceName, fmt.Sprintf("workspaces/%s/bigDataPools/%s", sparkPoolID.WorkspaceName, sparkPoolID.BigDataPoolName), nil
 	}
 
+	linkedServiceID, err := LinkedServiceID(synapseScope)
+	if err == nil {
+		return linkedServiceID.WorkspaceName, fmt.Sprintf("workspaces/%s/linkedServices/%s", linkedServiceID.WorkspaceName, linkedServiceID.Name), nil
+	}
+
 	return "", "", fmt.Errorf("synapseScope format error")
 }
@@ -55,3 +55,66 @@ func TestSynapseRoleAssignmentID(t *testing.T) {
 		}
 	}
 }
+
+func TestSynapseScope(t *testing.T) {
+	testData := []struct {
+		Name              string
+		Input             string
+		ExpectedWorkspace string
+		ExpectedScope     string
+		Error             bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:              "Workspace",
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1",
+			ExpectedWorkspace: "workspace1",
+			ExpectedScope:     "workspaces/workspace1",
+		},
+		{
+			Name:              "Spark Pool",
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1/bigDataPools/pool1",
+			ExpectedWorkspace: "workspace1",
+			ExpectedScope:     "workspaces/workspace1/bigDataPools/pool1",
+		},
+		{
+			Name:              "Linked Service",
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1/linkedServices/linkedService1",
+			ExpectedWorkspace: "workspace1",
+			ExpectedScope:     "workspaces/workspace1/linkedServices/linkedService1",
+		},
+		{
+			Name:  "SQL Pool",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/pool1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		workspaceName, scope, err := SynapseScope(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if workspaceName != v.ExpectedWorkspace {
+			t.Fatalf("Expected %q but got %q for Workspace Name", v.ExpectedWorkspace, workspaceName)
+		}
+
+		if scope != v.ExpectedScope {
+			t.Fatalf("Expected %q but got %q for Scope", v.ExpectedScope, scope)
+		}
+	}
+}
@@ -28,7 +28,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_synapse_workspace": dataSourceSynapseWorkspace(),
+		"azurerm_synapse_role_definitions":        dataSourceSynapseRoleDefinitions(),
+		"azurerm_synapse_sql_pool_restore_points": dataSourceSynapseSqlPoolRestorePoints(),
+		"azurerm_synapse_workspace":               dataSourceSynapseWorkspace(),
 	}
 }
 
@@ -38,6 +40,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_synapse_firewall_rule":                              resourceSynapseFirewallRule(),
 		"azurerm_synapse_integration_runtime_azure":                  resourceSynapseIntegrationRuntimeAzure(),
 		"azurerm_synapse_integration_runtime_self_hosted":            resourceSynapseIntegrationRuntimeSelfHosted(),
+		"azurerm_synapse_kusto_pool":                                 resourceSynapseKustoPool(),
+		"azurerm_synapse_kusto_pool_database":                        resourceSynapseKustoPoolDatabase(),
+		"azurerm_synapse_kusto_pool_eventhub_data_connection":        resourceSynapseKustoPoolEventHubDataConnection(),
+		"azurerm_synapse_kusto_pool_principal_assignment":            resourceSynapseKustoPoolPrincipalAssignment(),
 		"azurerm_synapse_linked_service":                             resourceSynapseLinkedService(),
 		"azurerm_synapse_managed_private_endpoint":                   resourceSynapseManagedPrivateEndpoint(),
 		"azurerm_synapse_private_link_hub":                           resourceSynapsePrivateLinkHub(),
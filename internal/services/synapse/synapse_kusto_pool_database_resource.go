@@ -0,0 +1,199 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helperValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPoolDatabase() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolDatabaseCreateUpdate,
+		Read:   resourceSynapseKustoPoolDatabaseRead,
+		Update: resourceSynapseKustoPoolDatabaseCreateUpdate,
+		Delete: resourceSynapseKustoPoolDatabaseDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.KustoPoolDatabaseID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustoValidate.DatabaseName,
+			},
+
+			"kusto_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KustoPoolID,
+			},
+
+			"hot_cache_period": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: helperValidate.ISO8601Duration,
+			},
+
+			"soft_delete_period": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: helperValidate.ISO8601Duration,
+			},
+
+			"size": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSynapseKustoPoolDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabaseClient
+	kustoPoolClient := meta.(*clients.Client).Synapse.KustoPoolClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	kustoPoolId, err := parse.KustoPoolID(d.Get("kusto_pool_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `kusto_pool_id`: %+v", err)
+	}
+
+	id := parse.NewKustoPoolDatabaseID(kustoPoolId.SubscriptionId, kustoPoolId.ResourceGroup, kustoPoolId.WorkspaceName, kustoPoolId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool_database", id.ID())
+		}
+	}
+
+	kustoPool, err := kustoPoolClient.Get(ctx, kustoPoolId.WorkspaceName, kustoPoolId.Name, kustoPoolId.ResourceGroup)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", kustoPoolId, err)
+	}
+
+	props := &synapse.ReadWriteDatabaseProperties{}
+	if v, ok := d.GetOk("hot_cache_period"); ok {
+		props.HotCachePeriod = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("soft_delete_period"); ok {
+		props.SoftDeletePeriod = utils.String(v.(string))
+	}
+
+	database := synapse.ReadWriteDatabase{
+		Kind:                        synapse.KindReadWrite,
+		Location:                    synapseKustoPoolLocation(kustoPool),
+		ReadWriteDatabaseProperties: props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, database)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSynapseKustoPoolDatabaseRead(d, meta)
+}
+
+func resourceSynapseKustoPoolDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Value == nil {
+		return fmt.Errorf("retrieving %s: response was nil", *id)
+	}
+
+	database, ok := resp.Value.AsReadWriteDatabase()
+	if !ok {
+		return fmt.Errorf("retrieving %s: expected a ReadWrite database", *id)
+	}
+
+	d.Set("name", id.DatabaseName)
+	d.Set("kusto_pool_id", parse.NewKustoPoolID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName).ID())
+
+	if props := database.ReadWriteDatabaseProperties; props != nil {
+		d.Set("hot_cache_period", props.HotCachePeriod)
+		d.Set("soft_delete_period", props.SoftDeletePeriod)
+
+		size := 0.0
+		if props.Statistics != nil && props.Statistics.Size != nil {
+			size = *props.Statistics.Size
+		}
+		d.Set("size", size)
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
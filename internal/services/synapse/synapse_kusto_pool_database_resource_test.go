@@ -0,0 +1,122 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseKustoPoolDatabaseResource struct{}
+
+func TestAccSynapseKustoPoolDatabase_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := SynapseKustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPoolDatabase_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := SynapseKustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseKustoPoolDatabase_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_database", "test")
+	r := SynapseKustoPoolDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P7D"),
+				check.That(data.ResourceName).Key("soft_delete_period").HasValue("P31D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SynapseKustoPoolDatabaseResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KustoPoolDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Synapse.KustoPoolDatabaseClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SynapseKustoPoolDatabaseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "test" {
+  name          = "acctestkpdb-%d"
+  kusto_pool_id = azurerm_synapse_kusto_pool.test.id
+}
+`, SynapseKustoPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SynapseKustoPoolDatabaseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "import" {
+  name          = azurerm_synapse_kusto_pool_database.test.name
+  kusto_pool_id = azurerm_synapse_kusto_pool_database.test.kusto_pool_id
+}
+`, r.basic(data))
+}
+
+func (r SynapseKustoPoolDatabaseResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_database" "test" {
+  name               = "acctestkpdb-%d"
+  kusto_pool_id      = azurerm_synapse_kusto_pool.test.id
+  hot_cache_period   = "P7D"
+  soft_delete_period = "P31D"
+}
+`, SynapseKustoPoolResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,280 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPoolEventHubDataConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate,
+		Read:   resourceSynapseKustoPoolEventHubDataConnectionRead,
+		Update: resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate,
+		Delete: resourceSynapseKustoPoolEventHubDataConnectionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.KustoPoolDataConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustoValidate.DataConnectionName,
+			},
+
+			"kusto_pool_database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KustoPoolDatabaseID,
+			},
+
+			"eventhub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: eventhubs.ValidateEventhubID,
+			},
+
+			"consumer_group": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					eventhubValidate.ValidateEventHubConsumerName(),
+					validation.StringInSlice([]string{"$Default"}, false)),
+			},
+
+			"compression": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(synapse.CompressionNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(synapse.CompressionGZip),
+					string(synapse.CompressionNone),
+				}, false),
+			},
+
+			"data_format": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(synapseKustoPoolEventHubDataFormats(), false),
+			},
+
+			"event_system_properties": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"identity_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					validate.WorkspaceID,
+					commonids.ValidateUserAssignedIdentityID,
+				),
+			},
+
+			"mapping_rule_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: kustoValidate.EntityName,
+			},
+
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: kustoValidate.EntityName,
+			},
+		},
+	}
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionClient
+	kustoPoolClient := meta.(*clients.Client).Synapse.KustoPoolClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.KustoPoolDatabaseID(d.Get("kusto_pool_database_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `kusto_pool_database_id`: %+v", err)
+	}
+
+	id := parse.NewKustoPoolDataConnectionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.WorkspaceName, databaseId.KustoPoolName, databaseId.DatabaseName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool_eventhub_data_connection", id.ID())
+		}
+	}
+
+	kustoPoolId := parse.NewKustoPoolID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName)
+	kustoPool, err := kustoPoolClient.Get(ctx, kustoPoolId.WorkspaceName, kustoPoolId.Name, kustoPoolId.ResourceGroup)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", kustoPoolId, err)
+	}
+
+	props := &synapse.EventHubConnectionProperties{
+		EventHubResourceID:    utils.String(d.Get("eventhub_id").(string)),
+		ConsumerGroup:         utils.String(d.Get("consumer_group").(string)),
+		Compression:           synapse.Compression(d.Get("compression").(string)),
+		EventSystemProperties: utils.ExpandStringSlice(d.Get("event_system_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("data_format"); ok {
+		props.DataFormat = synapse.EventHubDataFormat(v.(string))
+	}
+
+	if v, ok := d.GetOk("identity_id"); ok {
+		props.ManagedIdentityResourceID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mapping_rule_name"); ok {
+		props.MappingRuleName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_name"); ok {
+		props.TableName = utils.String(v.(string))
+	}
+
+	dataConnection := synapse.EventHubDataConnection{
+		Location:                     synapseKustoPoolLocation(kustoPool),
+		EventHubConnectionProperties: props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName, dataConnection)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSynapseKustoPoolEventHubDataConnectionRead(d, meta)
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolDataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Value == nil {
+		return fmt.Errorf("retrieving %s: response was nil", *id)
+	}
+
+	dataConnection, ok := resp.Value.AsEventHubDataConnection()
+	if !ok {
+		return fmt.Errorf("retrieving %s: expected an EventHub data connection", *id)
+	}
+
+	d.Set("name", id.DataConnectionName)
+	d.Set("kusto_pool_database_id", parse.NewKustoPoolDatabaseID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName).ID())
+
+	if props := dataConnection.EventHubConnectionProperties; props != nil {
+		eventHubId := ""
+		if props.EventHubResourceID != nil {
+			parsed, err := eventhubs.ParseEventhubIDInsensitively(*props.EventHubResourceID)
+			if err != nil {
+				return fmt.Errorf("parsing `eventhub_id`: %+v", err)
+			}
+			eventHubId = parsed.ID()
+		}
+		d.Set("eventhub_id", eventHubId)
+		d.Set("consumer_group", props.ConsumerGroup)
+		d.Set("compression", string(props.Compression))
+		d.Set("data_format", string(props.DataFormat))
+		d.Set("event_system_properties", utils.FlattenStringSlice(props.EventSystemProperties))
+		d.Set("identity_id", props.ManagedIdentityResourceID)
+		d.Set("mapping_rule_name", props.MappingRuleName)
+		d.Set("table_name", props.TableName)
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolEventHubDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolDataConnectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolDataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func synapseKustoPoolEventHubDataFormats() []string {
+	formats := make([]string, 0)
+	for _, v := range synapse.PossibleEventHubDataFormatValues() {
+		formats = append(formats, string(v))
+	}
+	return formats
+}
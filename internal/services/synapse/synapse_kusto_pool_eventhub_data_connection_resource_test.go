@@ -0,0 +1,148 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseKustoPoolEventHubDataConnectionResource struct{}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := SynapseKustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := SynapseKustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseKustoPoolEventHubDataConnection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_eventhub_data_connection", "test")
+	r := SynapseKustoPoolEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SynapseKustoPoolEventHubDataConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KustoPoolDataConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Synapse.KustoPoolDataConnectionClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName, id.DatabaseName, id.DataConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SynapseKustoPoolEventHubDataConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "test" {
+  name                   = "acctestkpdc-%d"
+  kusto_pool_database_id = azurerm_synapse_kusto_pool_database.test.id
+  eventhub_id            = azurerm_eventhub.test.id
+  consumer_group         = azurerm_eventhub_consumer_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SynapseKustoPoolEventHubDataConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "import" {
+  name                   = azurerm_synapse_kusto_pool_eventhub_data_connection.test.name
+  kusto_pool_database_id = azurerm_synapse_kusto_pool_eventhub_data_connection.test.kusto_pool_database_id
+  eventhub_id            = azurerm_synapse_kusto_pool_eventhub_data_connection.test.eventhub_id
+  consumer_group         = azurerm_synapse_kusto_pool_eventhub_data_connection.test.consumer_group
+}
+`, r.basic(data))
+}
+
+func (r SynapseKustoPoolEventHubDataConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_eventhub_data_connection" "test" {
+  name                    = "acctestkpdc-%d"
+  kusto_pool_database_id  = azurerm_synapse_kusto_pool_database.test.id
+  eventhub_id             = azurerm_eventhub.test.id
+  consumer_group          = azurerm_eventhub_consumer_group.test.name
+  compression             = "GZip"
+  data_format             = "JSON"
+  event_system_properties = ["x-opt-publisher"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SynapseKustoPoolEventHubDataConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 1
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, SynapseKustoPoolDatabaseResource{}.basic(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
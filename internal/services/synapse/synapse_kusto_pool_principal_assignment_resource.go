@@ -0,0 +1,202 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPoolPrincipalAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolPrincipalAssignmentCreate,
+		Read:   resourceSynapseKustoPoolPrincipalAssignmentRead,
+		Delete: resourceSynapseKustoPoolPrincipalAssignmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.KustoPoolPrincipalAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: kustoValidate.ClusterPrincipalAssignmentName,
+			},
+
+			"kusto_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KustoPoolID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"principal_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(synapse.PrincipalTypeApp),
+					string(synapse.PrincipalTypeGroup),
+					string(synapse.PrincipalTypeUser),
+				}, false),
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(synapse.ClusterPrincipalRoleAllDatabasesAdmin),
+					string(synapse.ClusterPrincipalRoleAllDatabasesViewer),
+				}, false),
+			},
+
+			"principal_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tenant_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSynapseKustoPoolPrincipalAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolPrincipalAssignmentClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	kustoPoolId, err := parse.KustoPoolID(d.Get("kusto_pool_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `kusto_pool_id`: %+v", err)
+	}
+
+	id := parse.NewKustoPoolPrincipalAssignmentID(kustoPoolId.SubscriptionId, kustoPoolId.ResourceGroup, kustoPoolId.WorkspaceName, kustoPoolId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName, id.ResourceGroup)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_synapse_kusto_pool_principal_assignment", id.ID())
+	}
+
+	principalAssignment := synapse.ClusterPrincipalAssignment{
+		ClusterPrincipalProperties: &synapse.ClusterPrincipalProperties{
+			TenantID:      utils.String(d.Get("tenant_id").(string)),
+			PrincipalID:   utils.String(d.Get("principal_id").(string)),
+			PrincipalType: synapse.PrincipalType(d.Get("principal_type").(string)),
+			Role:          synapse.ClusterPrincipalRole(d.Get("role").(string)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName, id.ResourceGroup, principalAssignment)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSynapseKustoPoolPrincipalAssignmentRead(d, meta)
+}
+
+func resourceSynapseKustoPoolPrincipalAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolPrincipalAssignmentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolPrincipalAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName, id.ResourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PrincipalAssignmentName)
+	d.Set("kusto_pool_id", parse.NewKustoPoolID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.KustoPoolName).ID())
+
+	if props := resp.ClusterPrincipalProperties; props != nil {
+		d.Set("tenant_id", props.TenantID)
+		d.Set("tenant_name", props.TenantName)
+		d.Set("principal_id", props.PrincipalID)
+		d.Set("principal_name", props.PrincipalName)
+		d.Set("principal_type", string(props.PrincipalType))
+		d.Set("role", string(props.Role))
+	}
+
+	return nil
+}
+
+func resourceSynapseKustoPoolPrincipalAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolPrincipalAssignmentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolPrincipalAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName, id.ResourceGroup)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
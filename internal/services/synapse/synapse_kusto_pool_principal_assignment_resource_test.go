@@ -0,0 +1,98 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseKustoPoolPrincipalAssignmentResource struct{}
+
+func TestAccSynapseKustoPoolPrincipalAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_principal_assignment", "test")
+	r := SynapseKustoPoolPrincipalAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPoolPrincipalAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool_principal_assignment", "test")
+	r := SynapseKustoPoolPrincipalAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SynapseKustoPoolPrincipalAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KustoPoolPrincipalAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Synapse.KustoPoolPrincipalAssignmentClient.Get(ctx, id.WorkspaceName, id.KustoPoolName, id.PrincipalAssignmentName, id.ResourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SynapseKustoPoolPrincipalAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_kusto_pool_principal_assignment" "test" {
+  name          = "acctestkppa%d"
+  kusto_pool_id = azurerm_synapse_kusto_pool.test.id
+
+  tenant_id      = data.azurerm_client_config.current.tenant_id
+  principal_id   = data.azurerm_client_config.current.client_id
+  principal_type = "App"
+  role           = "AllDatabasesViewer"
+}
+`, SynapseKustoPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SynapseKustoPoolPrincipalAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool_principal_assignment" "import" {
+  name          = azurerm_synapse_kusto_pool_principal_assignment.test.name
+  kusto_pool_id = azurerm_synapse_kusto_pool_principal_assignment.test.kusto_pool_id
+
+  tenant_id      = azurerm_synapse_kusto_pool_principal_assignment.test.tenant_id
+  principal_id   = azurerm_synapse_kusto_pool_principal_assignment.test.principal_id
+  principal_type = azurerm_synapse_kusto_pool_principal_assignment.test.principal_type
+  role           = azurerm_synapse_kusto_pool_principal_assignment.test.role
+}
+`, r.basic(data))
+}
@@ -0,0 +1,370 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSynapseKustoPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseKustoPoolCreateUpdate,
+		Read:   resourceSynapseKustoPoolRead,
+		Update: resourceSynapseKustoPoolCreateUpdate,
+		Delete: resourceSynapseKustoPoolDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.KustoPoolID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KustoPoolName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(synapse.SkuNameComputeoptimized),
+								string(synapse.SkuNameStorageoptimized),
+							}, false),
+						},
+
+						"size": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(synapse.SkuSizeExtrasmall),
+								string(synapse.SkuSizeSmall),
+								string(synapse.SkuSizeMedium),
+								string(synapse.SkuSizeLarge),
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+					},
+				},
+			},
+
+			"optimized_auto_scale": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"minimum_instances": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+
+						"maximum_instances": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 1000),
+						},
+					},
+				},
+			},
+
+			"purge_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"streaming_ingestion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"data_ingestion_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"workspace_uid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceSynapseKustoPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolClient
+	workspaceClient := meta.(*clients.Client).Synapse.WorkspaceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `synapse_workspace_id`: %+v", err)
+	}
+
+	id := parse.NewKustoPoolID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.WorkspaceName, id.Name, id.ResourceGroup)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_synapse_kusto_pool", id.ID())
+		}
+	}
+
+	workspace, err := workspaceClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	sku, err := expandSynapseKustoPoolSku(d.Get("sku").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	optimizedAutoScale := expandSynapseKustoPoolOptimizedAutoScale(d.Get("optimized_auto_scale").([]interface{}))
+	if optimizedAutoScale != nil && *optimizedAutoScale.IsEnabled {
+		if *optimizedAutoScale.Minimum > *optimizedAutoScale.Maximum {
+			return fmt.Errorf("`optimized_auto_scale.minimum_instances` must be less than or equal to `optimized_auto_scale.maximum_instances`")
+		}
+
+		// the capacity must be within the autoscale range
+		if sku.Capacity == nil || *sku.Capacity < *optimizedAutoScale.Minimum || *sku.Capacity > *optimizedAutoScale.Maximum {
+			sku.Capacity = optimizedAutoScale.Minimum
+		}
+	}
+
+	kustoPool := synapse.KustoPool{
+		Location: workspace.Location,
+		Sku:      sku,
+		KustoPoolProperties: &synapse.KustoPoolProperties{
+			OptimizedAutoscale:    optimizedAutoScale,
+			EnablePurge:           utils.Bool(d.Get("purge_enabled").(bool)),
+			EnableStreamingIngest: utils.Bool(d.Get("streaming_ingestion_enabled").(bool)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.WorkspaceName, id.ResourceGroup, id.Name, kustoPool, "", "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSynapseKustoPoolRead(d, meta)
+}
+
+func resourceSynapseKustoPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.WorkspaceName, id.Name, id.ResourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if err := d.Set("sku", flattenSynapseKustoPoolSku(resp.Sku)); err != nil {
+		return fmt.Errorf("setting `sku`: %+v", err)
+	}
+
+	if props := resp.KustoPoolProperties; props != nil {
+		if err := d.Set("optimized_auto_scale", flattenSynapseKustoPoolOptimizedAutoScale(props.OptimizedAutoscale)); err != nil {
+			return fmt.Errorf("setting `optimized_auto_scale`: %+v", err)
+		}
+
+		d.Set("purge_enabled", props.EnablePurge)
+		d.Set("streaming_ingestion_enabled", props.EnableStreamingIngest)
+		d.Set("data_ingestion_uri", props.DataIngestionURI)
+		d.Set("uri", props.URI)
+		d.Set("workspace_uid", props.WorkspaceUID)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceSynapseKustoPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.KustoPoolClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.WorkspaceName, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandSynapseKustoPoolSku(input []interface{}) (*synapse.AzureSku, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	name := v["name"].(string)
+	size := v["size"].(string)
+	if name == string(synapse.SkuNameStorageoptimized) && size == string(synapse.SkuSizeExtrasmall) {
+		return nil, fmt.Errorf("`sku.size` %q is only supported when `sku.name` is %q", size, synapse.SkuNameComputeoptimized)
+	}
+
+	sku := &synapse.AzureSku{
+		Name: synapse.SkuName(name),
+		Size: synapse.SkuSize(size),
+	}
+
+	if capacity := v["capacity"].(int); capacity > 0 {
+		sku.Capacity = utils.Int32(int32(capacity))
+	}
+
+	return sku, nil
+}
+
+func flattenSynapseKustoPoolSku(input *synapse.AzureSku) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	var capacity int32
+	if input.Capacity != nil {
+		capacity = *input.Capacity
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     string(input.Name),
+			"size":     string(input.Size),
+			"capacity": capacity,
+		},
+	}
+}
+
+func expandSynapseKustoPoolOptimizedAutoScale(input []interface{}) *synapse.OptimizedAutoscale {
+	if len(input) == 0 || input[0] == nil {
+		return &synapse.OptimizedAutoscale{
+			Version:   utils.Int32(1),
+			IsEnabled: utils.Bool(false),
+			Minimum:   utils.Int32(0),
+			Maximum:   utils.Int32(0),
+		}
+	}
+	v := input[0].(map[string]interface{})
+
+	return &synapse.OptimizedAutoscale{
+		Version:   utils.Int32(1),
+		IsEnabled: utils.Bool(true),
+		Minimum:   utils.Int32(int32(v["minimum_instances"].(int))),
+		Maximum:   utils.Int32(int32(v["maximum_instances"].(int))),
+	}
+}
+
+func flattenSynapseKustoPoolOptimizedAutoScale(input *synapse.OptimizedAutoscale) []interface{} {
+	if input == nil || input.IsEnabled == nil || !*input.IsEnabled {
+		return make([]interface{}, 0)
+	}
+
+	var minimum, maximum int32
+	if input.Minimum != nil {
+		minimum = *input.Minimum
+	}
+	if input.Maximum != nil {
+		maximum = *input.Maximum
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"minimum_instances": minimum,
+			"maximum_instances": maximum,
+		},
+	}
+}
+
+// child resources of a Kusto Pool have to be created in the same location as the Kusto Pool
+func synapseKustoPoolLocation(pool synapse.KustoPool) *string {
+	if pool.Location == nil {
+		return nil
+	}
+	return utils.String(location.Normalize(*pool.Location))
+}
@@ -0,0 +1,209 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseKustoPoolResource struct{}
+
+func TestAccSynapseKustoPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool", "test")
+	r := SynapseKustoPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uri").Exists(),
+				check.That(data.ResourceName).Key("data_ingestion_uri").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool", "test")
+	r := SynapseKustoPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSynapseKustoPool_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool", "test")
+	r := SynapseKustoPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseKustoPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_kusto_pool", "test")
+	r := SynapseKustoPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SynapseKustoPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KustoPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Synapse.KustoPoolClient.Get(ctx, id.WorkspaceName, id.Name, id.ResourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SynapseKustoPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool" "test" {
+  name                 = "acckp%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  sku {
+    name     = "Compute optimized"
+    size     = "Extra small"
+    capacity = 2
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r SynapseKustoPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool" "import" {
+  name                 = azurerm_synapse_kusto_pool.test.name
+  synapse_workspace_id = azurerm_synapse_kusto_pool.test.synapse_workspace_id
+
+  sku {
+    name     = "Compute optimized"
+    size     = "Extra small"
+    capacity = 2
+  }
+}
+`, r.basic(data))
+}
+
+func (r SynapseKustoPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_kusto_pool" "test" {
+  name                        = "acckp%s"
+  synapse_workspace_id        = azurerm_synapse_workspace.test.id
+  purge_enabled               = true
+  streaming_ingestion_enabled = true
+
+  sku {
+    name = "Compute optimized"
+    size = "Extra small"
+  }
+
+  optimized_auto_scale {
+    minimum_instances = 2
+    maximum_instances = 3
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r SynapseKustoPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "BlobStorage"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomInteger)
+}
@@ -48,7 +48,7 @@ func resourceSynapseRoleAssignment() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id"},
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
 				ValidateFunc: validate.WorkspaceID,
 			},
 
@@ -56,10 +56,18 @@ func resourceSynapseRoleAssignment() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id"},
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
 				ValidateFunc: validate.SparkPoolID,
 			},
 
+			"synapse_linked_service_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
+				ValidateFunc: validate.LinkedServiceID,
+			},
+
 			"principal_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -107,6 +115,8 @@ func resourceSynapseRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interfa
 		synapseScope = v.(string)
 	} else if v, ok := d.GetOk("synapse_spark_pool_id"); ok {
 		synapseScope = v.(string)
+	} else if v, ok := d.GetOk("synapse_linked_service_id"); ok {
+		synapseScope = v.(string)
 	}
 
 	workspaceName, scope, err := parse.SynapseScope(synapseScope)
@@ -224,14 +234,18 @@ func resourceSynapseRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface
 
 	synapseWorkspaceId := ""
 	synapseSparkPoolId := ""
+	synapseLinkedServiceId := ""
 	if _, err := parse.WorkspaceIDInsensitively(id.Scope); err == nil {
 		synapseWorkspaceId = id.Scope
 	} else if _, err := parse.SparkPoolIDInsensitively(id.Scope); err == nil {
 		synapseSparkPoolId = id.Scope
+	} else if _, err := parse.LinkedServiceIDInsensitively(id.Scope); err == nil {
+		synapseLinkedServiceId = id.Scope
 	}
 
 	d.Set("synapse_workspace_id", synapseWorkspaceId)
 	d.Set("synapse_spark_pool_id", synapseSparkPoolId)
+	d.Set("synapse_linked_service_id", synapseLinkedServiceId)
 
 	if resp.RoleDefinitionID != nil {
 		role, err := roleDefinitionsClient.GetRoleDefinitionByID(ctx, resp.RoleDefinitionID.String())
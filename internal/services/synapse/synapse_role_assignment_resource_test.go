@@ -60,6 +60,21 @@ func TestAccSynapseRoleAssignment_sparkPool(t *testing.T) {
 	})
 }
 
+func TestAccSynapseRoleAssignment_linkedService(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_role_assignment", "test")
+	r := SynapseRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedService(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SynapseRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RoleAssignmentID(state.ID)
 	if err != nil {
@@ -143,6 +158,32 @@ resource "azurerm_synapse_role_assignment" "test" {
 `, template, data.RandomString)
 }
 
+func (r SynapseRoleAssignmentResource) linkedService(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_linked_service" "test" {
+  name                 = "acctestls%d"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  type                 = "AzureBlobStorage"
+  type_properties_json = <<JSON
+{
+  "connectionString": "${azurerm_storage_account.test.primary_connection_string}"
+}
+JSON
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+
+resource "azurerm_synapse_role_assignment" "test" {
+  synapse_linked_service_id = azurerm_synapse_linked_service.test.id
+  role_name                 = "Synapse Credential User"
+  principal_id              = data.azurerm_client_config.current.object_id
+}
+`, template, data.RandomInteger)
+}
+
 func (r SynapseRoleAssignmentResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,150 @@
+package synapse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/2020-08-01-preview/accesscontrol"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceSynapseRoleDefinitions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSynapseRoleDefinitionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"synapse_spark_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
+				ValidateFunc: validate.SparkPoolID,
+			},
+
+			"synapse_linked_service_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"synapse_workspace_id", "synapse_spark_pool_id", "synapse_linked_service_id"},
+				ValidateFunc: validate.LinkedServiceID,
+			},
+
+			"role_definitions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"built_in": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"scopes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSynapseRoleDefinitionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	env := meta.(*clients.Client).Account.Environment
+	synapseDomainSuffix, ok := env.Synapse.DomainSuffix()
+	if !ok {
+		return fmt.Errorf("could not determine the domain suffix for synapse in environment %q: %+v", env.Name, env.Storage)
+	}
+
+	synapseScope := ""
+	if v, ok := d.GetOk("synapse_workspace_id"); ok {
+		synapseScope = v.(string)
+	} else if v, ok := d.GetOk("synapse_spark_pool_id"); ok {
+		synapseScope = v.(string)
+	} else if v, ok := d.GetOk("synapse_linked_service_id"); ok {
+		synapseScope = v.(string)
+	}
+
+	workspaceName, scope, err := parse.SynapseScope(synapseScope)
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.RoleDefinitionsClient(workspaceName, *synapseDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListRoleDefinitions(ctx, nil, scope)
+	if err != nil {
+		return fmt.Errorf("listing Synapse Role Definitions for scope %q (workspace %q): %+v", scope, workspaceName, err)
+	}
+
+	d.SetId(synapseScope)
+
+	if err := d.Set("role_definitions", flattenSynapseRoleDefinitions(resp.Value)); err != nil {
+		return fmt.Errorf("setting `role_definitions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenSynapseRoleDefinitions(input *[]accesscontrol.SynapseRoleDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = item.ID.String()
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":          id,
+			"name":        utils.NormalizeNilableString(item.Name),
+			"description": utils.NormalizeNilableString(item.Description),
+			"built_in":    utils.NormaliseNilableBool(item.IsBuiltIn),
+			"scopes":      utils.FlattenStringSlice(item.Scopes),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,38 @@
+package synapse_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SynapseRoleDefinitionsDataSource struct{}
+
+func TestAccDataSourceSynapseRoleDefinitions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_synapse_role_definitions", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: SynapseRoleDefinitionsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_definitions.#").Exists(),
+				check.That(data.ResourceName).Key("role_definitions.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (d SynapseRoleDefinitionsDataSource) basic(data acceptance.TestData) string {
+	config := SynapseRoleAssignmentResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_synapse_role_definitions" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+
+  depends_on = [azurerm_synapse_firewall_rule.test]
+}
+`, config)
+}
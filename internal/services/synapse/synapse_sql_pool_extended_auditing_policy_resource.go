@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/gofrs/uuid"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
@@ -56,6 +57,12 @@ func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"storage_account_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"storage_account_access_key_is_secondary": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -75,6 +82,8 @@ func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(synapseExtendedAuditingPolicyTargetDiff),
 	}
 }
 
@@ -120,6 +129,16 @@ func resourceSynapseSqlPoolExtendedAuditingPolicyCreateUpdate(d *pluginsdk.Resou
 		params.ExtendedSQLPoolBlobAuditingPolicyProperties.StorageAccountAccessKey = utils.String(v.(string))
 	}
 
+	// when no access key is specified the workspace's Managed Identity is used to authenticate against the Storage Account,
+	// which may live in a different subscription to the workspace
+	if v, ok := d.GetOk("storage_account_subscription_id"); ok {
+		u, err := uuid.FromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing `storage_account_subscription_id` %q as a UUID: %+v", v.(string), err)
+		}
+		params.ExtendedSQLPoolBlobAuditingPolicyProperties.StorageAccountSubscriptionID = &u
+	}
+
 	_, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, params)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -156,6 +175,12 @@ func resourceSynapseSqlPoolExtendedAuditingPolicyRead(d *pluginsdk.ResourceData,
 	if props := resp.ExtendedSQLPoolBlobAuditingPolicyProperties; props != nil {
 		d.Set("storage_endpoint", props.StorageEndpoint)
 		d.Set("storage_account_access_key_is_secondary", props.IsStorageSecondaryKeyInUse)
+
+		storageAccountSubscriptionId := ""
+		if props.StorageAccountSubscriptionID != nil && *props.StorageAccountSubscriptionID != uuid.Nil {
+			storageAccountSubscriptionId = props.StorageAccountSubscriptionID.String()
+		}
+		d.Set("storage_account_subscription_id", storageAccountSubscriptionId)
 		d.Set("retention_in_days", props.RetentionDays)
 		d.Set("log_monitoring_enabled", props.IsAzureMonitorTargetEnabled)
 	}
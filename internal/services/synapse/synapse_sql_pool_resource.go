@@ -229,6 +229,11 @@ func resourceSynapseSqlPoolCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		if parseErr != nil {
 			return fmt.Errorf("parsing time format: %+v", parseErr)
 		}
+		if sourceSqlPoolId, err := parse.SqlPoolID(v["source_database_id"].(string)); err == nil {
+			if err := validateSynapseSqlPoolRestorePointInTime(ctx, meta.(*clients.Client).Synapse.SqlPoolRestorePointsClient, *sourceSqlPoolId, vTime); err != nil {
+				return err
+			}
+		}
 		sqlPoolInfo.SQLPoolResourceProperties.RestorePointInTime = &date.Time{Time: vTime}
 		sqlPoolInfo.SQLPoolResourceProperties.SourceDatabaseID = utils.String(sourceDatabaseId)
 	}
@@ -444,6 +449,30 @@ func synapseSqlPoolScaleStateRefreshFunc(ctx context.Context, client *synapse.SQ
 	}
 }
 
+// validateSynapseSqlPoolRestorePointInTime checks the requested point in time against the restore points which are
+// available for the source SQL Pool, so that an invalid value is surfaced before the restore operation is started
+func validateSynapseSqlPoolRestorePointInTime(ctx context.Context, client *synapse.SQLPoolRestorePointsClient, sourceId parse.SqlPoolId, pointInTime time.Time) error {
+	restorePoints, err := listSynapseSqlPoolRestorePoints(ctx, client, sourceId)
+	if err != nil {
+		return err
+	}
+
+	earliest := earliestSynapseSqlPoolRestorePointInTime(restorePoints)
+	if earliest == nil {
+		return fmt.Errorf("no restore points are available for %s yet", sourceId)
+	}
+
+	if pointInTime.Before(*earliest) {
+		return fmt.Errorf("`point_in_time` (%s) must not be before the earliest restore point of %s (%s)", pointInTime.Format(time.RFC3339), sourceId, earliest.Format(time.RFC3339))
+	}
+
+	if pointInTime.After(time.Now()) {
+		return fmt.Errorf("`point_in_time` (%s) must not be in the future", pointInTime.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
 // sqlPool backend service is a proxy to sql database
 // backend service restore and backup only accept id format of sql database
 // so if the id is sqlPool, we need to construct the corresponding sql database id
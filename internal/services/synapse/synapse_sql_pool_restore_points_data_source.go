@@ -0,0 +1,180 @@
+package synapse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceSynapseSqlPoolRestorePoints() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSynapseSqlPoolRestorePointsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"sql_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SqlPoolID,
+			},
+
+			"earliest_restore_point_in_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"restore_points": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"earliest_restore_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"label": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSynapseSqlPoolRestorePointsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Synapse.SqlPoolRestorePointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlPoolID(d.Get("sql_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	restorePoints, err := listSynapseSqlPoolRestorePoints(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("sql_pool_id", id.ID())
+
+	earliestRestorePointInTime := ""
+	if earliest := earliestSynapseSqlPoolRestorePointInTime(restorePoints); earliest != nil {
+		earliestRestorePointInTime = earliest.Format(time.RFC3339)
+	}
+	d.Set("earliest_restore_point_in_time", earliestRestorePointInTime)
+
+	if err := d.Set("restore_points", flattenSynapseSqlPoolRestorePoints(restorePoints)); err != nil {
+		return fmt.Errorf("setting `restore_points`: %+v", err)
+	}
+
+	return nil
+}
+
+func listSynapseSqlPoolRestorePoints(ctx context.Context, client *synapse.SQLPoolRestorePointsClient, id parse.SqlPoolId) ([]synapse.RestorePoint, error) {
+	results := make([]synapse.RestorePoint, 0)
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("listing restore points for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing restore points for %s: %+v", id, err)
+		}
+	}
+
+	return results, nil
+}
+
+// earliestSynapseSqlPoolRestorePointInTime returns the earliest time the SQL Pool can be restored to, or nil when
+// there are no restore points available yet
+func earliestSynapseSqlPoolRestorePointInTime(input []synapse.RestorePoint) *time.Time {
+	var earliest *time.Time
+	for _, item := range input {
+		props := item.RestorePointProperties
+		if props == nil {
+			continue
+		}
+
+		candidate := props.EarliestRestoreDate
+		if candidate == nil {
+			candidate = props.RestorePointCreationDate
+		}
+		if candidate == nil {
+			continue
+		}
+
+		if earliest == nil || candidate.Time.Before(*earliest) {
+			v := candidate.Time
+			earliest = &v
+		}
+	}
+
+	return earliest
+}
+
+func flattenSynapseSqlPoolRestorePoints(input []synapse.RestorePoint) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		var restorePointType, creationTime, earliestRestoreTime, label string
+		if props := item.RestorePointProperties; props != nil {
+			restorePointType = string(props.RestorePointType)
+			if props.RestorePointCreationDate != nil {
+				creationTime = props.RestorePointCreationDate.Format(time.RFC3339)
+			}
+			if props.EarliestRestoreDate != nil {
+				earliestRestoreTime = props.EarliestRestoreDate.Format(time.RFC3339)
+			}
+			if props.RestorePointLabel != nil {
+				label = *props.RestorePointLabel
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                  name,
+			"type":                  restorePointType,
+			"creation_time":         creationTime,
+			"earliest_restore_time": earliestRestoreTime,
+			"label":                 label,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,36 @@
+package synapse_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SynapseSqlPoolRestorePointsDataSource struct{}
+
+func TestAccDataSourceSynapseSqlPoolRestorePoints_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_synapse_sql_pool_restore_points", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: SynapseSqlPoolRestorePointsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sql_pool_id").Exists(),
+				check.That(data.ResourceName).Key("restore_points.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d SynapseSqlPoolRestorePointsDataSource) basic(data acceptance.TestData) string {
+	config := SynapseSqlPoolResource{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_synapse_sql_pool_restore_points" "test" {
+  sql_pool_id = azurerm_synapse_sql_pool.test.id
+}
+`, config)
+}
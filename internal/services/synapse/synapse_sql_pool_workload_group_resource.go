@@ -1,8 +1,10 @@
 package synapse
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
@@ -86,6 +88,70 @@ func resourceSynapseSQLPoolWorkloadGroup() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+
+			"classifier": {
+				Type:       pluginsdk.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"member_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"end_time": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^\d{2}:\d{2}$`),
+								"The `end_time` is of the `HH:MM` format in UTC time zone",
+							),
+						},
+
+						"importance": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"low",
+								"below_normal",
+								"normal",
+								"above_normal",
+								"high",
+							}, false),
+						},
+
+						"label": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^\d{2}:\d{2}$`),
+								"The `start_time` is of the `HH:MM` format in UTC time zone",
+							),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -136,6 +202,14 @@ func resourceSynapseSQLPoolWorkloadGroupCreateUpdate(d *pluginsdk.ResourceData,
 		return fmt.Errorf("waiting for creation/update of %q: %+v", id, err)
 	}
 
+	if d.HasChange("classifier") {
+		classifiersClient := meta.(*clients.Client).Synapse.SQLPoolWorkloadClassifierClient
+		oldRaw, newRaw := d.GetChange("classifier")
+		if err := updateSynapseSQLPoolWorkloadGroupClassifiers(ctx, classifiersClient, id, oldRaw.(*pluginsdk.Set), newRaw.(*pluginsdk.Set)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceSynapseSQLPoolWorkloadGroupRead(d, meta)
 }
@@ -169,6 +243,23 @@ func resourceSynapseSQLPoolWorkloadGroupRead(d *pluginsdk.ResourceData, meta int
 		d.Set("min_resource_percent_per_request", props.MinResourcePercentPerRequest)
 		d.Set("query_execution_timeout_in_seconds", props.QueryExecutionTimeout)
 	}
+
+	classifiersClient := meta.(*clients.Client).Synapse.SQLPoolWorkloadClassifierClient
+	classifiers, err := classifiersClient.ListComplete(ctx, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.WorkloadGroupName)
+	if err != nil {
+		return fmt.Errorf("listing classifiers for %q: %+v", id, err)
+	}
+	classifierList := make([]interface{}, 0)
+	for classifiers.NotDone() {
+		classifierList = append(classifierList, flattenSynapseSQLPoolWorkloadGroupClassifier(classifiers.Value()))
+		if err := classifiers.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing classifiers for %q: %+v", id, err)
+		}
+	}
+	if err := d.Set("classifier", classifierList); err != nil {
+		return fmt.Errorf("setting `classifier`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -192,3 +283,71 @@ func resourceSynapseSQLPoolWorkloadGroupDelete(d *pluginsdk.ResourceData, meta i
 	}
 	return nil
 }
+
+// updateSynapseSQLPoolWorkloadGroupClassifiers reconciles the classifiers of the Workload Group - the classifiers which are
+// no longer defined are removed before the new and changed ones are created, since a member can only be classified once
+func updateSynapseSQLPoolWorkloadGroupClassifiers(ctx context.Context, client *synapse.SQLPoolWorkloadClassifierClient, id parse.SqlPoolWorkloadGroupId, oldClassifiers, newClassifiers *pluginsdk.Set) error {
+	newNames := make(map[string]bool)
+	for _, raw := range newClassifiers.List() {
+		newNames[raw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for _, raw := range oldClassifiers.List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		if newNames[name] {
+			continue
+		}
+
+		classifierId := parse.NewSqlPoolWorkloadClassifierID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.WorkloadGroupName, name)
+		future, err := client.Delete(ctx, classifierId.ResourceGroup, classifierId.WorkspaceName, classifierId.SqlPoolName, classifierId.WorkloadGroupName, classifierId.WorkloadClassifierName)
+		if err != nil {
+			return fmt.Errorf("deleting %q: %+v", classifierId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of %q: %+v", classifierId, err)
+		}
+	}
+
+	// only the classifiers which were added or changed need to be sent
+	for _, raw := range newClassifiers.Difference(oldClassifiers).List() {
+		v := raw.(map[string]interface{})
+		classifierId := parse.NewSqlPoolWorkloadClassifierID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.WorkloadGroupName, v["name"].(string))
+		parameters := synapse.WorkloadClassifier{
+			WorkloadClassifierProperties: &synapse.WorkloadClassifierProperties{
+				Context:    utils.String(v["context"].(string)),
+				EndTime:    utils.String(v["end_time"].(string)),
+				Importance: utils.String(v["importance"].(string)),
+				Label:      utils.String(v["label"].(string)),
+				MemberName: utils.String(v["member_name"].(string)),
+				StartTime:  utils.String(v["start_time"].(string)),
+			},
+		}
+
+		future, err := client.CreateOrUpdate(ctx, classifierId.ResourceGroup, classifierId.WorkspaceName, classifierId.SqlPoolName, classifierId.WorkloadGroupName, classifierId.WorkloadClassifierName, parameters)
+		if err != nil {
+			return fmt.Errorf("creating/updating %q: %+v", classifierId, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %q: %+v", classifierId, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenSynapseSQLPoolWorkloadGroupClassifier(input synapse.WorkloadClassifier) map[string]interface{} {
+	output := map[string]interface{}{
+		"name": utils.NormalizeNilableString(input.Name),
+	}
+
+	if props := input.WorkloadClassifierProperties; props != nil {
+		output["context"] = utils.NormalizeNilableString(props.Context)
+		output["end_time"] = utils.NormalizeNilableString(props.EndTime)
+		output["importance"] = utils.NormalizeNilableString(props.Importance)
+		output["label"] = utils.NormalizeNilableString(props.Label)
+		output["member_name"] = utils.NormalizeNilableString(props.MemberName)
+		output["start_time"] = utils.NormalizeNilableString(props.StartTime)
+	}
+
+	return output
+}
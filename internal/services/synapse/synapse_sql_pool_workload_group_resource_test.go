@@ -89,6 +89,38 @@ func TestAccSynapseWorkloadGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkloadGroup_classifiers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_sql_pool_workload_group", "test")
+	r := SynapseWorkloadGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.classifiers(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("classifier.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.classifiersUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("classifier.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.classifiersRemoved(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("classifier.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SynapseWorkloadGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SqlPoolWorkloadGroupID(state.ID)
 	if err != nil {
@@ -154,6 +186,74 @@ resource "azurerm_synapse_sql_pool_workload_group" "test" {
 `, template, data.RandomString)
 }
 
+func (r SynapseWorkloadGroupResource) classifiers(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_pool_workload_group" "test" {
+  name                             = "acctestWG%s"
+  sql_pool_id                      = azurerm_synapse_sql_pool.test.id
+  max_resource_percent             = 100
+  min_resource_percent             = 0
+  min_resource_percent_per_request = 3
+
+  classifier {
+    name        = "classifier1"
+    member_name = "dbo"
+    importance  = "high"
+  }
+
+  classifier {
+    name        = "classifier2"
+    member_name = "sa"
+    context     = "ctx"
+    label       = "label"
+    start_time  = "12:00"
+    end_time    = "14:00"
+  }
+}
+`, template, data.RandomString)
+}
+
+func (r SynapseWorkloadGroupResource) classifiersUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_pool_workload_group" "test" {
+  name                             = "acctestWG%s"
+  sql_pool_id                      = azurerm_synapse_sql_pool.test.id
+  max_resource_percent             = 100
+  min_resource_percent             = 0
+  min_resource_percent_per_request = 3
+
+  classifier {
+    name        = "classifier1"
+    member_name = "dbo"
+    importance  = "low"
+  }
+}
+`, template, data.RandomString)
+}
+
+func (r SynapseWorkloadGroupResource) classifiersRemoved(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_sql_pool_workload_group" "test" {
+  name                             = "acctestWG%s"
+  sql_pool_id                      = azurerm_synapse_sql_pool.test.id
+  max_resource_percent             = 100
+  min_resource_percent             = 0
+  min_resource_percent_per_request = 3
+
+  classifier = []
+}
+`, template, data.RandomString)
+}
+
 func (r SynapseWorkloadGroupResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
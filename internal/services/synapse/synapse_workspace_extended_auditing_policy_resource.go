@@ -1,11 +1,13 @@
 package synapse
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/gofrs/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -57,6 +59,12 @@ func resourceSynapseWorkspaceExtendedAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"storage_account_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"storage_account_access_key_is_secondary": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -76,6 +84,8 @@ func resourceSynapseWorkspaceExtendedAuditingPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(synapseExtendedAuditingPolicyTargetDiff),
 	}
 }
 
@@ -121,6 +131,16 @@ func resourceSynapseWorkspaceExtendedAuditingPolicyCreateUpdate(d *pluginsdk.Res
 		params.ExtendedServerBlobAuditingPolicyProperties.StorageAccountAccessKey = utils.String(v.(string))
 	}
 
+	// when no access key is specified the workspace's Managed Identity is used to authenticate against the Storage Account,
+	// which may live in a different subscription to the workspace
+	if v, ok := d.GetOk("storage_account_subscription_id"); ok {
+		u, err := uuid.FromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing `storage_account_subscription_id` %q as a UUID: %+v", v.(string), err)
+		}
+		params.ExtendedServerBlobAuditingPolicyProperties.StorageAccountSubscriptionID = &u
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, params)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -162,6 +182,12 @@ func resourceSynapseWorkspaceExtendedAuditingPolicyRead(d *pluginsdk.ResourceDat
 	if props := resp.ExtendedServerBlobAuditingPolicyProperties; props != nil {
 		d.Set("storage_endpoint", props.StorageEndpoint)
 		d.Set("storage_account_access_key_is_secondary", props.IsStorageSecondaryKeyInUse)
+
+		storageAccountSubscriptionId := ""
+		if props.StorageAccountSubscriptionID != nil && *props.StorageAccountSubscriptionID != uuid.Nil {
+			storageAccountSubscriptionId = props.StorageAccountSubscriptionID.String()
+		}
+		d.Set("storage_account_subscription_id", storageAccountSubscriptionId)
 		d.Set("retention_in_days", props.RetentionDays)
 		d.Set("log_monitoring_enabled", props.IsAzureMonitorTargetEnabled)
 	}
@@ -198,3 +224,21 @@ func resourceSynapseWorkspaceExtendedAuditingPolicyDelete(d *pluginsdk.ResourceD
 
 	return nil
 }
+
+// synapseExtendedAuditingPolicyTargetDiff ensures that at least one audit target is configured - audit logs are either
+// written to a Storage Account or sent to Azure Monitor, from where a Diagnostic Setting routes them to a Log Analytics
+// Workspace and/or an Event Hub.
+func synapseExtendedAuditingPolicyTargetDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the endpoint is commonly interpolated from a Storage Account which may not exist yet
+	if d.NewValueKnown("storage_endpoint") && d.Get("storage_endpoint").(string) == "" {
+		if !d.Get("log_monitoring_enabled").(bool) {
+			return fmt.Errorf("at least one of `storage_endpoint` or `log_monitoring_enabled` must be specified")
+		}
+
+		if d.Get("storage_account_access_key").(string) != "" || d.Get("storage_account_subscription_id").(string) != "" {
+			return fmt.Errorf("`storage_account_access_key` and `storage_account_subscription_id` can only be specified when `storage_endpoint` is set")
+		}
+	}
+
+	return nil
+}
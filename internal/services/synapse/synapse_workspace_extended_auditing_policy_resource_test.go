@@ -104,6 +104,21 @@ func TestAccSynapseWorkspaceExtendedAuditingPolicy_storageAccBehindFireWall(t *t
 	})
 }
 
+func TestAccSynapseWorkspaceExtendedAuditingPolicy_managedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_extended_auditing_policy", "test")
+	r := SynapseWorkspaceExtendedAuditingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSynapseWorkspaceExtendedAuditingPolicy_logAnalytics(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_extended_auditing_policy", "test")
 	r := SynapseWorkspaceExtendedAuditingPolicyResource{}
@@ -330,6 +345,31 @@ resource "azurerm_synapse_workspace_extended_auditing_policy" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SynapseWorkspaceExtendedAuditingPolicyResource) managedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_synapse_workspace.test.identity.0.principal_id
+}
+
+resource "azurerm_synapse_workspace_extended_auditing_policy" "test" {
+  synapse_workspace_id            = azurerm_synapse_workspace.test.id
+  storage_endpoint                = azurerm_storage_account.test.primary_blob_endpoint
+  storage_account_subscription_id = data.azurerm_client_config.current.subscription_id
+  log_monitoring_enabled          = false
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.template(data))
+}
+
 func (r SynapseWorkspaceExtendedAuditingPolicyResource) monitorTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -200,6 +200,7 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 						"last_commit_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"project_name": {
@@ -252,6 +253,7 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 						"last_commit_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"repository_name": {
@@ -569,11 +571,18 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		if !d.Get("public_network_access_enabled").(bool) {
 			publicNetworkAccess = synapse.WorkspacePublicNetworkAccessDisabled
 		}
+
+		repositoryConfiguration := expandWorkspaceRepositoryConfiguration(d)
+		if repositoryConfiguration == nil && d.HasChanges("github_repo", "azure_devops_repo") {
+			// an empty configuration has to be sent to remove the Git integration from the workspace
+			repositoryConfiguration = &synapse.WorkspaceRepositoryConfiguration{}
+		}
+
 		workspacePatchInfo := synapse.WorkspacePatchInfo{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 			WorkspacePatchProperties: &synapse.WorkspacePatchProperties{
 				SQLAdministratorLoginPassword:    utils.String(d.Get("sql_administrator_login_password").(string)),
-				WorkspaceRepositoryConfiguration: expandWorkspaceRepositoryConfiguration(d),
+				WorkspaceRepositoryConfiguration: repositoryConfiguration,
 				Encryption:                       expandEncryptionDetails(d),
 				PublicNetworkAccess:              publicNetworkAccess,
 			},
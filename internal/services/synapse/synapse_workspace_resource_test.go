@@ -123,6 +123,13 @@ func TestAccSynapseWorkspace_azdo(t *testing.T) {
 				check.That(data.ResourceName).Key("azure_devops_repo.0.tenant_id").IsEmpty(),
 			),
 		},
+		{
+			Config: r.withoutRepository(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azure_devops_repo.#").HasValue("0"),
+			),
+		},
 	})
 }
 
@@ -162,6 +169,13 @@ func TestAccSynapseWorkspace_github(t *testing.T) {
 				check.That(data.ResourceName).Key("github_repo.0.root_folder").HasValue("/"),
 			),
 		},
+		{
+			Config: r.withoutRepository(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("github_repo.#").HasValue("0"),
+			),
+		},
 	})
 }
 
@@ -522,6 +536,26 @@ resource "azurerm_synapse_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SynapseWorkspaceResource) withoutRepository(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r SynapseWorkspaceResource) customerManagedKey(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func KustoPoolName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The name attribute rules are :
+	// 1. must contain only lowercase letters or numbers.
+	// 2. must start with a letter.
+	// 3. must be between 4 and 22 characters long
+
+	if !regexp.MustCompile(`^[a-z][a-z\d]{3,21}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s can contain only lowercase letters or numbers, must start with a letter, and be between 4 and 22 characters long", k))
+		return
+	}
+
+	return warnings, errors
+}
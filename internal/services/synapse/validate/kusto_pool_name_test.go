@@ -0,0 +1,63 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestKustoPoolName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "abc123",
+			expected: true,
+		},
+		{
+			// can't contain uppercase letters
+			input:    "aBc123",
+			expected: false,
+		},
+		{
+			// can't contain hyphen
+			input:    "ab-c",
+			expected: false,
+		},
+		{
+			// can't start with a number
+			input:    "123abc",
+			expected: false,
+		},
+		{
+			// 3 chars
+			input:    "abc",
+			expected: false,
+		},
+		{
+			// 22 chars
+			input:    "abcdefghijklmnopqrstuv",
+			expected: true,
+		},
+		{
+			// 23 chars
+			input:    "abcdefghijklmnopqrstuvw",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := KustoPoolName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
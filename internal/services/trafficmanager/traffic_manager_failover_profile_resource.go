@@ -0,0 +1,290 @@
+package trafficmanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2018-08-01/endpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2018-08-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	trafficManagerFailoverPrimaryEndpointName   = "primary"
+	trafficManagerFailoverSecondaryEndpointName = "secondary"
+)
+
+func resourceArmTrafficManagerFailoverProfile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmTrafficManagerFailoverProfileCreateUpdate,
+		Read:   resourceArmTrafficManagerFailoverProfileRead,
+		Update: resourceArmTrafficManagerFailoverProfileCreateUpdate,
+		Delete: resourceArmTrafficManagerFailoverProfileDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := profiles.ParseTrafficManagerProfileID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"dns_config": trafficManagerProfileDNSConfigSchema(),
+
+			"monitor_config": trafficManagerProfileMonitorConfigSchema(),
+
+			"primary_endpoint": trafficManagerFailoverEndpointSchema("primary_endpoint"),
+
+			"secondary_endpoint": trafficManagerFailoverEndpointSchema("secondary_endpoint"),
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func trafficManagerFailoverEndpointSchema(name string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"target": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{name + ".0.target", name + ".0.target_resource_id"},
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"target_resource_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ExactlyOneOf: []string{name + ".0.target", name + ".0.target_resource_id"},
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmTrafficManagerFailoverProfileCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := profiles.NewTrafficManagerProfileID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_traffic_manager_failover_profile", id.ID())
+		}
+	}
+
+	monitorConfig := expandArmTrafficManagerMonitorConfig(d)
+	if *monitorConfig.IntervalInSeconds == int64(10) && *monitorConfig.TimeoutInSeconds == int64(10) {
+		return fmt.Errorf("`timeout_in_seconds` must be between `5` and `9` when `interval_in_seconds` is set to `10`")
+	}
+
+	// the Endpoints are defined inline so that the Profile and both Endpoints are always updated together
+	trafficRoutingMethod := profiles.TrafficRoutingMethodPriority
+	profile := profiles.Profile{
+		Name:     utils.String(id.TrafficManagerProfileName),
+		Location: utils.String("global"), // must be provided in request
+		Properties: &profiles.ProfileProperties{
+			TrafficRoutingMethod: &trafficRoutingMethod,
+			DnsConfig:            expandArmTrafficManagerDNSConfig(d),
+			MonitorConfig:        monitorConfig,
+			Endpoints: &[]profiles.Endpoint{
+				expandArmTrafficManagerFailoverEndpoint(trafficManagerFailoverPrimaryEndpointName, 1, d.Get("primary_endpoint").([]interface{})),
+				expandArmTrafficManagerFailoverEndpoint(trafficManagerFailoverSecondaryEndpointName, 2, d.Get("secondary_endpoint").([]interface{})),
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, profile); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceArmTrafficManagerFailoverProfileRead(d, meta)
+}
+
+func resourceArmTrafficManagerFailoverProfileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseTrafficManagerProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TrafficManagerProfileName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if profile := model.Properties; profile != nil {
+			if profile.TrafficRoutingMethod == nil || *profile.TrafficRoutingMethod != profiles.TrafficRoutingMethodPriority {
+				return fmt.Errorf("%s must use the `Priority` traffic routing method to be managed as a failover profile", *id)
+			}
+
+			d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DnsConfig))
+			d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig))
+
+			primaryEndpoint := make([]interface{}, 0)
+			secondaryEndpoint := make([]interface{}, 0)
+			if profile.Endpoints != nil {
+				for _, endpoint := range *profile.Endpoints {
+					if endpoint.Name == nil {
+						continue
+					}
+
+					switch *endpoint.Name {
+					case trafficManagerFailoverPrimaryEndpointName:
+						primaryEndpoint = flattenArmTrafficManagerFailoverEndpoint(endpoint)
+					case trafficManagerFailoverSecondaryEndpointName:
+						secondaryEndpoint = flattenArmTrafficManagerFailoverEndpoint(endpoint)
+					}
+				}
+			}
+			if err := d.Set("primary_endpoint", primaryEndpoint); err != nil {
+				return fmt.Errorf("setting `primary_endpoint`: %+v", err)
+			}
+			if err := d.Set("secondary_endpoint", secondaryEndpoint); err != nil {
+				return fmt.Errorf("setting `secondary_endpoint`: %+v", err)
+			}
+
+			if dns := profile.DnsConfig; dns != nil {
+				d.Set("fqdn", dns.Fqdn)
+			}
+		}
+		return tags.FlattenAndSet(d, model.Tags)
+	}
+	return nil
+}
+
+func resourceArmTrafficManagerFailoverProfileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseTrafficManagerProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, *id)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmTrafficManagerFailoverEndpoint(name string, priority int64, input []interface{}) profiles.Endpoint {
+	raw := input[0].(map[string]interface{})
+
+	status := profiles.EndpointStatusDisabled
+	if raw["enabled"].(bool) {
+		status = profiles.EndpointStatusEnabled
+	}
+
+	endpoint := profiles.Endpoint{
+		Name: utils.String(name),
+		Properties: &profiles.EndpointProperties{
+			EndpointStatus: &status,
+			Priority:       utils.Int64(priority),
+		},
+	}
+
+	if v := raw["target_resource_id"].(string); v != "" {
+		endpoint.Type = utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", endpoints.EndpointTypeAzureEndpoints))
+		endpoint.Properties.TargetResourceId = utils.String(v)
+	} else {
+		endpoint.Type = utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", endpoints.EndpointTypeExternalEndpoints))
+		endpoint.Properties.Target = utils.String(raw["target"].(string))
+	}
+
+	return endpoint
+}
+
+func flattenArmTrafficManagerFailoverEndpoint(input profiles.Endpoint) []interface{} {
+	target := ""
+	targetResourceId := ""
+	enabled := true
+	if props := input.Properties; props != nil {
+		// the API returns the resolved hostname as the `target` of Azure Endpoints, so it's only set for External Endpoints
+		if input.Type != nil && strings.HasSuffix(strings.ToLower(*input.Type), strings.ToLower(string(endpoints.EndpointTypeExternalEndpoints))) {
+			target = utils.NormalizeNilableString(props.Target)
+		} else {
+			targetResourceId = utils.NormalizeNilableString(props.TargetResourceId)
+		}
+
+		if props.EndpointStatus != nil {
+			enabled = *props.EndpointStatus == profiles.EndpointStatusEnabled
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"target":             target,
+			"target_resource_id": targetResourceId,
+			"enabled":            enabled,
+		},
+	}
+}
@@ -0,0 +1,228 @@
+package trafficmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2018-08-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type TrafficManagerFailoverProfileResource struct{}
+
+func TestAccTrafficManagerFailoverProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_failover_profile", "test")
+	r := TrafficManagerFailoverProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTrafficManagerFailoverProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_failover_profile", "test")
+	r := TrafficManagerFailoverProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTrafficManagerFailoverProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_failover_profile", "test")
+	r := TrafficManagerFailoverProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_endpoint.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TrafficManagerFailoverProfileResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := profiles.ParseTrafficManagerProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.TrafficManager.ProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r TrafficManagerFailoverProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_failover_profile" "test" {
+  name                = "acctest-TMP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "HTTPS"
+    port     = 443
+    path     = "/"
+  }
+
+  primary_endpoint {
+    target = "primary.example.com"
+  }
+
+  secondary_endpoint {
+    target = "secondary.example.com"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r TrafficManagerFailoverProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_traffic_manager_failover_profile" "import" {
+  name                = azurerm_traffic_manager_failover_profile.test.name
+  resource_group_name = azurerm_traffic_manager_failover_profile.test.resource_group_name
+
+  dns_config {
+    relative_name = "acctest-tmp-%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "HTTPS"
+    port     = 443
+    path     = "/"
+  }
+
+  primary_endpoint {
+    target = "primary.example.com"
+  }
+
+  secondary_endpoint {
+    target = "secondary.example.com"
+  }
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (r TrafficManagerFailoverProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  domain_name_label   = "acctestpip-%[1]d"
+}
+
+resource "azurerm_traffic_manager_failover_profile" "test" {
+  name                = "acctest-TMP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 60
+  }
+
+  monitor_config {
+    protocol                     = "HTTP"
+    port                         = 8080
+    path                         = "/health"
+    expected_status_code_ranges  = ["200-202"]
+    interval_in_seconds          = 10
+    timeout_in_seconds           = 5
+    tolerated_number_of_failures = 2
+  }
+
+  primary_endpoint {
+    target_resource_id = azurerm_public_ip.test.id
+  }
+
+  secondary_endpoint {
+    target  = "secondary.example.com"
+    enabled = false
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "app"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 60
+  target_resource_id  = azurerm_traffic_manager_failover_profile.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
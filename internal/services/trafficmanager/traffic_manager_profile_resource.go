@@ -73,103 +73,9 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"dns_config": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"relative_name": {
-							Type:     pluginsdk.TypeString,
-							ForceNew: true,
-							Required: true,
-						},
-						"ttl": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 2147483647),
-						},
-					},
-				},
-			},
-
-			"monitor_config": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"expected_status_code_ranges": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.StatusCodeRange,
-							},
-						},
-
-						"custom_header": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"value": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
+			"dns_config": trafficManagerProfileDNSConfigSchema(),
 
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(profiles.MonitorProtocolHTTP),
-								string(profiles.MonitorProtocolHTTPS),
-								string(profiles.MonitorProtocolTCP),
-							}, false),
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 65535),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval_in_seconds": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntInSlice([]int{10, 30}),
-							Default:      30,
-						},
-
-						"timeout_in_seconds": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(5, 10),
-							Default:      10,
-						},
-
-						"tolerated_number_of_failures": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 9),
-							Default:      3,
-						},
-					},
-				},
-			},
+			"monitor_config": trafficManagerProfileMonitorConfigSchema(),
 
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
@@ -192,6 +98,108 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 	}
 }
 
+func trafficManagerProfileDNSConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"relative_name": {
+					Type:     pluginsdk.TypeString,
+					ForceNew: true,
+					Required: true,
+				},
+				"ttl": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 2147483647),
+				},
+			},
+		},
+	}
+}
+
+func trafficManagerProfileMonitorConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"expected_status_code_ranges": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validate.StatusCodeRange,
+					},
+				},
+
+				"custom_header": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"value": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"protocol": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(profiles.MonitorProtocolHTTP),
+						string(profiles.MonitorProtocolHTTPS),
+						string(profiles.MonitorProtocolTCP),
+					}, false),
+				},
+
+				"port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+
+				"path": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"interval_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntInSlice([]int{10, 30}),
+					Default:      30,
+				},
+
+				"timeout_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(5, 10),
+					Default:      10,
+				},
+
+				"tolerated_number_of_failures": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 9),
+					Default:      3,
+				},
+			},
+		},
+	}
+}
+
 func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).TrafficManager.ProfilesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -32,4 +33,7 @@ func main() {
 			ProviderFunc: provider.AzureProvider,
 		})
 	}
+
+	// spans are exported in batches, so ensure any which are pending are sent before the process exits
+	common.FlushTelemetry()
 }